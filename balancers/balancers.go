@@ -21,6 +21,18 @@ func RandomChoice() *balancerConfig.Config {
 	return &balancerConfig.Config{}
 }
 
+// PreferLowestLatency creates balancer which tracks exponentially weighted moving averages
+// of latency and error rate of calls per endpoint and shifts traffic away from slow or failing endpoints.
+// Endpoint selects as better of two random endpoints.
+// Balancer can be combined with location preferences (such as PreferNearestDC(PreferLowestLatency()))
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PreferLowestLatency() *balancerConfig.Config {
+	return &balancerConfig.Config{
+		PreferLowestLatency: true,
+	}
+}
+
 func SingleConn() *balancerConfig.Config {
 	return &balancerConfig.Config{
 		SingleConn: true,
@@ -70,12 +70,22 @@ func createByType(t balancerType) (*balancerConfig.Config, error) {
 	}
 }
 
+// shorthands of balancers with preferred endpoints
+const (
+	preferLocal   = "prefer_local"
+	preferNearest = "prefer_nearest"
+)
+
 func CreateFromConfig(s string) (*balancerConfig.Config, error) {
 	// try to parse s as identifier of balancer
 	if c, err := createByType(balancerType(s)); err == nil {
 		return c, nil
 	}
 
+	if s == preferLocal || s == preferNearest {
+		return PreferNearestDCWithFallBack(RandomChoice()), nil
+	}
+
 	var (
 		b   *balancerConfig.Config
 		err error
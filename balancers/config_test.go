@@ -91,6 +91,18 @@ func TestFromConfig(t *testing.T) {
 				}),
 			},
 		},
+		{
+			name:   "prefer_local",
+			config: `prefer_local`,
+			res: balancerConfig.Config{
+				AllowFallback:   true,
+				DetectNearestDC: true,
+				Filter: filterFunc(func(info balancerConfig.Info, e endpoint.Info) bool {
+					// some non nil func
+					return false
+				}),
+			},
+		},
 		{
 			name: "prefer_unknown_type",
 			config: `{
@@ -6,6 +6,26 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 )
 
+// NodeFallback defines behaviour of client balancer if node from context (WithNodeID)
+// is unavailable
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type NodeFallback = endpoint.NodeFallback
+
+const (
+	// NodeFallbackAnyNode selects any available node (default behaviour)
+	NodeFallbackAnyNode = endpoint.NodeFallbackAnyNode
+
+	// NodeFallbackSameLocation selects available node from location of preferred node.
+	// If location has no available nodes - selects any available node
+	NodeFallbackSameLocation = endpoint.NodeFallbackSameLocation
+
+	// NodeFallbackNone makes call failed with retryable transport error (codes.Unavailable)
+	// if preferred node is unavailable. Retryer (for example, Client.Do of query or table client)
+	// deletes session on the node and repeats operation with new session
+	NodeFallbackNone = endpoint.NodeFallbackNone
+)
+
 // WithNodeID returns the copy of context with NodeID which the client balancer will
 // prefer on step of choose YDB endpoint step
 //
@@ -13,3 +33,12 @@ import (
 func WithNodeID(ctx context.Context, nodeID uint32) context.Context {
 	return endpoint.WithNodeID(ctx, nodeID)
 }
+
+// WithNodeFallback returns the copy of context with fallback policy of client balancer
+// for calls with preferred node. Calls of query and table sessions are pinned to the node
+// of session (Session.NodeID()) by default.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithNodeFallback(ctx context.Context, fallback NodeFallback) context.Context {
+	return endpoint.WithNodeFallback(ctx, fallback)
+}
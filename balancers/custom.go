@@ -0,0 +1,54 @@
+package balancers
+
+import (
+	"context"
+	"fmt"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+// Balancer is an interface of custom balancing policy (for example zone draining,
+// canary endpoints or maintenance windows).
+//
+// Calls pinned to node of session (query and table sessions, WithNodeID) don't use custom balancer
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Balancer interface {
+	// Choose returns index of endpoint for call from endpoints.
+	// Endpoints contains available endpoints which allowed by filters of balancer
+	// config (Prefer*) or fallback endpoints if preferred endpoints are unavailable.
+	// Error of Choose returns as error of call
+	Choose(ctx context.Context, endpoints []Endpoint) (int, error)
+}
+
+type customChooser struct {
+	balancer Balancer
+}
+
+func (c customChooser) Choose(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+	list := make([]Endpoint, len(endpoints))
+	for i, e := range endpoints {
+		list[i] = e
+	}
+
+	return c.balancer.Choose(ctx, list)
+}
+
+func (c customChooser) String() string {
+	if s, ok := c.balancer.(fmt.Stringer); ok {
+		return s.String()
+	}
+
+	return fmt.Sprintf("%T", c.balancer)
+}
+
+// Custom creates balancer with custom balancing policy.
+// Custom balancer can be combined with filters of endpoints (such as PreferNearestDC(Custom(b)))
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Custom(balancer Balancer) *balancerConfig.Config {
+	return &balancerConfig.Config{
+		Chooser: customChooser{balancer: balancer},
+	}
+}
@@ -0,0 +1,33 @@
+package balancers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+type lastEndpointBalancer struct{}
+
+func (lastEndpointBalancer) Choose(ctx context.Context, endpoints []Endpoint) (int, error) {
+	return len(endpoints) - 1, nil
+}
+
+func TestCustom(t *testing.T) {
+	b := PreferNearestDC(Custom(lastEndpointBalancer{}))
+	require.NotNil(t, b.Chooser)
+	require.True(t, b.DetectNearestDC)
+	require.Equal(t,
+		"Custom{Chooser=balancers.lastEndpointBalancer,DetectNearestDC=true,AllowFallback=false,Filter=LocalDC}",
+		b.String(),
+	)
+
+	index, err := b.Chooser.Choose(context.Background(), []endpoint.Info{
+		endpoint.New("a:2135"),
+		endpoint.New("b:2135"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, index)
+}
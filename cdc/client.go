@@ -0,0 +1,85 @@
+package cdc
+
+import (
+	"context"
+	"path"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+// Client is a client for manage changefeeds of tables and read changes of rows
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Client struct {
+	table func() table.Client
+	topic func() topic.Client
+}
+
+// New makes changefeed client on top of table and topic clients
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func New(tableClient func() table.Client, topicClient func() topic.Client) *Client {
+	return &Client{
+		table: tableClient,
+		topic: topicClient,
+	}
+}
+
+// Create creates changefeed with name on table
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Create(
+	ctx context.Context,
+	tablePath, name string,
+	mode options.ChangefeedMode,
+	format options.ChangefeedFormat,
+	opts ...options.ChangefeedOption,
+) error {
+	return c.alterTable(ctx, tablePath, options.WithAddChangefeed(name, mode, format, opts...))
+}
+
+// Alter alters settings of topic of changefeed (retention, partitioning, consumers, etc.)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Alter(ctx context.Context, tablePath, name string, opts ...topicoptions.AlterOption) error {
+	return xerrors.WithStackTrace(c.topic().Alter(ctx, path.Join(tablePath, name), opts...))
+}
+
+// Drop drops changefeed with name of table
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Drop(ctx context.Context, tablePath, name string) error {
+	return c.alterTable(ctx, tablePath, options.WithDropChangefeed(name))
+}
+
+func (c *Client) alterTable(ctx context.Context, tablePath string, opt options.AlterTableOption) error {
+	return xerrors.WithStackTrace(c.table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.AlterTable(ctx, tablePath, opt)
+	}))
+}
+
+// StartReader starts reader of changes of table from changefeed with name.
+// Consumer should be added to changefeed before read (see Alter and topicoptions.AlterWithAddConsumers)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) StartReader(
+	consumer, tablePath, name string,
+	opts ...topicoptions.ReaderOption,
+) (*Reader, error) {
+	reader, err := c.topic().StartReader(consumer, topicoptions.ReadTopic(path.Join(tablePath, name)), opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &Reader{
+		reader: reader,
+	}, nil
+}
+
+func (c *Client) Close(ctx context.Context) error {
+	return nil
+}
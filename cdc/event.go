@@ -0,0 +1,240 @@
+package cdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// virtual timestamp encodes in JSON records as pair [step, txId]
+const virtualTimestampLen = 2
+
+var errUnknownDebeziumOp = errors.New("unknown op of debezium record")
+
+// Op is a kind of change of row
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Op int
+
+const (
+	OpUnknown Op = iota
+
+	// OpUpsert is an insert or update of row. Changefeeds in JSON format
+	// with UPDATES, NEW_IMAGE and KEYS_ONLY modes not distinguish inserts and updates
+	OpUpsert
+
+	// OpInsert is an insert of row
+	OpInsert
+
+	// OpUpdate is an update of existing row
+	OpUpdate
+
+	// OpDelete is a delete of row
+	OpDelete
+
+	// OpRead is a read of row while initial scan of table
+	OpRead
+
+	// OpResolved is a resolved timestamp marker: all changes with virtual timestamps
+	// before the marker already written to changefeed. Marker has no key and images
+	OpResolved
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpUpsert:
+		return "upsert"
+	case OpInsert:
+		return "insert"
+	case OpUpdate:
+		return "update"
+	case OpDelete:
+		return "delete"
+	case OpRead:
+		return "read"
+	case OpResolved:
+		return "resolved"
+	default:
+		return "unknown"
+	}
+}
+
+// VirtualTimestamp is a global order of changes in database
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type VirtualTimestamp struct {
+	Step uint64
+	TxID uint64
+}
+
+// Less returns true if ts is before other
+func (ts VirtualTimestamp) Less(other VirtualTimestamp) bool {
+	if ts.Step != other.Step {
+		return ts.Step < other.Step
+	}
+
+	return ts.TxID < other.TxID
+}
+
+// Event is a decoded changefeed record.
+// Numbers of key and images decodes as json.Number for precision of 64-bit values
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Event struct {
+	Op Op
+
+	// Key contains values of primary key columns in order of columns in primary key.
+	// Key is empty for DebeziumJSON records (key columns contains in images)
+	Key []interface{}
+
+	// NewImage contains columns of row after change.
+	// For JSON changefeed with UPDATES mode contains changed columns only
+	NewImage map[string]interface{}
+
+	// OldImage contains columns of row before change
+	OldImage map[string]interface{}
+
+	// VirtualTimestamp of change. Nil if virtual timestamps disabled for changefeed
+	VirtualTimestamp *VirtualTimestamp
+
+	message *topicreader.Message
+}
+
+// Message returns source topic message of event
+func (e *Event) Message() *topicreader.Message {
+	return e.message
+}
+
+func decoder(data []byte) *json.Decoder {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	return d
+}
+
+func virtualTimestamp(ts []uint64) *VirtualTimestamp {
+	if len(ts) != virtualTimestampLen {
+		return nil
+	}
+
+	return &VirtualTimestamp{
+		Step: ts[0],
+		TxID: ts[1],
+	}
+}
+
+// Decode decodes record of changefeed in JSON or DebeziumJSON format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Decode(data []byte) (Event, error) {
+	var probe struct {
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return Event{}, xerrors.WithStackTrace(err)
+	}
+	if probe.Payload != nil {
+		return DecodeDebeziumJSON(data)
+	}
+
+	return DecodeJSON(data)
+}
+
+// DecodeJSON decodes record of changefeed in JSON format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DecodeJSON(data []byte) (e Event, _ error) {
+	var record struct {
+		Key      []interface{}          `json:"key"`
+		Update   map[string]interface{} `json:"update"`
+		Erase    json.RawMessage        `json:"erase"`
+		NewImage map[string]interface{} `json:"newImage"`
+		OldImage map[string]interface{} `json:"oldImage"`
+		TS       []uint64               `json:"ts"`
+		Resolved []uint64               `json:"resolved"`
+	}
+	if err := decoder(data).Decode(&record); err != nil {
+		return e, xerrors.WithStackTrace(fmt.Errorf("failed to decode changefeed record: %w", err))
+	}
+
+	if record.Resolved != nil {
+		return Event{
+			Op:               OpResolved,
+			VirtualTimestamp: virtualTimestamp(record.Resolved),
+		}, nil
+	}
+
+	e = Event{
+		Key:              record.Key,
+		NewImage:         record.NewImage,
+		OldImage:         record.OldImage,
+		VirtualTimestamp: virtualTimestamp(record.TS),
+	}
+	switch {
+	case record.Erase != nil:
+		e.Op = OpDelete
+	case record.Update != nil:
+		e.Op = OpUpsert
+		e.NewImage = record.Update
+	case record.NewImage != nil && record.OldImage != nil:
+		e.Op = OpUpdate
+	case record.NewImage != nil:
+		e.Op = OpUpsert
+	case record.OldImage != nil:
+		e.Op = OpDelete
+	default:
+		e.Op = OpUpsert
+	}
+
+	return e, nil
+}
+
+// DecodeDebeziumJSON decodes record of changefeed in DebeziumJSON format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DecodeDebeziumJSON(data []byte) (e Event, _ error) {
+	var record struct {
+		Payload struct {
+			Before map[string]interface{} `json:"before"`
+			After  map[string]interface{} `json:"after"`
+			Op     string                 `json:"op"`
+			Source struct {
+				Step *uint64 `json:"step"`
+				TxID *uint64 `json:"txId"`
+			} `json:"source"`
+		} `json:"payload"`
+	}
+	if err := decoder(data).Decode(&record); err != nil {
+		return e, xerrors.WithStackTrace(fmt.Errorf("failed to decode changefeed record: %w", err))
+	}
+
+	payload := &record.Payload
+	e = Event{
+		NewImage: payload.After,
+		OldImage: payload.Before,
+	}
+	switch payload.Op {
+	case "c":
+		e.Op = OpInsert
+	case "u":
+		e.Op = OpUpdate
+	case "d":
+		e.Op = OpDelete
+	case "r":
+		e.Op = OpRead
+	default:
+		return e, xerrors.WithStackTrace(fmt.Errorf("%w: %q", errUnknownDebeziumOp, payload.Op))
+	}
+	if payload.Source.Step != nil && payload.Source.TxID != nil {
+		e.VirtualTimestamp = &VirtualTimestamp{
+			Step: *payload.Source.Step,
+			TxID: *payload.Source.TxID,
+		}
+	}
+
+	return e, nil
+}
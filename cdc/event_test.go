@@ -0,0 +1,91 @@
+package cdc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		data  string
+		event Event
+	}{
+		{
+			name: "Update",
+			data: `{"update":{"value":"a"},"key":[1,"k"],"ts":[1700000000,281474976710657]}`,
+			event: Event{
+				Op:               OpUpsert,
+				Key:              []interface{}{json.Number("1"), "k"},
+				NewImage:         map[string]interface{}{"value": "a"},
+				VirtualTimestamp: &VirtualTimestamp{Step: 1700000000, TxID: 281474976710657},
+			},
+		},
+		{
+			name: "Erase",
+			data: `{"erase":{},"key":[1]}`,
+			event: Event{
+				Op:  OpDelete,
+				Key: []interface{}{json.Number("1")},
+			},
+		},
+		{
+			name: "NewAndOldImages",
+			data: `{"key":[1],"oldImage":{"value":"a"},"newImage":{"value":"b"}}`,
+			event: Event{
+				Op:       OpUpdate,
+				Key:      []interface{}{json.Number("1")},
+				NewImage: map[string]interface{}{"value": "b"},
+				OldImage: map[string]interface{}{"value": "a"},
+			},
+		},
+		{
+			name: "DeleteWithOldImage",
+			data: `{"key":[1],"oldImage":{"value":"a"}}`,
+			event: Event{
+				Op:       OpDelete,
+				Key:      []interface{}{json.Number("1")},
+				OldImage: map[string]interface{}{"value": "a"},
+			},
+		},
+		{
+			name: "Resolved",
+			data: `{"resolved":[1700000000,18446744073709551615]}`,
+			event: Event{
+				Op:               OpResolved,
+				VirtualTimestamp: &VirtualTimestamp{Step: 1700000000, TxID: 18446744073709551615},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Decode([]byte(tt.data))
+			require.NoError(t, err)
+			require.Equal(t, tt.event, e)
+		})
+	}
+}
+
+func TestDecodeDebeziumJSON(t *testing.T) {
+	data := `{"payload":{"before":{"id":1,"value":"a"},"after":{"id":1,"value":"b"},"op":"u",` +
+		`"source":{"version":"1.0.0","connector":"ydb","ts_ms":1700000000000,"step":1700000000,"txId":42}}}`
+
+	e, err := Decode([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, Event{
+		Op:               OpUpdate,
+		NewImage:         map[string]interface{}{"id": json.Number("1"), "value": "b"},
+		OldImage:         map[string]interface{}{"id": json.Number("1"), "value": "a"},
+		VirtualTimestamp: &VirtualTimestamp{Step: 1700000000, TxID: 42},
+	}, e)
+
+	_, err = DecodeDebeziumJSON([]byte(`{"payload":{"op":"x"}}`))
+	require.ErrorIs(t, err, errUnknownDebeziumOp)
+}
+
+func TestVirtualTimestampLess(t *testing.T) {
+	require.True(t, VirtualTimestamp{Step: 1, TxID: 2}.Less(VirtualTimestamp{Step: 2, TxID: 1}))
+	require.True(t, VirtualTimestamp{Step: 1, TxID: 1}.Less(VirtualTimestamp{Step: 1, TxID: 2}))
+	require.False(t, VirtualTimestamp{Step: 1, TxID: 2}.Less(VirtualTimestamp{Step: 1, TxID: 2}))
+}
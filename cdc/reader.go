@@ -0,0 +1,58 @@
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// Reader reads changes of rows from changefeed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Reader struct {
+	reader *topicreader.Reader
+}
+
+// Read reads and decodes next record of changefeed.
+// Resolved timestamp markers returns as events with OpResolved
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Read(ctx context.Context) (*Event, error) {
+	mess, err := r.reader.ReadMessage(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	data, err := io.ReadAll(mess)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	e, err := Decode(data)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf(
+			"ydb: failed to decode changefeed record (partition %d, offset %d): %w",
+			mess.PartitionID(), mess.Offset, err,
+		))
+	}
+	e.message = mess
+
+	return &e, nil
+}
+
+// Commit commits event (and all previous events of partition)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Commit(ctx context.Context, e *Event) error {
+	return xerrors.WithStackTrace(r.reader.Commit(ctx, e.message))
+}
+
+// Close stops reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Close(ctx context.Context) error {
+	return xerrors.WithStackTrace(r.reader.Close(ctx))
+}
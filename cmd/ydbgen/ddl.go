@@ -0,0 +1,373 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type (
+	tableDesc struct {
+		Name       string
+		Columns    []columnDesc
+		PrimaryKey []string
+	}
+	columnDesc struct {
+		Name string
+		Type columnType
+	}
+	lexeme struct {
+		text   string
+		quoted bool
+		pos    int
+	}
+	ddlParser struct {
+		tokens []lexeme
+		pos    int
+	}
+)
+
+// parseDDL extracts descriptions of tables from CREATE TABLE statements.
+// Other statements are skipped
+func parseDDL(src string) ([]tableDesc, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &ddlParser{tokens: tokens}
+
+	var tables []tableDesc
+	for !p.eof() {
+		if p.isKeyword("CREATE") && p.peekKeyword(1, "TABLE") {
+			p.pos += 2
+			t, err := p.parseCreateTable()
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, t)
+		}
+		p.skipStatement()
+	}
+
+	return tables, nil
+}
+
+func (p *ddlParser) parseCreateTable() (t tableDesc, _ error) {
+	name, err := p.ident()
+	if err != nil {
+		return t, err
+	}
+	t.Name = name
+
+	if err = p.expect("("); err != nil {
+		return t, err
+	}
+
+	for {
+		switch {
+		case p.isKeyword("PRIMARY"):
+			p.pos++
+			if err = p.expectKeyword("KEY"); err != nil {
+				return t, err
+			}
+			if t.PrimaryKey, err = p.identList(); err != nil {
+				return t, err
+			}
+		case p.isKeyword("INDEX"), p.isKeyword("FAMILY"), p.isKeyword("CHANGEFEED"):
+			p.skipDefinition()
+		default:
+			c, err := p.parseColumn()
+			if err != nil {
+				return t, fmt.Errorf("table %q: %w", t.Name, err)
+			}
+			t.Columns = append(t.Columns, c)
+		}
+
+		if p.is(")") {
+			p.pos++
+
+			return t, nil
+		}
+		if err = p.expect(","); err != nil {
+			return t, err
+		}
+	}
+}
+
+func (p *ddlParser) parseColumn() (c columnDesc, _ error) {
+	name, err := p.ident()
+	if err != nil {
+		return c, err
+	}
+	c.Name = name
+
+	if c.Type, err = p.parseType(); err != nil {
+		return c, fmt.Errorf("column %q: %w", c.Name, err)
+	}
+	// columns are nullable if not declared as NOT NULL
+	c.Type.Optional = true
+
+	for !p.eof() && !p.is(",") && !p.is(")") {
+		if p.isKeyword("NOT") && p.peekKeyword(1, "NULL") {
+			c.Type.Optional = false
+			p.pos += 2
+
+			continue
+		}
+		p.skipBalanced()
+	}
+
+	return c, nil
+}
+
+func (p *ddlParser) parseType() (t columnType, _ error) {
+	name, err := p.ident()
+	if err != nil {
+		return t, err
+	}
+
+	switch {
+	case strings.EqualFold(name, "Optional"):
+		if err = p.expect("<"); err != nil {
+			return t, err
+		}
+		if t, err = p.parseType(); err != nil {
+			return t, err
+		}
+		if err = p.expect(">"); err != nil {
+			return t, err
+		}
+		t.Optional = true
+	case strings.EqualFold(name, "Decimal"):
+		t.Name = "Decimal"
+		if err = p.expect("("); err != nil {
+			return t, err
+		}
+		if _, err = fmt.Sscan(p.next().text, &t.Precision); err != nil {
+			return t, fmt.Errorf("decimal precision: %w", err)
+		}
+		if err = p.expect(","); err != nil {
+			return t, err
+		}
+		if _, err = fmt.Sscan(p.next().text, &t.Scale); err != nil {
+			return t, fmt.Errorf("decimal scale: %w", err)
+		}
+		if err = p.expect(")"); err != nil {
+			return t, err
+		}
+	default:
+		t.Name = name
+	}
+
+	if p.is("?") {
+		p.pos++
+		t.Optional = true
+	}
+
+	return t, nil
+}
+
+func (p *ddlParser) identList() (names []string, _ error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+		if p.is(")") {
+			p.pos++
+
+			return names, nil
+		}
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *ddlParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *ddlParser) next() lexeme {
+	if p.eof() {
+		return lexeme{}
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+
+	return t
+}
+
+func (p *ddlParser) is(s string) bool {
+	return !p.eof() && !p.tokens[p.pos].quoted && p.tokens[p.pos].text == s
+}
+
+func (p *ddlParser) isKeyword(keyword string) bool {
+	return p.peekKeyword(0, keyword)
+}
+
+func (p *ddlParser) peekKeyword(offset int, keyword string) bool {
+	i := p.pos + offset
+	if i >= len(p.tokens) || p.tokens[i].quoted {
+		return false
+	}
+
+	return strings.EqualFold(p.tokens[i].text, keyword)
+}
+
+func (p *ddlParser) expect(s string) error {
+	if !p.is(s) {
+		return p.errorf("expected %q", s)
+	}
+	p.pos++
+
+	return nil
+}
+
+func (p *ddlParser) expectKeyword(keyword string) error {
+	if !p.isKeyword(keyword) {
+		return p.errorf("expected %s", keyword)
+	}
+	p.pos++
+
+	return nil
+}
+
+func (p *ddlParser) ident() (string, error) {
+	if p.eof() {
+		return "", p.errorf("expected identifier")
+	}
+	t := p.tokens[p.pos]
+	if !t.quoted && !isIdentStart(rune(t.text[0])) {
+		return "", p.errorf("expected identifier")
+	}
+	p.pos++
+
+	return t.text, nil
+}
+
+func (p *ddlParser) errorf(format string, args ...interface{}) error {
+	if p.eof() {
+		return fmt.Errorf(format+" at end of input", args...)
+	}
+
+	return fmt.Errorf(format+" at offset %d, got %q", append(args, p.tokens[p.pos].pos, p.tokens[p.pos].text)...)
+}
+
+// skipBalanced skips current token or whole parenthesized group
+func (p *ddlParser) skipBalanced() {
+	depth := 0
+	for !p.eof() {
+		switch {
+		case p.is("("):
+			depth++
+		case p.is(")"):
+			depth--
+		}
+		p.pos++
+		if depth <= 0 {
+			return
+		}
+	}
+}
+
+// skipDefinition skips definition of table element up to next comma or closing parenthesis
+func (p *ddlParser) skipDefinition() {
+	for !p.eof() && !p.is(",") && !p.is(")") {
+		p.skipBalanced()
+	}
+}
+
+// skipStatement skips tokens up to the end of current statement
+func (p *ddlParser) skipStatement() {
+	for !p.eof() {
+		if p.is(";") {
+			p.pos++
+
+			return
+		}
+		p.skipBalanced()
+	}
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isIdentPart(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r)
+}
+
+//nolint:funlen
+func tokenize(src string) (tokens []lexeme, _ error) {
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			j := i + 2
+			for j+1 < len(runes) && (runes[j] != '*' || runes[j+1] != '/') {
+				j++
+			}
+			if j+1 >= len(runes) {
+				return nil, fmt.Errorf("unterminated comment at offset %d", i)
+			}
+			i = j + 2
+		case r == '`' || r == '\'' || r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != r {
+				if runes[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated quoted string at offset %d", i)
+			}
+			tokens = append(tokens, lexeme{text: string(runes[i+1 : j]), quoted: true, pos: i})
+			i = j + 1
+		case isIdentPart(r):
+			j := i
+			for j < len(runes) && isIdentPart(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, lexeme{text: string(runes[i:j]), pos: i})
+			i = j
+		default:
+			tokens = append(tokens, lexeme{text: string(r), pos: i})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+// parseTypeString parses type in YQL syntax (such as Optional<Utf8> or Decimal(22,9))
+func parseTypeString(s string) (columnType, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return columnType{}, err
+	}
+
+	p := &ddlParser{tokens: tokens}
+	t, err := p.parseType()
+	if err != nil {
+		return t, err
+	}
+	if !p.eof() {
+		return t, p.errorf("unexpected token")
+	}
+
+	return t, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDDL(t *testing.T) {
+	tables, err := parseDDL(`
+		-- comment
+		CREATE TABLE series (
+			series_id Uint64 NOT NULL,
+			title Text,
+			price Decimal(22, 9),
+			/* comment */
+			` + "`release date`" + ` Optional<Date>,
+			INDEX idx_title GLOBAL ON (title),
+			PRIMARY KEY (series_id)
+		) WITH (AUTO_PARTITIONING_BY_LOAD = ENABLED);
+		ALTER TABLE series ADD COLUMN x Uint64;
+		CREATE TABLE ` + "`dir/log`" + ` (ts Timestamp?);
+	`)
+	require.NoError(t, err)
+	require.Equal(t, []tableDesc{
+		{
+			Name: "series",
+			Columns: []columnDesc{
+				{Name: "series_id", Type: columnType{Name: "Uint64"}},
+				{Name: "title", Type: columnType{Name: "Text", Optional: true}},
+				{Name: "price", Type: columnType{Name: "Decimal", Optional: true, Precision: 22, Scale: 9}},
+				{Name: "release date", Type: columnType{Name: "Date", Optional: true}},
+			},
+			PrimaryKey: []string{"series_id"},
+		},
+		{
+			Name: "dir/log",
+			Columns: []columnDesc{
+				{Name: "ts", Type: columnType{Name: "Timestamp", Optional: true}},
+			},
+		},
+	}, tables)
+}
+
+func TestParseDDLErrors(t *testing.T) {
+	for _, src := range []string{
+		"CREATE TABLE t (id Uint64",
+		"CREATE TABLE t (id Decimal(x, 1))",
+		"CREATE TABLE t (id Uint64, PRIMARY (id))",
+		"CREATE TABLE t (id Uint64) /* comment",
+	} {
+		t.Run(src, func(t *testing.T) {
+			_, err := parseDDL(src)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParseTypeString(t *testing.T) {
+	for _, tt := range []struct {
+		src string
+		exp columnType
+		yql string
+	}{
+		{src: "Utf8", exp: columnType{Name: "Utf8"}, yql: "Utf8"},
+		{src: "Optional<Uint64>", exp: columnType{Name: "Uint64", Optional: true}, yql: "Optional<Uint64>"},
+		{
+			src: "Decimal(35,0)?",
+			exp: columnType{Name: "Decimal", Optional: true, Precision: 35},
+			yql: "Optional<Decimal(35,0)>",
+		},
+		{src: "bytes", exp: columnType{Name: "bytes"}, yql: "String"},
+	} {
+		t.Run(tt.src, func(t *testing.T) {
+			ct, err := parseTypeString(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, ct)
+			require.Equal(t, tt.yql, ct.Yql())
+		})
+	}
+	_, err := parseTypeString("Optional<Uint64")
+	require.Error(t, err)
+}
@@ -0,0 +1,19 @@
+// Command ydbgen generates typed Go code for YDB tables: structs with fields for columns,
+// reflection-free scanning of rows and making of query parameters, and CRUD helpers
+// (Upsert, Insert, Get and Delete by primary key) for the query service.
+//
+// Tables are described by CREATE TABLE statements from file with DDL:
+//
+//	ydbgen -ddl schema.yql -package models -output models.go
+//
+// or by describing of existing tables in database:
+//
+//	ydbgen -dsn grpc://localhost:2136/local -table series,episodes -package models -output models.go
+//
+// ydbgen may be used with go:generate directive. In this case name of package for generated
+// code is taken from $GOPACKAGE environment variable:
+//
+//	//go:generate go run github.com/ydb-platform/ydb-go-sdk/v3/cmd/ydbgen -ddl schema.yql -output models.go
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package main
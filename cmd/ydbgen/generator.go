@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+type (
+	genFile struct {
+		Package      string
+		StdImports   []string
+		Imports      []string
+		Tables       []genTable
+		DecimalBytes bool
+	}
+	genTable struct {
+		GoName     string
+		VarName    string
+		Name       string
+		Fields     []genField
+		PrimaryKey []genField
+		Declare    string
+		Columns    string
+		Upsert     string
+		Insert     string
+		Select     string
+		Delete     string
+	}
+	genField struct {
+		GoName  string
+		ArgName string
+		Column  string
+		GoType  string
+		Param   string
+		Setter  string
+		KeyArg  string
+	}
+)
+
+// commonInitialisms is a set of words which must be in upper case in Go names
+var commonInitialisms = map[string]bool{
+	"ACL": true, "API": true, "DB": true, "DNS": true, "HTML": true, "HTTP": true, "ID": true,
+	"IP": true, "JSON": true, "SQL": true, "TTL": true, "UID": true, "URI": true, "URL": true,
+	"UUID": true, "XML": true, "YSON": true,
+}
+
+func generate(pkg string, tables []tableDesc) ([]byte, error) {
+	f := genFile{
+		Package: pkg,
+	}
+	imports := map[string]bool{
+		"context":                                     true,
+		"github.com/ydb-platform/ydb-go-sdk/v3":       true,
+		"github.com/ydb-platform/ydb-go-sdk/v3/query": true,
+		"github.com/ydb-platform/ydb-go-sdk/v3/table": true,
+	}
+	for _, t := range tables {
+		gt, err := newGenTable(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range gt.Fields {
+			switch strings.TrimPrefix(field.GoType, "*") {
+			case "time.Time", "time.Duration":
+				imports["time"] = true
+			case "types.Decimal":
+				imports["github.com/ydb-platform/ydb-go-sdk/v3/table/types"] = true
+				if strings.HasPrefix(field.GoType, "*") {
+					f.DecimalBytes = true
+				}
+			}
+		}
+		f.Tables = append(f.Tables, gt)
+	}
+	for imp := range imports {
+		if strings.Contains(imp, ".") {
+			f.Imports = append(f.Imports, imp)
+		} else {
+			f.StdImports = append(f.StdImports, imp)
+		}
+	}
+	sort.Strings(f.StdImports)
+	sort.Strings(f.Imports)
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+//nolint:funlen
+func newGenTable(t tableDesc) (gt genTable, _ error) {
+	gt.Name = t.Name
+	gt.GoName = goName(path.Base(t.Name), true)
+	gt.VarName = goName(path.Base(t.Name), false)
+
+	if len(t.Columns) == 0 {
+		return gt, fmt.Errorf("table %q has no columns", t.Name)
+	}
+
+	fields := make(map[string]genField, len(t.Columns))
+	columns := make([]string, 0, len(t.Columns))
+	var declare strings.Builder
+	for _, c := range t.Columns {
+		goType, err := c.Type.GoType()
+		if err != nil {
+			return gt, fmt.Errorf("table %q, column %q: %w", t.Name, c.Name, err)
+		}
+		f := genField{
+			GoName:  goName(c.Name, true),
+			ArgName: goName(c.Name, false),
+			Column:  c.Name,
+			GoType:  goType,
+			Param:   "$" + paramName(c.Name),
+		}
+		if f.Setter, err = c.Type.paramSetter("v." + f.GoName); err != nil {
+			return gt, err
+		}
+		if f.KeyArg, err = c.Type.paramSetter(f.ArgName); err != nil {
+			return gt, err
+		}
+		fields[c.Name] = f
+		gt.Fields = append(gt.Fields, f)
+		columns = append(columns, quoteIdent(c.Name))
+		fmt.Fprintf(&declare, "DECLARE %s AS %s;\n", f.Param, c.Type.Yql())
+	}
+	gt.Declare = declare.String()
+	gt.Columns = strings.Join(columns, ", ")
+
+	params := make([]string, 0, len(gt.Fields))
+	for _, f := range gt.Fields {
+		params = append(params, f.Param)
+	}
+	values := "(" + gt.Columns + ") VALUES (" + strings.Join(params, ", ") + ");"
+	gt.Upsert = gt.Declare + "UPSERT INTO " + quoteIdent(t.Name) + " " + values
+	gt.Insert = gt.Declare + "INSERT INTO " + quoteIdent(t.Name) + " " + values
+
+	if len(t.PrimaryKey) == 0 {
+		return gt, nil
+	}
+
+	var (
+		keyDeclare strings.Builder
+		where      = make([]string, 0, len(t.PrimaryKey))
+	)
+	for _, name := range t.PrimaryKey {
+		f, has := fields[name]
+		if !has {
+			return gt, fmt.Errorf("table %q: primary key column %q not found", t.Name, name)
+		}
+		gt.PrimaryKey = append(gt.PrimaryKey, f)
+		for _, c := range t.Columns {
+			if c.Name == name {
+				fmt.Fprintf(&keyDeclare, "DECLARE %s AS %s;\n", f.Param, c.Type.Yql())
+			}
+		}
+		where = append(where, quoteIdent(name)+" = "+f.Param)
+	}
+	gt.Select = keyDeclare.String() + "SELECT " + gt.Columns + " FROM " + quoteIdent(t.Name) +
+		" WHERE " + strings.Join(where, " AND ") + ";"
+	gt.Delete = keyDeclare.String() + "DELETE FROM " + quoteIdent(t.Name) +
+		" WHERE " + strings.Join(where, " AND ") + ";"
+
+	return gt, nil
+}
+
+func quoteIdent(s string) string {
+	return "`" + s + "`"
+}
+
+// paramName makes valid name of query parameter from column name
+func paramName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isIdentPart(r) {
+			return r
+		}
+
+		return '_'
+	}, s)
+}
+
+// goName makes Go identifier from snake_case, kebab-case or path-like name
+func goName(s string, exported bool) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var b strings.Builder
+	for i, w := range words {
+		switch upper := strings.ToUpper(w); {
+		case i == 0 && !exported:
+			b.WriteString(strings.ToLower(w))
+		case commonInitialisms[upper]:
+			b.WriteString(upper)
+		default:
+			r := []rune(w)
+			b.WriteString(strings.ToUpper(string(r[0])) + string(r[1:]))
+		}
+	}
+	name := b.String()
+	if name == "" || !isIdentStart([]rune(name)[0]) {
+		name = "X" + name
+	}
+	if !exported && (isGoKeyword(name) || reservedNames[name]) {
+		name += "_"
+	}
+
+	return name
+}
+
+// reservedNames is a set of names of local variables and packages used in generated code
+var reservedNames = map[string]bool{
+	"b": true, "context": true, "ctx": true, "e": true, "err": true, "query": true, "row": true,
+	"table": true, "time": true, "types": true, "v": true, "ydb": true,
+}
+
+func isGoKeyword(s string) bool {
+	switch s {
+	case "break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough",
+		"for", "func", "go", "goto", "if", "import", "interface", "map", "package", "range",
+		"return", "select", "struct", "switch", "type", "var":
+		return true
+	default:
+		return false
+	}
+}
+
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{
+	"quote": func(s string) string { return fmt.Sprintf("%q", s) },
+	"columns": func(fields []genField) string {
+		columns := make([]string, 0, len(fields))
+		for _, f := range fields {
+			columns = append(columns, fmt.Sprintf("%q", f.Column))
+		}
+
+		return strings.Join(columns, ", ")
+	},
+}).Parse(`// Code generated by ydbgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- range .StdImports }}
+	{{ quote . }}
+{{- end }}
+{{ range .Imports }}
+	{{ quote . }}
+{{- end }}
+)
+{{ range .Tables }}
+// {{ .GoName }} is a row of table ` + "`{{ .Name }}`" + `
+type {{ .GoName }} struct {
+{{- range .Fields }}
+	{{ .GoName }} {{ .GoType }}
+{{- end }}
+}
+
+// {{ .GoName }}Table is a name of table ` + "`{{ .Name }}`" + `
+const {{ .GoName }}Table = {{ quote .Name }}
+
+// {{ .GoName }}Columns is a list of columns of table ` + "`{{ .Name }}`" + ` in order of fields of {{ .GoName }}
+var {{ .GoName }}Columns = []string{ {{- columns .Fields -}} }
+
+const (
+	{{ .VarName }}UpsertQuery = {{ quote .Upsert }}
+	{{ .VarName }}InsertQuery = {{ quote .Insert }}
+{{- if .PrimaryKey }}
+	{{ .VarName }}SelectQuery = {{ quote .Select }}
+	{{ .VarName }}DeleteQuery = {{ quote .Delete }}
+{{- end }}
+)
+
+// ScanRow scans row with columns in order of {{ .GoName }}Columns into v
+func (v *{{ .GoName }}) ScanRow(row query.Row) error {
+	return row.Scan(
+{{- range .Fields }}
+		&v.{{ .GoName }},
+{{- end }}
+	)
+}
+
+// Params makes query parameters from v with names of columns prefixed by $
+func (v *{{ .GoName }}) Params() *table.QueryParameters {
+	b := ydb.ParamsBuilder()
+{{- range .Fields }}
+	b = b.Param({{ quote .Param }}).{{ .Setter }}
+{{- end }}
+
+	return b.Build()
+}
+
+// Upsert{{ .GoName }} upserts v into table ` + "`{{ .Name }}`" + `
+func Upsert{{ .GoName }}(ctx context.Context, e query.Executor, v *{{ .GoName }}) error {
+	return e.Exec(ctx, {{ .VarName }}UpsertQuery, query.WithParameters(v.Params()))
+}
+
+// Insert{{ .GoName }} inserts v into table ` + "`{{ .Name }}`" + `
+func Insert{{ .GoName }}(ctx context.Context, e query.Executor, v *{{ .GoName }}) error {
+	return e.Exec(ctx, {{ .VarName }}InsertQuery, query.WithParameters(v.Params()))
+}
+{{- if .PrimaryKey }}
+
+// Get{{ .GoName }} reads row of table ` + "`{{ .Name }}`" + ` by primary key. Returns io.EOF if row not found
+func Get{{ .GoName }}(ctx context.Context, e query.Executor
+{{- range .PrimaryKey }}, {{ .ArgName }} {{ .GoType }}{{ end -}}
+) (*{{ .GoName }}, error) {
+	b := ydb.ParamsBuilder()
+{{- range .PrimaryKey }}
+	b = b.Param({{ quote .Param }}).{{ .KeyArg }}
+{{- end }}
+
+	row, err := e.QueryRow(ctx, {{ .VarName }}SelectQuery, query.WithParameters(b.Build()))
+	if err != nil {
+		return nil, err
+	}
+
+	var v {{ .GoName }}
+	if err = v.ScanRow(row); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// Delete{{ .GoName }} deletes row of table ` + "`{{ .Name }}`" + ` by primary key
+func Delete{{ .GoName }}(ctx context.Context, e query.Executor
+{{- range .PrimaryKey }}, {{ .ArgName }} {{ .GoType }}{{ end -}}
+) error {
+	b := ydb.ParamsBuilder()
+{{- range .PrimaryKey }}
+	b = b.Param({{ quote .Param }}).{{ .KeyArg }}
+{{- end }}
+
+	return e.Exec(ctx, {{ .VarName }}DeleteQuery, query.WithParameters(b.Build()))
+}
+{{- end }}
+{{ end }}
+{{- if .DecimalBytes }}
+func decimalBytes(v *types.Decimal) *[16]byte {
+	if v == nil {
+		return nil
+	}
+
+	return &v.Bytes
+}
+{{- end }}
+`))
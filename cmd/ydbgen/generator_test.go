@@ -0,0 +1,69 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoName(t *testing.T) {
+	for _, tt := range []struct {
+		src      string
+		exported bool
+		exp      string
+	}{
+		{src: "series_id", exported: true, exp: "SeriesID"},
+		{src: "series_id", exported: false, exp: "seriesID"},
+		{src: "release date", exported: true, exp: "ReleaseDate"},
+		{src: "json_data", exported: false, exp: "jsonData"},
+		{src: "type", exported: false, exp: "type_"},
+		{src: "v", exported: false, exp: "v_"},
+		{src: "1st", exported: true, exp: "X1st"},
+	} {
+		t.Run(tt.src, func(t *testing.T) {
+			require.Equal(t, tt.exp, goName(tt.src, tt.exported))
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tables, err := parseDDL(`
+		CREATE TABLE series (
+			series_id Uint64 NOT NULL,
+			title Utf8,
+			price Decimal(22,9),
+			release_date Date NOT NULL,
+			PRIMARY KEY (series_id)
+		);
+		CREATE TABLE log (data Json);
+	`)
+	require.NoError(t, err)
+
+	src, err := generate("models", tables)
+	require.NoError(t, err)
+
+	f, err := parser.ParseFile(token.NewFileSet(), "models.go", src, parser.ParseComments)
+	require.NoError(t, err)
+	require.Equal(t, "models", f.Name.Name)
+
+	code := string(src)
+	require.Contains(t, code, "SeriesID    uint64\n")
+	require.Contains(t, code, "Title       *string\n")
+	require.Contains(t, code, "Price       *types.Decimal\n")
+	require.Contains(t, code, `b = b.Param("$title").BeginOptional().Text(v.Title).EndOptional()`)
+	require.Contains(t, code, `b = b.Param("$price").BeginOptional().Decimal(decimalBytes(v.Price), 22, 9).EndOptional()`)
+	require.Contains(t, code, "func GetSeries(ctx context.Context, e query.Executor, seriesID uint64) (*Series, error)")
+	require.Contains(t, code, "DELETE FROM `series` WHERE `series_id` = $series_id;")
+	require.Contains(t, code, "func UpsertLog(ctx context.Context, e query.Executor, v *Log) error")
+	require.NotContains(t, code, "func GetLog(")
+}
+
+func TestGenerateUnsupportedType(t *testing.T) {
+	_, err := generate("models", []tableDesc{{
+		Name:    "t",
+		Columns: []columnDesc{{Name: "id", Type: columnType{Name: "DyNumber"}}},
+	}})
+	require.Error(t, err)
+}
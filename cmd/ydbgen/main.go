@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: ydbgen -ddl schema.yql [-package name] [-output file.go]\n")
+	fmt.Fprintf(os.Stderr, "       ydbgen -dsn grpc://localhost:2136/local -table series,episodes "+
+		"[-package name] [-output file.go]\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	var (
+		ddlPath   string
+		dsn       string
+		tableList string
+		pkg       string
+		output    string
+	)
+	flag.StringVar(&ddlPath, "ddl", "", "path to file with CREATE TABLE statements (- for stdin)")
+	flag.StringVar(&dsn, "dsn", "", "connection string for describing tables in database")
+	flag.StringVar(&tableList, "table", "", "comma separated list of tables (relative to database) for describing")
+	flag.StringVar(&pkg, "package", os.Getenv("GOPACKAGE"), "name of package for generated code")
+	flag.StringVar(&output, "output", "", "path to output file (stdout if empty)")
+	flag.Usage = usage
+	flag.Parse()
+
+	if pkg == "" {
+		pkg = "models"
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	var (
+		tables []tableDesc
+		err    error
+	)
+	switch {
+	case ddlPath != "" && dsn == "":
+		tables, err = readDDL(ddlPath)
+	case dsn != "" && ddlPath == "" && tableList != "":
+		tables, err = describeTables(ctx, dsn, strings.Split(tableList, ","))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if len(tables) == 0 {
+		fatalf("no tables found")
+	}
+
+	src, err := generate(pkg, tables)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(output, src, 0o644) //nolint:gomnd,gosec
+	}
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "ydbgen: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+func readDDL(fileName string) ([]tableDesc, error) {
+	var (
+		src []byte
+		err error
+	)
+	if fileName == "-" {
+		src, err = io.ReadAll(os.Stdin)
+	} else {
+		src, err = os.ReadFile(fileName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDDL(string(src))
+}
+
+func describeTables(ctx context.Context, dsn string, names []string) (tables []tableDesc, _ error) {
+	db, err := ydb.Open(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		var desc options.Description
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			desc, err = s.DescribeTable(ctx, path.Join(db.Name(), name))
+
+			return err
+		}, table.WithIdempotent())
+		if err != nil {
+			return nil, fmt.Errorf("describe table %q: %w", name, err)
+		}
+		t, err := tableFromDescription(name, desc)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+
+	return tables, nil
+}
+
+func tableFromDescription(name string, desc options.Description) (t tableDesc, _ error) {
+	t.Name = name
+	t.PrimaryKey = desc.PrimaryKey
+	for _, c := range desc.Columns {
+		columnType, err := parseTypeString(c.Type.Yql())
+		if err != nil {
+			return t, fmt.Errorf("table %q, column %q: %w", name, c.Name, err)
+		}
+		t.Columns = append(t.Columns, columnDesc{
+			Name: c.Name,
+			Type: columnType,
+		})
+	}
+
+	return t, nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	columnType struct {
+		Name      string
+		Optional  bool
+		Precision uint32
+		Scale     uint32
+	}
+	primitive struct {
+		yql    string
+		goType string
+		method string
+	}
+)
+
+var primitives = func() map[string]primitive {
+	m := make(map[string]primitive)
+	for _, p := range []primitive{
+		{yql: "Bool", goType: "bool", method: "Bool"},
+		{yql: "Int8", goType: "int8", method: "Int8"},
+		{yql: "Int16", goType: "int16", method: "Int16"},
+		{yql: "Int32", goType: "int32", method: "Int32"},
+		{yql: "Int64", goType: "int64", method: "Int64"},
+		{yql: "Uint8", goType: "uint8", method: "Uint8"},
+		{yql: "Uint16", goType: "uint16", method: "Uint16"},
+		{yql: "Uint32", goType: "uint32", method: "Uint32"},
+		{yql: "Uint64", goType: "uint64", method: "Uint64"},
+		{yql: "Float", goType: "float32", method: "Float"},
+		{yql: "Double", goType: "float64", method: "Double"},
+		{yql: "Date", goType: "time.Time", method: "Date"},
+		{yql: "Datetime", goType: "time.Time", method: "Datetime"},
+		{yql: "Timestamp", goType: "time.Time", method: "Timestamp"},
+		{yql: "Interval", goType: "time.Duration", method: "Interval"},
+		{yql: "TzDate", goType: "time.Time", method: "TzDate"},
+		{yql: "TzDatetime", goType: "time.Time", method: "TzDatetime"},
+		{yql: "TzTimestamp", goType: "time.Time", method: "TzTimestamp"},
+		{yql: "String", goType: "[]byte", method: "Bytes"},
+		{yql: "Utf8", goType: "string", method: "Text"},
+		{yql: "Yson", goType: "[]byte", method: "YSON"},
+		{yql: "Json", goType: "string", method: "JSON"},
+		{yql: "JsonDocument", goType: "string", method: "JSONDocument"},
+		{yql: "Uuid", goType: "[16]byte", method: "UUID"},
+		{yql: "Decimal", goType: "types.Decimal", method: "Decimal"},
+	} {
+		m[strings.ToLower(p.yql)] = p
+	}
+	// aliases of types in YQL
+	m["bytes"] = m["string"]
+	m["text"] = m["utf8"]
+
+	return m
+}()
+
+func (t columnType) primitive() (primitive, error) {
+	p, has := primitives[strings.ToLower(t.Name)]
+	if !has {
+		return p, fmt.Errorf("unsupported type %q", t.Name)
+	}
+
+	return p, nil
+}
+
+// Yql returns type in YQL syntax for DECLARE section of queries
+func (t columnType) Yql() string {
+	p, err := t.primitive()
+	if err != nil {
+		return t.Name
+	}
+	s := p.yql
+	if p.yql == "Decimal" {
+		s = fmt.Sprintf("Decimal(%d,%d)", t.Precision, t.Scale)
+	}
+	if t.Optional {
+		return "Optional<" + s + ">"
+	}
+
+	return s
+}
+
+// GoType returns type of struct field for column
+func (t columnType) GoType() (string, error) {
+	p, err := t.primitive()
+	if err != nil {
+		return "", err
+	}
+	if t.Optional {
+		return "*" + p.goType, nil
+	}
+
+	return p.goType, nil
+}
+
+// paramSetter returns call chain of params.Parameter which binds Go expression v to parameter
+func (t columnType) paramSetter(v string) (string, error) {
+	p, err := t.primitive()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case p.yql == "Decimal" && t.Optional:
+		return fmt.Sprintf("BeginOptional().Decimal(decimalBytes(%s), %d, %d).EndOptional()",
+			v, t.Precision, t.Scale,
+		), nil
+	case p.yql == "Decimal":
+		return fmt.Sprintf("Decimal(%s.Bytes, %d, %d)", v, t.Precision, t.Scale), nil
+	case t.Optional:
+		return fmt.Sprintf("BeginOptional().%s(%s).EndOptional()", p.method, v), nil
+	default:
+		return fmt.Sprintf("%s(%s)", p.method, v), nil
+	}
+}
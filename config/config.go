@@ -9,6 +9,7 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
@@ -22,10 +23,13 @@ type Config struct {
 
 	trace          *trace.Driver
 	dialTimeout    time.Duration
+	dialRetry      DialRetryPolicy
 	connectionTTL  time.Duration
+	connsPerNode   int
 	balancerConfig *balancerConfig.Config
 	secure         bool
 	endpoint       string
+	endpoints      []string
 	database       string
 	metaOptions    []meta.Option
 	grpcOptions    []grpc.DialOption
@@ -65,6 +69,14 @@ func (c *Config) ConnectionTTL() time.Duration {
 	return c.connectionTTL
 }
 
+// ConnectionsPerEndpoint defines count of grpc connections which opens for each endpoint.
+// Calls and streams are striped across connections of endpoint.
+//
+// If ConnectionsPerEndpoint is less than or equal to one - single connection per endpoint is used.
+func (c *Config) ConnectionsPerEndpoint() int {
+	return c.connsPerNode
+}
+
 // Secure is a flag for secure connection
 func (c *Config) Secure() bool {
 	return c.secure
@@ -75,6 +87,16 @@ func (c *Config) Endpoint() string {
 	return c.endpoint
 }
 
+// Endpoints reports about bootstrap endpoints for cluster discovery.
+// Discovery uses first reachable endpoint from list
+func (c *Config) Endpoints() []string {
+	if len(c.endpoints) > 0 {
+		return c.endpoints
+	}
+
+	return []string{c.endpoint}
+}
+
 // TLSConfig reports about TLS configuration
 func (c *Config) TLSConfig() *tls.Config {
 	return c.tlsConfig
@@ -88,6 +110,23 @@ func (c *Config) DialTimeout() time.Duration {
 	return c.dialTimeout
 }
 
+// DialRetryPolicy describes retries of initial discovery of cluster on dial of driver.
+// Bootstrap endpoints are dialed in parallel on each attempt, each endpoint with dial timeout
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DialRetryPolicy struct {
+	// MaxAttempts limits count of attempts. Zero means attempts are limited only by context of dial
+	MaxAttempts int
+
+	// Backoff defines delays between attempts. Nil means default exponential backoff with jitter
+	Backoff backoff.Backoff
+}
+
+// DialRetry reports about policy of retries of initial discovery of cluster
+func (c *Config) DialRetry() DialRetryPolicy {
+	return c.dialRetry
+}
+
 // Database is a required database name.
 func (c *Config) Database() string {
 	return c.database
@@ -118,6 +157,18 @@ func WithInternalDNSResolver() Option {
 func WithEndpoint(endpoint string) Option {
 	return func(c *Config) {
 		c.endpoint = endpoint
+		c.endpoints = nil
+	}
+}
+
+// WithEndpoints defines list of bootstrap endpoints. First reachable endpoint uses for cluster discovery
+func WithEndpoints(endpoints ...string) Option {
+	return func(c *Config) {
+		if len(endpoints) == 0 {
+			return
+		}
+		c.endpoint = endpoints[0]
+		c.endpoints = endpoints
 	}
 }
 
@@ -195,6 +246,12 @@ func WithConnectionTTL(ttl time.Duration) Option {
 	}
 }
 
+func WithConnectionsPerEndpoint(n int) Option {
+	return func(c *Config) {
+		c.connsPerNode = n
+	}
+}
+
 func WithCredentials(credentials credentials.Credentials) Option {
 	return func(c *Config) {
 		c.credentials = credentials
@@ -245,6 +302,15 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithDialRetry sets policy of retries of initial discovery of cluster
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDialRetry(policy DialRetryPolicy) Option {
+	return func(c *Config) {
+		c.dialRetry = policy
+	}
+}
+
 func WithBalancer(balancer *balancerConfig.Config) Option {
 	return func(c *Config) {
 		c.balancerConfig = balancer
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 )
 
 // WithOperationTimeout returns a copy of parent context in which YDB operation timeout
@@ -19,3 +20,11 @@ func WithOperationTimeout(ctx context.Context, operationTimeout time.Duration) c
 func WithOperationCancelAfter(ctx context.Context, operationCancelAfter time.Duration) context.Context {
 	return operation.WithCancelAfter(ctx, operationCancelAfter)
 }
+
+// WithWorkload returns a copy of parent context with workload label.
+// Workload label selects named sub-pool of sessions defined with ydb.WithSessionSubPool option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWorkload(ctx context.Context, workload string) context.Context {
+	return pool.WithWorkload(ctx, workload)
+}
@@ -0,0 +1,164 @@
+// Package cleanup implements a scheduler of periodic maintenance jobs (e.g. deletion of expired soft-deleted rows
+// with sugar.DeleteExpired) which runs jobs only on a single instance of a fleet at a time.
+//
+// Instances of a fleet elect the leader over coordination service (see package election) and only the leader runs
+// the jobs. If the leader loses its coordination session, another instance is elected and continues the jobs.
+// Each job is started after its interval with a random jitter, so the load of the jobs is spread over the time.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package cleanup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/election"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+)
+
+const (
+	defaultInterval = time.Hour
+	defaultJitter   = 0.1
+)
+
+type (
+	// Job is a periodic maintenance job
+	Job struct {
+		// Name is a name of job which passes to error handler
+		Name string
+
+		// Interval is a period of job runs. Default interval is one hour
+		Interval time.Duration
+
+		// Do runs the job. Context of Do is canceled when the scheduler loses leadership
+		Do func(ctx context.Context) error
+	}
+
+	// Scheduler runs jobs on single instance of a fleet at a time.
+	// Scheduler is safe for concurrent use
+	Scheduler struct {
+		election *election.Election
+		jobs     []Job
+		jitter   float64
+		onError  func(job string, err error)
+		rand     xrand.Rand
+	}
+
+	// Option is an option of Scheduler
+	Option func(s *Scheduler)
+)
+
+// WithJitter defines max random addition to job interval as a fraction of interval (from 0 to 1).
+// Default jitter is 0.1
+func WithJitter(jitter float64) Option {
+	return func(s *Scheduler) {
+		if jitter >= 0 && jitter <= 1 {
+			s.jitter = jitter
+		}
+	}
+}
+
+// WithErrorHandler defines handler of job errors. Job errors do not stop the scheduler
+func WithErrorHandler(onError func(job string, err error)) Option {
+	return func(s *Scheduler) {
+		s.onError = onError
+	}
+}
+
+// New makes scheduler of jobs over coordination session.
+// All instances of a fleet must use the same coordination node and scheduler name
+func New(session coordination.Session, name string, jobs []Job, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		election: election.New(session, name),
+		jobs:     make([]Job, 0, len(jobs)),
+		jitter:   defaultJitter,
+		rand:     xrand.New(xrand.WithLock()),
+	}
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			job.Interval = defaultInterval
+		}
+		s.jobs = append(s.jobs, job)
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+
+	return s
+}
+
+// Run blocks until ctx is canceled or coordination session is over.
+// Run campaigns for leadership and runs jobs while the scheduler is the leader
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		term, err := s.election.Campaign(ctx, nil)
+		if err != nil {
+			if xerrors.Is(err, election.ErrNotLeader) && ctx.Err() == nil {
+				continue
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+
+		s.runTerm(ctx, term)
+
+		if ctx.Err() != nil {
+			_ = s.election.Resign(context.Background())
+
+			return xerrors.WithStackTrace(ctx.Err())
+		}
+	}
+}
+
+func (s *Scheduler) runTerm(ctx context.Context, term *election.Term) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stop := context.AfterFunc(term.Context(), cancel)
+	defer stop()
+
+	var wg sync.WaitGroup
+	wg.Add(len(s.jobs))
+	for i := range s.jobs {
+		go func(job Job) {
+			defer wg.Done()
+
+			s.runJob(ctx, job)
+		}(s.jobs[i])
+	}
+	wg.Wait()
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	// First run is jittered only for spreading of jobs load after leader election
+	timer := time.NewTimer(s.jitterOf(job.Interval))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := job.Do(ctx); err != nil && ctx.Err() == nil && s.onError != nil {
+			s.onError(job.Name, err)
+		}
+
+		timer.Reset(job.Interval + s.jitterOf(job.Interval))
+	}
+}
+
+func (s *Scheduler) jitterOf(interval time.Duration) time.Duration {
+	limit := int64(s.jitter * float64(interval))
+	if limit <= 0 {
+		return 0
+	}
+
+	return time.Duration(s.rand.Int64(limit))
+}
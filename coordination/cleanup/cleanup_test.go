@@ -0,0 +1,186 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// testNode emulates single exclusive ephemeral semaphore of coordination node
+type testNode struct {
+	mu      sync.Mutex
+	owner   *coordination.SemaphoreSession
+	orderID uint64
+	release chan struct{}
+}
+
+type testSession struct {
+	coordination.Session
+
+	id   uint64
+	node *testNode
+	ctx  context.Context //nolint:containedctx
+}
+
+type testLease struct {
+	coordination.Lease
+
+	ctx     context.Context //nolint:containedctx
+	cancel  context.CancelFunc
+	session *testSession
+}
+
+func (l *testLease) Context() context.Context {
+	return l.ctx
+}
+
+func (l *testLease) Release() error {
+	l.cancel()
+
+	n := l.session.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.owner != nil && n.owner.SessionID == l.session.id {
+		n.owner = nil
+		close(n.release)
+	}
+
+	return nil
+}
+
+func (s *testSession) SessionID() uint64 {
+	return s.id
+}
+
+func (s *testSession) AcquireSemaphore(
+	ctx context.Context, name string, count uint64, opts ...options.AcquireSemaphoreOption,
+) (coordination.Lease, error) {
+	n := s.node
+	for {
+		n.mu.Lock()
+		if n.owner == nil {
+			break
+		}
+		release := n.release
+		n.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-release:
+		}
+	}
+	defer n.mu.Unlock()
+
+	n.orderID++
+	n.owner = &coordination.SemaphoreSession{
+		SessionID: s.id,
+		Count:     count,
+		OrderID:   n.orderID,
+	}
+	n.release = make(chan struct{})
+
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	return &testLease{ctx: ctx, cancel: cancel, session: s}, nil
+}
+
+func (s *testSession) DescribeSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	n := s.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.owner == nil {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND)))
+	}
+
+	return &coordination.SemaphoreDescription{
+		Name:   name,
+		Owners: []*coordination.SemaphoreSession{n.owner},
+	}, nil
+}
+
+func TestScheduler(t *testing.T) {
+	ctx := xtest.Context(t)
+	node := &testNode{}
+
+	var (
+		runs    [2]atomic.Int64
+		running atomic.Int64
+		errs    = make(chan error, 1)
+	)
+	newScheduler := func(i int) *Scheduler {
+		return New(&testSession{id: uint64(i + 1), node: node, ctx: ctx}, "cleanup", []Job{{
+			Name:     "job",
+			Interval: time.Millisecond,
+			Do: func(ctx context.Context) error {
+				if running.Add(1) > 1 {
+					t.Error("job runs concurrently on several instances")
+				}
+				defer running.Add(-1)
+
+				if runs[i].Add(1) == 3 {
+					return errors.New("job error")
+				}
+
+				return nil
+			},
+		}}, WithJitter(1), WithErrorHandler(func(job string, err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		}))
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(ctx)
+	firstDone := make(chan error, 1)
+	go func() {
+		firstDone <- newScheduler(0).Run(firstCtx)
+	}()
+
+	require.Eventually(t, func() bool {
+		return runs[0].Load() > 5
+	}, time.Second*10, time.Millisecond)
+	require.ErrorContains(t, <-errs, "job error")
+
+	secondCtx, cancelSecond := context.WithCancel(ctx)
+	secondDone := make(chan error, 1)
+	go func() {
+		secondDone <- newScheduler(1).Run(secondCtx)
+	}()
+
+	cancelFirst()
+	require.ErrorIs(t, <-firstDone, context.Canceled)
+
+	require.Eventually(t, func() bool {
+		return runs[1].Load() > 5
+	}, time.Second*10, time.Millisecond)
+
+	cancelSecond()
+	require.ErrorIs(t, <-secondDone, context.Canceled)
+}
+
+func TestJitterOf(t *testing.T) {
+	s := New(nil, "cleanup", nil, WithJitter(0.5))
+	for i := 0; i < 100; i++ {
+		jitter := s.jitterOf(time.Second)
+		require.GreaterOrEqual(t, jitter, time.Duration(0))
+		require.Less(t, jitter, time.Second/2)
+	}
+	require.Equal(t, time.Duration(0), New(nil, "cleanup", nil, WithJitter(0)).jitterOf(time.Second))
+}
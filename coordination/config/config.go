@@ -0,0 +1,156 @@
+// Package config implements distribution of service configs on top of coordination service.
+//
+// Config is stored as data of semaphore of coordination node. Writers publish new version of config with Publish,
+// readers observe changes with WatchConfig. Watcher resubscribes automatically after restarts of coordination
+// sessions, so it can be used for dynamic feature flags without extra dependencies.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package config
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const defaultRetryDelay = time.Second
+
+type (
+	watchOptions struct {
+		retryDelay     time.Duration
+		sessionOptions []options.SessionOption
+	}
+
+	// Option is an option of WatchConfig
+	Option func(o *watchOptions)
+)
+
+// WithRetryDelay defines delay before resubscription after errors (1 second by default)
+func WithRetryDelay(delay time.Duration) Option {
+	return func(o *watchOptions) {
+		if delay > 0 {
+			o.retryDelay = delay
+		}
+	}
+}
+
+// WithSessionOptions defines options of coordination sessions of watcher
+func WithSessionOptions(opts ...options.SessionOption) Option {
+	return func(o *watchOptions) {
+		o.sessionOptions = append(o.sessionOptions, opts...)
+	}
+}
+
+// Publish stores config with name into coordination node. Semaphore of config creates if not exists
+func Publish(ctx context.Context, client coordination.Client, node, name string, config []byte) (finalErr error) {
+	session, err := client.Session(ctx, node)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		if err := session.Close(ctx); err != nil && finalErr == nil {
+			finalErr = xerrors.WithStackTrace(err)
+		}
+	}()
+
+	err = session.CreateSemaphore(ctx, name, coordination.MaxSemaphoreLimit, options.WithCreateData(config))
+	if err == nil {
+		return nil
+	}
+	if !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(session.UpdateSemaphore(ctx, name, options.WithUpdateData(config)))
+}
+
+// WatchConfig returns channel of versions of config with name from coordination node.
+// The first value is the current config, every next value is sent after change of config.
+// Errors of sessions and missing config are retried with delay (see WithRetryDelay),
+// subscription restores automatically after restart of coordination session.
+//
+// The channel is closed when ctx is done.
+func WatchConfig(
+	ctx context.Context, client coordination.Client, node, name string, opts ...Option,
+) <-chan []byte {
+	o := watchOptions{retryDelay: defaultRetryDelay}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	configs := make(chan []byte, 1)
+	go func() {
+		defer close(configs)
+
+		var (
+			last []byte
+			sent bool
+		)
+		for {
+			// errors are not fatal for watcher, watch restarts until ctx is done
+			_ = watch(ctx, client, node, name, o.sessionOptions, func(config []byte) bool {
+				if sent && bytes.Equal(last, config) {
+					return true
+				}
+				last, sent = config, true
+
+				select {
+				case configs <- config:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(o.retryDelay):
+			}
+		}
+	}()
+
+	return configs
+}
+
+// watch sends configs to callback until session is over, semaphore watch failed or callback returns false
+func watch(
+	ctx context.Context, client coordination.Client, node, name string,
+	sessionOptions []options.SessionOption, callback func(config []byte) bool,
+) error {
+	session, err := client.Session(ctx, node, sessionOptions...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = session.Close(ctx)
+	}()
+
+	changes, err := session.WatchSemaphore(ctx, name,
+		options.WithWatchData(true),
+		options.WithWatchOwners(false),
+		options.WithDescribeOwners(false),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	for change := range changes {
+		if change.Err != nil {
+			return xerrors.WithStackTrace(change.Err)
+		}
+		if !callback(bytes.Clone(change.Description.Data)) {
+			return nil
+		}
+	}
+
+	return nil
+}
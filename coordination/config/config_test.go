@@ -0,0 +1,131 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// testSession emulates session which sends changes of semaphore data and stops (like after restart of session)
+type testSession struct {
+	coordination.Session
+
+	changes []coordination.SemaphoreChange
+	data    map[string][]byte
+}
+
+func (s *testSession) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *testSession) WatchSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (<-chan coordination.SemaphoreChange, error) {
+	ch := make(chan coordination.SemaphoreChange, len(s.changes))
+	for _, change := range s.changes {
+		ch <- change
+	}
+	close(ch)
+
+	return ch, nil
+}
+
+func (s *testSession) CreateSemaphore(
+	ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption,
+) error {
+	if _, ok := s.data[name]; ok {
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ALREADY_EXISTS))
+	}
+	req := &Ydb_Coordination.SessionRequest_CreateSemaphore{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	s.data[name] = req.GetData()
+
+	return nil
+}
+
+func (s *testSession) UpdateSemaphore(ctx context.Context, name string, opts ...options.UpdateSemaphoreOption) error {
+	req := &Ydb_Coordination.SessionRequest_UpdateSemaphore{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	s.data[name] = req.GetData()
+
+	return nil
+}
+
+type testClient struct {
+	coordination.Client
+
+	sessions []coordination.Session
+}
+
+func (c *testClient) Session(
+	ctx context.Context, path string, opts ...options.SessionOption,
+) (coordination.Session, error) {
+	if len(c.sessions) == 0 {
+		return nil, errors.New("no sessions")
+	}
+	s := c.sessions[0]
+	c.sessions = c.sessions[1:]
+
+	return s, nil
+}
+
+func change(data string) coordination.SemaphoreChange {
+	return coordination.SemaphoreChange{
+		Description: &coordination.SemaphoreDescription{Data: []byte(data)},
+		DataChanged: true,
+	}
+}
+
+func TestWatchConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &testClient{sessions: []coordination.Session{
+		&testSession{changes: []coordination.SemaphoreChange{
+			{Err: errors.New("semaphore not found")},
+		}},
+		&testSession{changes: []coordination.SemaphoreChange{change("v1")}},
+		// resubscription after restart of session sends current config again
+		&testSession{changes: []coordination.SemaphoreChange{change("v1"), change("v2")}},
+	}}
+
+	configs := WatchConfig(ctx, client, "/local/node", "config", WithRetryDelay(time.Millisecond))
+	for _, expected := range []string{"v1", "v2"} {
+		select {
+		case config := <-configs:
+			require.Equal(t, expected, string(config))
+		case <-time.After(time.Second):
+			t.Fatal("config not received")
+		}
+	}
+
+	cancel()
+	for range configs {
+		t.Fatal("unexpected config")
+	}
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+	s := &testSession{data: make(map[string][]byte)}
+	client := &testClient{sessions: []coordination.Session{s, s}}
+
+	require.NoError(t, Publish(ctx, client, "/local/node", "config", []byte("v1")))
+	require.Equal(t, "v1", string(s.data["config"]))
+
+	require.NoError(t, Publish(ctx, client, "/local/node", "config", []byte("v2")))
+	require.Equal(t, "v2", string(s.data["config"]))
+}
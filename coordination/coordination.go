@@ -99,6 +99,21 @@ type Session interface {
 		opts ...options.DescribeSemaphoreOption,
 	) (*SemaphoreDescription, error)
 
+	// WatchSemaphore returns a channel of the semaphore changes. The first value sent to the channel is the current
+	// state of the semaphore, every next value is sent after the server notifies the session that the semaphore data
+	// or owners are changed. By default, both data and owners are watched and owners are included into descriptions,
+	// use options.WithWatchData, options.WithWatchOwners and options.WithDescribeOwners to override this behavior.
+	//
+	// The channel is closed when the ctx is canceled or the session is closed. If the semaphore cannot be described
+	// anymore (e.g. it was deleted), the last value sent to the channel contains the error.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	WatchSemaphore(
+		ctx context.Context,
+		name string,
+		opts ...options.DescribeSemaphoreOption,
+	) (<-chan SemaphoreChange, error)
+
 	// AcquireSemaphore acquires the semaphore. If you acquire an ephemeral semaphore (see options.WithEphemeral), its
 	// limit will be set to MaxSemaphoreLimit. Later requests override previous operations with the same semaphore, e.g.
 	// to reduce acquired count, change timeout or attached data.
@@ -119,6 +134,13 @@ type Session interface {
 		opts ...options.AcquireSemaphoreOption,
 	) (Lease, error)
 
+	// Events returns a channel of the session state changes. The same channel is returned on every call. Events are
+	// dropped if the channel buffer is full, so a consumer must read the channel without long delays. The channel is
+	// closed when the session is over.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Events() <-chan SessionEvent
+
 	// SessionID returns a server-generated identifier of the session. This value is permanent and unique within the
 	// coordination service node.
 	SessionID() uint64
@@ -187,6 +209,76 @@ type SemaphoreSession struct {
 	Timeout time.Duration
 }
 
+// SessionEventType is a type of the session state change.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SessionEventType uint8
+
+const (
+	// SessionEventStart is sent once the session is started on the server.
+	SessionEventStart = SessionEventType(iota + 1)
+
+	// SessionEventAttached is sent when the session is attached to a new gRPC stream after reconnect.
+	SessionEventAttached
+
+	// SessionEventDetached is sent when the gRPC stream of the session is lost. The session is still alive on the
+	// server until its timeout expires, but the leases acquired by the session must not be relied on until the session
+	// is attached again.
+	SessionEventDetached
+
+	// SessionEventReconnecting is sent before every attempt to attach the session to a new gRPC stream.
+	SessionEventReconnecting
+
+	// SessionEventExpired is sent when the session is expired on the server or the client could not reconnect to the
+	// session before its timeout. The session context is canceled and all the leases are lost.
+	SessionEventExpired
+)
+
+func (t SessionEventType) String() string {
+	switch t {
+	case SessionEventStart:
+		return "START"
+	case SessionEventAttached:
+		return "ATTACHED"
+	case SessionEventDetached:
+		return "DETACHED"
+	case SessionEventReconnecting:
+		return "RECONNECTING"
+	case SessionEventExpired:
+		return "EXPIRED"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", uint8(t))
+	}
+}
+
+// SessionEvent describes a change of the session state observed by Session.Events.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SessionEvent struct {
+	// Type is the type of the change.
+	Type SessionEventType
+
+	// SessionID is a server-generated identifier of the session.
+	SessionID uint64
+}
+
+// SemaphoreChange describes a change of a semaphore observed by Session.WatchSemaphore.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SemaphoreChange struct {
+	// Description is the state of the semaphore after the change.
+	Description *SemaphoreDescription
+
+	// DataChanged is true if the semaphore data may have been changed.
+	DataChanged bool
+
+	// OwnersChanged is true if the list of semaphore owners may have been changed.
+	OwnersChanged bool
+
+	// Err is the reason why the watch is over. It is set only in the last value sent to the channel.
+	Err error
+}
+
 func (d *SemaphoreDescription) String() string {
 	return fmt.Sprintf(
 		"{Name: %q Limit: %d Count: %d Ephemeral: %t Data: %q Owners: %s Waiters: %s}",
@@ -0,0 +1,164 @@
+package coordination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// DataCodec (de)serializes typed data of semaphores
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DataCodec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, dst interface{}) error
+}
+
+// DataValidator is an optional interface of typed data of semaphores. Validate calls before marshaling
+// and after unmarshaling of data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DataValidator interface {
+	Validate() error
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, dst interface{}) error {
+	return json.Unmarshal(data, dst)
+}
+
+// JSONDataCodec returns codec of semaphore data based on encoding/json. It is a default codec of typed helpers
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func JSONDataCodec() DataCodec {
+	return jsonCodec{}
+}
+
+type dataOptions struct {
+	codec DataCodec
+}
+
+// DataOption is an option of typed helpers of semaphore data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DataOption func(o *dataOptions)
+
+// WithDataCodec overrides codec of semaphore data (JSONDataCodec by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDataCodec(codec DataCodec) DataOption {
+	return func(o *dataOptions) {
+		o.codec = codec
+	}
+}
+
+func newDataOptions(opts []DataOption) dataOptions {
+	o := dataOptions{codec: jsonCodec{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	return o
+}
+
+// validateData validates data if type of data or pointer to data implements DataValidator
+func validateData[T any](data *T) error {
+	v, ok := interface{}(data).(DataValidator)
+	if !ok {
+		v, ok = interface{}(*data).(DataValidator)
+	}
+	if !ok {
+		return nil
+	}
+	if err := v.Validate(); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: invalid semaphore data: %w", err))
+	}
+
+	return nil
+}
+
+func marshalData[T any](codec DataCodec, data T) ([]byte, error) {
+	if err := validateData(&data); err != nil {
+		return nil, err
+	}
+
+	bytes, err := codec.Marshal(data)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to marshal semaphore data: %w", err))
+	}
+
+	return bytes, nil
+}
+
+// UnmarshalSemaphoreData deserializes and validates data of semaphore or semaphore session (owner or waiter)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UnmarshalSemaphoreData[T any](data []byte, opts ...DataOption) (dst T, _ error) {
+	o := newDataOptions(opts)
+
+	if err := o.codec.Unmarshal(data, &dst); err != nil {
+		return dst, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to unmarshal semaphore data: %w", err))
+	}
+	if err := validateData(&dst); err != nil {
+		return dst, err
+	}
+
+	return dst, nil
+}
+
+// CreateSemaphoreJSON creates semaphore with typed data serialized by codec (JSON by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CreateSemaphoreJSON[T any](
+	ctx context.Context, s Session, name string, limit uint64, data T, opts ...DataOption,
+) error {
+	bytes, err := marshalData(newDataOptions(opts).codec, data)
+	if err != nil {
+		return err
+	}
+
+	return s.CreateSemaphore(ctx, name, limit, options.WithCreateData(bytes))
+}
+
+// UpdateSemaphoreJSON updates semaphore with typed data serialized by codec (JSON by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func UpdateSemaphoreJSON[T any](ctx context.Context, s Session, name string, data T, opts ...DataOption) error {
+	bytes, err := marshalData(newDataOptions(opts).codec, data)
+	if err != nil {
+		return err
+	}
+
+	return s.UpdateSemaphore(ctx, name, options.WithUpdateData(bytes))
+}
+
+// DescribeSemaphoreJSON describes semaphore and deserializes its typed data with codec (JSON by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DescribeSemaphoreJSON[T any](
+	ctx context.Context, s Session, name string, opts ...DataOption,
+) (*SemaphoreDescription, T, error) {
+	var data T
+
+	description, err := s.DescribeSemaphore(ctx, name)
+	if err != nil {
+		return nil, data, err
+	}
+
+	data, err = UnmarshalSemaphoreData[T](description.Data, opts...)
+	if err != nil {
+		return description, data, err
+	}
+
+	return description, data, nil
+}
@@ -0,0 +1,110 @@
+package coordination
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+)
+
+type semaphoresSessionStub struct {
+	Session
+
+	data map[string][]byte
+}
+
+func (s *semaphoresSessionStub) CreateSemaphore(
+	ctx context.Context, name string, limit uint64, opts ...options.CreateSemaphoreOption,
+) error {
+	req := &Ydb_Coordination.SessionRequest_CreateSemaphore{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	s.data[name] = req.GetData()
+
+	return nil
+}
+
+func (s *semaphoresSessionStub) UpdateSemaphore(
+	ctx context.Context, name string, opts ...options.UpdateSemaphoreOption,
+) error {
+	req := &Ydb_Coordination.SessionRequest_UpdateSemaphore{}
+	for _, opt := range opts {
+		opt(req)
+	}
+	s.data[name] = req.GetData()
+
+	return nil
+}
+
+func (s *semaphoresSessionStub) DescribeSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (*SemaphoreDescription, error) {
+	return &SemaphoreDescription{Name: name, Data: s.data[name]}, nil
+}
+
+type leaderInfo struct {
+	Endpoint string `json:"endpoint"`
+}
+
+var errEmptyEndpoint = errors.New("empty endpoint")
+
+func (l *leaderInfo) Validate() error {
+	if l.Endpoint == "" {
+		return errEmptyEndpoint
+	}
+
+	return nil
+}
+
+type reverseCodec struct{}
+
+func (reverseCodec) Marshal(v interface{}) ([]byte, error) {
+	return []byte(v.(string)), nil
+}
+
+func (reverseCodec) Unmarshal(data []byte, dst interface{}) error {
+	*(dst.(*string)) = "decoded:" + string(data)
+
+	return nil
+}
+
+func TestSemaphoreDataCodec(t *testing.T) {
+	ctx := context.Background()
+	s := &semaphoresSessionStub{data: make(map[string][]byte)}
+
+	t.Run("JSON", func(t *testing.T) {
+		require.NoError(t, CreateSemaphoreJSON(ctx, s, "leader", 1, leaderInfo{Endpoint: "host:1"}))
+		require.JSONEq(t, `{"endpoint":"host:1"}`, string(s.data["leader"]))
+
+		require.NoError(t, UpdateSemaphoreJSON(ctx, s, "leader", &leaderInfo{Endpoint: "host:2"}))
+		description, data, err := DescribeSemaphoreJSON[leaderInfo](ctx, s, "leader")
+		require.NoError(t, err)
+		require.Equal(t, "leader", description.Name)
+		require.Equal(t, leaderInfo{Endpoint: "host:2"}, data)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		require.ErrorIs(t, UpdateSemaphoreJSON(ctx, s, "leader", leaderInfo{}), errEmptyEndpoint)
+		require.ErrorIs(t, UpdateSemaphoreJSON(ctx, s, "leader", &leaderInfo{}), errEmptyEndpoint)
+
+		s.data["invalid"] = []byte(`{}`)
+		_, _, err := DescribeSemaphoreJSON[leaderInfo](ctx, s, "invalid")
+		require.ErrorIs(t, err, errEmptyEndpoint)
+
+		s.data["broken"] = []byte(`{`)
+		_, _, err = DescribeSemaphoreJSON[leaderInfo](ctx, s, "broken")
+		require.Error(t, err)
+	})
+	t.Run("WithDataCodec", func(t *testing.T) {
+		require.NoError(t, CreateSemaphoreJSON(ctx, s, "custom", 1, "value", WithDataCodec(reverseCodec{})))
+		require.Equal(t, "value", string(s.data["custom"]))
+
+		data, err := UnmarshalSemaphoreData[string](s.data["custom"], WithDataCodec(reverseCodec{}))
+		require.NoError(t, err)
+		require.Equal(t, "decoded:value", data)
+	})
+}
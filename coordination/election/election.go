@@ -0,0 +1,252 @@
+// Package election implements leader election on top of coordination service sessions and ephemeral semaphores.
+//
+// Each candidate acquires the same ephemeral semaphore in exclusive mode. The owner of the semaphore is the leader.
+// The value of leader is attached to the acquire operation and can be observed by other participants.
+// Order identifier of acquire operation is monotonically increasing, so it is used as a fencing token of leader term.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package election
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const defaultObservePeriod = time.Second
+
+var (
+	// ErrNoLeader indicates that election has no leader at the moment
+	ErrNoLeader = errors.New("election has no leader")
+
+	// ErrNotLeader indicates that Resign called without leadership
+	ErrNotLeader = errors.New("session is not a leader")
+
+	// ErrAlreadyCampaigning indicates that Campaign called while previous Campaign is in progress or term is active
+	ErrAlreadyCampaigning = errors.New("election campaign already in progress")
+)
+
+type (
+	// Election is a leader election over coordination session.
+	// Election is safe for concurrent use
+	Election struct {
+		session       coordination.Session
+		name          string
+		observePeriod time.Duration
+
+		mu       sync.Mutex
+		campaign bool
+		term     *Term
+	}
+
+	// Option is an option of Election
+	Option func(e *Election)
+
+	// Leader describes current leader of election
+	Leader struct {
+		// Value is a value which leader proclaimed on Campaign
+		Value []byte
+
+		// SessionID is an identifier of coordination session of leader
+		SessionID uint64
+
+		// FencingToken is a monotonically increasing token of leader term
+		FencingToken uint64
+	}
+
+	// Term is a leadership term of elected session
+	Term struct {
+		lease coordination.Lease
+		token uint64
+	}
+)
+
+// WithObservePeriod defines period of polling the election state in Observe
+func WithObservePeriod(period time.Duration) Option {
+	return func(e *Election) {
+		if period > 0 {
+			e.observePeriod = period
+		}
+	}
+}
+
+// New makes election with name over coordination session.
+// All candidates of election must use the same coordination node and election name
+func New(session coordination.Session, name string, opts ...Option) *Election {
+	e := &Election{
+		session:       session,
+		name:          name,
+		observePeriod: defaultObservePeriod,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(e)
+		}
+	}
+
+	return e
+}
+
+// Context returns the context of term. It is canceled when leadership is lost
+// (session lost or closed) or resigned
+func (t *Term) Context() context.Context {
+	return t.lease.Context()
+}
+
+// FencingToken returns monotonically increasing token of the term.
+// Token must be passed into protected resource for rejecting of requests from stale leaders
+func (t *Term) FencingToken() uint64 {
+	return t.token
+}
+
+// Campaign blocks until the session is elected as leader with value, an error occurs or ctx is canceled
+func (e *Election) Campaign(ctx context.Context, value []byte) (_ *Term, finalErr error) {
+	e.mu.Lock()
+	if e.campaign || (e.term != nil && e.term.Context().Err() == nil) {
+		e.mu.Unlock()
+
+		return nil, xerrors.WithStackTrace(ErrAlreadyCampaigning)
+	}
+	e.campaign = true
+	e.mu.Unlock()
+
+	defer func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		e.campaign = false
+	}()
+
+	lease, err := e.session.AcquireSemaphore(ctx, e.name, coordination.Exclusive,
+		options.WithEphemeral(true),
+		options.WithAcquireInfiniteTimeout(),
+		options.WithAcquireData(value),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	leader, err := e.Leader(ctx)
+	if err == nil && leader.SessionID != e.session.SessionID() {
+		err = ErrNotLeader
+	}
+	if err != nil {
+		_ = lease.Release()
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	term := &Term{
+		lease: lease,
+		token: leader.FencingToken,
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.term = term
+
+	return term, nil
+}
+
+// Resign gives up leadership
+func (e *Election) Resign(ctx context.Context) error {
+	e.mu.Lock()
+	term := e.term
+	e.term = nil
+	e.mu.Unlock()
+
+	if term == nil || term.Context().Err() != nil {
+		return xerrors.WithStackTrace(ErrNotLeader)
+	}
+
+	if err := term.lease.Release(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Leader returns current leader of election or ErrNoLeader if election has no leader
+func (e *Election) Leader(ctx context.Context) (*Leader, error) {
+	description, err := e.session.DescribeSemaphore(ctx, e.name, options.WithDescribeOwners(true))
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND) {
+			return nil, xerrors.WithStackTrace(ErrNoLeader)
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if len(description.Owners) == 0 {
+		return nil, xerrors.WithStackTrace(ErrNoLeader)
+	}
+
+	owner := description.Owners[0]
+
+	return &Leader{
+		Value:        owner.Data,
+		SessionID:    owner.SessionID,
+		FencingToken: owner.OrderID,
+	}, nil
+}
+
+func sameLeader(lhs, rhs *Leader) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+
+	return lhs.FencingToken == rhs.FencingToken && lhs.SessionID == rhs.SessionID && bytes.Equal(lhs.Value, rhs.Value)
+}
+
+// Observe returns channel which receives current leader on each change of leadership.
+// Nil leader means that election has no leader at the moment.
+// Channel closes when ctx is canceled or coordination session is over
+func (e *Election) Observe(ctx context.Context) <-chan *Leader {
+	ch := make(chan *Leader)
+
+	go func() {
+		defer close(ch)
+
+		var (
+			last     *Leader
+			observed bool
+			ticker   = time.NewTicker(e.observePeriod)
+		)
+		defer ticker.Stop()
+
+		for {
+			leader, err := e.Leader(ctx)
+			if err == nil || xerrors.Is(err, ErrNoLeader) {
+				if !observed || !sameLeader(last, leader) {
+					select {
+					case ch <- leader:
+						last, observed = leader, true
+					case <-ctx.Done():
+						return
+					case <-e.session.Context().Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-e.session.Context().Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,178 @@
+package election
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// testNode emulates single exclusive ephemeral semaphore of coordination node
+type testNode struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	owner   *coordination.SemaphoreSession
+	orderID uint64
+}
+
+func newTestNode() *testNode {
+	n := &testNode{}
+	n.cond = sync.NewCond(&n.mu)
+
+	return n
+}
+
+type testSession struct {
+	coordination.Session
+
+	id   uint64
+	node *testNode
+	ctx  context.Context //nolint:containedctx
+}
+
+type testLease struct {
+	ctx     context.Context //nolint:containedctx
+	cancel  context.CancelFunc
+	session *testSession
+}
+
+func (l *testLease) Context() context.Context {
+	return l.ctx
+}
+
+func (l *testLease) Release() error {
+	l.cancel()
+
+	n := l.session.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.owner != nil && n.owner.SessionID == l.session.id {
+		n.owner = nil
+		n.cond.Broadcast()
+	}
+
+	return nil
+}
+
+func (l *testLease) Session() coordination.Session {
+	return l.session
+}
+
+func (s *testSession) SessionID() uint64 {
+	return s.id
+}
+
+func (s *testSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSession) AcquireSemaphore(
+	ctx context.Context, name string, count uint64, opts ...options.AcquireSemaphoreOption,
+) (coordination.Lease, error) {
+	var request Ydb_Coordination.SessionRequest_AcquireSemaphore
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&request)
+		}
+	}
+
+	n := s.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for n.owner != nil {
+		n.cond.Wait()
+	}
+	n.orderID++
+	n.owner = &coordination.SemaphoreSession{
+		SessionID: s.id,
+		Count:     count,
+		OrderID:   n.orderID,
+		Data:      request.GetData(),
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	return &testLease{ctx: ctx, cancel: cancel, session: s}, nil
+}
+
+func (s *testSession) DescribeSemaphore(
+	ctx context.Context, name string, opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	n := s.node
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.owner == nil {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND)))
+	}
+
+	return &coordination.SemaphoreDescription{
+		Name:      name,
+		Limit:     coordination.MaxSemaphoreLimit,
+		Count:     n.owner.Count,
+		Ephemeral: true,
+		Owners:    []*coordination.SemaphoreSession{n.owner},
+	}, nil
+}
+
+func TestElection(t *testing.T) {
+	ctx := xtest.Context(t)
+	node := newTestNode()
+	first := New(&testSession{id: 1, node: node, ctx: ctx}, "leader")
+	second := New(&testSession{id: 2, node: node, ctx: ctx}, "leader", WithObservePeriod(time.Millisecond))
+
+	_, err := first.Leader(ctx)
+	require.ErrorIs(t, err, ErrNoLeader)
+
+	observeCtx, cancelObserve := context.WithCancel(ctx)
+	defer cancelObserve()
+	observed := second.Observe(observeCtx)
+	require.Nil(t, <-observed)
+
+	term1, err := first.Campaign(ctx, []byte("first"))
+	require.NoError(t, err)
+	require.NoError(t, term1.Context().Err())
+
+	_, err = first.Campaign(ctx, []byte("first"))
+	require.ErrorIs(t, err, ErrAlreadyCampaigning)
+
+	leader := <-observed
+	require.NotNil(t, leader)
+	require.EqualValues(t, 1, leader.SessionID)
+	require.Equal(t, []byte("first"), leader.Value)
+	require.Equal(t, term1.FencingToken(), leader.FencingToken)
+
+	elected := make(chan *Term, 1)
+	campaignErr := make(chan error, 1)
+	go func() {
+		term, err := second.Campaign(ctx, []byte("second"))
+		campaignErr <- err
+		elected <- term
+	}()
+
+	require.NoError(t, first.Resign(ctx))
+	require.Error(t, term1.Context().Err())
+	require.ErrorIs(t, first.Resign(ctx), ErrNotLeader)
+
+	require.NoError(t, <-campaignErr)
+	term2 := <-elected
+	require.Greater(t, term2.FencingToken(), term1.FencingToken())
+
+	for leader = range observed {
+		if leader != nil && leader.SessionID == 2 {
+			break
+		}
+	}
+	require.Equal(t, term2.FencingToken(), leader.FencingToken)
+}
@@ -169,5 +169,21 @@ func WithDescribeWaiters(describeWaiters bool) DescribeSemaphoreOption {
 	}
 }
 
+// WithWatchData return a DescribeSemaphoreOption which causes server notify the session when the semaphore data is
+// changed. It is used by the WatchSemaphore method of the session.
+func WithWatchData(watchData bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchData = watchData
+	}
+}
+
+// WithWatchOwners return a DescribeSemaphoreOption which causes server notify the session when the list of semaphore
+// owners is changed. It is used by the WatchSemaphore method of the session.
+func WithWatchOwners(watchOwners bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchOwners = watchOwners
+	}
+}
+
 // DescribeSemaphoreOption configures how we update a semaphore.
 type DescribeSemaphoreOption func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore)
@@ -103,3 +103,33 @@ func NewJWTTokenSource(opts ...credentials.JWTTokenSourceOption) (credentials.To
 func NewFixedTokenSource(token, tokenType string) credentials.TokenSource {
 	return credentials.NewFixedTokenSource(token, tokenType)
 }
+
+// NewFileTokenSource makes token source which reads token from file on each token exchange
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewFileTokenSource(path, tokenType string) credentials.TokenSource {
+	return credentials.NewFileTokenSource(path, tokenType)
+}
+
+// NewKubernetesServiceAccountCredentials makes credentials which exchanges projected kubernetes service
+// account token to access token at STS token endpoint using OAuth 2.0 token exchange protocol.
+// Rotated service account token picks up on next token exchange
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewKubernetesServiceAccountCredentials(
+	tokenEndpoint string,
+	opts ...credentials.Oauth2TokenExchangeCredentialsOption,
+) (Credentials, error) {
+	return credentials.NewKubernetesServiceAccountCredentials(tokenEndpoint, opts...)
+}
+
+// NewAccessTokenFileCredentials makes access token credentials object which reads token from file.
+// File is read again after expiration of previously read token (see WithAccessTokenFileTTL),
+// so rotated tokens are picked up without restart
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewAccessTokenFileCredentials(
+	path string, opts ...credentials.AccessTokenFileCredentialsOption,
+) *credentials.AccessTokenFile {
+	return credentials.NewAccessTokenFileCredentials(path, opts...)
+}
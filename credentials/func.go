@@ -0,0 +1,17 @@
+package credentials
+
+import "context"
+
+// Func is an adapter of function to Credentials. Function calls with context of each request,
+// so token can be defined per request (for example, by tenant from context)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Func func(ctx context.Context) (string, error)
+
+func (f Func) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+func (f Func) String() string {
+	return "credentials.Func"
+}
@@ -20,6 +20,14 @@ func WithSourceInfo(sourceInfo string) credentials.SourceInfoOption {
 	return credentials.WithSourceInfo(sourceInfo)
 }
 
+// WithAccessTokenFileTTL defines lifetime of access token which was read from file
+// with NewAccessTokenFileCredentials. Non-positive ttl means reading of file on each call of Token
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAccessTokenFileTTL(ttl time.Duration) credentials.AccessTokenFileCredentialsOption {
+	return credentials.WithAccessTokenFileTTL(ttl)
+}
+
 // WithGrpcDialOptions option append to static credentials object GRPC dial options
 func WithGrpcDialOptions(opts ...grpc.DialOption) credentials.StaticCredentialsOption {
 	return credentials.WithGrpcDialOptions(opts...)
@@ -60,6 +68,14 @@ func WithSyncExchangeTimeout(timeout time.Duration) Oauth2TokenExchangeCredentia
 	return credentials.WithSyncExchangeTimeout(timeout)
 }
 
+// WithOnTokenRefreshError defines hook which calls on each failed token exchange
+// (synchronous or in background before expiration of current token)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOnTokenRefreshError(f func(err error)) Oauth2TokenExchangeCredentialsOption {
+	return credentials.WithOnTokenRefreshError(f)
+}
+
 // SubjectTokenSource
 func WithSubjectToken(subjectToken credentials.TokenSource) Oauth2TokenExchangeCredentialsOption {
 	return credentials.WithSubjectToken(subjectToken)
@@ -0,0 +1,78 @@
+package ydb
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/debug"
+)
+
+// RecentQuery is a sampled record about executed statement with rendered parameters
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type RecentQuery = debug.RecentQuery
+
+// Debug provides access to debugging helpers of Driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Debug struct {
+	sampler *debug.QuerySampler
+}
+
+// RecentQueries returns sampled statements (from oldest to newest) recorded with WithQuerySampler option.
+// If WithQuerySampler option not defined - RecentQueries returns nil
+func (dbg *Debug) RecentQueries() []RecentQuery {
+	if dbg.sampler == nil {
+		return nil
+	}
+
+	return dbg.sampler.RecentQueries()
+}
+
+// Debug returns debugging helpers of Driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Debug() *Debug {
+	return &Debug{
+		sampler: d.querySampler,
+	}
+}
+
+// WithQuerySampler enables recording of sampled subset of executed statements (query and table services)
+// with fully rendered parameters into ring buffer with given capacity.
+// Sample rate must be in (0, 1]; out of range sample rate means recording of all statements.
+// Recorded statements are available with Driver.Debug().RecentQueries()
+//
+// Warning: recorded parameters are stored in memory as is, use it for debugging only
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQuerySampler(capacity int, sampleRate float64) Option {
+	sampler := debug.NewQuerySampler(capacity, sampleRate)
+
+	return func(ctx context.Context, d *Driver) error {
+		d.querySampler = sampler
+
+		return MergeOptions(
+			WithTraceQuery(sampler.Query()),
+			WithTraceTable(sampler.Table()),
+		)(ctx, d)
+	}
+}
+
+// WithSlowQueryThreshold enables detection of statements (query and table services) which executed longer
+// than threshold. Slow statements are reported to onSlowQuery callback without parameters.
+// Threshold can be changed at runtime with Driver.Reconfigure and ReconfigureSlowQueryThreshold option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSlowQueryThreshold(threshold time.Duration, onSlowQuery func(q RecentQuery)) Option {
+	detector := debug.NewSlowQueryDetector(threshold, onSlowQuery)
+
+	return func(ctx context.Context, d *Driver) error {
+		d.slowQueryDetector = detector
+
+		return MergeOptions(
+			WithTraceQuery(detector.Query()),
+			WithTraceTable(detector.Table()),
+		)(ctx, d)
+	}
+}
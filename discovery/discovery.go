@@ -17,7 +17,43 @@ func (w WhoAmI) String() string {
 	return fmt.Sprintf("{User: %s, Groups: [%s]}", w.User, strings.Join(w.Groups, ","))
 }
 
+// EndpointsUpdate describes changes of cluster endpoints after discovery cycle
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EndpointsUpdate struct {
+	// Endpoints contains all actual endpoints of cluster
+	Endpoints []endpoint.Info
+
+	Added   []endpoint.Info
+	Removed []endpoint.Info
+
+	// Changed contains endpoints with the same address and changed node ID or location
+	Changed []endpoint.Info
+}
+
+func (u EndpointsUpdate) String() string {
+	return fmt.Sprintf("{Endpoints: %d, Added: [%s], Removed: [%s], Changed: [%s]}",
+		len(u.Endpoints), addresses(u.Added), addresses(u.Removed), addresses(u.Changed),
+	)
+}
+
+func addresses(endpoints []endpoint.Info) string {
+	list := make([]string, len(endpoints))
+	for i, e := range endpoints {
+		list[i] = e.Address()
+	}
+
+	return strings.Join(list, ",")
+}
+
 type Client interface {
 	Discover(ctx context.Context) ([]endpoint.Endpoint, error)
 	WhoAmI(ctx context.Context) (*WhoAmI, error)
+
+	// Subscribe returns channel of endpoints updates which emits after every discovery cycle of driver.
+	// First update contains current endpoints as added (if discovery was done already).
+	// Channel closes on done of ctx or close of driver. Updates drops if subscriber is too slow
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Subscribe(ctx context.Context) <-chan EndpointsUpdate
 }
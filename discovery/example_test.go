@@ -45,3 +45,18 @@ func Example_whoAmI() {
 	}
 	fmt.Printf("%s whoAmI: %s\n", db.Name(), whoAmI.String())
 }
+
+func Example_subscribe() {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	for update := range db.Discovery().Subscribe(ctx) {
+		fmt.Printf("%s endpoints updated: %s\n", db.Name(), update.String())
+	}
+}
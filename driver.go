@@ -9,18 +9,23 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/cdc"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/export"
+	"github.com/ydb-platform/ydb-go-sdk/v3/imports"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
 	coordinationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/debug"
 	internalDiscovery "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery"
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	internalRatelimiter "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter"
@@ -60,6 +65,8 @@ type Driver struct {
 	loggerOpts    []log.Option
 	loggerDetails trace.Detailer
 
+	reconfigurableDetails *reconfigurableDetails
+
 	opts []Option
 
 	config  *config.Config
@@ -70,12 +77,21 @@ type Driver struct {
 
 	operation *xsync.Once[*operation.Client]
 
+	export  *xsync.Once[*export.Client]
+	imports *xsync.Once[*imports.Client]
+
+	changefeed *xsync.Once[*cdc.Client]
+
 	table        *xsync.Once[*internalTable.Client]
 	tableOptions []tableConfig.Option
 
 	query        *xsync.Once[*internalQuery.Client]
 	queryOptions []queryConfig.Option
 
+	// sessionPoolMinSize is a min size of sessions pools of table and query clients.
+	// Clients with non-zero min size of sessions pool create on connect
+	sessionPoolMinSize int
+
 	scripting        *xsync.Once[*internalScripting.Client]
 	scriptingOptions []scriptingConfig.Option
 
@@ -103,6 +119,20 @@ type Driver struct {
 	onClose     []func(c *Driver)
 
 	panicCallback func(e interface{})
+
+	querySampler      *debug.QuerySampler
+	slowQueryDetector *debug.SlowQueryDetector
+
+	queryHints   []string
+	queryPragmas []interceptor.Pragma
+}
+
+// onPanic is a panic callback of all driver traces. Panics in trace callbacks always recovers
+// and passes to user-defined panic callback (if defined) regardless of order of options
+func (d *Driver) onPanic(e interface{}) {
+	if d.panicCallback != nil {
+		d.panicCallback(e)
+	}
 }
 
 func (d *Driver) trace() *trace.Driver {
@@ -150,8 +180,11 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 		d.coordination.Close,
 		d.scheme.Close,
 		d.scripting.Close,
+		d.changefeed.Close,
 		d.table.Close,
 		d.operation.Close,
+		d.export.Close,
+		d.imports.Close,
 		d.query.Close,
 		d.topic.Close,
 		d.discovery.Close,
@@ -173,6 +206,61 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 	return nil
 }
 
+// Drain gracefully closes Driver: session pools of query and table clients stop handing out
+// sessions and wait for complete of operations with sessions in use, topic readers, listeners
+// and writers close with flush of commits and messages. Waiting is bounded by ctx.
+// After draining Driver closes even if draining failed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Drain(ctx context.Context) error {
+	drains := []func(ctx context.Context) error{
+		func(ctx context.Context) error {
+			return d.query.IfCreated(func(c *internalQuery.Client) error {
+				return c.Drain(ctx)
+			})
+		},
+		func(ctx context.Context) error {
+			return d.table.IfCreated(func(c *internalTable.Client) error {
+				return c.Drain(ctx)
+			})
+		},
+		func(ctx context.Context) error {
+			return d.topic.IfCreated(func(c *topicclientinternal.Client) error {
+				return c.Drain(ctx)
+			})
+		},
+	}
+
+	errs := make([]error, len(drains))
+	var wg sync.WaitGroup
+	wg.Add(len(drains))
+	for i := range drains {
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = drains[i](ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	var issues []error
+	for _, err := range errs {
+		if err != nil {
+			issues = append(issues, err)
+		}
+	}
+
+	// driver closes with clean resources also after done of ctx
+	if err := d.Close(xcontext.ValueOnly(ctx)); err != nil {
+		issues = append(issues, err)
+	}
+
+	if len(issues) > 0 {
+		return xerrors.WithStackTrace(xerrors.NewWithIssues("drain failed", issues...))
+	}
+
+	return nil
+}
+
 // Endpoint returns initial endpoint
 func (d *Driver) Endpoint() string {
 	return d.config.Endpoint()
@@ -220,13 +308,34 @@ func (d *Driver) Discovery() discovery.Client {
 	return d.discovery.Must()
 }
 
-// Operation returns operation client
+// Operation returns client for manage long-running operations (index builds, imports, exports, etc.)
 //
 // Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func (d *Driver) Operation() *operation.Client {
 	return d.operation.Must()
 }
 
+// Export returns client for export of tables to S3 compatible storage
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Export() *export.Client {
+	return d.export.Must()
+}
+
+// Import returns client for import of tables from S3 compatible storage
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Import() *imports.Client {
+	return d.imports.Must()
+}
+
+// Changefeed returns client for manage changefeeds of tables and read changes of rows
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Changefeed() *cdc.Client {
+	return d.changefeed.Must()
+}
+
 // Scripting returns scripting client
 func (d *Driver) Scripting() scripting.Client {
 	return d.scripting.Must()
@@ -366,6 +475,8 @@ func newConnectionFromOptions(ctx context.Context, opts ...Option) (_ *Driver, e
 		}
 	}
 	if d.logger != nil {
+		d.reconfigurableDetails = newReconfigurableDetails(d.loggerDetails)
+		d.loggerDetails = d.reconfigurableDetails
 		for _, opt := range []Option{
 			WithTraceDriver(log.Driver(d.logger, d.loggerDetails, d.loggerOpts...)),       //nolint:contextcheck
 			WithTraceTable(log.Table(d.logger, d.loggerDetails, d.loggerOpts...)),         //nolint:contextcheck
@@ -387,6 +498,12 @@ func newConnectionFromOptions(ctx context.Context, opts ...Option) (_ *Driver, e
 			}
 		}
 	}
+	if len(d.queryHints) > 0 || len(d.queryPragmas) > 0 {
+		err = WithQueryInterceptors(interceptor.Pragmas(d.queryHints, d.queryPragmas))(ctx, d)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
 	d.config = config.New(d.options...)
 
 	return d, nil
@@ -463,6 +580,9 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 					[]schemeConfig.Option{
 						schemeConfig.WithDatabaseName(d.Name()),
 						schemeConfig.With(d.config.Common),
+						schemeConfig.WithTableClient(d.Table),
+						schemeConfig.WithTopicClient(d.Topic),
+						schemeConfig.WithCoordinationClient(d.Coordination),
 					},
 					d.schemeOptions...,
 				)...,
@@ -501,7 +621,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 	})
 
 	d.discovery = xsync.OnceValue(func() (*internalDiscovery.Client, error) {
-		return internalDiscovery.New(xcontext.ValueOnly(ctx),
+		client := internalDiscovery.New(xcontext.ValueOnly(ctx),
 			d.pool.Get(endpoint.New(d.config.Endpoint())),
 			discoveryConfig.New(
 				append(
@@ -516,7 +636,10 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 					d.discoveryOptions...,
 				)...,
 			),
-		), nil
+		)
+		d.balancer.OnUpdate(client.OnUpdate)
+
+		return client, nil
 	})
 
 	d.operation = xsync.OnceValue(func() (*operation.Client, error) {
@@ -525,6 +648,22 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		), nil
 	})
 
+	d.export = xsync.OnceValue(func() (*export.Client, error) {
+		return export.New(xcontext.ValueOnly(ctx),
+			d.balancer,
+		), nil
+	})
+
+	d.imports = xsync.OnceValue(func() (*imports.Client, error) {
+		return imports.New(xcontext.ValueOnly(ctx),
+			d.balancer,
+		), nil
+	})
+
+	d.changefeed = xsync.OnceValue(func() (*cdc.Client, error) {
+		return cdc.New(d.Table, d.Topic), nil
+	})
+
 	d.scripting = xsync.OnceValue(func() (*internalScripting.Client, error) {
 		return internalScripting.New(xcontext.ValueOnly(ctx),
 			d.balancer,
@@ -549,12 +688,19 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 				[]topicoptions.TopicOption{
 					topicoptions.WithOperationTimeout(d.config.OperationTimeout()),
 					topicoptions.WithOperationCancelAfter(d.config.OperationCancelAfter()),
+					topicoptions.WithPanicCallback(d.config.PanicCallback()),
 				},
 				d.topicOptions...,
 			)...,
 		), nil
 	})
 
+	if d.sessionPoolMinSize > 0 {
+		// warm-up of sessions pools right after connect
+		d.table.Must()
+		d.query.Must()
+	}
+
 	return nil
 }
 
@@ -3,8 +3,11 @@ package ydb
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
@@ -53,6 +56,11 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 	if token := info.Params.Get("token"); token != "" {
 		opts = append(opts, WithCredentials(credentials.NewAccessTokenCredentials(token)))
 	}
+	paramsOpts, err := parseDriverParams(info.Params)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	opts = append(opts, paramsOpts...)
 	if balancer := info.Params.Get("go_balancer"); balancer != "" {
 		opts = append(opts, WithBalancer(balancers.FromConfig(balancer)))
 	} else if balancer := info.Params.Get("balancer"); balancer != "" {
@@ -111,6 +119,47 @@ func parseConnectionString(dataSourceName string) (opts []Option, _ error) {
 	return opts, nil
 }
 
+// parseDriverParams makes options of credentials, session pool and dialing from DSN params
+func parseDriverParams(params url.Values) (opts []Option, _ error) {
+	if user := params.Get("user"); user != "" {
+		opts = append(opts, WithStaticCredentials(user, params.Get("password")))
+	}
+	if tokenFile := params.Get("token_file"); tokenFile != "" {
+		// token file re-reads periodically for support of rotated tokens
+		opts = append(opts, WithCredentials(credentials.NewAccessTokenFileCredentials(tokenFile,
+			credentials.WithSourceInfo("token_file"),
+		)))
+	}
+	// oauth2_key_file is a config of OAuth 2.0 token exchange credentials (as in `ydb --oauth2-key-file`),
+	// which may define service account key for signing of JWT token
+	if configFile := params.Get("oauth2_key_file"); configFile != "" {
+		opts = append(opts, WithOauth2TokenExchangeCredentialsFile(configFile))
+	}
+	if poolMax := params.Get("pool_max"); poolMax != "" {
+		sizeLimit, err := strconv.Atoi(poolMax)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("wrong pool_max param: %w", err))
+		}
+		opts = append(opts, WithSessionPoolSizeLimit(sizeLimit))
+	}
+	for param, option := range map[string]func(time.Duration) Option{
+		"pool_idle_threshold":         WithSessionPoolIdleThreshold,
+		"pool_create_session_timeout": WithSessionPoolCreateSessionTimeout,
+		"pool_delete_timeout":         WithSessionPoolDeleteTimeout,
+		"dial_timeout":                WithDialTimeout,
+	} {
+		if v := params.Get(param); v != "" {
+			timeout, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("wrong %s param: %w", param, err))
+			}
+			opts = append(opts, option(timeout))
+		}
+	}
+
+	return opts, nil
+}
+
 var (
 	tablePathPrefixRe       = regexp.MustCompile(tablePathPrefixTransformer + "\\((.*)\\)")
 	errWrongTablePathPrefix = errors.New("wrong '" + tablePathPrefixTransformer + "' query transformer")
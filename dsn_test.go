@@ -2,7 +2,10 @@ package ydb
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -216,3 +219,53 @@ func TestExtractTablePathPrefixFromBinderName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDriverParams(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("secret\n"), 0o600))
+
+	t.Run("Credentials", func(t *testing.T) {
+		opts, err := parseConnectionString("grpc://localhost:2135/local?user=root&password=1234")
+		require.NoError(t, err)
+		d, err := newConnectionFromOptions(context.Background(), opts...)
+		require.NoError(t, err)
+		require.Equal(t, "root", d.userInfo.User)
+		require.Equal(t, "1234", d.userInfo.Password)
+	})
+	t.Run("TokenFile", func(t *testing.T) {
+		opts, err := parseConnectionString("grpc://localhost:2135/local?token_file=" + tokenFile)
+		require.NoError(t, err)
+		d, err := newConnectionFromOptions(context.Background(), opts...)
+		require.NoError(t, err)
+		token, err := d.config.Credentials().Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "secret", token)
+	})
+	t.Run("NotExistingTokenFile", func(t *testing.T) {
+		opts, err := parseConnectionString("grpc://localhost:2135/local?token_file=" + tokenFile + ".not_exists")
+		require.NoError(t, err)
+		d, err := newConnectionFromOptions(context.Background(), opts...)
+		require.NoError(t, err)
+		_, err = d.config.Credentials().Token(context.Background())
+		require.Error(t, err)
+	})
+	t.Run("PoolAndDial", func(t *testing.T) {
+		opts, err := parseConnectionString(
+			"grpc://localhost:2135/local?pool_max=500&pool_idle_threshold=1m&dial_timeout=3s",
+		)
+		require.NoError(t, err)
+		d, err := newConnectionFromOptions(context.Background(), opts...)
+		require.NoError(t, err)
+		require.Equal(t, 3*time.Second, d.config.DialTimeout())
+		require.Len(t, d.tableOptions, 2)
+	})
+	t.Run("WrongParams", func(t *testing.T) {
+		for _, dsn := range []string{
+			"grpc://localhost:2135/local?pool_max=many",
+			"grpc://localhost:2135/local?dial_timeout=3",
+		} {
+			_, err := parseConnectionString(dsn)
+			require.Error(t, err, dsn)
+		}
+	})
+}
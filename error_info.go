@@ -0,0 +1,296 @@
+package ydb
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+// ErrorKind is a kind of ydb error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ErrorKind uint8
+
+const (
+	// ErrorKindUnknown is a kind of errors which are not ydb errors (such as context errors)
+	ErrorKindUnknown ErrorKind = iota
+
+	// ErrorKindTransport is a kind of transport (grpc) errors
+	ErrorKindTransport
+
+	// ErrorKindOperation is a kind of errors which returned by server with status code of operation
+	ErrorKindOperation
+
+	// ErrorKindDriver is a kind of other errors of driver
+	ErrorKindDriver
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindTransport:
+		return "transport"
+	case ErrorKindOperation:
+		return "operation"
+	case ErrorKindDriver:
+		return "driver"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrorBackoff is a type of backoff which applies before retry of failed operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ErrorBackoff uint8
+
+const (
+	// ErrorBackoffNone means retry without delay
+	ErrorBackoffNone ErrorBackoff = iota
+
+	// ErrorBackoffFast means retry with fast backoff
+	ErrorBackoffFast
+
+	// ErrorBackoffSlow means retry with slow backoff (such as for OVERLOADED errors)
+	ErrorBackoffSlow
+)
+
+func (b ErrorBackoff) String() string {
+	switch b {
+	case ErrorBackoffFast:
+		return "fast"
+	case ErrorBackoffSlow:
+		return "slow"
+	default:
+		return "none"
+	}
+}
+
+// IssueSeverity is a severity of issue
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type IssueSeverity uint32
+
+const (
+	IssueSeverityFatal IssueSeverity = iota
+	IssueSeverityError
+	IssueSeverityWarning
+	IssueSeverityInfo
+)
+
+func (s IssueSeverity) String() string {
+	switch s {
+	case IssueSeverityFatal:
+		return "Fatal"
+	case IssueSeverityError:
+		return "Error"
+	case IssueSeverityWarning:
+		return "Warning"
+	case IssueSeverityInfo:
+		return "Info"
+	default:
+		return "Severity(" + strconv.FormatUint(uint64(s), 10) + ")"
+	}
+}
+
+// IssuePosition is a position of issue in text of query
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type IssuePosition struct {
+	// Row is a line number starting from 1
+	Row uint32
+	// Column is a column number starting from 1
+	Column uint32
+	// File is a name of file (such as name of imported module), empty for query text
+	File string
+}
+
+func (p IssuePosition) String() string {
+	s := strconv.FormatUint(uint64(p.Row), 10) + ":" + strconv.FormatUint(uint64(p.Column), 10)
+	if p.File != "" {
+		return p.File + ":" + s
+	}
+
+	return s
+}
+
+// Issue is an issue of operation error reported by server
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Issue struct {
+	Code     uint32
+	Severity IssueSeverity
+	Message  string
+
+	// Position is a position of issue in query text, nil if issue is not related to query text
+	Position *IssuePosition
+
+	// Nested are issues which detail this issue
+	Nested []Issue
+}
+
+// String renders issue with nested issues as indented tree, one issue per line, such as
+//
+//	Error: Type annotation (code 1030)
+//	  2:8: Error: At function: Add (code 0)
+//	    2:13: Error: Cannot add type Int32 and String (code 1)
+func (i Issue) String() string {
+	var b strings.Builder
+	i.render(&b, 0)
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (i Issue) render(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	if i.Position != nil {
+		b.WriteString(i.Position.String())
+		b.WriteString(": ")
+	}
+	b.WriteString(i.Severity.String())
+	b.WriteString(": ")
+	b.WriteString(i.Message)
+	b.WriteString(" (code ")
+	b.WriteString(strconv.FormatUint(uint64(i.Code), 10))
+	b.WriteString(")\n")
+	for _, nested := range i.Nested {
+		nested.render(b, depth+1)
+	}
+}
+
+// Issues returns tree of issues of operation error. Returns nil if err is not an operation error
+// or operation error has no issues
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Issues(err error) []Issue {
+	var withIssues interface {
+		Issues() []*Ydb_Issue.IssueMessage
+	}
+	if !xerrors.As(err, &withIssues) {
+		return nil
+	}
+
+	return makeIssues(withIssues.Issues())
+}
+
+// ErrorDetails is a structured description of error returned by ErrorInfo
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ErrorDetails struct {
+	Kind ErrorKind
+
+	// Code is a status code of operation error (Ydb.StatusIds_StatusCode) or
+	// code of transport error (grpc codes.Code)
+	Code int32
+
+	// Name is a short name of error such as "operation/OVERLOADED"
+	Name string
+
+	// Retryable reports that error is retryable for any operation
+	Retryable bool
+
+	// RetryableIdempotent reports that error is retryable for idempotent operations
+	RetryableIdempotent bool
+
+	// Backoff is a type of backoff before retry
+	Backoff ErrorBackoff
+
+	// SessionInvalid reports that session (or other object) of failed operation must not be used anymore
+	SessionInvalid bool
+
+	// NodeID is an ID of node which failed operation, zero if unknown
+	NodeID uint32
+
+	// Address is an address of node which failed operation, empty if unknown
+	Address string
+
+	// TraceID is a trace ID of failed request, empty if unknown
+	TraceID string
+
+	// Issues is a tree of issues of operation error
+	Issues []Issue
+}
+
+// ErrorInfo returns structured description of err for making of policy decisions
+// (retries, alerts, circuit breaking) without parsing of error text.
+// ErrorInfo returns nil if err is nil
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ErrorInfo(err error) *ErrorDetails {
+	if err == nil {
+		return nil
+	}
+
+	m := retry.Check(err)
+	info := &ErrorDetails{
+		Retryable:           m.MustRetry(false),
+		RetryableIdempotent: m.MustRetry(true),
+		SessionInvalid:      m.MustDeleteSession(),
+	}
+	switch m.BackoffType() {
+	case backoff.TypeFast:
+		info.Backoff = ErrorBackoffFast
+	case backoff.TypeSlow:
+		info.Backoff = ErrorBackoffSlow
+	}
+
+	switch {
+	case xerrors.IsOperationError(err):
+		info.Kind = ErrorKindOperation
+	case xerrors.IsTransportError(err):
+		info.Kind = ErrorKindTransport
+	case xerrors.IsYdb(err):
+		info.Kind = ErrorKindDriver
+	}
+
+	var e xerrors.Error
+	if xerrors.As(err, &e) {
+		info.Code = e.Code()
+		info.Name = e.Name()
+	}
+
+	var node interface {
+		NodeID() uint32
+		Address() string
+		TraceID() string
+	}
+	if xerrors.As(err, &node) {
+		info.NodeID = node.NodeID()
+		info.Address = node.Address()
+		info.TraceID = node.TraceID()
+	}
+
+	info.Issues = Issues(err)
+
+	return info
+}
+
+func makeIssues(messages []*Ydb_Issue.IssueMessage) []Issue {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	issues := make([]Issue, 0, len(messages))
+	for _, m := range messages {
+		issue := Issue{
+			Code:     m.GetIssueCode(),
+			Severity: IssueSeverity(m.GetSeverity()),
+			Message:  m.GetMessage(),
+			Nested:   makeIssues(m.GetIssues()),
+		}
+		if p := m.GetPosition(); p != nil {
+			issue.Position = &IssuePosition{
+				Row:    p.GetRow(),
+				Column: p.GetColumn(),
+				File:   p.GetFile(),
+			}
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
@@ -0,0 +1,121 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestErrorInfo(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		require.Nil(t, ErrorInfo(nil))
+	})
+	t.Run("Operation", func(t *testing.T) {
+		err := fmt.Errorf("wrap: %w", xerrors.WithStackTrace(xerrors.Operation(
+			xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED),
+			xerrors.WithNodeID(42),
+			xerrors.WithAddress("node:2135"),
+			xerrors.WithTraceID("trace"),
+			xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+				Message:   "outer",
+				IssueCode: 1,
+				Severity:  1,
+				Issues: []*Ydb_Issue.IssueMessage{{
+					Message:   "inner",
+					IssueCode: 2,
+				}},
+			}}),
+		)))
+		require.Equal(t, &ErrorDetails{
+			Kind:                ErrorKindOperation,
+			Code:                int32(Ydb.StatusIds_OVERLOADED),
+			Name:                "operation/OVERLOADED",
+			Retryable:           true,
+			RetryableIdempotent: true,
+			Backoff:             ErrorBackoffSlow,
+			NodeID:              42,
+			Address:             "node:2135",
+			TraceID:             "trace",
+			Issues: []Issue{{
+				Code:     1,
+				Severity: 1,
+				Message:  "outer",
+				Nested:   []Issue{{Code: 2, Message: "inner"}},
+			}},
+		}, ErrorInfo(err))
+	})
+	t.Run("ConditionallyRetryable", func(t *testing.T) {
+		info := ErrorInfo(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED)))
+		require.False(t, info.Retryable)
+		require.True(t, info.RetryableIdempotent)
+	})
+	t.Run("Transport", func(t *testing.T) {
+		info := ErrorInfo(xerrors.Transport(
+			grpcStatus.Error(grpcCodes.Unavailable, "unavailable"),
+			xerrors.WithAddress("node:2135"),
+		))
+		require.Equal(t, ErrorKindTransport, info.Kind)
+		require.Equal(t, int32(grpcCodes.Unavailable), info.Code)
+		require.Equal(t, "node:2135", info.Address)
+		require.True(t, info.RetryableIdempotent)
+		require.True(t, info.SessionInvalid)
+	})
+	t.Run("Context", func(t *testing.T) {
+		info := ErrorInfo(context.Canceled)
+		require.Equal(t, ErrorKindUnknown, info.Kind)
+		require.False(t, info.Retryable)
+		require.False(t, info.RetryableIdempotent)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		info := ErrorInfo(errors.New("test"))
+		require.Equal(t, ErrorKindUnknown, info.Kind)
+		require.False(t, info.RetryableIdempotent)
+	})
+}
+
+func TestIssues(t *testing.T) {
+	err := xerrors.WithStackTrace(xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_GENERIC_ERROR),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+			Message:   "Type annotation",
+			IssueCode: 1030,
+			Severity:  1,
+			Issues: []*Ydb_Issue.IssueMessage{{
+				Message:  "At function: Add",
+				Severity: 1,
+				Position: &Ydb_Issue.IssueMessage_Position{Row: 2, Column: 8},
+				Issues: []*Ydb_Issue.IssueMessage{{
+					Message:   "Cannot add type Int32 and String",
+					IssueCode: 1,
+					Severity:  1,
+					Position:  &Ydb_Issue.IssueMessage_Position{Row: 2, Column: 13, File: "module.yql"},
+				}},
+			}},
+		}}),
+	))
+
+	issues := Issues(err)
+	require.Len(t, issues, 1)
+	require.Nil(t, issues[0].Position)
+	require.Equal(t, IssueSeverityError, issues[0].Severity)
+	require.Equal(t, &IssuePosition{Row: 2, Column: 8}, issues[0].Nested[0].Position)
+	require.Equal(t, &IssuePosition{Row: 2, Column: 13, File: "module.yql"}, issues[0].Nested[0].Nested[0].Position)
+	require.Equal(t, ""+
+		"Error: Type annotation (code 1030)\n"+
+		"  2:8: Error: At function: Add (code 0)\n"+
+		"    module.yql:2:13: Error: Cannot add type Int32 and String (code 1)",
+		issues[0].String(),
+	)
+
+	require.Nil(t, Issues(errors.New("test")))
+	require.Nil(t, Issues(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))))
+}
@@ -99,3 +99,56 @@ func IsRatelimiterAcquireError(err error) bool {
 func ToRatelimiterAcquireError(err error) ratelimiter.AcquireError {
 	return ratelimiterErrors.ToAcquireError(err)
 }
+
+// PanicError is an interface of error which reports about recovered panic in user callback
+type PanicError interface {
+	error
+
+	// Recovered returns the value which was passed to panic
+	Recovered() interface{}
+
+	// Stack returns stack trace of goroutine at the moment of recover
+	Stack() []byte
+}
+
+// IsPanicError checks whether given err is a recovered panic from user callback
+func IsPanicError(err error) bool {
+	return xerrors.IsPanic(err)
+}
+
+// ToPanicError casts given err to PanicError.
+// If given err is not a recovered panic - returns nil
+func ToPanicError(err error) PanicError {
+	if e := xerrors.ToPanic(err); e != nil {
+		return e
+	}
+
+	return nil
+}
+
+// SessionError is an interface of error which reports about broken session (BAD_SESSION or SESSION_EXPIRED).
+// Broken sessions are invalidated automatically in sessions pools of table and query clients.
+// Applications which hold sessions by themselves (e.g. long-lived stream readers) must rebuild the session
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SessionError interface {
+	error
+
+	// SessionID returns ID of broken session
+	SessionID() string
+
+	// NodeID returns ID of node of broken session
+	NodeID() uint32
+}
+
+// ToSessionError casts given err to SessionError.
+// If given err is not an error about broken session - returns nil
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ToSessionError(err error) SessionError {
+	if e := xerrors.ToSessionError(err); e != nil {
+		return e
+	}
+
+	return nil
+}
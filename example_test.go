@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -22,6 +23,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 )
 
 //nolint:testableexamples, nonamedreturns
@@ -71,6 +73,40 @@ func Example_query() {
 	}
 }
 
+//nolint:testableexamples, nonamedreturns
+func Example_outbox() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	err = ydb.DoTxWithOutbox(ctx, db, "orders/events",
+		func(ctx context.Context, tx query.TxActor, outbox *ydb.Outbox) error {
+			err := tx.Exec(ctx, `UPSERT INTO orders (id, status) VALUES ($id, "created")`,
+				query.WithParameters(
+					ydb.ParamsBuilder().
+						Param("$id").Uint64(42).
+						Build(),
+				),
+			)
+			if err != nil {
+				return err
+			}
+
+			// message will be visible for topic readers only after commit of transaction
+			return outbox.Publish(ctx, topicwriter.Message{
+				Data: strings.NewReader(`{"id":42,"status":"created"}`),
+			})
+		},
+		query.WithIdempotent(),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
 //nolint:testableexamples, nonamedreturns
 func Example_table() {
 	ctx := context.TODO()
@@ -0,0 +1,105 @@
+package export
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Export_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+var errNoItems = xerrors.Wrap(errors.New("no items for export"))
+
+// Client is a client of export service of YDB
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Client struct {
+	exportServiceClient Ydb_Export_V1.ExportServiceClient
+	operations          *operation.Client
+}
+
+// ToS3 exports tables to S3 compatible storage and waits completion of export operation
+// (if WithAsync not defined). ToS3 returns identifier of export operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) ToS3(ctx context.Context, settings S3Settings, opts ...Option) (operationID string, _ error) {
+	if len(settings.Items) == 0 {
+		return "", xerrors.WithStackTrace(errNoItems)
+	}
+
+	o := options{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	request := &Ydb_Export.ExportToS3Request{
+		OperationParams: internalOperation.Params(ctx, 0, 0, internalOperation.ModeAsync),
+		Settings:        settings.toYDB(),
+	}
+
+	operationID, err := retry.RetryWithResult(ctx, func(ctx context.Context) (string, error) {
+		response, err := c.exportServiceClient.ExportToS3(conn.WithoutWrapping(ctx), request)
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+
+		if status := response.GetOperation().GetStatus(); status != Ydb.StatusIds_SUCCESS &&
+			status != Ydb.StatusIds_STATUS_CODE_UNSPECIFIED {
+			return "", xerrors.WithStackTrace(xerrors.Operation(
+				xerrors.FromOperation(response.GetOperation()),
+			))
+		}
+
+		return response.GetOperation().GetId(), nil
+	})
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	if o.async {
+		return operationID, nil
+	}
+
+	return operationID, wait(ctx, c.operations, operationID, o)
+}
+
+func wait(ctx context.Context, operations *operation.Client, operationID string, o options) error {
+	var pollPolicy operation.PollPolicy
+	if o.pollInterval > 0 {
+		pollPolicy = operation.PollInterval(o.pollInterval)
+	}
+
+	_, err := operations.WaitWithProgress(ctx, operationID, pollPolicy, func(op *operation.Operation) {
+		if o.progress != nil {
+			o.progress(op.Progress)
+		}
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *Client) Close(ctx context.Context) error {
+	return nil
+}
+
+func New(ctx context.Context, cc grpc.ClientConnInterface) *Client {
+	return &Client{
+		exportServiceClient: Ydb_Export_V1.NewExportServiceClient(
+			conn.WithContextModifier(cc, conn.WithoutWrapping),
+		),
+		operations: operation.New(ctx, cc),
+	}
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Export_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type exportConnStub struct {
+	t        *testing.T
+	request  *Ydb_Export.ExportToS3Request
+	polls    int
+	progress []uint32
+}
+
+func (cc *exportConnStub) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	switch method {
+	case Ydb_Export_V1.ExportService_ExportToS3_FullMethodName:
+		cc.request = args.(*Ydb_Export.ExportToS3Request)                  //nolint:forcetypeassert
+		proto.Merge(reply.(proto.Message), &Ydb_Export.ExportToS3Response{ //nolint:forcetypeassert
+			Operation: &Ydb_Operations.Operation{Id: "export", Ready: false},
+		})
+	case Ydb_Operation_V1.OperationService_GetOperation_FullMethodName:
+		completed := cc.progress[cc.polls]
+		cc.polls++
+		md, err := anypb.New(&Ydb_Export.ExportToS3Metadata{
+			ItemsProgress: []*Ydb_Export.ExportItemProgress{
+				{PartsTotal: 2, PartsCompleted: completed},
+			},
+		})
+		require.NoError(cc.t, err)
+		proto.Merge(reply.(proto.Message), &Ydb_Operations.GetOperationResponse{ //nolint:forcetypeassert
+			Operation: &Ydb_Operations.Operation{
+				Id:       "export",
+				Ready:    cc.polls == len(cc.progress),
+				Status:   Ydb.StatusIds_SUCCESS,
+				Metadata: md,
+			},
+		})
+	default:
+		cc.t.Fatalf("unexpected method %q", method)
+	}
+
+	return nil
+}
+
+func (cc *exportConnStub) NewStream(
+	ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	cc.t.Fatalf("unexpected stream %q", method)
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestClientToS3(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Wait", func(t *testing.T) {
+		cc := &exportConnStub{t: t, progress: []uint32{0, 1, 2}}
+		c := New(ctx, cc)
+
+		var progress []float32
+		id, err := c.ToS3(ctx, S3Settings{
+			Endpoint:     "s3.example.com",
+			Scheme:       SchemeHTTP,
+			Bucket:       "backups",
+			Items:        []S3Item{{SourcePath: "/local/series", DestinationPrefix: "dump/series"}},
+			StorageClass: StorageClassStandardIA,
+			Compression:  "zstd-3",
+		}, WithPollInterval(time.Millisecond), WithProgress(func(p float32) {
+			progress = append(progress, p)
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "export", id)
+		require.Equal(t, []float32{0, 50, 100}, progress)
+
+		settings := cc.request.GetSettings()
+		require.Equal(t, Ydb_Export.ExportToS3Settings_HTTP, settings.GetScheme())
+		require.Equal(t, Ydb_Export.ExportToS3Settings_STANDARD_IA, settings.GetStorageClass())
+		require.Equal(t, "zstd-3", settings.GetCompression())
+		require.Equal(t, "/local/series", settings.GetItems()[0].GetSourcePath())
+		require.Equal(t, "dump/series", settings.GetItems()[0].GetDestinationPrefix())
+	})
+	t.Run("Async", func(t *testing.T) {
+		cc := &exportConnStub{t: t}
+		c := New(ctx, cc)
+
+		id, err := c.ToS3(ctx, S3Settings{
+			Items: []S3Item{{SourcePath: "/local/series", DestinationPrefix: "dump/series"}},
+		}, WithAsync())
+		require.NoError(t, err)
+		require.Equal(t, "export", id)
+		require.Zero(t, cc.polls)
+	})
+	t.Run("NoItems", func(t *testing.T) {
+		_, err := New(ctx, &exportConnStub{t: t}).ToS3(ctx, S3Settings{})
+		require.ErrorIs(t, err, errNoItems)
+	})
+}
@@ -0,0 +1,151 @@
+package export
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+)
+
+type (
+	// Scheme is a scheme of S3 endpoint
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Scheme uint8
+
+	// StorageClass is a storage class of exported S3 objects
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StorageClass uint8
+
+	// S3Item describes exported table
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	S3Item struct {
+		// SourcePath is a database path of exported table
+		SourcePath string
+		// DestinationPrefix is a prefix of S3 objects with data and scheme of table
+		DestinationPrefix string
+	}
+
+	// S3Settings is a settings of export to S3
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	S3Settings struct {
+		Endpoint  string
+		Scheme    Scheme
+		Bucket    string
+		Region    string
+		AccessKey string
+		SecretKey string
+
+		Items       []S3Item
+		Description string
+
+		NumberOfRetries uint32
+		StorageClass    StorageClass
+
+		// Compression is a codec of exported data: "zstd" or "zstd-N", where N is a compression level
+		Compression string
+
+		// DisableVirtualAddressing disables virtual hosting style of buckets
+		DisableVirtualAddressing bool
+	}
+)
+
+const (
+	SchemeHTTPS = Scheme(iota) // default
+	SchemeHTTP
+)
+
+const (
+	StorageClassUnspecified = StorageClass(iota)
+	StorageClassStandard
+	StorageClassReducedRedundancy
+	StorageClassStandardIA
+	StorageClassOnezoneIA
+	StorageClassIntelligentTiering
+	StorageClassGlacier
+	StorageClassDeepArchive
+	StorageClassOutposts
+)
+
+var storageClasses = map[StorageClass]Ydb_Export.ExportToS3Settings_StorageClass{
+	StorageClassUnspecified:        Ydb_Export.ExportToS3Settings_STORAGE_CLASS_UNSPECIFIED,
+	StorageClassStandard:           Ydb_Export.ExportToS3Settings_STANDARD,
+	StorageClassReducedRedundancy:  Ydb_Export.ExportToS3Settings_REDUCED_REDUNDANCY,
+	StorageClassStandardIA:         Ydb_Export.ExportToS3Settings_STANDARD_IA,
+	StorageClassOnezoneIA:          Ydb_Export.ExportToS3Settings_ONEZONE_IA,
+	StorageClassIntelligentTiering: Ydb_Export.ExportToS3Settings_INTELLIGENT_TIERING,
+	StorageClassGlacier:            Ydb_Export.ExportToS3Settings_GLACIER,
+	StorageClassDeepArchive:        Ydb_Export.ExportToS3Settings_DEEP_ARCHIVE,
+	StorageClassOutposts:           Ydb_Export.ExportToS3Settings_OUTPOSTS,
+}
+
+func (s S3Settings) toYDB() *Ydb_Export.ExportToS3Settings {
+	settings := &Ydb_Export.ExportToS3Settings{
+		Endpoint:                 s.Endpoint,
+		Scheme:                   Ydb_Export.ExportToS3Settings_HTTPS,
+		Bucket:                   s.Bucket,
+		Region:                   s.Region,
+		AccessKey:                s.AccessKey,
+		SecretKey:                s.SecretKey,
+		Items:                    make([]*Ydb_Export.ExportToS3Settings_Item, len(s.Items)),
+		Description:              s.Description,
+		NumberOfRetries:          s.NumberOfRetries,
+		StorageClass:             storageClasses[s.StorageClass],
+		Compression:              s.Compression,
+		DisableVirtualAddressing: s.DisableVirtualAddressing,
+	}
+	if s.Scheme == SchemeHTTP {
+		settings.Scheme = Ydb_Export.ExportToS3Settings_HTTP
+	}
+	for i, item := range s.Items {
+		settings.Items[i] = &Ydb_Export.ExportToS3Settings_Item{
+			SourcePath:        item.SourcePath,
+			DestinationPrefix: item.DestinationPrefix,
+		}
+	}
+
+	return settings
+}
+
+type (
+	options struct {
+		async        bool
+		pollInterval time.Duration
+		progress     func(progress float32)
+	}
+
+	// Option is an option of export
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(o *options)
+)
+
+// WithAsync returns identifier of export operation without waiting of operation completion.
+// Status of operation can be polled with operation client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAsync() Option {
+	return func(o *options) {
+		o.async = true
+	}
+}
+
+// WithPollInterval defines interval of polling of operation status
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = interval
+	}
+}
+
+// WithProgress defines callback of export progress (in percents)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithProgress(progress func(progress float32)) Option {
+	return func(o *options) {
+		o.progress = progress
+	}
+}
@@ -0,0 +1,161 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	internalTable "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+// Names of checks of HealthReport
+const (
+	HealthCheckCredentials = "credentials"
+	HealthCheckDiscovery   = "discovery"
+	HealthCheckQueryPool   = "query_pool"
+	HealthCheckTablePool   = "table_pool"
+)
+
+var errSessionPoolExhausted = errors.New("ydb: session pool exhausted")
+
+// HealthCheckResult is a result of single check of Driver.HealthCheck
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type HealthCheckResult struct {
+	Name    string
+	Latency time.Duration
+	Err     error
+}
+
+// HealthReport is a structured report of Driver.HealthCheck
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type HealthReport struct {
+	Endpoint string
+	Database string
+
+	// User is an authenticated user from discovery probe
+	User string
+
+	Checks []HealthCheckResult
+
+	// QueryPool and TablePool are the states of default sessions pools.
+	// Nil if client of service was not used yet
+	QueryPool *query.PoolStats
+	TablePool *query.PoolStats
+}
+
+// Healthy returns true if all checks passed
+func (r HealthReport) Healthy() bool {
+	return r.Err() == nil
+}
+
+// Err returns joined errors of failed checks
+func (r HealthReport) Err() error {
+	var errs []error
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", check.Name, check.Err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func runHealthCheck(name string, f func() error) HealthCheckResult {
+	start := time.Now()
+	err := f()
+
+	return HealthCheckResult{
+		Name:    name,
+		Latency: time.Since(start),
+		Err:     err,
+	}
+}
+
+// HealthCheck checks credentials, availability of cluster with cheap WhoAmI probe and state of
+// sessions pools. Report is suitable for HTTP readiness probes. Returned error is not nil if any
+// check failed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) HealthCheck(ctx context.Context) (HealthReport, error) {
+	report := HealthReport{
+		Endpoint: d.Endpoint(),
+		Database: d.Name(),
+	}
+
+	report.Checks = append(report.Checks, runHealthCheck(HealthCheckCredentials, func() error {
+		if credentials := d.config.Credentials(); credentials != nil {
+			if _, err := credentials.Token(ctx); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+
+		return nil
+	}))
+
+	report.Checks = append(report.Checks, runHealthCheck(HealthCheckDiscovery, func() error {
+		whoAmI, err := d.Discovery().WhoAmI(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		report.User = whoAmI.User
+
+		return nil
+	}))
+
+	_ = d.query.IfCreated(func(c *internalQuery.Client) error {
+		stats := c.Stats()
+		report.QueryPool = &stats
+		report.Checks = append(report.Checks, runHealthCheck(HealthCheckQueryPool, func() error {
+			return checkPoolStats(stats)
+		}))
+
+		return nil
+	})
+
+	_ = d.table.IfCreated(func(c *internalTable.Client) error {
+		stats := poolStats(c.PoolStats())
+		report.TablePool = &stats
+		report.Checks = append(report.Checks, runHealthCheck(HealthCheckTablePool, func() error {
+			return checkPoolStats(stats)
+		}))
+
+		return nil
+	})
+
+	if err := report.Err(); err != nil {
+		return report, xerrors.WithStackTrace(err)
+	}
+
+	return report, nil
+}
+
+// checkPoolStats fails if all sessions of pool are in use and callers wait for session
+func checkPoolStats(stats query.PoolStats) error {
+	if stats.Limit > 0 && stats.InUse >= stats.Limit && stats.Wait > 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %d sessions in use, %d callers wait for session",
+			errSessionPoolExhausted, stats.InUse, stats.Wait,
+		))
+	}
+
+	return nil
+}
+
+func poolStats(stats pool.Stats) query.PoolStats {
+	return query.PoolStats{
+		Limit:            stats.Limit,
+		Index:            stats.Index,
+		Idle:             stats.Idle,
+		InUse:            stats.Index - stats.Idle,
+		Wait:             stats.Wait,
+		CreateInProgress: stats.CreateInProgress,
+		Created:          stats.Created,
+		Deleted:          stats.Deleted,
+	}
+}
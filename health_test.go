@@ -0,0 +1,58 @@
+package ydb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func TestHealthReport(t *testing.T) {
+	report := HealthReport{
+		Checks: []HealthCheckResult{
+			{Name: HealthCheckCredentials},
+			{Name: HealthCheckDiscovery},
+		},
+	}
+	require.True(t, report.Healthy())
+	require.NoError(t, report.Err())
+
+	testErr := errors.New("test")
+	report.Checks = append(report.Checks, HealthCheckResult{Name: HealthCheckQueryPool, Err: testErr})
+	require.False(t, report.Healthy())
+	require.ErrorIs(t, report.Err(), testErr)
+	require.Contains(t, report.Err().Error(), HealthCheckQueryPool)
+}
+
+func TestCheckPoolStats(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		stats query.PoolStats
+		err   error
+	}{
+		{
+			name:  "Idle",
+			stats: query.PoolStats{Limit: 10, Index: 2, Idle: 2},
+		},
+		{
+			name:  "AllInUse",
+			stats: query.PoolStats{Limit: 2, Index: 2, InUse: 2},
+		},
+		{
+			name:  "Exhausted",
+			stats: query.PoolStats{Limit: 2, Index: 2, InUse: 2, Wait: 3},
+			err:   errSessionPoolExhausted,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPoolStats(tt.stats)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package imports
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+)
+
+type (
+	// Scheme is a scheme of S3 endpoint
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Scheme uint8
+
+	// S3Item describes imported table
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	S3Item struct {
+		// SourcePrefix is a prefix of S3 objects with data and scheme of table
+		SourcePrefix string
+		// DestinationPath is a database path of imported table
+		DestinationPath string
+	}
+
+	// S3Settings is a settings of import from S3
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	S3Settings struct {
+		Endpoint  string
+		Scheme    Scheme
+		Bucket    string
+		Region    string
+		AccessKey string
+		SecretKey string
+
+		Items       []S3Item
+		Description string
+
+		NumberOfRetries uint32
+
+		// DisableVirtualAddressing disables virtual hosting style of buckets
+		DisableVirtualAddressing bool
+	}
+)
+
+const (
+	SchemeHTTPS = Scheme(iota) // default
+	SchemeHTTP
+)
+
+func (s S3Settings) toYDB() *Ydb_Import.ImportFromS3Settings {
+	settings := &Ydb_Import.ImportFromS3Settings{
+		Endpoint:                 s.Endpoint,
+		Scheme:                   Ydb_Import.ImportFromS3Settings_HTTPS,
+		Bucket:                   s.Bucket,
+		Region:                   s.Region,
+		AccessKey:                s.AccessKey,
+		SecretKey:                s.SecretKey,
+		Items:                    make([]*Ydb_Import.ImportFromS3Settings_Item, len(s.Items)),
+		Description:              s.Description,
+		NumberOfRetries:          s.NumberOfRetries,
+		DisableVirtualAddressing: s.DisableVirtualAddressing,
+	}
+	if s.Scheme == SchemeHTTP {
+		settings.Scheme = Ydb_Import.ImportFromS3Settings_HTTP
+	}
+	for i, item := range s.Items {
+		settings.Items[i] = &Ydb_Import.ImportFromS3Settings_Item{
+			SourcePrefix:    item.SourcePrefix,
+			DestinationPath: item.DestinationPath,
+		}
+	}
+
+	return settings
+}
+
+type (
+	options struct {
+		async        bool
+		pollInterval time.Duration
+		progress     func(progress float32)
+	}
+
+	// Option is an option of import
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(o *options)
+)
+
+// WithAsync returns identifier of import operation without waiting of operation completion.
+// Status of operation can be polled with operation client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAsync() Option {
+	return func(o *options) {
+		o.async = true
+	}
+}
+
+// WithPollInterval defines interval of polling of operation status
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPollInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = interval
+	}
+}
+
+// WithProgress defines callback of import progress (in percents)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithProgress(progress func(progress float32)) Option {
+	return func(o *options) {
+		o.progress = progress
+	}
+}
@@ -0,0 +1,90 @@
+package ydb
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
+	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	tableConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+)
+
+const (
+	// StatementServiceQuery is a service name of statements executed with db.Query() client
+	StatementServiceQuery = interceptor.ServiceQuery
+
+	// StatementServiceTable is a service name of statements executed with db.Table() client
+	StatementServiceTable = interceptor.ServiceTable
+)
+
+type (
+	// Statement describes executing statement
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Statement = interceptor.Statement
+
+	// StatementExecutor executes statement
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StatementExecutor = interceptor.Executor
+
+	// StatementExecutorFunc is an adapter to allow the use of ordinary functions as StatementExecutor
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	StatementExecutorFunc = interceptor.ExecutorFunc
+
+	// QueryInterceptor is a middleware of executing statements.
+	// QueryInterceptor can rewrite statement (query text and parameters) before passing it to next executor,
+	// veto statement with returning error without calling of next executor or observe executing of statement.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	QueryInterceptor = interceptor.Interceptor
+)
+
+// WithQueryInterceptors appends interceptors of statements executed with db.Query() and db.Table() clients.
+// First interceptor is an outermost and calls first.
+//
+// Interceptors wraps sending of statement and opening of result stream, reading of result
+// parts not covered by interceptors.
+// Table client intercepts data queries (Execute) and scan queries (StreamExecuteScanQuery)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryInterceptors(interceptors ...QueryInterceptor) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithInterceptors(interceptors...))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithInterceptors(interceptors...))
+
+		return nil
+	}
+}
+
+// WithQueryPragma appends pragma which injects into each statement executed with db.Query() and db.Table() clients.
+// Empty value means pragma without value (`PRAGMA name;`), otherwise pragma will be `PRAGMA name("value");`.
+// Pragma is not injected if statement already declares pragma with the same name.
+//
+// Pragmas injects after all interceptors defined with WithQueryInterceptors, so interceptors
+// observe original statement
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryPragma(name, value string) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryPragmas = append(d.queryPragmas, interceptor.Pragma{
+			Name:  name,
+			Value: value,
+		})
+
+		return nil
+	}
+}
+
+// WithQueryHint appends statement hint (such as `--!syntax_v1`) which writes as is on the top
+// of each statement executed with db.Query() and db.Table() clients (before injected pragmas).
+// Hint is not injected if statement already contains it.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryHint(hint string) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryHints = append(d.queryHints, hint)
+
+		return nil
+	}
+}
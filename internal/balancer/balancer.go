@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
@@ -26,7 +29,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
-var ErrNoEndpoints = xerrors.Wrap(fmt.Errorf("no endpoints"))
+var (
+	ErrNoEndpoints = xerrors.Wrap(fmt.Errorf("no endpoints"))
+
+	errSingleConnReconfigure = xerrors.Wrap(fmt.Errorf("single connection mode can't be changed without reconnecting"))
+)
 
 type discoveryClient interface {
 	closer.Closer
@@ -36,28 +43,82 @@ type discoveryClient interface {
 
 type Balancer struct {
 	driverConfig      *config.Config
+	configMu          xsync.RWMutex
 	config            balancerConfig.Config
 	pool              *conn.Pool
 	discoveryClient   discoveryClient
 	discoveryRepeater repeater.Repeater
 	localDCDetector   func(ctx context.Context, endpoints []endpoint.Endpoint) (string, error)
+	latency           *latencyTracker
 
 	connectionsState atomic.Pointer[connectionsState]
 
 	mu                         xsync.RWMutex
 	onApplyDiscoveredEndpoints []func(ctx context.Context, endpoints []endpoint.Info)
+	discoveredEndpoints        []endpoint.Info
 }
 
+// OnUpdate registers callback of applying of discovered endpoints.
+// Callback calls immediately with current endpoints if discovery was done already
 func (b *Balancer) OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) {
 	b.mu.WithLock(func() {
 		b.onApplyDiscoveredEndpoints = append(b.onApplyDiscoveredEndpoints, onApplyDiscoveredEndpoints)
+
+		if b.discoveredEndpoints != nil {
+			onApplyDiscoveredEndpoints(context.Background(), b.discoveredEndpoints)
+		}
+	})
+}
+
+func (b *Balancer) currentConfig() balancerConfig.Config {
+	return xsync.WithRLock(&b.configMu, func() balancerConfig.Config {
+		return b.config
+	})
+}
+
+// Reconfigure changes balancer preferences at runtime and re-applies them to the discovered endpoints.
+// Switching of single connection mode isn't supported without reconnecting
+func (b *Balancer) Reconfigure(ctx context.Context, cfg balancerConfig.Config) error {
+	if cfg.SingleConn != b.currentConfig().SingleConn {
+		return xerrors.WithStackTrace(errSingleConnReconfigure)
+	}
+
+	b.configMu.WithLock(func() {
+		b.config = cfg
 	})
+
+	if cfg.SingleConn {
+		return nil
+	}
+
+	if err := b.clusterDiscoveryAttempt(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
 }
 
 func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
+	var (
+		policy   = b.driverConfig.DialRetry()
+		attempts = 0
+		opts     = []retry.Option{
+			retry.WithIdempotent(true),
+			retry.WithTrace(b.driverConfig.TraceRetry()),
+			retry.WithBudget(b.driverConfig.RetryBudget()),
+		}
+	)
+	if policy.Backoff != nil {
+		opts = append(opts,
+			retry.WithFastBackoff(policy.Backoff),
+			retry.WithSlowBackoff(policy.Backoff),
+		)
+	}
+
 	return retry.Retry(
 		repeater.WithEvent(ctx, repeater.EventInit),
 		func(childCtx context.Context) (err error) {
+			attempts++
 			if err = b.clusterDiscoveryAttempt(childCtx); err != nil {
 				if credentials.IsAccessError(err) {
 					return credentials.AccessError("cluster discovery failed", err,
@@ -66,6 +127,11 @@ func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
 						credentials.WithCredentials(b.driverConfig.Credentials()),
 					)
 				}
+				if policy.MaxAttempts > 0 && attempts >= policy.MaxAttempts {
+					return xerrors.WithStackTrace(xerrors.Unretryable(
+						fmt.Errorf("cluster discovery failed after %d attempts: %w", attempts, err),
+					))
+				}
 				// if got err but parent context is not done - mark error as retryable
 				if ctx.Err() == nil && xerrors.IsTimeoutError(err) {
 					return xerrors.WithStackTrace(xerrors.Retryable(err))
@@ -76,9 +142,7 @@ func (b *Balancer) clusterDiscovery(ctx context.Context) (err error) {
 
 			return nil
 		},
-		retry.WithIdempotent(true),
-		retry.WithTrace(b.driverConfig.TraceRetry()),
-		retry.WithBudget(b.driverConfig.RetryBudget()),
+		opts...,
 	)
 }
 
@@ -99,6 +163,7 @@ func (b *Balancer) clusterDiscoveryAttempt(ctx context.Context) (err error) {
 		onDone(err)
 	}()
 
+	// bootstrap endpoints dials in parallel, each with dial timeout
 	if dialTimeout := b.driverConfig.DialTimeout(); dialTimeout > 0 {
 		ctx, cancel = xcontext.WithTimeout(ctx, dialTimeout)
 	} else {
@@ -111,7 +176,7 @@ func (b *Balancer) clusterDiscoveryAttempt(ctx context.Context) (err error) {
 		return xerrors.WithStackTrace(err)
 	}
 
-	if b.config.DetectNearestDC {
+	if b.currentConfig().DetectNearestDC {
 		localDC, err = b.localDCDetector(ctx, endpoints)
 		if err != nil {
 			return xerrors.WithStackTrace(err)
@@ -125,11 +190,12 @@ func (b *Balancer) clusterDiscoveryAttempt(ctx context.Context) (err error) {
 
 func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, newest []endpoint.Endpoint, localDC string) {
 	var (
+		cfg    = b.currentConfig()
 		onDone = trace.DriverOnBalancerUpdate(
 			b.driverConfig.Trace(), &ctx,
 			stack.FunctionID(
 				"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer.(*Balancer).applyDiscoveredEndpoints"),
-			b.config.DetectNearestDC,
+			cfg.DetectNearestDC,
 		)
 		previous = b.connections().All()
 	)
@@ -152,7 +218,14 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, newest []endpoi
 	}
 
 	info := balancerConfig.Info{SelfLocation: localDC}
-	state := newConnectionsState(connections, b.config.Filter, info, b.config.AllowFallback)
+	state := newConnectionsState(connections, cfg.Filter, info, cfg.AllowFallback)
+	state.chooser = cfg.Chooser
+	if cfg.PreferLowestLatency && b.latency != nil {
+		b.latency.retain(connections)
+		state.score = func(c conn.Conn) float64 {
+			return b.latency.score(c.Endpoint().Address())
+		}
+	}
 
 	endpointsInfo := make([]endpoint.Info, len(newest))
 	for i, e := range newest {
@@ -162,6 +235,7 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, newest []endpoi
 	b.connectionsState.Store(state)
 
 	b.mu.WithLock(func() {
+		b.discoveredEndpoints = endpointsInfo
 		for _, onApplyDiscoveredEndpoints := range b.onApplyDiscoveredEndpoints {
 			onApplyDiscoveredEndpoints(ctx, endpointsInfo)
 		}
@@ -200,13 +274,16 @@ func New(
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer.New"),
 			driverConfig.Balancer().String(),
 		)
-		discoveryConfig = discoveryConfig.New(append(opts,
-			discoveryConfig.With(driverConfig.Common),
-			discoveryConfig.WithEndpoint(driverConfig.Endpoint()),
-			discoveryConfig.WithDatabase(driverConfig.Database()),
-			discoveryConfig.WithSecure(driverConfig.Secure()),
-			discoveryConfig.WithMeta(driverConfig.Meta()),
-		)...)
+		newDiscoveryConfig = func(endpoint string) *discoveryConfig.Config {
+			return discoveryConfig.New(append(opts,
+				discoveryConfig.With(driverConfig.Common),
+				discoveryConfig.WithEndpoint(endpoint),
+				discoveryConfig.WithDatabase(driverConfig.Database()),
+				discoveryConfig.WithSecure(driverConfig.Secure()),
+				discoveryConfig.WithMeta(driverConfig.Meta()),
+			)...)
+		}
+		discoveryConfig = newDiscoveryConfig(driverConfig.Endpoint())
 	)
 	defer func() {
 		onDone(finalErr)
@@ -219,6 +296,21 @@ func New(
 			endpoint.New(driverConfig.Endpoint()),
 		), discoveryConfig),
 		localDCDetector: detectLocalDC,
+		latency:         newLatencyTracker(),
+	}
+
+	if endpoints := driverConfig.Endpoints(); len(endpoints) > 1 {
+		bootstrap := &bootstrapDiscovery{
+			clients:     make([]discoveryClient, len(endpoints)),
+			dialTimeout: driverConfig.DialTimeout(),
+		}
+		bootstrap.clients[0] = b.discoveryClient
+		for i := 1; i < len(endpoints); i++ {
+			bootstrap.clients[i] = internalDiscovery.New(ctx, pool.Get(
+				endpoint.New(endpoints[i]),
+			), newDiscoveryConfig(endpoints[i]))
+		}
+		b.discoveryClient = bootstrap
 	}
 
 	if config := driverConfig.Balancer(); config == nil {
@@ -300,6 +392,15 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 		return xerrors.WithStackTrace(err)
 	}
 
+	if b.latency != nil && b.currentConfig().PreferLowestLatency {
+		start := time.Now()
+		defer func() {
+			b.latency.observe(cc.Endpoint().Address(), time.Since(start),
+				err != nil && conn.IsBadConn(err, b.driverConfig.ExcludeGRPCCodesForPessimization()...),
+			)
+		}()
+	}
+
 	if err = f(ctx, cc); err != nil {
 		if conn.UseWrapping(ctx) {
 			if credentials.IsAccessError(err) {
@@ -330,6 +431,9 @@ func (b *Balancer) getConn(ctx context.Context) (c conn.Conn, err error) {
 	)
 	defer func() {
 		if err == nil {
+			if usedNodes := endpoint.ContextUsedNodes(ctx); usedNodes != nil {
+				usedNodes.Add(c.Endpoint().NodeID())
+			}
 			onDone(c.Endpoint(), nil)
 		} else {
 			onDone(nil, err)
@@ -351,8 +455,26 @@ func (b *Balancer) getConn(ctx context.Context) (c conn.Conn, err error) {
 		}
 	}()
 
+	// calls pinned to node of session selects connection with builtin selection
+	if _, hasPreferEndpoint := endpoint.ContextNodeID(ctx); state.chooser != nil && !hasPreferEndpoint {
+		if c, err = state.ChooseConnection(ctx); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if c != nil {
+			return c, nil
+		}
+	}
+
 	c, failedCount = state.GetConnection(ctx)
 	if c == nil {
+		nodeID, hasPreferEndpoint := endpoint.ContextNodeID(ctx)
+		if hasPreferEndpoint && endpoint.ContextNodeFallback(ctx) == endpoint.NodeFallbackNone {
+			return nil, xerrors.WithStackTrace(xerrors.Transport(
+				grpcStatus.Errorf(grpcCodes.Unavailable, "preferred node %d is unavailable", nodeID),
+				xerrors.WithNodeID(nodeID),
+			))
+		}
+
 		return nil, xerrors.WithStackTrace(
 			fmt.Errorf("%w: cannot get connection from Balancer after %d attempts", ErrNoEndpoints, failedCount),
 		)
@@ -0,0 +1,78 @@
+package balancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// bootstrapDiscovery discovers cluster with bootstrap endpoints in parallel.
+// Result of first successful bootstrap endpoint returns, discovering with other endpoints cancels
+type bootstrapDiscovery struct {
+	clients     []discoveryClient
+	dialTimeout time.Duration
+}
+
+type bootstrapResult struct {
+	endpoints []endpoint.Endpoint
+	err       error
+}
+
+func (d *bootstrapDiscovery) Discover(ctx context.Context) ([]endpoint.Endpoint, error) {
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan bootstrapResult, len(d.clients))
+	for _, client := range d.clients {
+		go func(client discoveryClient) {
+			endpoints, err := d.discover(ctx, client)
+			results <- bootstrapResult{endpoints: endpoints, err: err}
+		}(client)
+	}
+
+	errs := make([]error, 0, len(d.clients))
+	for range d.clients {
+		result := <-results
+		if result.err == nil {
+			return result.endpoints, nil
+		}
+		errs = append(errs, result.err)
+	}
+
+	return nil, xerrors.WithStackTrace(xerrors.Join(errs...))
+}
+
+func (d *bootstrapDiscovery) discover(ctx context.Context, client discoveryClient) ([]endpoint.Endpoint, error) {
+	var cancel context.CancelFunc
+	if d.dialTimeout > 0 {
+		ctx, cancel = xcontext.WithTimeout(ctx, d.dialTimeout)
+	} else {
+		ctx, cancel = xcontext.WithCancel(ctx)
+	}
+	defer cancel()
+
+	endpoints, err := client.Discover(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return endpoints, nil
+}
+
+func (d *bootstrapDiscovery) Close(ctx context.Context) error {
+	errs := make([]error, 0, len(d.clients))
+	for _, client := range d.clients {
+		if err := client.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+)
+
+type discoveryStub struct {
+	calls     atomic.Int64
+	endpoints []endpoint.Endpoint
+	err       error
+	// hang makes Discover blocked until context done
+	hang bool
+}
+
+func (d *discoveryStub) Close(ctx context.Context) error {
+	return nil
+}
+
+func (d *discoveryStub) Discover(ctx context.Context) ([]endpoint.Endpoint, error) {
+	d.calls.Add(1)
+	if d.hang {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+
+	return d.endpoints, d.err
+}
+
+func TestBootstrapDiscovery(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FirstReachable", func(t *testing.T) {
+		unavailable := &discoveryStub{err: errors.New("unavailable")}
+		available := &discoveryStub{endpoints: []endpoint.Endpoint{&mock.Endpoint{AddrField: "a:123"}}}
+		d := &bootstrapDiscovery{clients: []discoveryClient{unavailable, available}}
+		endpoints, err := d.Discover(ctx)
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+		require.Equal(t, int64(1), available.calls.Load())
+	})
+	t.Run("Parallel", func(t *testing.T) {
+		// hanged endpoint does not delay discovering with reachable endpoint
+		hanged := &discoveryStub{hang: true}
+		available := &discoveryStub{endpoints: []endpoint.Endpoint{&mock.Endpoint{AddrField: "a:123"}}}
+		d := &bootstrapDiscovery{
+			clients:     []discoveryClient{hanged, available},
+			dialTimeout: time.Hour,
+		}
+		start := time.Now()
+		endpoints, err := d.Discover(ctx)
+		require.NoError(t, err)
+		require.Len(t, endpoints, 1)
+		require.Less(t, time.Since(start), time.Minute)
+	})
+	t.Run("DialTimeout", func(t *testing.T) {
+		d := &bootstrapDiscovery{
+			clients:     []discoveryClient{&discoveryStub{hang: true}, &discoveryStub{hang: true}},
+			dialTimeout: time.Millisecond,
+		}
+		_, err := d.Discover(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("AllUnreachable", func(t *testing.T) {
+		unavailable := &discoveryStub{err: errors.New("unavailable")}
+		d := &bootstrapDiscovery{clients: []discoveryClient{unavailable, unavailable}}
+		_, err := d.Discover(ctx)
+		require.Error(t, err)
+		require.Equal(t, int64(2), unavailable.calls.Load())
+	})
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
@@ -14,6 +15,12 @@ type Config struct {
 	AllowFallback   bool
 	SingleConn      bool
 	DetectNearestDC bool
+
+	// PreferLowestLatency enables selection of endpoints by EWMA of latency and error rate of calls
+	PreferLowestLatency bool
+
+	// Chooser defines custom selection of endpoint for call. If Chooser is nil - uses builtin selection
+	Chooser Chooser
 }
 
 func (c Config) String() string {
@@ -24,7 +31,16 @@ func (c Config) String() string {
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 
-	buffer.WriteString("RandomChoice{")
+	switch {
+	case c.Chooser != nil:
+		buffer.WriteString("Custom{Chooser=")
+		buffer.WriteString(c.Chooser.String())
+		buffer.WriteByte(',')
+	case c.PreferLowestLatency:
+		buffer.WriteString("LowestLatency{")
+	default:
+		buffer.WriteString("RandomChoice{")
+	}
 
 	buffer.WriteString("DetectNearestDC=")
 	fmt.Fprintf(buffer, "%t", c.DetectNearestDC)
@@ -50,3 +66,10 @@ type Filter interface {
 	Allow(info Info, e endpoint.Info) bool
 	String() string
 }
+
+// Chooser selects endpoint for call from available endpoints
+type Chooser interface {
+	// Choose returns index of selected endpoint in endpoints
+	Choose(ctx context.Context, endpoints []endpoint.Info) (int, error)
+	String() string
+}
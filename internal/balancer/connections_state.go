@@ -2,13 +2,18 @@ package balancer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
 )
 
+var errChooserIndexOutOfRange = errors.New("index of chosen endpoint out of range")
+
 type connectionsState struct {
 	connByNodeID map[uint32]conn.Conn
 
@@ -17,6 +22,13 @@ type connectionsState struct {
 	all      []conn.Conn
 
 	rand xrand.Rand
+
+	// score defines preferred connection from two random choices (lower is better).
+	// If score is nil - connection selects randomly
+	score func(c conn.Conn) float64
+
+	// chooser defines custom selection of connection
+	chooser balancerConfig.Chooser
 }
 
 func newConnectionsState(
@@ -62,14 +74,37 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 		return nil, 0
 	}
 
-	if c := s.preferConnection(ctx); c != nil {
-		return c, 0
+	if nodeID, hasPreferEndpoint := endpoint.ContextNodeID(ctx); hasPreferEndpoint {
+		if c := s.preferConnection(nodeID); c != nil {
+			return c, 0
+		}
+		switch endpoint.ContextNodeFallback(ctx) {
+		case endpoint.NodeFallbackNone:
+			return nil, 0
+		case endpoint.NodeFallbackSameLocation:
+			if c := s.sameLocationConnection(nodeID); c != nil {
+				return c, 0
+			}
+		}
 	}
 
+	usedNodes := endpoint.ContextUsedNodes(ctx)
+
 	try := func(conns []conn.Conn) conn.Conn {
+		if usedNodes != nil {
+			// hedged attempts of operation selects connection to other nodes if any
+			if unused := withoutUsedNodes(conns, usedNodes); len(unused) > 0 {
+				conns = unused
+			}
+		}
+
 		c, tryFailed := s.selectRandomConnection(conns, false)
 		failedCount += tryFailed
 
+		if c != nil && s.score != nil {
+			return s.selectBetterConnection(conns, c)
+		}
+
 		return c
 	}
 
@@ -86,17 +121,78 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 	return c, failedCount
 }
 
-func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
-	if nodeID, hasPreferEndpoint := endpoint.ContextNodeID(ctx); hasPreferEndpoint {
-		c := s.connByNodeID[nodeID]
-		if c != nil && isOkConnection(c, true) {
-			return c
+// ChooseConnection selects connection with custom chooser from available connections.
+// Preferred connections passes to chooser if any of them is available, then fallback connections
+func (s *connectionsState) ChooseConnection(ctx context.Context) (conn.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var candidates []conn.Conn
+	for _, conns := range [][]conn.Conn{s.prefer, s.fallback} {
+		for _, c := range conns {
+			if isOkConnection(c, false) {
+				candidates = append(candidates, c)
+			}
+		}
+		if len(candidates) > 0 {
+			break
 		}
 	}
+	if len(candidates) == 0 {
+		candidates = s.all
+	}
+	if len(candidates) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	endpoints := make([]endpoint.Info, len(candidates))
+	for i, c := range candidates {
+		endpoints[i] = c.Endpoint()
+	}
+
+	index, err := s.chooser.Choose(ctx, endpoints)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if index < 0 || index >= len(candidates) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %d of %d endpoints (%s)",
+			errChooserIndexOutOfRange, index, len(candidates), s.chooser.String(),
+		))
+	}
+
+	return candidates[index], nil
+}
+
+func (s *connectionsState) preferConnection(nodeID uint32) conn.Conn {
+	c := s.connByNodeID[nodeID]
+	if c != nil && isOkConnection(c, true) {
+		return c
+	}
 
 	return nil
 }
 
+// sameLocationConnection selects random connection from location of node with nodeID
+func (s *connectionsState) sameLocationConnection(nodeID uint32) conn.Conn {
+	preferred := s.connByNodeID[nodeID]
+	if preferred == nil {
+		return nil
+	}
+
+	location := preferred.Endpoint().Location()
+	conns := make([]conn.Conn, 0, len(s.all))
+	for _, c := range s.all {
+		if c != preferred && c.Endpoint().Location() == location {
+			conns = append(conns, c)
+		}
+	}
+
+	c, _ := s.selectRandomConnection(conns, false)
+
+	return c
+}
+
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
@@ -129,6 +225,36 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 	return nil, failedConns
 }
 
+func withoutUsedNodes(conns []conn.Conn, usedNodes *endpoint.UsedNodes) []conn.Conn {
+	unused := make([]conn.Conn, 0, len(conns))
+	for _, c := range conns {
+		if !usedNodes.Has(c.Endpoint().NodeID()) && isOkConnection(c, false) {
+			unused = append(unused, c)
+		}
+	}
+
+	return unused
+}
+
+// selectBetterConnection compares connection c with other random connection by score
+// (power of two random choices)
+func (s *connectionsState) selectBetterConnection(conns []conn.Conn, c conn.Conn) conn.Conn {
+	if len(conns) < 2 {
+		return c
+	}
+
+	other := conns[s.rand.Int(len(conns))]
+	if other == c || !isOkConnection(other, false) {
+		return c
+	}
+
+	if s.score(other) < s.score(c) {
+		return other
+	}
+
+	return c
+}
+
 func connsToNodeIDMap(conns []conn.Conn) (nodes map[uint32]conn.Conn) {
 	if len(conns) == 0 {
 		return nil
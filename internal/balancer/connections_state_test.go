@@ -2,6 +2,7 @@ package balancer
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -462,3 +463,123 @@ func TestConnection(t *testing.T) {
 		require.Equal(t, 0, failed)
 	})
 }
+
+func TestConnectionNodeFallback(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "a1", State: conn.Online, NodeIDField: 1, LocationField: "a"},
+		&mock.Conn{AddrField: "a2", State: conn.Unknown, NodeIDField: 2, LocationField: "a"},
+		&mock.Conn{AddrField: "b3", State: conn.Online, NodeIDField: 3, LocationField: "b"},
+	}
+	t.Run("AnyNode", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		c, _ := s.GetConnection(endpoint.WithNodeID(context.Background(), 2))
+		require.NotNil(t, c)
+		require.NotEqual(t, "a2", c.Endpoint().Address())
+	})
+	t.Run("SameLocation", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		ctx := endpoint.WithNodeFallback(
+			endpoint.WithNodeID(context.Background(), 2),
+			endpoint.NodeFallbackSameLocation,
+		)
+		for i := 0; i < 10; i++ {
+			c, _ := s.GetConnection(ctx)
+			require.Equal(t, "a1", c.Endpoint().Address())
+		}
+	})
+	t.Run("None", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		ctx := endpoint.WithNodeFallback(
+			endpoint.WithNodeID(context.Background(), 2),
+			endpoint.NodeFallbackNone,
+		)
+		c, _ := s.GetConnection(ctx)
+		require.Nil(t, c)
+
+		c, _ = s.GetConnection(endpoint.WithNodeFallback(
+			endpoint.WithNodeID(context.Background(), 3),
+			endpoint.NodeFallbackNone,
+		))
+		require.Equal(t, "b3", c.Endpoint().Address())
+	})
+}
+
+func TestConnectionUsedNodes(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "n1", State: conn.Online, NodeIDField: 1},
+		&mock.Conn{AddrField: "n2", State: conn.Online, NodeIDField: 2},
+	}
+	s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+	for i := 0; i < 10; i++ {
+		usedNodes := endpoint.NewUsedNodes()
+		usedNodes.Add(1)
+		c, _ := s.GetConnection(endpoint.WithUsedNodes(context.Background(), usedNodes))
+		require.Equal(t, "n2", c.Endpoint().Address())
+
+		// all nodes used, selects any node
+		usedNodes.Add(2)
+		c, _ = s.GetConnection(endpoint.WithUsedNodes(context.Background(), usedNodes))
+		require.NotNil(t, c)
+	}
+}
+
+type chooserFunc func(ctx context.Context, endpoints []endpoint.Info) (int, error)
+
+func (f chooserFunc) Choose(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+	return f(ctx, endpoints)
+}
+
+func (f chooserFunc) String() string {
+	return "Func"
+}
+
+func TestChooseConnection(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "t1", State: conn.Online, LocationField: "t"},
+		&mock.Conn{AddrField: "t2", State: conn.Banned, LocationField: "t"},
+		&mock.Conn{AddrField: "f3", State: conn.Online, LocationField: "f"},
+	}
+	filter := filterFunc(func(info balancerConfig.Info, e endpoint.Info) bool {
+		return e.Location() == info.SelfLocation
+	})
+	t.Run("Preferred", func(t *testing.T) {
+		s := newConnectionsState(conns, filter, balancerConfig.Info{SelfLocation: "t"}, true)
+		s.chooser = chooserFunc(func(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+			require.Len(t, endpoints, 1)
+			require.Equal(t, "t1", endpoints[0].Address())
+
+			return 0, nil
+		})
+		c, err := s.ChooseConnection(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "t1", c.Endpoint().Address())
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		s := newConnectionsState(conns, filter, balancerConfig.Info{SelfLocation: "x"}, true)
+		s.chooser = chooserFunc(func(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+			require.Len(t, endpoints, 2)
+
+			return 1, nil
+		})
+		c, err := s.ChooseConnection(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "f3", c.Endpoint().Address())
+	})
+	t.Run("Error", func(t *testing.T) {
+		testErr := errors.New("test")
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		s.chooser = chooserFunc(func(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+			return 0, testErr
+		})
+		_, err := s.ChooseConnection(context.Background())
+		require.ErrorIs(t, err, testErr)
+	})
+	t.Run("OutOfRange", func(t *testing.T) {
+		s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+		s.chooser = chooserFunc(func(ctx context.Context, endpoints []endpoint.Info) (int, error) {
+			return len(endpoints), nil
+		})
+		_, err := s.ChooseConnection(context.Background())
+		require.ErrorIs(t, err, errChooserIndexOutOfRange)
+	})
+}
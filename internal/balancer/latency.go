@@ -0,0 +1,83 @@
+package balancer
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+const (
+	// latencyEWMAAlpha is a weight of last observation in exponentially weighted moving averages
+	latencyEWMAAlpha = 0.2
+
+	// latencyErrorPenalty is a multiplier of error rate in score of endpoint
+	latencyErrorPenalty = 10
+)
+
+type endpointLatency struct {
+	latency   float64 // EWMA of latency in nanoseconds
+	errorRate float64 // EWMA of failed calls ratio
+}
+
+// latencyTracker tracks EWMA of latency and error rate of calls per endpoint address
+type latencyTracker struct {
+	mu    xsync.Mutex
+	stats map[string]*endpointLatency
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{
+		stats: make(map[string]*endpointLatency),
+	}
+}
+
+func (t *latencyTracker) observe(address string, latency time.Duration, failed bool) {
+	var failure float64
+	if failed {
+		failure = 1
+	}
+
+	t.mu.WithLock(func() {
+		s, has := t.stats[address]
+		if !has {
+			t.stats[address] = &endpointLatency{
+				latency:   float64(latency),
+				errorRate: failure,
+			}
+
+			return
+		}
+		s.latency += latencyEWMAAlpha * (float64(latency) - s.latency)
+		s.errorRate += latencyEWMAAlpha * (failure - s.errorRate)
+	})
+}
+
+// score returns score of endpoint, endpoint with lower score is preferred.
+// Endpoints without observations have zero score for getting traffic and observations
+func (t *latencyTracker) score(address string) float64 {
+	return xsync.WithLock(&t.mu, func() float64 {
+		s, has := t.stats[address]
+		if !has {
+			return 0
+		}
+
+		return s.latency * (1 + latencyErrorPenalty*s.errorRate)
+	})
+}
+
+// retain drops observations of endpoints which not contains in conns
+func (t *latencyTracker) retain(conns []conn.Conn) {
+	addresses := make(map[string]struct{}, len(conns))
+	for _, c := range conns {
+		addresses[c.Endpoint().Address()] = struct{}{}
+	}
+
+	t.mu.WithLock(func() {
+		for address := range t.stats {
+			if _, has := addresses[address]; !has {
+				delete(t.stats, address)
+			}
+		}
+	})
+}
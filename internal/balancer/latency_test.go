@@ -0,0 +1,61 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	tracker := newLatencyTracker()
+	require.Zero(t, tracker.score("fast"))
+
+	for i := 0; i < 10; i++ {
+		tracker.observe("fast", time.Millisecond, false)
+		tracker.observe("slow", 100*time.Millisecond, false)
+		tracker.observe("failing", time.Millisecond, true)
+	}
+	require.Less(t, tracker.score("fast"), tracker.score("slow"))
+	require.Less(t, tracker.score("fast"), tracker.score("failing"))
+
+	// recovered endpoint
+	for i := 0; i < 100; i++ {
+		tracker.observe("slow", time.Millisecond, false)
+	}
+	require.InDelta(t, tracker.score("fast"), tracker.score("slow"), float64(time.Millisecond))
+
+	tracker.retain([]conn.Conn{&mock.Conn{AddrField: "fast"}})
+	require.Zero(t, tracker.score("slow"))
+	require.NotZero(t, tracker.score("fast"))
+}
+
+func TestConnectionsStateLowestLatency(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "fast", State: conn.Online},
+		&mock.Conn{AddrField: "slow", State: conn.Online},
+	}
+	tracker := newLatencyTracker()
+	tracker.observe("fast", time.Millisecond, false)
+	tracker.observe("slow", time.Second, false)
+
+	s := newConnectionsState(conns, nil, balancerConfig.Info{}, false)
+	s.score = func(c conn.Conn) float64 {
+		return tracker.score(c.Endpoint().Address())
+	}
+
+	fast := 0
+	for i := 0; i < 100; i++ {
+		c, _ := s.GetConnection(context.Background())
+		if c.Endpoint().Address() == "fast" {
+			fast++
+		}
+	}
+	// slow endpoint selects only if both random choices are slow endpoint
+	require.Greater(t, fast, 60)
+}
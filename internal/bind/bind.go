@@ -14,6 +14,7 @@ const (
 	blockPragma = blockID(iota)
 	blockDeclare
 	blockYQL
+	blockStructArgs
 )
 
 type Bind interface {
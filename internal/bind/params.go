@@ -9,6 +9,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -23,6 +24,15 @@ var (
 
 //nolint:gocyclo,funlen
 func toValue(v interface{}) (_ types.Value, err error) {
+	if marshaler, ok := v.(value.ValueMarshaler); ok {
+		vv, err := marshaler.MarshalYDBValue()
+		if err != nil {
+			return nil, fmt.Errorf("ydb: ydb.ValueMarshaler error: %w", err)
+		}
+
+		return vv, nil
+	}
+
 	if valuer, ok := v.(driver.Valuer); ok {
 		v, err = valuer.Value()
 		if err != nil {
@@ -35,6 +45,14 @@ func toValue(v interface{}) (_ types.Value, err error) {
 		return types.VoidValue(), nil
 	case value.Value:
 		return x, nil
+	case decimal.Decimal:
+		return value.DecimalValue(x.Bytes, x.Precision, x.Scale), nil
+	case *decimal.Decimal:
+		if x == nil {
+			return types.NullValue(types.DefaultDecimal), nil
+		}
+
+		return types.NullableDecimalValue(&x.Bytes, x.Precision, x.Scale), nil
 	case bool:
 		return types.BoolValue(x), nil
 	case *bool:
@@ -125,6 +143,10 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	case *time.Duration:
 		return types.NullableIntervalValueFromDuration(x), nil
 	default:
+		if v, ok := value.UUIDValueFromAny(x, value.UUIDNativeByteOrder); ok {
+			return v, nil
+		}
+
 		return nil, xerrors.WithStackTrace(
 			fmt.Errorf("%T: %w. Create issue for support new type %s",
 				x, errUnsupportedType, supportNewTypeLink(x),
@@ -133,6 +155,16 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	}
 }
 
+// ToValue converts go value into YDB value as database/sql query arg
+func ToValue(v interface{}) (types.Value, error) {
+	vv, err := toValue(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return vv, nil
+}
+
 func supportNewTypeLink(x interface{}) string {
 	v := url.Values{}
 	v.Add("labels", "enhancement,database/sql")
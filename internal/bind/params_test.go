@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -37,6 +38,21 @@ func TestToValue(t *testing.T) {
 			dst: types.BoolValue(true),
 			err: nil,
 		},
+		{
+			src: *types.DecimalFromBigInt(big.NewInt(12345), 22, 9),
+			dst: types.DecimalValueFromBigInt(big.NewInt(12345), 22, 9),
+			err: nil,
+		},
+		{
+			src: types.DecimalFromBigInt(big.NewInt(12345), 22, 9),
+			dst: types.OptionalValue(types.DecimalValueFromBigInt(big.NewInt(12345), 22, 9)),
+			err: nil,
+		},
+		{
+			src: func() *types.Decimal { return nil }(),
+			dst: types.NullValue(types.DefaultDecimal),
+			err: nil,
+		},
 		{
 			src: func(v bool) *bool { return &v }(true),
 			dst: types.OptionalValue(types.BoolValue(true)),
@@ -325,6 +341,11 @@ func TestToValue(t *testing.T) {
 			dst: types.NullValue(types.TypeInterval),
 			err: nil,
 		},
+		{
+			src: testEnum("active"),
+			dst: types.Uint8Value(1),
+			err: nil,
+		},
 	} {
 		t.Run(fmt.Sprintf("%T(%v)", tt.src, tt.src), func(t *testing.T) {
 			dst, err := toValue(tt.src)
@@ -337,6 +358,17 @@ func TestToValue(t *testing.T) {
 	}
 }
 
+type testEnum string
+
+func (e testEnum) MarshalYDBValue() (types.Value, error) {
+	return types.Uint8Value(map[testEnum]uint8{"active": 1}[e]), nil
+}
+
+// Value must be ignored because of testEnum implements types.ValueMarshaler
+func (e testEnum) Value() (driver.Value, error) {
+	return string(e), nil
+}
+
 func named(name string, value interface{}) driver.NamedValue {
 	return driver.NamedValue{
 		Name:  name,
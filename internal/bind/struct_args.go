@@ -0,0 +1,66 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// structArgTagName is a tag of struct fields with names of query parameters
+const structArgTagName = "sql"
+
+var typeOfTime = reflect.TypeOf(time.Time{})
+
+// StructArgs expands query args which are Go structs (or pointers to structs) to named
+// query parameters. Names of parameters defines by `sql` tag (name of Go field by default)
+type StructArgs struct{}
+
+func (m StructArgs) blockID() blockID {
+	return blockStructArgs
+}
+
+func (m StructArgs) RewriteQuery(query string, args ...interface{}) (
+	yql string, newArgs []interface{}, err error,
+) {
+	newArgs = make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		v := arg
+		if nv, ok := arg.(driver.NamedValue); ok && nv.Name == "" {
+			v = nv.Value
+		}
+
+		if !isStructArg(v) {
+			newArgs = append(newArgs, arg)
+
+			continue
+		}
+
+		parameters, err := params.FromStruct(v, structArgTagName)
+		if err != nil {
+			return "", nil, xerrors.WithStackTrace(err)
+		}
+
+		for _, p := range *parameters {
+			newArgs = append(newArgs, p)
+		}
+	}
+
+	return query, newArgs, nil
+}
+
+func isStructArg(v interface{}) bool {
+	switch v.(type) {
+	case nil, value.Value, driver.Valuer, sql.NamedArg, driver.NamedValue,
+		*params.Parameter, *params.Parameters, time.Time, *time.Time:
+		return false
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+
+	return rv.Kind() == reflect.Struct && rv.Type() != typeOfTime
+}
@@ -0,0 +1,86 @@
+package bind
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestStructArgsBindRewriteQuery(t *testing.T) {
+	type args struct {
+		ID      uint64 `sql:"id"`
+		Title   string `sql:"$title"`
+		Ignored string `sql:"-"`
+		Count   int32
+	}
+	now := time.Now()
+	for _, tt := range []struct {
+		name   string
+		args   []interface{}
+		params []interface{}
+		err    bool
+	}{
+		{
+			name: "Struct",
+			args: []interface{}{
+				args{ID: 1, Title: "test", Ignored: "ignored", Count: 2},
+			},
+			params: []interface{}{
+				table.ValueParam("$id", types.Uint64Value(1)),
+				table.ValueParam("$title", types.TextValue("test")),
+				table.ValueParam("$Count", types.Int32Value(2)),
+			},
+		},
+		{
+			name: "PointerToStructInNamedValue",
+			args: []interface{}{
+				driver.NamedValue{Ordinal: 1, Value: &args{ID: 1, Title: "test", Count: 2}},
+			},
+			params: []interface{}{
+				table.ValueParam("$id", types.Uint64Value(1)),
+				table.ValueParam("$title", types.TextValue("test")),
+				table.ValueParam("$Count", types.Int32Value(2)),
+			},
+		},
+		{
+			name: "NotStructArgs",
+			args: []interface{}{
+				sql.Named("a", 1),
+				now,
+				table.ValueParam("$b", types.Int32Value(2)),
+				driver.NamedValue{Name: "c", Value: 3},
+			},
+			params: []interface{}{
+				sql.Named("a", 1),
+				now,
+				table.ValueParam("$b", types.Int32Value(2)),
+				driver.NamedValue{Name: "c", Value: 3},
+			},
+		},
+		{
+			name: "UnsupportedField",
+			args: []interface{}{
+				struct{ C chan int }{},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			yql, params, err := StructArgs{}.RewriteQuery("SELECT 1", tt.args...)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "SELECT 1", yql)
+			require.Equal(t, tt.params, params)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 type Config interface {
 	DialTimeout() time.Duration
 	ConnectionTTL() time.Duration
+	ConnectionsPerEndpoint() int
 	Trace() *trace.Driver
 	GrpcDialOptions() []grpc.DialOption
 }
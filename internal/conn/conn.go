@@ -51,7 +51,8 @@ type Conn interface {
 type conn struct {
 	mtx               sync.RWMutex
 	config            Config // ro access
-	grpcConn          *grpc.ClientConn
+	grpcConns         []*grpc.ClientConn
+	nextGrpcConn      atomic.Uint64
 	done              chan struct{}
 	endpoint          endpoint.Endpoint // ro access
 	closed            bool
@@ -121,7 +122,7 @@ func (c *conn) park(ctx context.Context) (err error) {
 		return nil
 	}
 
-	if c.grpcConn == nil {
+	if len(c.grpcConns) == 0 {
 		return nil
 	}
 
@@ -160,9 +161,9 @@ func (c *conn) setState(ctx context.Context, s State) State {
 func (c *conn) Unban(ctx context.Context) State {
 	var newState State
 	c.mtx.RLock()
-	cc := c.grpcConn //nolint:ifshort
+	ccs := c.grpcConns //nolint:ifshort
 	c.mtx.RUnlock()
-	if isAvailable(cc) {
+	if isAvailable(ccs...) {
 		newState = Online
 	} else {
 		newState = Offline
@@ -197,8 +198,8 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
-	if c.grpcConn != nil {
-		return c.grpcConn, nil
+	if len(c.grpcConns) > 0 {
+		return c.pickGrpcConn(), nil
 	}
 
 	if dialTimeout := c.config.DialTimeout(); dialTimeout > 0 {
@@ -222,11 +223,22 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 
 	dialOption := makeDialOption(c.endpoint.OverrideHost())
 
-	cc, err = grpc.DialContext(ctx, address, append( //nolint:staticcheck,nolintlint
-		dialOption,
-		c.config.GrpcDialOptions()...,
-	)...)
+	ccs := make([]*grpc.ClientConn, 0, connectionsPerEndpoint(c.config))
+	for len(ccs) < cap(ccs) {
+		cc, err = grpc.DialContext(ctx, address, append( //nolint:staticcheck,nolintlint
+			dialOption,
+			c.config.GrpcDialOptions()...,
+		)...)
+		if err != nil {
+			break
+		}
+		ccs = append(ccs, cc)
+	}
 	if err != nil {
+		for _, dialed := range ccs {
+			_ = dialed.Close()
+		}
+
 		if xerrors.IsContextError(err) {
 			return nil, xerrors.WithStackTrace(err)
 		}
@@ -243,10 +255,28 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 		)
 	}
 
-	c.grpcConn = cc
+	c.grpcConns = ccs
 	c.setState(ctx, Online)
 
-	return c.grpcConn, nil
+	return c.pickGrpcConn(), nil
+}
+
+// pickGrpcConn stripes calls and streams across grpc connections of endpoint in round-robin manner.
+// conn must be locked
+func (c *conn) pickGrpcConn() *grpc.ClientConn {
+	if len(c.grpcConns) == 1 {
+		return c.grpcConns[0]
+	}
+
+	return c.grpcConns[(c.nextGrpcConn.Add(1)-1)%uint64(len(c.grpcConns))]
+}
+
+func connectionsPerEndpoint(config Config) int {
+	if n := config.ConnectionsPerEndpoint(); n > 1 {
+		return n
+	}
+
+	return 1
 }
 
 func (c *conn) onTransportError(ctx context.Context, cause error) {
@@ -255,22 +285,34 @@ func (c *conn) onTransportError(ctx context.Context, cause error) {
 	}
 }
 
-func isAvailable(raw *grpc.ClientConn) bool {
-	return raw != nil && raw.GetState() == connectivity.Ready
+func isAvailable(raws ...*grpc.ClientConn) bool {
+	for _, raw := range raws {
+		if raw != nil && raw.GetState() == connectivity.Ready {
+			return true
+		}
+	}
+
+	return false
 }
 
 // conn must be locked
 func (c *conn) close(ctx context.Context) (err error) {
-	if c.grpcConn == nil {
+	if len(c.grpcConns) == 0 {
 		return nil
 	}
 
 	defer func() {
-		c.grpcConn = nil
+		c.grpcConns = nil
 		c.setState(ctx, Offline)
 	}()
 
-	err = c.grpcConn.Close()
+	errs := make([]error, 0, len(c.grpcConns))
+	for _, cc := range c.grpcConns {
+		if closeErr := cc.Close(); closeErr != nil {
+			errs = append(errs, closeErr)
+		}
+	}
+	err = xerrors.Join(errs...)
 	if err == nil || !UseWrapping(ctx) {
 		return err
 	}
@@ -17,6 +17,8 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
@@ -150,3 +152,28 @@ func TestConn(t *testing.T) {
 		})
 	})
 }
+
+func TestConnectionsPerEndpoint(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newConn(endpoint.New("127.0.0.1:2135"), config.New(
+		config.WithConnectionsPerEndpoint(3),
+	))
+	defer func() {
+		_ = c.Close(ctx)
+	}()
+
+	picked := make(map[*grpc.ClientConn]int)
+	for i := 0; i < 6; i++ {
+		cc, err := c.realConn(ctx)
+		require.NoError(t, err)
+		picked[cc]++
+	}
+	require.Len(t, picked, 3)
+	for _, count := range picked {
+		require.Equal(t, 2, count)
+	}
+
+	require.NoError(t, c.park(ctx))
+	require.Empty(t, c.grpcConns)
+	require.Equal(t, Offline, c.GetState())
+}
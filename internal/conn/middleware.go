@@ -86,3 +86,54 @@ func WithBeforeFunc(
 		},
 	}
 }
+
+// WithErrorModifier applies modifyErr to errors of calls and of messages of streams
+func WithErrorModifier(
+	cc grpc.ClientConnInterface,
+	modifyErr func(err error) error,
+) grpc.ClientConnInterface {
+	return &middleware{
+		invoke: func(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+			if err := cc.Invoke(ctx, method, args, reply, opts...); err != nil {
+				return modifyErr(err)
+			}
+
+			return nil
+		},
+		newStream: func(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (
+			grpc.ClientStream, error,
+		) {
+			stream, err := cc.NewStream(ctx, desc, method, opts...)
+			if err != nil {
+				return nil, modifyErr(err)
+			}
+
+			return &errorModifierStream{
+				ClientStream: stream,
+				modifyErr:    modifyErr,
+			}, nil
+		},
+	}
+}
+
+type errorModifierStream struct {
+	grpc.ClientStream
+
+	modifyErr func(err error) error
+}
+
+func (s *errorModifierStream) SendMsg(m interface{}) error {
+	if err := s.ClientStream.SendMsg(m); err != nil {
+		return s.modifyErr(err)
+	}
+
+	return nil
+}
+
+func (s *errorModifierStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return s.modifyErr(err)
+	}
+
+	return nil
+}
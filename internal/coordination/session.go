@@ -28,10 +28,13 @@ type session struct {
 	sessionClosedChan chan struct{}
 	controller        *conversation.Controller
 	sessionID         uint64
+	events            chan coordination.SessionEvent
+	expireOnce        sync.Once
 
 	mutex                sync.Mutex // guards the field below
 	lastGoodResponseTime time.Time
 	cancelStream         context.CancelFunc
+	watchers             map[uint64]chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged
 }
 
 type lease struct {
@@ -55,6 +58,7 @@ func createSession(
 		cancel:            cancel,
 		sessionClosedChan: make(chan struct{}),
 		controller:        conversation.NewController(),
+		events:            make(chan coordination.SessionEvent, sessionEventsBufferSize),
 	}
 	client.sessionCreated(&s)
 
@@ -79,6 +83,9 @@ func newProtectionKey() []byte {
 	return key
 }
 
+// sessionEventsBufferSize is large enough to keep all the events of several reconnects.
+const sessionEventsBufferSize = 16
+
 func newReqID() uint64 {
 	return rand.Uint64() //nolint:gosec
 }
@@ -94,6 +101,70 @@ func (s *session) updateLastGoodResponseTime() {
 	}
 }
 
+func (s *session) addWatcher(reqID uint64) chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.watchers == nil {
+		s.watchers = make(map[uint64]chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged)
+	}
+	ch := make(chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged, 1)
+	s.watchers[reqID] = ch
+
+	return ch
+}
+
+func (s *session) removeWatcher(reqID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.watchers, reqID)
+}
+
+// notifyWatchers notifies the watcher with the req_id of the changed message or all the watchers if the message is
+// nil. Watchers are never blocked: the notification is dropped if the previous one has not been handled yet.
+func (s *session) notifyWatchers(changed *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if changed != nil {
+		if ch, has := s.watchers[changed.GetReqId()]; has {
+			select {
+			case ch <- changed:
+			default:
+			}
+		}
+
+		return
+	}
+
+	for reqID, ch := range s.watchers {
+		select {
+		case ch <- &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+			ReqId:         reqID,
+			DataChanged:   true,
+			OwnersChanged: true,
+		}:
+		default:
+		}
+	}
+}
+
+// emit sends the event to the session events channel unless the channel buffer is full.
+func (s *session) emit(eventType coordination.SessionEventType, sessionID uint64) {
+	select {
+	case s.events <- coordination.SessionEvent{Type: eventType, SessionID: sessionID}:
+	default:
+	}
+}
+
+func (s *session) expire(sessionID uint64) {
+	s.expireOnce.Do(func() {
+		trace.CoordinationOnSessionExpired(s.client.config.Trace(), sessionID)
+		s.emit(coordination.SessionEventExpired, sessionID)
+	})
+}
+
 func (s *session) getLastGoodResponseTime() time.Time {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
@@ -168,6 +239,7 @@ func (s *session) newStream(
 				s.getLastGoodResponseTime(),
 				s.options.SessionTimeout,
 			)
+			s.expire(s.sessionID)
 			cancelStream()
 
 			return nil, coordination.ErrSessionClosed
@@ -203,6 +275,7 @@ func (s *session) newStream(
 func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 	defer s.client.sessionClosed(s)
 	defer close(s.sessionClosedChan)
+	defer close(s.events)
 	defer s.cancel()
 
 	var seqNo uint64
@@ -220,6 +293,11 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		// We intentionally place a stream context outside the scope of any existing contexts to make an attempt to
 		// close the session gracefully at the end of the main loop.
 
+		if s.sessionID != 0 {
+			trace.CoordinationOnSessionReconnecting(s.client.config.Trace(), s.sessionID)
+			s.emit(coordination.SessionEventReconnecting, s.sessionID)
+		}
+
 		streamCtx, cancelStream := context.WithCancel(context.Background())
 		sessionClient, err := s.newStream(streamCtx, cancelStream)
 		if err != nil {
@@ -236,10 +314,11 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		wg.Add(2) //nolint:gomnd
 		sessionStarted := make(chan *Ydb_Coordination.SessionResponse_SessionStarted, 1)
 		sessionStopped := make(chan *Ydb_Coordination.SessionResponse_SessionStopped, 1)
+		sessionExpired := make(chan struct{}, 1)
 		startSending := make(chan struct{})
 		s.controller.OnAttach()
 
-		go s.receiveLoop(&wg, sessionClient, cancelStream, sessionStarted, sessionStopped)
+		go s.receiveLoop(&wg, sessionClient, cancelStream, sessionStarted, sessionStopped, sessionExpired)
 		go s.sendLoop(
 			&wg,
 			sessionClient,
@@ -261,9 +340,13 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 			if s.sessionID == 0 {
 				s.sessionID = start.GetSessionId()
 				close(sessionStartedChan)
+				s.emit(coordination.SessionEventStart, s.sessionID)
 			} else if start.GetSessionId() != s.sessionID {
 				// Reconnect if the server response is invalid.
 				cancelStream()
+			} else {
+				trace.CoordinationOnSessionAttached(s.client.config.Trace(), s.sessionID)
+				s.emit(coordination.SessionEventAttached, s.sessionID)
 			}
 			close(startSending)
 		case <-sessionStartTimer.C:
@@ -362,6 +445,17 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		wg.Wait()
 
 		s.controller.OnDetach()
+		if s.sessionID != 0 {
+			trace.CoordinationOnSessionDetached(s.client.config.Trace(), s.sessionID)
+			s.emit(coordination.SessionEventDetached, s.sessionID)
+		}
+		select {
+		case <-sessionExpired:
+			s.expire(s.sessionID)
+		default:
+		}
+		// Changes may be missed while the stream is detached, so make the watchers describe their semaphores again.
+		s.notifyWatchers(nil)
 		seqNo++
 	}
 }
@@ -373,6 +467,7 @@ func (s *session) receiveLoop(
 	cancelStream context.CancelFunc,
 	sessionStarted chan *Ydb_Coordination.SessionResponse_SessionStarted,
 	sessionStopped chan *Ydb_Coordination.SessionResponse_SessionStopped,
+	sessionExpired chan struct{},
 ) {
 	// If the sendLoop is done, make sure the stream is also canceled to make the receiveLoop finish its work and cause
 	// reconnect.
@@ -397,6 +492,7 @@ func (s *session) receiveLoop(
 				message.GetFailure().GetStatus() == Ydb.StatusIds_NOT_FOUND {
 				// Consider the session expired if we got an unrecoverable status.
 				trace.CoordinationOnSessionServerExpire(s.client.config.Trace(), message.GetFailure())
+				sessionExpired <- struct{}{}
 
 				return
 			}
@@ -432,6 +528,10 @@ func (s *session) receiveLoop(
 			s.updateLastGoodResponseTime()
 		case *Ydb_Coordination.SessionResponse_Pong:
 			// Ignore pongs since we do not ping the server.
+		case *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_:
+			// Notifications of unknown watchers are ignored since the watcher may have already been removed.
+			s.notifyWatchers(message.GetDescribeSemaphoreChanged())
+			s.updateLastGoodResponseTime()
 		default:
 			if !s.controller.OnRecv(message) {
 				// Reconnect if the message is not from any known conversation.
@@ -511,6 +611,10 @@ func (s *session) sendLoop(
 	}
 }
 
+func (s *session) Events() <-chan coordination.SessionEvent {
+	return s.events
+}
+
 func (s *session) Context() context.Context {
 	return s.ctx
 }
@@ -675,11 +779,99 @@ func (s *session) DescribeSemaphore(
 	ctx context.Context,
 	name string,
 	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	return s.describeSemaphore(ctx, name, newReqID(), opts...)
+}
+
+func (s *session) WatchSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (<-chan coordination.SemaphoreChange, error) {
+	opts = append([]options.DescribeSemaphoreOption{
+		options.WithDescribeOwners(true),
+		options.WithWatchData(true),
+		options.WithWatchOwners(true),
+	}, opts...)
+
+	reqID := newReqID()
+	notify := s.addWatcher(reqID)
+	desc, err := s.describeSemaphore(ctx, name, reqID, opts...)
+	if err != nil {
+		s.removeWatcher(reqID)
+
+		return nil, err
+	}
+
+	changes := make(chan coordination.SemaphoreChange, 1)
+	changes <- coordination.SemaphoreChange{Description: desc}
+
+	go s.watchLoop(ctx, name, reqID, notify, changes, opts)
+
+	return changes, nil
+}
+
+func (s *session) watchLoop(
+	ctx context.Context,
+	name string,
+	reqID uint64,
+	notify chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged,
+	changes chan coordination.SemaphoreChange,
+	opts []options.DescribeSemaphoreOption,
+) {
+	defer close(changes)
+	defer func() {
+		s.removeWatcher(reqID)
+	}()
+
+	for {
+		var changed *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.ctx.Done():
+			return
+		case changed = <-notify:
+		}
+
+		// The server notifies a watcher only once, so the semaphore must be described with a new watch.
+		s.removeWatcher(reqID)
+		reqID = newReqID()
+		notify = s.addWatcher(reqID)
+
+		change := coordination.SemaphoreChange{
+			DataChanged:   changed.GetDataChanged(),
+			OwnersChanged: changed.GetOwnersChanged(),
+		}
+		change.Description, change.Err = s.describeSemaphore(ctx, name, reqID, opts...)
+		if change.Err != nil && (ctx.Err() != nil || s.ctx.Err() != nil) {
+			return
+		}
+
+		select {
+		case changes <- change:
+		case <-ctx.Done():
+			return
+		case <-s.ctx.Done():
+			return
+		}
+
+		if change.Err != nil {
+			return
+		}
+	}
+}
+
+func (s *session) describeSemaphore(
+	ctx context.Context,
+	name string,
+	reqID uint64,
+	opts ...options.DescribeSemaphoreOption,
 ) (*coordination.SemaphoreDescription, error) {
 	req := conversation.NewConversation(
 		func() *Ydb_Coordination.SessionRequest {
 			describeSemaphore := Ydb_Coordination.SessionRequest_DescribeSemaphore{
-				ReqId: newReqID(),
+				ReqId: reqID,
 				Name:  name,
 			}
 			for _, o := range opts {
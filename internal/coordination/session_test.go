@@ -0,0 +1,73 @@
+package coordination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+)
+
+func TestSessionNotifyWatchers(t *testing.T) {
+	t.Run("ByReqID", func(t *testing.T) {
+		s := &session{}
+		first := s.addWatcher(1)
+		second := s.addWatcher(2)
+		s.notifyWatchers(&Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+			ReqId:       2,
+			DataChanged: true,
+		})
+		require.Empty(t, first)
+		changed := <-second
+		require.True(t, changed.GetDataChanged())
+		require.False(t, changed.GetOwnersChanged())
+	})
+	t.Run("UnknownReqID", func(t *testing.T) {
+		s := &session{}
+		ch := s.addWatcher(1)
+		s.notifyWatchers(&Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{ReqId: 2})
+		require.Empty(t, ch)
+	})
+	t.Run("NonBlocking", func(t *testing.T) {
+		s := &session{}
+		ch := s.addWatcher(1)
+		s.notifyWatchers(&Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{ReqId: 1, DataChanged: true})
+		s.notifyWatchers(&Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{ReqId: 1, OwnersChanged: true})
+		require.Len(t, ch, 1)
+		require.True(t, (<-ch).GetDataChanged())
+	})
+	t.Run("All", func(t *testing.T) {
+		s := &session{}
+		first := s.addWatcher(1)
+		second := s.addWatcher(2)
+		s.notifyWatchers(nil)
+		for reqID, ch := range map[uint64]chan *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+			1: first,
+			2: second,
+		} {
+			changed := <-ch
+			require.Equal(t, reqID, changed.GetReqId())
+			require.True(t, changed.GetDataChanged())
+			require.True(t, changed.GetOwnersChanged())
+		}
+	})
+	t.Run("Removed", func(t *testing.T) {
+		s := &session{}
+		ch := s.addWatcher(1)
+		s.removeWatcher(1)
+		s.notifyWatchers(nil)
+		require.Empty(t, ch)
+	})
+}
+
+func TestSessionEmit(t *testing.T) {
+	s := &session{events: make(chan coordination.SessionEvent, 1)}
+	s.emit(coordination.SessionEventStart, 1)
+	s.emit(coordination.SessionEventDetached, 1)
+	require.Len(t, s.events, 1)
+	require.Equal(t, coordination.SessionEvent{
+		Type:      coordination.SessionEventStart,
+		SessionID: 1,
+	}, <-s.events)
+}
@@ -0,0 +1,108 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/secret"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// DefaultAccessTokenFileTTL is a lifetime of access token which was read from file
+const DefaultAccessTokenFileTTL = time.Minute
+
+var (
+	_ Credentials                      = (*AccessTokenFile)(nil)
+	_ fmt.Stringer                     = (*AccessTokenFile)(nil)
+	_ AccessTokenFileCredentialsOption = SourceInfoOption("")
+)
+
+type AccessTokenFileCredentialsOption interface {
+	ApplyAccessTokenFileCredentialsOption(c *AccessTokenFile)
+}
+
+// AccessTokenFile implements Credentials interface with access token which reads from file.
+// File is read again when token which was read before is older than ttl, so rotated tokens
+// (such as projected kubernetes tokens) are picked up without restart
+type AccessTokenFile struct {
+	source     *fileTokenSource
+	ttl        time.Duration
+	clock      clockwork.Clock
+	sourceInfo string
+
+	mu        xsync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewAccessTokenFileCredentials(path string, opts ...AccessTokenFileCredentialsOption) *AccessTokenFile {
+	c := &AccessTokenFile{
+		source:     NewFileTokenSource(path, ""),
+		ttl:        DefaultAccessTokenFileTTL,
+		clock:      clockwork.NewRealClock(),
+		sourceInfo: stack.Record(1),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyAccessTokenFileCredentialsOption(c)
+		}
+	}
+
+	return c
+}
+
+// Token implements Credentials.
+func (c *AccessTokenFile) Token(_ context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if c.token != "" && now.Before(c.expiresAt) {
+		return c.token, nil
+	}
+
+	token, err := c.source.Token()
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	c.token = token.Token
+	c.expiresAt = now.Add(c.ttl)
+
+	return c.token, nil
+}
+
+// String implements fmt.Stringer.
+func (c *AccessTokenFile) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	fmt.Fprintf(buffer, "AccessTokenFile{Path:%q", c.source.path)
+	if token := xsync.WithLock(&c.mu, func() string { return c.token }); token != "" {
+		fmt.Fprintf(buffer, ",Token:%q", secret.Token(token))
+	}
+	if c.sourceInfo != "" {
+		buffer.WriteString(",From:")
+		fmt.Fprintf(buffer, "%q", c.sourceInfo)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.String()
+}
+
+type accessTokenFileTTLOption time.Duration
+
+func (ttl accessTokenFileTTLOption) ApplyAccessTokenFileCredentialsOption(c *AccessTokenFile) {
+	c.ttl = time.Duration(ttl)
+}
+
+// WithAccessTokenFileTTL defines lifetime of access token which was read from file.
+// Non-positive ttl means reading of file on each call of Token
+func WithAccessTokenFileTTL(ttl time.Duration) AccessTokenFileCredentialsOption {
+	return accessTokenFileTTLOption(ttl)
+}
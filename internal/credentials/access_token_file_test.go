@@ -0,0 +1,44 @@
+package credentials
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessTokenFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+
+	clock := clockwork.NewFakeClock()
+	c := NewAccessTokenFileCredentials(path, WithAccessTokenFileTTL(time.Minute))
+	c.clock = clock
+
+	token, err := c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+
+	// rotated token picks up after expiration of previously read token
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "first", token)
+	clock.Advance(time.Minute)
+	token, err = c.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "second", token)
+
+	// error of reading of file returns after expiration of token
+	require.NoError(t, os.Remove(path))
+	clock.Advance(time.Minute)
+	_, err = c.Token(ctx)
+	require.Error(t, err)
+
+	require.NotContains(t, c.String(), "second")
+}
@@ -0,0 +1,76 @@
+package credentials
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const (
+	// DefaultKubernetesServiceAccountTokenPath is a path of projected service account token in kubernetes pod
+	DefaultKubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token" //nolint:gosec
+
+	jwtTokenType = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+var errEmptyTokenFile = errors.New("empty token file")
+
+// fileTokenSource reads token from file on each call. Rotated tokens (such as projected
+// kubernetes service account tokens) are picked up without restart
+type fileTokenSource struct {
+	path      string
+	tokenType string
+}
+
+func NewFileTokenSource(path, tokenType string) *fileTokenSource {
+	return &fileTokenSource{
+		path:      path,
+		tokenType: tokenType,
+	}
+}
+
+func (s *fileTokenSource) Token() (Token, error) {
+	content, err := readFileContent(s.path)
+	if err != nil {
+		return Token{}, xerrors.WithStackTrace(err)
+	}
+	content = bytes.TrimSpace(content)
+	if len(content) == 0 {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errEmptyTokenFile, s.path))
+	}
+
+	return Token{
+		Token:     string(content),
+		TokenType: s.tokenType,
+	}, nil
+}
+
+func (s *fileTokenSource) String() string {
+	buffer := xstring.Buffer()
+	defer buffer.Free()
+	fmt.Fprintf(buffer, "FileTokenSource{Path:%q,Type:%s}", s.path, s.tokenType)
+
+	return buffer.String()
+}
+
+// NewKubernetesServiceAccountCredentials makes credentials which exchanges projected kubernetes
+// service account token (workload identity federation) to access token at token endpoint of STS.
+// Service account token reads on each exchange for support of token rotation.
+// Options redefines defaults (for example, WithSubjectToken with other path of token file)
+func NewKubernetesServiceAccountCredentials(
+	tokenEndpoint string, opts ...Oauth2TokenExchangeCredentialsOption,
+) (*oauth2TokenExchange, error) {
+	c, err := NewOauth2TokenExchangeCredentials(append([]Oauth2TokenExchangeCredentialsOption{
+		WithTokenEndpoint(tokenEndpoint),
+		WithSubjectToken(NewFileTokenSource(DefaultKubernetesServiceAccountTokenPath, jwtTokenType)),
+		WithSourceInfo("credentials.NewKubernetesServiceAccountCredentials"),
+	}, opts...)...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return c, nil
+}
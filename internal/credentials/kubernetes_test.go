@@ -0,0 +1,56 @@
+package credentials
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	src := NewFileTokenSource(path, jwtTokenType)
+
+	_, err := src.Token()
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("\n"), 0o600))
+	_, err = src.Token()
+	require.ErrorIs(t, err, errEmptyTokenFile)
+
+	require.NoError(t, os.WriteFile(path, []byte("token_1\n"), 0o600))
+	token, err := src.Token()
+	require.NoError(t, err)
+	require.Equal(t, Token{Token: "token_1", TokenType: jwtTokenType}, token)
+
+	// rotated token
+	require.NoError(t, os.WriteFile(path, []byte("token_2"), 0o600))
+	token, err = src.Token()
+	require.NoError(t, err)
+	require.Equal(t, "token_2", token.Token)
+}
+
+func TestKubernetesServiceAccountCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("test_source_token\n"), 0o600))
+
+	server := runTokenExchangeServer(&Oauth2TokenExchangeTestParams{
+		Response: `{"access_token":"test_token","token_type":"Bearer","expires_in":10000}`,
+		Status:   http.StatusOK,
+	}, true, nil)
+	defer server.Close()
+
+	client, err := NewKubernetesServiceAccountCredentials(server.URL+"/exchange",
+		WithSubjectToken(NewFileTokenSource(path, "urn:ietf:params:oauth:token-type:test_jwt")),
+		WithAudience("test_audience"),
+		WithScope("test_scope1", "test_scope2"),
+	)
+	require.NoError(t, err)
+
+	token, err := client.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer test_token", token)
+}
@@ -220,6 +220,20 @@ func WithSyncExchangeTimeout(timeout time.Duration) syncExchangeTimeoutOption {
 	return syncExchangeTimeoutOption(timeout)
 }
 
+// OnTokenRefreshError
+type onTokenRefreshErrorOption func(err error)
+
+func (f onTokenRefreshErrorOption) ApplyOauth2CredentialsOption(c *oauth2TokenExchange) error {
+	c.onTokenRefreshError = f
+
+	return nil
+}
+
+// WithOnTokenRefreshError defines hook which calls on each failed token exchange (synchronous or in background)
+func WithOnTokenRefreshError(f func(err error)) onTokenRefreshErrorOption {
+	return f
+}
+
 const (
 	SubjectTokenSourceType = 1
 	ActorTokenSourceType   = 2
@@ -338,6 +352,9 @@ type oauth2TokenExchange struct {
 	mutex    sync.RWMutex
 	updating atomic.Bool // true if separate goroutine is run and updates token in background
 
+	// onTokenRefreshError calls on failed token exchange
+	onTokenRefreshError func(err error)
+
 	sourceInfo string
 }
 
@@ -966,6 +983,8 @@ func (provider *oauth2TokenExchange) exchangeTokenSync(ctx context.Context) erro
 		retry.WithSlowBackoff(syncRetrySlowBackoff),
 	)
 	if err != nil {
+		provider.tokenRefreshFailed(err)
+
 		return xerrors.WithStackTrace(err)
 	}
 
@@ -991,6 +1010,8 @@ func (provider *oauth2TokenExchange) exchangeTokenInBackground() {
 		retry.WithSlowBackoff(backgroundRetrySlowBackoff),
 	)
 	if err != nil {
+		provider.tokenRefreshFailed(err)
+
 		return
 	}
 
@@ -999,6 +1020,12 @@ func (provider *oauth2TokenExchange) exchangeTokenInBackground() {
 	provider.updateToken(response)
 }
 
+func (provider *oauth2TokenExchange) tokenRefreshFailed(err error) {
+	if provider.onTokenRefreshError != nil {
+		provider.onTokenRefreshError(err)
+	}
+}
+
 func (provider *oauth2TokenExchange) checkBackgroundUpdate(now time.Time) {
 	if provider.needUpdate(now) && !provider.updating.Load() {
 		if provider.updating.CompareAndSwap(false, true) {
@@ -454,6 +454,7 @@ func TestErrorInSourceToken(t *testing.T) {
 
 func TestErrorInHTTPRequest(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
+		var refreshErr error
 		client, err := NewOauth2TokenExchangeCredentials(
 			WithTokenEndpoint("http://invalid_host:42/exchange"),
 			WithJWTSubjectToken(
@@ -472,6 +473,9 @@ func TestErrorInHTTPRequest(t *testing.T) {
 			WithScope("1", "2", "3"),
 			WithSourceInfo("TestErrorInHTTPRequest"),
 			WithSyncExchangeTimeout(time.Second*3),
+			WithOnTokenRefreshError(func(err error) {
+				refreshErr = err
+			}),
 		)
 		require.NoError(t, err)
 
@@ -480,6 +484,7 @@ func TestErrorInHTTPRequest(t *testing.T) {
 			require.ErrorIs(t, err, errCouldNotExchangeToken)
 		}
 		require.Equal(t, "", token)
+		require.Error(t, refreshErr)
 
 		// check format:
 		formatted := fmt.Sprint(client)
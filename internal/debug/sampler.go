@@ -0,0 +1,164 @@
+package debug
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const (
+	ServiceQuery = "query"
+	ServiceTable = "table"
+)
+
+type (
+	// RecentQuery is a sampled record about executed statement
+	RecentQuery struct {
+		Service    string
+		SessionID  string
+		Query      string
+		Parameters map[string]string // YQL representation of parameter values
+		Start      time.Time
+		// Duration is a time from start of execution to closing of result of query service
+		// (fully read, stopped or failed result) or to receiving of result of table service
+		Duration time.Duration
+		Error    error
+	}
+	// QuerySampler records sampled subset of executed statements into ring buffer
+	QuerySampler struct {
+		rate float64
+		rand xrand.Rand
+
+		mu      sync.Mutex
+		records []RecentQuery
+		next    int
+		full    bool
+	}
+)
+
+// NewQuerySampler makes sampler with given ring buffer capacity and sample rate
+// Sample rate must be in (0, 1]. Out of range sample rate means sampling of all statements.
+func NewQuerySampler(capacity int, rate float64) *QuerySampler {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	if rate <= 0 || rate > 1 {
+		rate = 1
+	}
+
+	return &QuerySampler{
+		rate:    rate,
+		rand:    xrand.New(xrand.WithLock()),
+		records: make([]RecentQuery, capacity),
+	}
+}
+
+const sampleScale = 1 << 20
+
+func (s *QuerySampler) sampled() bool {
+	if s.rate >= 1 {
+		return true
+	}
+
+	return float64(s.rand.Int64(sampleScale)) < s.rate*sampleScale
+}
+
+func (s *QuerySampler) add(q RecentQuery) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[s.next] = q
+	s.next++
+	if s.next == len(s.records) {
+		s.next = 0
+		s.full = true
+	}
+}
+
+// RecentQueries returns sampled statements ordered from oldest to newest
+func (s *QuerySampler) RecentQueries() []RecentQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.full {
+		return append([]RecentQuery(nil), s.records[:s.next]...)
+	}
+
+	queries := make([]RecentQuery, 0, len(s.records))
+	queries = append(queries, s.records[s.next:]...)
+	queries = append(queries, s.records[:s.next]...)
+
+	return queries
+}
+
+func renderParameters(parameters interface{}) map[string]string {
+	p, ok := parameters.(*params.Parameters)
+	if !ok || p.Count() == 0 {
+		return nil
+	}
+
+	rendered := make(map[string]string, p.Count())
+	p.Each(func(name string, v value.Value) {
+		rendered[name] = v.Yql()
+	})
+
+	return rendered
+}
+
+// Query returns trace which samples statements executed with query service
+func (s *QuerySampler) Query() trace.Query {
+	return trace.Query{
+		OnExecuteQuery: func(info trace.QueryExecuteQueryStartInfo) func(trace.QueryExecuteQueryDoneInfo) {
+			if !s.sampled() {
+				return nil
+			}
+
+			q := RecentQuery{
+				Service:    ServiceQuery,
+				SessionID:  info.SessionID,
+				Query:      info.Query,
+				Parameters: renderParameters(info.Parameters),
+				Start:      time.Now(),
+			}
+
+			return func(info trace.QueryExecuteQueryDoneInfo) {
+				q.Duration = time.Since(q.Start)
+				q.Error = info.Error
+				s.add(q)
+			}
+		},
+	}
+}
+
+// Table returns trace which samples data queries executed with table service
+func (s *QuerySampler) Table() trace.Table {
+	return trace.Table{
+		OnSessionQueryExecute: func(
+			info trace.TableExecuteDataQueryStartInfo,
+		) func(trace.TableExecuteDataQueryDoneInfo) {
+			if !s.sampled() {
+				return nil
+			}
+
+			q := RecentQuery{
+				Service:    ServiceTable,
+				Query:      info.Query.YQL(),
+				Parameters: renderParameters(info.Parameters),
+				Start:      time.Now(),
+			}
+			if info.Session != nil {
+				q.SessionID = info.Session.ID()
+			}
+
+			return func(info trace.TableExecuteDataQueryDoneInfo) {
+				q.Duration = time.Since(q.Start)
+				q.Error = info.Error
+				s.add(q)
+			}
+		},
+	}
+}
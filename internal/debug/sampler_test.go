@@ -0,0 +1,54 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func executeQuery(t *trace.Query, q string, p *params.Parameters, err error) {
+	ctx := context.Background()
+	trace.QueryOnExecuteQuery(t, &ctx, nil, "session", q, p)(err)
+}
+
+func TestQuerySampler(t *testing.T) {
+	t.Run("RingBuffer", func(t *testing.T) {
+		s := NewQuerySampler(2, 1)
+		tt := s.Query()
+		require.Empty(t, s.RecentQueries())
+		executeQuery(&tt, "SELECT 1", nil, nil)
+		executeQuery(&tt, "SELECT 2", nil, nil)
+		executeQuery(&tt, "SELECT 3", nil, errors.New("test"))
+		queries := s.RecentQueries()
+		require.Len(t, queries, 2)
+		require.Equal(t, "SELECT 2", queries[0].Query)
+		require.NoError(t, queries[0].Error)
+		require.Equal(t, "SELECT 3", queries[1].Query)
+		require.Error(t, queries[1].Error)
+		require.Equal(t, ServiceQuery, queries[1].Service)
+		require.Equal(t, "session", queries[1].SessionID)
+	})
+	t.Run("Parameters", func(t *testing.T) {
+		s := NewQuerySampler(10, 1)
+		tt := s.Query()
+		executeQuery(&tt, "SELECT $a", params.Builder{}.Param("$a").Text("test").Build(), nil)
+		queries := s.RecentQueries()
+		require.Len(t, queries, 1)
+		require.Equal(t, map[string]string{"$a": `"test"u`}, queries[0].Parameters)
+	})
+	t.Run("Sampling", func(t *testing.T) {
+		s := NewQuerySampler(1000, 0.1)
+		tt := s.Query()
+		for i := 0; i < 1000; i++ {
+			executeQuery(&tt, "SELECT 1", nil, nil)
+		}
+		n := len(s.RecentQueries())
+		require.Greater(t, n, 0)
+		require.Less(t, n, 500)
+	})
+}
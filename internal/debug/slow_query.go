@@ -0,0 +1,93 @@
+package debug
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// SlowQueryDetector reports statements which executed longer than threshold
+type SlowQueryDetector struct {
+	threshold   atomic.Int64
+	onSlowQuery func(q RecentQuery)
+}
+
+// NewSlowQueryDetector makes detector of slow statements with given threshold.
+// Non-positive threshold disables detection
+func NewSlowQueryDetector(threshold time.Duration, onSlowQuery func(q RecentQuery)) *SlowQueryDetector {
+	d := &SlowQueryDetector{
+		onSlowQuery: onSlowQuery,
+	}
+	d.SetThreshold(threshold)
+
+	return d
+}
+
+// SetThreshold changes threshold of slow statements at runtime
+func (d *SlowQueryDetector) SetThreshold(threshold time.Duration) {
+	d.threshold.Store(int64(threshold))
+}
+
+// Threshold returns current threshold of slow statements
+func (d *SlowQueryDetector) Threshold() time.Duration {
+	return time.Duration(d.threshold.Load())
+}
+
+func (d *SlowQueryDetector) check(q RecentQuery) {
+	if threshold := d.Threshold(); threshold > 0 && q.Duration >= threshold && d.onSlowQuery != nil {
+		d.onSlowQuery(q)
+	}
+}
+
+// Query returns trace which detects slow statements executed with query service
+func (d *SlowQueryDetector) Query() trace.Query {
+	return trace.Query{
+		OnExecuteQuery: func(info trace.QueryExecuteQueryStartInfo) func(trace.QueryExecuteQueryDoneInfo) {
+			if d.Threshold() <= 0 {
+				return nil
+			}
+
+			q := RecentQuery{
+				Service:   ServiceQuery,
+				SessionID: info.SessionID,
+				Query:     info.Query,
+				Start:     time.Now(),
+			}
+
+			return func(info trace.QueryExecuteQueryDoneInfo) {
+				q.Duration = time.Since(q.Start)
+				q.Error = info.Error
+				d.check(q)
+			}
+		},
+	}
+}
+
+// Table returns trace which detects slow data queries executed with table service
+func (d *SlowQueryDetector) Table() trace.Table {
+	return trace.Table{
+		OnSessionQueryExecute: func(
+			info trace.TableExecuteDataQueryStartInfo,
+		) func(trace.TableExecuteDataQueryDoneInfo) {
+			if d.Threshold() <= 0 {
+				return nil
+			}
+
+			q := RecentQuery{
+				Service: ServiceTable,
+				Query:   info.Query.YQL(),
+				Start:   time.Now(),
+			}
+			if info.Session != nil {
+				q.SessionID = info.Session.ID()
+			}
+
+			return func(info trace.TableExecuteDataQueryDoneInfo) {
+				q.Duration = time.Since(q.Start)
+				q.Error = info.Error
+				d.check(q)
+			}
+		},
+	}
+}
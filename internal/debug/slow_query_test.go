@@ -0,0 +1,29 @@
+package debug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlowQueryDetector(t *testing.T) {
+	var slow []RecentQuery
+	d := NewSlowQueryDetector(time.Hour, func(q RecentQuery) {
+		slow = append(slow, q)
+	})
+	tt := d.Query()
+	executeQuery(&tt, "SELECT 1", nil, nil)
+	require.Empty(t, slow)
+
+	d.SetThreshold(time.Nanosecond)
+	require.Equal(t, time.Nanosecond, d.Threshold())
+	executeQuery(&tt, "SELECT 2", nil, nil)
+	require.Len(t, slow, 1)
+	require.Equal(t, "SELECT 2", slow[0].Query)
+	require.Equal(t, ServiceQuery, slow[0].Service)
+
+	d.SetThreshold(0)
+	executeQuery(&tt, "SELECT 3", nil, nil)
+	require.Len(t, slow, 1)
+}
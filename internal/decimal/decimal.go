@@ -69,7 +69,7 @@ func FromBytes(bts []byte, precision, scale uint32) *big.Int {
 		v.Add(v, one)
 		v.Neg(v)
 	}
-	if v.CmpAbs(pow(ten, precision)) >= 0 {
+	if !IsInf(v) && !IsNaN(v) && !IsErr(v) && v.CmpAbs(pow(ten, precision)) >= 0 {
 		if neg {
 			v.Set(neginf)
 		} else {
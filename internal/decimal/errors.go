@@ -6,7 +6,13 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-var errSyntax = xerrors.Wrap(fmt.Errorf("invalid syntax"))
+var (
+	errSyntax          = xerrors.Wrap(fmt.Errorf("invalid syntax"))
+	errOverflow        = xerrors.Wrap(fmt.Errorf("decimal overflow"))
+	errNotFinite       = xerrors.Wrap(fmt.Errorf("arithmetic with not finite decimal"))
+	errDivisionByZero  = xerrors.Wrap(fmt.Errorf("decimal division by zero"))
+	errUnsupportedScan = xerrors.Wrap(fmt.Errorf("unsupported scan of decimal"))
+)
 
 type ParseError struct {
 	Err   error
@@ -1,6 +1,17 @@
 package decimal
 
-import "math/big"
+import (
+	"cmp"
+	"fmt"
+	"math/big"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	DefaultPrecision = 22
+	DefaultScale     = 9
+)
 
 type Decimal struct {
 	Bytes     [16]byte
@@ -8,12 +19,191 @@ type Decimal struct {
 	Scale     uint32
 }
 
+// NewFromBigInt makes Decimal from unscaled integer x with given precision and scale
+func NewFromBigInt(x *big.Int, precision, scale uint32) *Decimal {
+	return &Decimal{
+		Bytes:     BigIntToByte(x, precision, scale),
+		Precision: precision,
+		Scale:     scale,
+	}
+}
+
+// NewFromString parses Decimal from string s with given precision and scale
+func NewFromString(s string, precision, scale uint32) (*Decimal, error) {
+	x, err := Parse(s, precision, scale)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return NewFromBigInt(x, precision, scale), nil
+}
+
+// NewFromRat makes Decimal from rational r with given precision and scale.
+// Digits after scale are rounded half away from zero
+func NewFromRat(r *big.Rat, precision, scale uint32) (*Decimal, error) {
+	if scale > precision {
+		return nil, xerrors.WithStackTrace(precisionError(r.String(), precision, scale))
+	}
+
+	x := big.NewInt(0).Mul(r.Num(), pow(ten, scale))
+	x, rem := x.QuoRem(x, r.Denom(), big.NewInt(0))
+	if rem.Sign() != 0 && big.NewInt(0).Mul(rem.Abs(rem), big.NewInt(2)).Cmp(r.Denom()) >= 0 { //nolint:gomnd
+		x.Add(x, big.NewInt(int64(r.Sign())))
+	}
+
+	if x.CmpAbs(pow(ten, precision)) >= 0 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s as Decimal(%d,%d)",
+			errOverflow, r.FloatString(int(scale)), precision, scale,
+		))
+	}
+
+	return NewFromBigInt(x, precision, scale), nil
+}
+
 func (d *Decimal) String() string {
 	v := FromInt128(d.Bytes, d.Precision, d.Scale)
 
 	return Format(v, d.Precision, d.Scale)
 }
 
+// BigInt returns unscaled integer value of decimal
 func (d *Decimal) BigInt() *big.Int {
 	return FromInt128(d.Bytes, d.Precision, d.Scale)
 }
+
+// Exponent returns decimal exponent of value which equals to BigInt() * 10^Exponent().
+// Pair of BigInt and Exponent is compatible with constructors of third-party decimal
+// types such as shopspring.NewFromBigInt(d.BigInt(), d.Exponent())
+func (d *Decimal) Exponent() int32 {
+	return -int32(d.Scale)
+}
+
+// Rat returns value of decimal as big.Rat.
+// Rat returns nil for inf and nan values
+func (d *Decimal) Rat() *big.Rat {
+	x := d.BigInt()
+	if IsInf(x) || IsNaN(x) || IsErr(x) {
+		return nil
+	}
+
+	return new(big.Rat).SetFrac(x, pow(ten, d.Scale))
+}
+
+// Sign returns -1, 0 or +1 depending on sign of decimal
+func (d *Decimal) Sign() int {
+	return d.BigInt().Sign()
+}
+
+// Cmp compares decimals d and y and returns -1, 0 or +1.
+// Infinities compares as -inf < any finite value < +inf, nan (and error value) is greater than
+// any other value and equals to nan
+func (d *Decimal) Cmp(y *Decimal) int {
+	x, yy := d.Rat(), y.Rat()
+	if x != nil && yy != nil {
+		return x.Cmp(yy)
+	}
+
+	return cmp.Compare(cmpRank(d.BigInt(), x), cmpRank(y.BigInt(), yy))
+}
+
+// cmpRank returns order of special values: -1 for -inf, 0 for finite value, 1 for +inf and 2 for nan
+func cmpRank(x *big.Int, r *big.Rat) int {
+	switch {
+	case r != nil:
+		return 0
+	case IsInf(x):
+		return x.Sign()
+	default:
+		return 2 //nolint:gomnd
+	}
+}
+
+// Neg returns negated decimal with same precision and scale
+func (d *Decimal) Neg() *Decimal {
+	x := d.BigInt()
+
+	return NewFromBigInt(x.Neg(x), d.Precision, d.Scale)
+}
+
+// Add returns sum of decimals with maximum of operands precision and scale
+func (d *Decimal) Add(y *Decimal) (*Decimal, error) {
+	return d.apply(y, (*big.Rat).Add)
+}
+
+// Sub returns difference of decimals with maximum of operands precision and scale
+func (d *Decimal) Sub(y *Decimal) (*Decimal, error) {
+	return d.apply(y, (*big.Rat).Sub)
+}
+
+// Mul returns product of decimals with maximum of operands precision and scale
+func (d *Decimal) Mul(y *Decimal) (*Decimal, error) {
+	return d.apply(y, (*big.Rat).Mul)
+}
+
+// Quo returns quotient of decimals with maximum of operands precision and scale
+func (d *Decimal) Quo(y *Decimal) (*Decimal, error) {
+	if y.Sign() == 0 {
+		return nil, xerrors.WithStackTrace(errDivisionByZero)
+	}
+
+	return d.apply(y, (*big.Rat).Quo)
+}
+
+func (d *Decimal) apply(y *Decimal, op func(z, x, y *big.Rat) *big.Rat) (*Decimal, error) {
+	x, yy := d.Rat(), y.Rat()
+	if x == nil || yy == nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s, %s", errNotFinite, d, y))
+	}
+
+	return NewFromRat(op(new(big.Rat), x, yy), max(d.Precision, y.Precision), max(d.Scale, y.Scale))
+}
+
+// Scan implements sql.Scanner interface.
+// Strings are parsed with precision and scale of destination or with DefaultPrecision
+// and DefaultScale if destination is empty
+func (d *Decimal) Scan(src any) error {
+	switch v := src.(type) {
+	case interface {
+		Value() [16]byte
+		Precision() uint32
+		Scale() uint32
+	}:
+		*d = Decimal{
+			Bytes:     v.Value(),
+			Precision: v.Precision(),
+			Scale:     v.Scale(),
+		}
+
+		return nil
+	case *Decimal:
+		*d = *v
+
+		return nil
+	case Decimal:
+		*d = v
+
+		return nil
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w from %T", errUnsupportedScan, src))
+	}
+}
+
+func (d *Decimal) scanString(s string) error {
+	precision, scale := d.Precision, d.Scale
+	if precision == 0 {
+		precision, scale = DefaultPrecision, DefaultScale
+	}
+
+	v, err := NewFromString(s, precision, scale)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	*d = *v
+
+	return nil
+}
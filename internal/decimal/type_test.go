@@ -0,0 +1,133 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalArithmetic(t *testing.T) {
+	mustParse := func(s string, precision, scale uint32) *Decimal {
+		d, err := NewFromString(s, precision, scale)
+		require.NoError(t, err)
+
+		return d
+	}
+	for _, tt := range []struct {
+		name string
+		op   func(x, y *Decimal) (*Decimal, error)
+		x    *Decimal
+		y    *Decimal
+		exp  string
+	}{
+		{
+			name: "Add",
+			op:   (*Decimal).Add,
+			x:    mustParse("1.25", 22, 2),
+			y:    mustParse("-0.125", 22, 3),
+			exp:  "1.125",
+		},
+		{
+			name: "Sub",
+			op:   (*Decimal).Sub,
+			x:    mustParse("1", 10, 0),
+			y:    mustParse("2.5", 22, 1),
+			exp:  "-1.5",
+		},
+		{
+			name: "MulRounding",
+			op:   (*Decimal).Mul,
+			x:    mustParse("1.25", 22, 2),
+			y:    mustParse("0.05", 22, 2),
+			exp:  "0.06",
+		},
+		{
+			name: "Quo",
+			op:   (*Decimal).Quo,
+			x:    mustParse("-2", 22, 9),
+			y:    mustParse("3", 22, 9),
+			exp:  "-0.666666667",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.op(tt.x, tt.y)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, d.String())
+		})
+	}
+	t.Run("Overflow", func(t *testing.T) {
+		_, err := mustParse("9.9", 2, 1).Add(mustParse("0.1", 2, 1))
+		require.ErrorIs(t, err, errOverflow)
+	})
+	t.Run("DivisionByZero", func(t *testing.T) {
+		_, err := mustParse("1", 2, 1).Quo(mustParse("0", 2, 1))
+		require.ErrorIs(t, err, errDivisionByZero)
+	})
+}
+
+func TestDecimalCmp(t *testing.T) {
+	var (
+		posInf = NewFromBigInt(Inf(), 22, 9)
+		negInf = posInf.Neg()
+		nan    = NewFromBigInt(NaN(), 22, 9)
+	)
+	one, err := NewFromString("1", 22, 9)
+	require.NoError(t, err)
+	two, err := NewFromString("2", 10, 0)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name string
+		x    *Decimal
+		y    *Decimal
+		exp  int
+	}{
+		{name: "Finite", x: one, y: two, exp: -1},
+		{name: "FiniteEqual", x: two, y: two, exp: 0},
+		{name: "FiniteAndPosInf", x: two, y: posInf, exp: -1},
+		{name: "FiniteAndNegInf", x: two, y: negInf, exp: 1},
+		{name: "NegInfAndPosInf", x: negInf, y: posInf, exp: -1},
+		{name: "PosInfEqual", x: posInf, y: posInf, exp: 0},
+		{name: "NegInfEqual", x: negInf, y: negInf, exp: 0},
+		{name: "NaNAndFinite", x: nan, y: one, exp: 1},
+		{name: "PosInfAndNaN", x: posInf, y: nan, exp: -1},
+		{name: "NegInfAndNaN", x: negInf, y: nan, exp: -1},
+		{name: "NaNEqual", x: nan, y: nan, exp: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, tt.x.Cmp(tt.y))
+			require.Equal(t, -tt.exp, tt.y.Cmp(tt.x))
+		})
+	}
+}
+
+func TestDecimalConversions(t *testing.T) {
+	d, err := NewFromString("-123.45", 22, 9)
+	require.NoError(t, err)
+	require.Equal(t, "-123450000000", d.BigInt().String())
+	require.EqualValues(t, -9, d.Exponent())
+	require.Equal(t, big.NewRat(-12345, 100), d.Rat())
+	require.Equal(t, -1, d.Sign())
+	require.Equal(t, "123.450000000", d.Neg().String())
+	require.Equal(t, 0, d.Cmp(NewFromBigInt(big.NewInt(-12345), 5, 2)))
+
+	r, err := NewFromRat(big.NewRat(1, 3), 22, 9)
+	require.NoError(t, err)
+	require.Equal(t, "0.333333333", r.String())
+}
+
+func TestDecimalScan(t *testing.T) {
+	var d Decimal
+	require.NoError(t, d.Scan("1.5"))
+	require.Equal(t, *NewFromBigInt(big.NewInt(1500000000), DefaultPrecision, DefaultScale), d)
+
+	d = Decimal{Precision: 5, Scale: 1}
+	require.NoError(t, d.Scan([]byte("-2.5")))
+	require.Equal(t, "-2.5", d.String())
+
+	require.NoError(t, d.Scan(*NewFromBigInt(big.NewInt(7), 3, 0)))
+	require.Equal(t, "7", d.String())
+
+	require.ErrorIs(t, d.Scan(1), errUnsupportedScan)
+}
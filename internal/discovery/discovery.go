@@ -35,6 +35,8 @@ type Client struct {
 	config *config.Config
 	cc     grpc.ClientConnInterface
 	client Ydb_Discovery_V1.DiscoveryServiceClient
+
+	subscribers subscribers
 }
 
 func discover(
@@ -174,6 +176,8 @@ func (c *Client) WhoAmI(ctx context.Context) (whoAmI *discovery.WhoAmI, err erro
 }
 
 func (c *Client) Close(context.Context) error {
+	c.closeSubscribers()
+
 	if cc, has := c.cc.(io.Closer); has {
 		return cc.Close()
 	}
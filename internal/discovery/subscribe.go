@@ -0,0 +1,119 @@
+package discovery
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// subscriberBufferSize defines count of updates which buffers for slow subscriber
+const subscriberBufferSize = 16
+
+type subscribers struct {
+	mu     xsync.Mutex
+	last   []endpoint.Info
+	subs   map[chan discovery.EndpointsUpdate]struct{}
+	closed bool
+}
+
+// Subscribe returns channel of endpoints updates
+func (c *Client) Subscribe(ctx context.Context) <-chan discovery.EndpointsUpdate {
+	ch := make(chan discovery.EndpointsUpdate, subscriberBufferSize)
+
+	subscribed := false
+	c.subscribers.mu.WithLock(func() {
+		if c.subscribers.closed {
+			return
+		}
+		if c.subscribers.subs == nil {
+			c.subscribers.subs = make(map[chan discovery.EndpointsUpdate]struct{})
+		}
+		c.subscribers.subs[ch] = struct{}{}
+		if c.subscribers.last != nil {
+			ch <- discovery.EndpointsUpdate{
+				Endpoints: c.subscribers.last,
+				Added:     c.subscribers.last,
+			}
+		}
+		subscribed = true
+	})
+	if !subscribed {
+		close(ch)
+
+		return ch
+	}
+
+	go func() {
+		<-ctx.Done()
+		c.subscribers.mu.WithLock(func() {
+			if _, has := c.subscribers.subs[ch]; has {
+				delete(c.subscribers.subs, ch)
+				close(ch)
+			}
+		})
+	}()
+
+	return ch
+}
+
+// OnUpdate notifies subscribers about actual endpoints of cluster.
+// OnUpdate must be registered as callback of balancer updates
+func (c *Client) OnUpdate(ctx context.Context, endpoints []endpoint.Info) {
+	c.subscribers.mu.WithLock(func() {
+		if c.subscribers.closed {
+			return
+		}
+
+		update := diffEndpoints(c.subscribers.last, endpoints)
+		c.subscribers.last = endpoints
+
+		for ch := range c.subscribers.subs {
+			select {
+			case ch <- update:
+			default:
+			}
+		}
+	})
+}
+
+func (c *Client) closeSubscribers() {
+	c.subscribers.mu.WithLock(func() {
+		c.subscribers.closed = true
+		for ch := range c.subscribers.subs {
+			close(ch)
+		}
+		c.subscribers.subs = nil
+	})
+}
+
+func diffEndpoints(previous, actual []endpoint.Info) discovery.EndpointsUpdate {
+	update := discovery.EndpointsUpdate{
+		Endpoints: actual,
+	}
+
+	byAddress := make(map[string]endpoint.Info, len(previous))
+	for _, e := range previous {
+		byAddress[e.Address()] = e
+	}
+
+	for _, e := range actual {
+		p, has := byAddress[e.Address()]
+		switch {
+		case !has:
+			update.Added = append(update.Added, e)
+		case p.NodeID() != e.NodeID() || p.Location() != e.Location():
+			update.Changed = append(update.Changed, e)
+		}
+		delete(byAddress, e.Address())
+	}
+
+	for _, e := range previous {
+		if _, has := byAddress[e.Address()]; has {
+			update.Removed = append(update.Removed, e)
+		}
+	}
+
+	return update
+}
@@ -0,0 +1,75 @@
+package discovery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+func addresses(endpoints []endpoint.Info) []string {
+	list := make([]string, len(endpoints))
+	for i, e := range endpoints {
+		list[i] = e.Address()
+	}
+
+	return list
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &Client{}
+	c.OnUpdate(ctx, []endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1), endpoint.WithLocation("A")),
+		endpoint.New("b:2135", endpoint.WithID(2), endpoint.WithLocation("B")),
+	})
+
+	ch := c.Subscribe(ctx)
+
+	update := <-ch
+	require.Equal(t, []string{"a:2135", "b:2135"}, addresses(update.Added))
+	require.Len(t, update.Endpoints, 2)
+	require.Empty(t, update.Removed)
+
+	c.OnUpdate(ctx, []endpoint.Info{
+		endpoint.New("a:2135", endpoint.WithID(1), endpoint.WithLocation("A")),
+		endpoint.New("b:2135", endpoint.WithID(3), endpoint.WithLocation("B")),
+		endpoint.New("c:2135", endpoint.WithID(4), endpoint.WithLocation("C")),
+	})
+	update = <-ch
+	require.Equal(t, []string{"c:2135"}, addresses(update.Added))
+	require.Equal(t, []string{"b:2135"}, addresses(update.Changed))
+	require.Empty(t, update.Removed)
+
+	c.OnUpdate(ctx, []endpoint.Info{
+		endpoint.New("c:2135", endpoint.WithID(4), endpoint.WithLocation("C")),
+	})
+	update = <-ch
+	require.Empty(t, update.Added)
+	require.Empty(t, update.Changed)
+	require.Equal(t, []string{"a:2135", "b:2135"}, addresses(update.Removed))
+
+	cancel()
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestSubscribeClose(t *testing.T) {
+	c := &Client{}
+	ch := c.Subscribe(context.Background())
+	require.NoError(t, c.Close(context.Background()))
+
+	_, ok := <-ch
+	require.False(t, ok)
+
+	_, ok = <-c.Subscribe(context.Background())
+	require.False(t, ok)
+
+	// updates after close are ignored
+	c.OnUpdate(context.Background(), []endpoint.Info{endpoint.New("a:2135")})
+	require.Nil(t, c.subscribers.last)
+}
@@ -2,8 +2,10 @@ package dsn
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -38,12 +40,15 @@ func Parse(dsn string) (info parsedInfo, err error) {
 	if err != nil {
 		return info, xerrors.WithStackTrace(err)
 	}
-	if port := uri.Port(); port == "" {
-		return info, xerrors.WithStackTrace(fmt.Errorf("bad connection string '%s': port required", dsn))
+	endpoints := strings.Split(uri.Host, ",")
+	for _, endpoint := range endpoints {
+		if _, port, err := net.SplitHostPort(endpoint); err != nil || port == "" {
+			return info, xerrors.WithStackTrace(fmt.Errorf("bad connection string '%s': port required", dsn))
+		}
 	}
 	info.Options = append(info.Options,
 		config.WithSecure(uri.Scheme != insecureSchema),
-		config.WithEndpoint(uri.Host),
+		config.WithEndpoints(endpoints...),
 	)
 	if uri.Path != "" {
 		info.Options = append(info.Options, config.WithDatabase(uri.Path))
@@ -152,3 +152,15 @@ func TestParseConnectionStringEmptyDatabase(t *testing.T) {
 	require.Equal(t, "ydb-ru.yandex.net:2135", c.Endpoint())
 	require.Equal(t, "mydb", c.Database())
 }
+
+func TestParseConnectionStringMultipleEndpoints(t *testing.T) {
+	info, err := Parse("grpc://host1:2135,host2:2135,host3:2136/local")
+	require.NoError(t, err)
+	c := config.New(info.Options...)
+	require.Equal(t, "host1:2135", c.Endpoint())
+	require.Equal(t, []string{"host1:2135", "host2:2135", "host3:2136"}, c.Endpoints())
+	require.Equal(t, "/local", c.Database())
+
+	_, err = Parse("grpc://host1:2135,host2/local")
+	require.Error(t, err)
+}
@@ -1,9 +1,26 @@
 package endpoint
 
-import "context"
+import (
+	"context"
+	"sync"
+)
 
 type (
-	ctxEndpointKey struct{}
+	ctxEndpointKey     struct{}
+	ctxNodeFallbackKey struct{}
+)
+
+// NodeFallback defines behaviour of balancer if node from context is unavailable
+type NodeFallback uint8
+
+const (
+	// NodeFallbackAnyNode selects any available node (default)
+	NodeFallbackAnyNode = NodeFallback(iota)
+	// NodeFallbackSameLocation selects available node from location of preferred node,
+	// then any available node
+	NodeFallbackSameLocation
+	// NodeFallbackNone returns error if preferred node is unavailable
+	NodeFallbackNone
 )
 
 func WithNodeID(ctx context.Context, nodeID uint32) context.Context {
@@ -17,3 +34,58 @@ func ContextNodeID(ctx context.Context) (nodeID uint32, ok bool) {
 
 	return 0, false
 }
+
+func WithNodeFallback(ctx context.Context, fallback NodeFallback) context.Context {
+	return context.WithValue(ctx, ctxNodeFallbackKey{}, fallback)
+}
+
+func ContextNodeFallback(ctx context.Context) NodeFallback {
+	if fallback, ok := ctx.Value(ctxNodeFallbackKey{}).(NodeFallback); ok {
+		return fallback
+	}
+
+	return NodeFallbackAnyNode
+}
+
+type ctxUsedNodesKey struct{}
+
+// UsedNodes is a set of nodes which already used by concurrent attempts of operation (hedged attempts).
+// Balancer selects connection to node which not used yet if any
+type UsedNodes struct {
+	mu    sync.Mutex
+	nodes map[uint32]struct{}
+}
+
+func NewUsedNodes() *UsedNodes {
+	return &UsedNodes{
+		nodes: make(map[uint32]struct{}),
+	}
+}
+
+func (u *UsedNodes) Add(nodeID uint32) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.nodes[nodeID] = struct{}{}
+}
+
+func (u *UsedNodes) Has(nodeID uint32) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	_, has := u.nodes[nodeID]
+
+	return has
+}
+
+func WithUsedNodes(ctx context.Context, usedNodes *UsedNodes) context.Context {
+	return context.WithValue(ctx, ctxUsedNodesKey{}, usedNodes)
+}
+
+func ContextUsedNodes(ctx context.Context) *UsedNodes {
+	if usedNodes, ok := ctx.Value(ctxUsedNodesKey{}).(*UsedNodes); ok {
+		return usedNodes
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+)
+
+const (
+	ServiceQuery = "query"
+	ServiceTable = "table"
+)
+
+type (
+	// Statement describes executing statement
+	Statement struct {
+		// Service is a name of client service which executes statement (ServiceQuery or ServiceTable)
+		Service string
+
+		// SessionID is an identifier of session which executes statement
+		SessionID string
+
+		// Query is a text of statement
+		Query string
+
+		// Params are parameters of statement
+		Params *params.Parameters
+	}
+
+	// Executor executes statement
+	Executor interface {
+		Execute(ctx context.Context, stmt Statement) error
+	}
+
+	// ExecutorFunc is an adapter to allow the use of ordinary functions as Executor
+	ExecutorFunc func(ctx context.Context, stmt Statement) error
+
+	// Interceptor wraps next executor. Interceptor can rewrite statement before
+	// passing it to next executor, veto statement with returning error without calling
+	// of next executor or observe executing of statement (latency, errors)
+	Interceptor func(next Executor) Executor
+)
+
+func (f ExecutorFunc) Execute(ctx context.Context, stmt Statement) error {
+	return f(ctx, stmt)
+}
+
+// Chain wraps base executor with interceptors.
+// First interceptor is an outermost, so it calls first
+func Chain(base Executor, interceptors ...Interceptor) Executor {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		if interceptors[i] != nil {
+			base = interceptors[i](base)
+		}
+	}
+
+	return base
+}
@@ -0,0 +1,150 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	var calls []string
+	appendQuery := func(suffix string) Interceptor {
+		return func(next Executor) Executor {
+			return ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+				calls = append(calls, suffix)
+				stmt.Query += suffix
+
+				return next.Execute(ctx, stmt)
+			})
+		}
+	}
+	t.Run("Order", func(t *testing.T) {
+		calls = nil
+		var executed string
+		err := Chain(ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+			executed = stmt.Query
+
+			return nil
+		}), appendQuery("1"), nil, appendQuery("2")).Execute(context.Background(), Statement{Query: "q"})
+		require.NoError(t, err)
+		require.Equal(t, "q12", executed)
+		require.Equal(t, []string{"1", "2"}, calls)
+	})
+	t.Run("Veto", func(t *testing.T) {
+		calls = nil
+		errVeto := errors.New("veto")
+		err := Chain(ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+			t.Fatal("must not be called")
+
+			return nil
+		}), appendQuery("1"), func(next Executor) Executor {
+			return ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+				return errVeto
+			})
+		}, appendQuery("2")).Execute(context.Background(), Statement{Query: "q"})
+		require.ErrorIs(t, err, errVeto)
+		require.Equal(t, []string{"1"}, calls)
+	})
+}
+
+func TestPragmas(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		hints   []string
+		pragmas []Pragma
+		query   string
+		exp     string
+	}{
+		{
+			name:  "Empty",
+			query: "SELECT 1",
+			exp:   "SELECT 1",
+		},
+		{
+			name:  "HintsAndPragmas",
+			hints: []string{"--!syntax_v1"},
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+				{Name: "AnsiInForEmptyOrNullableItemsCollections"},
+			},
+			query: "SELECT 1",
+			exp: "--!syntax_v1\n" +
+				"PRAGMA TablePathPrefix(\"/local/path\");\n" +
+				"PRAGMA AnsiInForEmptyOrNullableItemsCollections;\n" +
+				"\n" +
+				"SELECT 1",
+		},
+		{
+			name: "SkipDeclared",
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+				{Name: "AnsiInForEmptyOrNullableItemsCollections"},
+			},
+			query: "pragma TablePathPrefix(\"/local/other\");\nSELECT 1",
+			exp: "PRAGMA AnsiInForEmptyOrNullableItemsCollections;\n" +
+				"\n" +
+				"pragma TablePathPrefix(\"/local/other\");\nSELECT 1",
+		},
+		{
+			name: "SkipDeclaredWithSpaces",
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+				{Name: "AnsiInForEmptyOrNullableItemsCollections"},
+			},
+			query: "--!syntax_v1\n" +
+				"PRAGMA  TablePathPrefix(\"/local/other\"); Pragma\tAnsiInForEmptyOrNullableItemsCollections;\n" +
+				"SELECT 1",
+			exp: "--!syntax_v1\n" +
+				"PRAGMA  TablePathPrefix(\"/local/other\"); Pragma\tAnsiInForEmptyOrNullableItemsCollections;\n" +
+				"SELECT 1",
+		},
+		{
+			name:  "NotDeclared",
+			hints: []string{"--!syntax_v1"},
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+			},
+			query: "PRAGMA TablePathPrefixFoo;\n-- PRAGMA TablePathPrefix(\"/local/other\")\n" +
+				"SELECT \"--!syntax_v1\"",
+			exp: "--!syntax_v1\n" +
+				"PRAGMA TablePathPrefix(\"/local/path\");\n" +
+				"\n" +
+				"PRAGMA TablePathPrefixFoo;\n-- PRAGMA TablePathPrefix(\"/local/other\")\n" +
+				"SELECT \"--!syntax_v1\"",
+		},
+		{
+			name:  "PragmasAfterStatementHints",
+			hints: []string{"--!syntax_v1"},
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+			},
+			query: "--!syntax_v1\n--!ansi_lexer\nSELECT 1",
+			exp: "--!syntax_v1\n" +
+				"--!ansi_lexer\n" +
+				"PRAGMA TablePathPrefix(\"/local/path\");\n" +
+				"\n" +
+				"SELECT 1",
+		},
+		{
+			name: "HintsOnly",
+			pragmas: []Pragma{
+				{Name: "TablePathPrefix", Value: "/local/path"},
+			},
+			query: "--!syntax_v1",
+			exp: "--!syntax_v1\n" +
+				"PRAGMA TablePathPrefix(\"/local/path\");\n" +
+				"\n",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Chain(ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+				require.Equal(t, tt.exp, stmt.Query)
+
+				return nil
+			}), Pragmas(tt.hints, tt.pragmas)).Execute(context.Background(), Statement{Query: tt.query})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package interceptor
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// Pragma describes YQL pragma
+type Pragma struct {
+	Name  string
+	Value string
+}
+
+func (p Pragma) String() string {
+	if p.Value == "" {
+		return "PRAGMA " + p.Name + ";"
+	}
+
+	return "PRAGMA " + p.Name + "(" + strconv.Quote(p.Value) + ");"
+}
+
+// pragmaRegexp matches declaration of pragma with given name at the beginning of line or after other statement
+func pragmaRegexp(name string) *regexp.Regexp {
+	return regexp.MustCompile(`(?im)(^|;)\s*pragma\s+` + regexp.QuoteMeta(name) + `\b`)
+}
+
+// hintRegexp matches hint which written on separate line
+func hintRegexp(hint string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^[ \t]*` + regexp.QuoteMeta(hint) + `[ \t]*\r?$`)
+}
+
+// splitLeadingHints splits query to leading hint lines (such as `--!syntax_v1`) and the rest of query.
+// Hints are valid on the top of statement only, so pragmas must be inserted after them.
+func splitLeadingHints(query string) (hints, rest string) {
+	rest = query
+	for strings.HasPrefix(strings.TrimLeft(rest, " \t\r\n"), "--!") {
+		end := strings.IndexByte(rest, '\n')
+		if end < 0 {
+			return query, ""
+		}
+		rest = rest[end+1:]
+	}
+
+	return query[:len(query)-len(rest)], rest
+}
+
+// Pragmas makes interceptor which prepends hints and pragmas to each statement.
+// Hints are written as is on the top of statement. Pragmas are written after hints
+// of statement. Pragmas which already declared in statement are skipped.
+func Pragmas(hints []string, pragmas []Pragma) Interceptor {
+	hintRegexps := make([]*regexp.Regexp, len(hints))
+	for i, hint := range hints {
+		hintRegexps[i] = hintRegexp(hint)
+	}
+	pragmaRegexps := make([]*regexp.Regexp, len(pragmas))
+	for i, pragma := range pragmas {
+		pragmaRegexps[i] = pragmaRegexp(pragma.Name)
+	}
+
+	return func(next Executor) Executor {
+		return ExecutorFunc(func(ctx context.Context, stmt Statement) error {
+			buffer := xstring.Buffer()
+			defer buffer.Free()
+
+			modified := false
+			for i, hint := range hints {
+				if !hintRegexps[i].MatchString(stmt.Query) {
+					buffer.WriteString(hint)
+					buffer.WriteByte('\n')
+					modified = true
+				}
+			}
+
+			queryHints, query := splitLeadingHints(stmt.Query)
+			buffer.WriteString(queryHints)
+			if queryHints != "" && !strings.HasSuffix(queryHints, "\n") {
+				buffer.WriteByte('\n')
+			}
+
+			for i, pragma := range pragmas {
+				if !pragmaRegexps[i].MatchString(stmt.Query) {
+					buffer.WriteString(pragma.String())
+					buffer.WriteByte('\n')
+					modified = true
+				}
+			}
+
+			if modified {
+				buffer.WriteByte('\n')
+				buffer.WriteString(query)
+				stmt.Query = buffer.String()
+			}
+
+			return next.Execute(ctx, stmt)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 
+	publicCredentials "github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
@@ -50,3 +51,24 @@ func TestMetaRequiredHeaders(t *testing.T) {
 	}, md.Get(internal.HeaderVersion))
 	require.Equal(t, []string{"some-user-value"}, md.Get("some-user-header"))
 }
+
+func TestMetaCredentialsFunc(t *testing.T) {
+	type tenantKey struct{}
+	m := internal.New(
+		"database",
+		publicCredentials.Func(func(ctx context.Context) (string, error) {
+			tenant, _ := ctx.Value(tenantKey{}).(string)
+
+			return "token-" + tenant, nil
+		}),
+		&trace.Driver{},
+	)
+
+	for _, tenant := range []string{"a", "b"} {
+		ctx, err := m.Context(context.WithValue(context.Background(), tenantKey{}, tenant))
+		require.NoError(t, err)
+		md, has := metadata.FromOutgoingContext(ctx)
+		require.True(t, has)
+		require.Equal(t, []string{"token-" + tenant}, md.Get(internal.HeaderTicket))
+	}
+}
@@ -20,6 +20,11 @@ type (
 		Description string
 		State       string
 		Progress    float32
+
+		// Path is a path of table of the index
+		Path string
+		// Index is a name of building index
+		Index string
 	}
 	ImportFromS3 struct {
 		Settings string
@@ -110,6 +115,8 @@ func (*BuildIndex) fromProto(metadata *anypb.Any) *BuildIndex { //nolint:unused
 		Description: pb.GetDescription().String(),
 		State:       pb.GetState().String(),
 		Progress:    pb.GetProgress(),
+		Path:        pb.GetDescription().GetPath(),
+		Index:       pb.GetDescription().GetIndex().GetName(),
 	}
 }
 
@@ -0,0 +1,71 @@
+package metadata
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const percents = 100
+
+type itemProgress interface {
+	GetPartsTotal() uint32
+	GetPartsCompleted() uint32
+}
+
+// Progress returns progress (in percents) of operation with metadata of any known kind.
+// Progress of operations without progress metadata is zero
+func Progress(metadata *anypb.Any) float32 {
+	if metadata == nil {
+		return 0
+	}
+
+	var (
+		buildIndex   Ydb_Table.IndexBuildMetadata
+		importFromS3 Ydb_Import.ImportFromS3Metadata
+		exportToS3   Ydb_Export.ExportToS3Metadata
+		exportToYT   Ydb_Export.ExportToYtMetadata
+	)
+	switch {
+	case metadata.MessageIs(&buildIndex):
+		if err := metadata.UnmarshalTo(&buildIndex); err != nil {
+			return 0
+		}
+
+		return buildIndex.GetProgress()
+	case metadata.MessageIs(&importFromS3):
+		if err := metadata.UnmarshalTo(&importFromS3); err != nil {
+			return 0
+		}
+
+		return itemsProgress(importFromS3.GetItemsProgress())
+	case metadata.MessageIs(&exportToS3):
+		if err := metadata.UnmarshalTo(&exportToS3); err != nil {
+			return 0
+		}
+
+		return itemsProgress(exportToS3.GetItemsProgress())
+	case metadata.MessageIs(&exportToYT):
+		if err := metadata.UnmarshalTo(&exportToYT); err != nil {
+			return 0
+		}
+
+		return itemsProgress(exportToYT.GetItemsProgress())
+	default:
+		return 0
+	}
+}
+
+func itemsProgress[T itemProgress](items []T) float32 {
+	var total, completed uint64
+	for _, item := range items {
+		total += uint64(item.GetPartsTotal())
+		completed += uint64(item.GetPartsCompleted())
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return float32(completed) * percents / float32(total)
+}
@@ -0,0 +1,69 @@
+// Package pagination implements opaque continuation tokens of listing APIs
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const tokenVersion = 1
+
+var ErrInvalidToken = errors.New("invalid page token")
+
+type (
+	// Token is a continuation token of listing. Token identifies position of listing by
+	// the key of last returned item, so token stays valid between requests and processes
+	Token struct {
+		// Scope identifies listing (such as listed path). Token of one listing is not valid for other listing
+		Scope string
+		// After is a key of last returned item
+		After string
+	}
+	token struct {
+		Version int    `json:"v"`
+		Scope   string `json:"s,omitempty"`
+		After   string `json:"a"`
+	}
+)
+
+// Encode returns opaque string representation of token
+func (t Token) Encode() string {
+	// marshaling of struct with string and int fields has no errors
+	data, _ := json.Marshal(token{
+		Version: tokenVersion,
+		Scope:   t.Scope,
+		After:   t.After,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode parses opaque string representation of token and checks scope of token
+func Decode(s string, scope string) (Token, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrInvalidToken, err))
+	}
+
+	var t token
+	if err = json.Unmarshal(data, &t); err != nil {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrInvalidToken, err))
+	}
+
+	if t.Version != tokenVersion {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("%w: unsupported version %d", ErrInvalidToken, t.Version))
+	}
+
+	if t.Scope != scope {
+		return Token{}, xerrors.WithStackTrace(fmt.Errorf("%w: token of %q used for %q", ErrInvalidToken, t.Scope, scope))
+	}
+
+	return Token{
+		Scope: t.Scope,
+		After: t.After,
+	}, nil
+}
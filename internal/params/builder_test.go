@@ -371,7 +371,7 @@ func TestBuilder(t *testing.T) {
 				},
 				Value: &Ydb.Value{
 					Value: &Ydb.Value_TextValue{
-						TextValue: "1973-11-29T21:33:09Z",
+						TextValue: "1973-11-29T21:33:09,UTC",
 					},
 				},
 			},
@@ -386,7 +386,7 @@ func TestBuilder(t *testing.T) {
 				},
 				Value: &Ydb.Value{
 					Value: &Ydb.Value_TextValue{
-						TextValue: "1973-11-29",
+						TextValue: "1973-11-29,UTC",
 					},
 				},
 			},
@@ -401,7 +401,7 @@ func TestBuilder(t *testing.T) {
 				},
 				Value: &Ydb.Value{
 					Value: &Ydb.Value_TextValue{
-						TextValue: "1973-11-29T21:33:09.000000Z",
+						TextValue: "1973-11-29T21:33:09.000000,UTC",
 					},
 				},
 			},
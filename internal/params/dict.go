@@ -467,3 +467,30 @@ func (d *dict) EndDict() Builder {
 
 	return d.parent
 }
+
+func (d *dictValue) Any(v value.Value) *dict {
+	d.pair.parent.values = append(d.pair.parent.values, value.DictValueField{
+		K: d.pair.keyValue,
+		V: v,
+	})
+
+	return d.pair.parent
+}
+
+func (d *dictValue) BeginList() *nestedList[*dict] {
+	return &nestedList[*dict]{
+		parent: d.pair.parent,
+		end: func(v value.Value) {
+			d.Any(v)
+		},
+	}
+}
+
+func (d *dictValue) BeginStruct() *nestedStruct[*dict] {
+	return &nestedStruct[*dict]{
+		parent: d.pair.parent,
+		end: func(v value.Value) {
+			d.Any(v)
+		},
+	}
+}
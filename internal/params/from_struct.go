@@ -0,0 +1,26 @@
+package params
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// FromStruct makes parameters from fields of struct (or pointer to struct) v.
+// Names of parameters defines by tag with tagName (name of Go field by default) with '$' prefix
+func FromStruct(v any, tagName string) (*Parameters, error) {
+	fields, err := value.StructFieldsFromGo(v, tagName)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	params := make(Parameters, 0, len(fields))
+	for _, f := range fields {
+		name := f.Name
+		if name[0] != '$' {
+			name = "$" + name
+		}
+		params = append(params, Named(name, f.V))
+	}
+
+	return &params, nil
+}
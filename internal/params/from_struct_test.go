@@ -0,0 +1,77 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestFromStruct(t *testing.T) {
+	type Embedded struct {
+		Tag string `sql:"tag"`
+	}
+	type Payload struct {
+		A int `json:"a"`
+	}
+	type Item struct {
+		ID   uint64
+		Name *string
+	}
+	name := "test"
+	s := struct {
+		Embedded
+		ID        uint64            `sql:"id"`
+		Name      *string           `sql:"name"`
+		Empty     *int64            `sql:"empty"`
+		CreatedAt time.Time         `sql:"created_at"`
+		TTL       time.Duration     `sql:"ttl"`
+		Tags      []string          `sql:"tags"`
+		Attrs     map[string]uint32 `sql:"attrs"`
+		Item      Item              `sql:"item"`
+		Payload   Payload           `sql:"payload,json"`
+		Skipped   string            `sql:"-"`
+		V         value.Value       `sql:"v"`
+	}{
+		Embedded:  Embedded{Tag: "tag"},
+		ID:        1,
+		Name:      &name,
+		CreatedAt: time.Unix(123, 0),
+		TTL:       time.Second,
+		Attrs:     map[string]uint32{"a": 1},
+		Item:      Item{ID: 2},
+		Payload:   Payload{A: 1},
+		V:         value.Uint8Value(1),
+	}
+	params, err := FromStruct(&s, "sql")
+	require.NoError(t, err)
+
+	actual := make(map[string]value.Value)
+	params.Each(func(name string, v value.Value) {
+		actual[name] = v
+	})
+	require.Len(t, actual, 11)
+	require.Equal(t, types.Text, actual["$tag"].Type())
+	require.Equal(t, value.Uint64Value(1), actual["$id"])
+	require.Equal(t, value.OptionalValue(value.TextValue("test")), actual["$name"])
+	require.Equal(t, value.NullValue(types.Int64), actual["$empty"])
+	require.Equal(t, value.TimestampValueFromTime(time.Unix(123, 0)), actual["$created_at"])
+	require.Equal(t, value.IntervalValueFromDuration(time.Second), actual["$ttl"])
+	require.Equal(t, types.NewList(types.Text), actual["$tags"].Type())
+	require.Equal(t, types.NewDict(types.Text, types.Uint32), actual["$attrs"].Type())
+	require.Equal(t, types.NewStruct(
+		types.StructField{Name: "ID", T: types.Uint64},
+		types.StructField{Name: "Name", T: types.NewOptional(types.Text)},
+	), actual["$item"].Type())
+	require.Equal(t, value.JSONDocumentValue(`{"a":1}`), actual["$payload"])
+	require.Equal(t, value.Uint8Value(1), actual["$v"])
+
+	_, err = FromStruct(1, "sql")
+	require.Error(t, err)
+
+	_, err = FromStruct(struct{ C chan int }{}, "sql")
+	require.Error(t, err)
+}
@@ -188,3 +188,27 @@ func (l *listItem) TzDatetime(v time.Time) *list {
 
 	return l.parent
 }
+
+func (l *listItem) Any(v value.Value) *list {
+	l.parent.values = append(l.parent.values, v)
+
+	return l.parent
+}
+
+func (l *listItem) BeginList() *nestedList[*list] {
+	return &nestedList[*list]{
+		parent: l.parent,
+		end: func(v value.Value) {
+			l.parent.values = append(l.parent.values, v)
+		},
+	}
+}
+
+func (l *listItem) BeginStruct() *nestedStruct[*list] {
+	return &nestedStruct[*list]{
+		parent: l.parent,
+		end: func(v value.Value) {
+			l.parent.values = append(l.parent.values, v)
+		},
+	}
+}
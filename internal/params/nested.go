@@ -0,0 +1,456 @@
+package params
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+type (
+	// nestedList is a builder of list which is an item of parent builder P
+	nestedList[P any] struct {
+		parent P
+		end    func(v value.Value)
+		values []value.Value
+	}
+	nestedListItem[P any] struct {
+		parent *nestedList[P]
+	}
+	// nestedStruct is a builder of struct which is an item of parent builder P
+	nestedStruct[P any] struct {
+		parent P
+		end    func(v value.Value)
+		values []value.StructValueField
+	}
+	nestedStructField[P any] struct {
+		parent *nestedStruct[P]
+		name   string
+	}
+)
+
+func (l *nestedList[P]) Add() *nestedListItem[P] {
+	return &nestedListItem[P]{
+		parent: l,
+	}
+}
+
+func (l *nestedList[P]) AddItems(items ...value.Value) *nestedList[P] {
+	l.values = append(l.values, items...)
+
+	return l
+}
+
+func (l *nestedList[P]) EndList() P {
+	l.end(value.ListValue(l.values...))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Any(v value.Value) *nestedList[P] {
+	l.parent.values = append(l.parent.values, v)
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Text(v string) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.TextValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Bytes(v []byte) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.BytesValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Bool(v bool) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.BoolValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Uint64(v uint64) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Uint64Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Int64(v int64) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Int64Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Uint32(v uint32) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Uint32Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Int32(v int32) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Int32Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Uint16(v uint16) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Uint16Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Int16(v int16) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Int16Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Uint8(v uint8) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Uint8Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Int8(v int8) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.Int8Value(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Float(v float32) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.FloatValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Double(v float64) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.DoubleValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Decimal(v [16]byte, precision, scale uint32) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.DecimalValue(v, precision, scale))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Timestamp(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.TimestampValueFromTime(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Date(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.DateValueFromTime(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Datetime(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.DatetimeValueFromTime(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) Interval(v time.Duration) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.IntervalValueFromDuration(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) JSON(v string) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.JSONValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) JSONDocument(v string) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.JSONDocumentValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) YSON(v []byte) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.YSONValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) UUID(v [16]byte) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.UUIDValue(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) TzDate(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.TzDateValueFromTime(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) TzTimestamp(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.TzTimestampValueFromTime(v))
+
+	return l.parent
+}
+
+func (l *nestedListItem[P]) TzDatetime(v time.Time) *nestedList[P] {
+	l.parent.values = append(l.parent.values, value.TzDatetimeValueFromTime(v))
+
+	return l.parent
+}
+
+func (s *nestedStruct[P]) Field(name string) *nestedStructField[P] {
+	return &nestedStructField[P]{
+		parent: s,
+		name:   name,
+	}
+}
+
+func (s *nestedStruct[P]) AddItems(items ...value.StructValueField) *nestedStruct[P] {
+	s.values = append(s.values, items...)
+
+	return s
+}
+
+func (s *nestedStruct[P]) EndStruct() P {
+	s.end(value.StructValue(s.values...))
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Any(v value.Value) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    v,
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Text(v string) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.TextValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Bytes(v []byte) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.BytesValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Bool(v bool) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.BoolValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Uint64(v uint64) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Uint64Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Int64(v int64) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Int64Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Uint32(v uint32) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Uint32Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Int32(v int32) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Int32Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Uint16(v uint16) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Uint16Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Int16(v int16) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Int16Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Uint8(v uint8) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Uint8Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Int8(v int8) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.Int8Value(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Float(v float32) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.FloatValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Double(v float64) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.DoubleValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Decimal(v [16]byte, precision, scale uint32) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.DecimalValue(v, precision, scale),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Timestamp(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.TimestampValueFromTime(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Date(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.DateValueFromTime(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Datetime(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.DatetimeValueFromTime(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) Interval(v time.Duration) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.IntervalValueFromDuration(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) JSON(v string) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.JSONValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) JSONDocument(v string) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.JSONDocumentValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) YSON(v []byte) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.YSONValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) UUID(v [16]byte) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.UUIDValue(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) TzDate(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.TzDateValueFromTime(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) TzTimestamp(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.TzTimestampValueFromTime(v),
+	})
+
+	return s.parent
+}
+
+func (s *nestedStructField[P]) TzDatetime(v time.Time) *nestedStruct[P] {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    value.TzDatetimeValueFromTime(v),
+	})
+
+	return s.parent
+}
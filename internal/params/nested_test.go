@@ -0,0 +1,67 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestNested(t *testing.T) {
+	params := Builder{}.
+		Param("$list").BeginList().
+		Add().BeginStruct().
+		Field("id").Uint64(1).
+		Field("tags").Any(value.ListValue(value.TextValue("a"))).
+		EndStruct().
+		Add().BeginStruct().
+		Field("id").Uint64(2).
+		Field("tags").Any(value.ListValue(value.TextValue("b"))).
+		EndStruct().
+		EndList().
+		Param("$struct").BeginStruct().
+		Field("id").Uint64(1).
+		Field("tags").BeginList().Add().Text("a").Add().Text("b").EndList().
+		EndStruct().
+		Param("$dict").BeginDict().
+		Add().Text("a").BeginList().Add().Int32(1).EndList().
+		Add().Text("b").BeginStruct().Field("x").Double(1).EndStruct().
+		EndDict().
+		Param("$tuple").BeginTuple().
+		Add().BeginList().Add().Bool(true).EndList().
+		Add().Any(value.Int8Value(1)).
+		EndTuple().
+		Build()
+
+	actual := make(map[string]value.Value)
+	params.Each(func(name string, v value.Value) {
+		actual[name] = v
+	})
+	require.Equal(t, map[string]value.Value{
+		"$list": value.ListValue(
+			value.StructValue(
+				value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+				value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("a"))},
+			),
+			value.StructValue(
+				value.StructValueField{Name: "id", V: value.Uint64Value(2)},
+				value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("b"))},
+			),
+		),
+		"$struct": value.StructValue(
+			value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+			value.StructValueField{Name: "tags", V: value.ListValue(value.TextValue("a"), value.TextValue("b"))},
+		),
+		"$dict": value.DictValue(
+			value.DictValueField{K: value.TextValue("a"), V: value.ListValue(value.Int32Value(1))},
+			value.DictValueField{K: value.TextValue("b"), V: value.StructValue(
+				value.StructValueField{Name: "x", V: value.DoubleValue(1)},
+			)},
+		),
+		"$tuple": value.TupleValue(
+			value.ListValue(value.BoolValue(true)),
+			value.Int8Value(1),
+		),
+	}, actual)
+}
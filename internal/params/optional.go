@@ -159,6 +159,12 @@ func (p *optional) UUID(v *[16]byte) *optionalBuilder {
 	return &optionalBuilder{opt: p}
 }
 
+func (p *optional) UUIDWithByteOrder(v *[16]byte, order value.UUIDByteOrder) *optionalBuilder {
+	p.value = value.NullableUUIDValueWithByteOrder(v, order)
+
+	return &optionalBuilder{opt: p}
+}
+
 func (p *optional) TzDate(v *time.Time) *optionalBuilder {
 	p.value = value.NullableTzDateValueFromTime(v)
 
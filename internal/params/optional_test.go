@@ -598,7 +598,7 @@ func TestOptional(t *testing.T) {
 					Value: &Ydb.Value_NestedValue{
 						NestedValue: &Ydb.Value{
 							Value: &Ydb.Value_TextValue{
-								TextValue: "1973-11-29T21:33:09Z",
+								TextValue: "1973-11-29T21:33:09,UTC",
 							},
 						},
 					},
@@ -623,7 +623,7 @@ func TestOptional(t *testing.T) {
 					Value: &Ydb.Value_NestedValue{
 						NestedValue: &Ydb.Value{
 							Value: &Ydb.Value_TextValue{
-								TextValue: "1973-11-29",
+								TextValue: "1973-11-29,UTC",
 							},
 						},
 					},
@@ -648,7 +648,7 @@ func TestOptional(t *testing.T) {
 					Value: &Ydb.Value_NestedValue{
 						NestedValue: &Ydb.Value{
 							Value: &Ydb.Value_TextValue{
-								TextValue: "1973-11-29T21:33:09.000000Z",
+								TextValue: "1973-11-29T21:33:09.000000,UTC",
 							},
 						},
 					},
@@ -1,6 +1,7 @@
 package params
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
@@ -282,6 +284,26 @@ func (p *Parameter) JSON(v string) Builder {
 	return p.parent
 }
 
+// JSONMarshal binds Json value which encodes from v with json.Marshal
+func (p *Parameter) JSONMarshal(v interface{}) (Builder, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return p.parent, xerrors.WithStackTrace(err)
+	}
+
+	return p.JSON(xstring.FromBytes(data)), nil
+}
+
+// JSONDocumentMarshal binds JsonDocument value which encodes from v with json.Marshal
+func (p *Parameter) JSONDocumentMarshal(v interface{}) (Builder, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return p.parent, xerrors.WithStackTrace(err)
+	}
+
+	return p.JSONDocument(xstring.FromBytes(data)), nil
+}
+
 func (p *Parameter) JSONDocument(v string) Builder {
 	p.value = value.JSONDocumentValue(v)
 	p.parent.params = append(p.parent.params, p)
@@ -303,6 +325,25 @@ func (p *Parameter) UUID(v [16]byte) Builder {
 	return p.parent
 }
 
+// UUIDWithByteOrder binds UUID bytes in given byte order.
+// Use value.UUIDBigEndianByteOrder for uuid.UUID types from github.com/google/uuid
+func (p *Parameter) UUIDWithByteOrder(v [16]byte, order value.UUIDByteOrder) Builder {
+	p.value = value.UUIDValueWithByteOrder(v, order)
+	p.parent.params = append(p.parent.params, p)
+
+	return p.parent
+}
+
+// Marshal binds value of type which converts itself into YDB value
+func (p *Parameter) Marshal(v value.ValueMarshaler) (Builder, error) {
+	vv, err := v.MarshalYDBValue()
+	if err != nil {
+		return p.parent, xerrors.WithStackTrace(err)
+	}
+
+	return p.Any(vv), nil
+}
+
 func (p *Parameter) Any(v types.Value) Builder {
 	p.value = v
 	p.parent.params = append(p.parent.params, p)
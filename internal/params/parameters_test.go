@@ -1,6 +1,7 @@
 package params
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -36,6 +37,41 @@ func TestParameters(t *testing.T) {
 	}, visited)
 }
 
+type testMarshaler struct {
+	v   value.Value
+	err error
+}
+
+func (m testMarshaler) MarshalYDBValue() (value.Value, error) {
+	return m.v, m.err
+}
+
+func TestParameterMarshal(t *testing.T) {
+	b, err := Builder{}.Param("$x").Marshal(testMarshaler{v: value.Int64Value(42)})
+	require.NoError(t, err)
+	require.Equal(t, `{"$x":42l}`, b.Build().String())
+
+	testErr := errors.New("test")
+	b, err = b.Param("$y").Marshal(testMarshaler{err: testErr})
+	require.ErrorIs(t, err, testErr)
+	require.Equal(t, 1, b.Build().Count())
+}
+
+func TestParameterJSONMarshal(t *testing.T) {
+	v := struct {
+		Name string `json:"name"`
+	}{Name: "test"}
+	b, err := Builder{}.Param("$x").JSONMarshal(v)
+	require.NoError(t, err)
+	b, err = b.Param("$y").JSONDocumentMarshal(v)
+	require.NoError(t, err)
+	require.Equal(t, `{"$x":Json(@@{"name":"test"}@@),"$y":JsonDocument(@@{"name":"test"}@@)}`, b.Build().String())
+
+	_, err = b.Param("$z").JSONMarshal(make(chan int))
+	require.Error(t, err)
+	require.Equal(t, 2, b.Build().Count())
+}
+
 func TestNil(t *testing.T) {
 	for _, tt := range []struct {
 		name string
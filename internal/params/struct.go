@@ -266,3 +266,30 @@ func (s *structValue) TzDate(v time.Time) *structure {
 
 	return s.parent
 }
+
+func (s *structValue) Any(v value.Value) *structure {
+	s.parent.values = append(s.parent.values, value.StructValueField{
+		Name: s.name,
+		V:    v,
+	})
+
+	return s.parent
+}
+
+func (s *structValue) BeginList() *nestedList[*structure] {
+	return &nestedList[*structure]{
+		parent: s.parent,
+		end: func(v value.Value) {
+			s.Any(v)
+		},
+	}
+}
+
+func (s *structValue) BeginStruct() *nestedStruct[*structure] {
+	return &nestedStruct[*structure]{
+		parent: s.parent,
+		end: func(v value.Value) {
+			s.Any(v)
+		},
+	}
+}
@@ -188,3 +188,27 @@ func (t *tupleItem) TzDatetime(v time.Time) *tuple {
 
 	return t.parent
 }
+
+func (t *tupleItem) Any(v value.Value) *tuple {
+	t.parent.values = append(t.parent.values, v)
+
+	return t.parent
+}
+
+func (t *tupleItem) BeginList() *nestedList[*tuple] {
+	return &nestedList[*tuple]{
+		parent: t.parent,
+		end: func(v value.Value) {
+			t.parent.values = append(t.parent.values, v)
+		},
+	}
+}
+
+func (t *tupleItem) BeginStruct() *nestedStruct[*tuple] {
+	return &nestedStruct[*tuple]{
+		parent: t.parent,
+		end: func(v value.Value) {
+			t.parent.values = append(t.parent.values, v)
+		},
+	}
+}
@@ -8,4 +8,7 @@ const (
 	DefaultLimit         = 50
 	defaultCreateTimeout = 5 * time.Second
 	defaultCloseTimeout  = time.Second
+	drainCheckInterval   = 10 * time.Millisecond
+	minSizeCheckInterval = time.Second
+	maxLifetimeJitter    = 0.1
 )
@@ -0,0 +1,78 @@
+package pool
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// maintainMinSize pre-creates idle items up to the configured minimal size and then
+// periodically replaces expired or broken idle items until the pool is closed or drained
+func (p *Pool[PT, T]) maintainMinSize(ctx context.Context) {
+	ticker := p.config.clock.NewTicker(minSizeCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		p.evictStaleIdle(ctx)
+		p.fillMinSize(ctx)
+
+		select {
+		case <-p.done:
+			return
+		case <-p.draining:
+			return
+		case <-ticker.Chan():
+		}
+	}
+}
+
+// evictStaleIdle closes idle items which are not alive or expired
+func (p *Pool[PT, T]) evictStaleIdle(ctx context.Context) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for el := p.idle.Front(); el != nil; {
+		item := el.Value
+		el = el.Next()
+
+		if item.IsAlive() && !p.isExpired(p.index[item]) {
+			continue
+		}
+
+		p.removeIdle(item)
+		p.closeItem(ctx, item)
+		p.changeState(func() Stats {
+			delete(p.index, item)
+
+			return p.stats()
+		})
+	}
+}
+
+// fillMinSize creates items until the pool holds at least minimal size of items
+func (p *Pool[PT, T]) fillMinSize(ctx context.Context) {
+	for {
+		if !xsync.WithRLock(&p.mu, func() bool {
+			return len(p.index)+p.createInProgress < min(p.config.minSize, p.config.limit)
+		}) {
+			return
+		}
+
+		select {
+		case <-p.done:
+			return
+		case <-p.draining:
+			return
+		default:
+		}
+
+		item, err := p.createItem(ctx)
+		if err != nil {
+			return
+		}
+
+		if err = p.putItem(ctx, item); err != nil {
+			return
+		}
+	}
+}
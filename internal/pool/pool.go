@@ -4,14 +4,17 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jonboulle/clockwork"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xlist"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 )
@@ -21,12 +24,17 @@ type (
 		IsAlive() bool
 		Close(ctx context.Context) error
 	}
+	// nodeItem is an item bound to node of cluster (such as session)
+	nodeItem interface {
+		NodeID() uint32
+	}
 	ItemConstraint[T any] interface {
 		*T
 		Item
 	}
 	Config[PT ItemConstraint[T], T any] struct {
 		trace          *Trace
+		statsTrace     func(Stats)
 		clock          clockwork.Clock
 		limit          int
 		createTimeout  time.Duration
@@ -35,10 +43,13 @@ type (
 		closeItem      func(ctx context.Context, item PT)
 		idleTimeToLive time.Duration
 		itemUsageLimit uint64
+		maxLifetime    time.Duration
+		minSize        int
 	}
 	itemInfo[PT ItemConstraint[T], T any] struct {
 		idle       *xlist.Element[PT]
 		lastUsage  time.Time
+		expiresAt  time.Time
 		useCounter *uint64
 	}
 	waitChPool[PT ItemConstraint[T], T any] interface {
@@ -53,12 +64,18 @@ type (
 
 		mu               xsync.RWMutex
 		createInProgress int // KIKIMR-9163: in-create-process counter
+		created          atomic.Uint64
+		deleted          atomic.Uint64
 		index            map[PT]itemInfo[PT, T]
 		idle             xlist.List[PT]
 		waitQ            xlist.List[*chan PT]
 		waitChPool       waitChPool[PT, T]
 
-		done chan struct{}
+		rand xrand.Rand
+
+		done      chan struct{}
+		draining  chan struct{}
+		drainOnce sync.Once
 	}
 	Option[PT ItemConstraint[T], T any] func(c *Config[PT, T])
 )
@@ -101,18 +118,41 @@ func WithItemUsageLimit[PT ItemConstraint[T], T any](itemUsageLimit uint64) Opti
 	}
 }
 
+// WithItemMaxLifetime limits total lifetime of item. Actual lifetime of each item is
+// jittered down to maxLifetimeJitter part of maxLifetime, so items created at the same
+// moment are recycled gradually
+func WithItemMaxLifetime[PT ItemConstraint[T], T any](maxLifetime time.Duration) Option[PT, T] {
+	return func(c *Config[PT, T]) {
+		c.maxLifetime = maxLifetime
+	}
+}
+
 func WithTrace[PT ItemConstraint[T], T any](t *Trace) Option[PT, T] {
 	return func(c *Config[PT, T]) {
 		c.trace = t
 	}
 }
 
+// WithStatsTrace defines callback which receives stats of pool on every change of pool state
+func WithStatsTrace[PT ItemConstraint[T], T any](onChange func(Stats)) Option[PT, T] {
+	return func(c *Config[PT, T]) {
+		c.statsTrace = onChange
+	}
+}
+
 func WithIdleTimeToLive[PT ItemConstraint[T], T any](idleTTL time.Duration) Option[PT, T] {
 	return func(c *Config[PT, T]) {
 		c.idleTimeToLive = idleTTL
 	}
 }
 
+// WithMinSize sets the number of idle items which the pool pre-creates and keeps alive in background
+func WithMinSize[PT ItemConstraint[T], T any](size int) Option[PT, T] {
+	return func(c *Config[PT, T]) {
+		c.minSize = size
+	}
+}
+
 func WithClock[PT ItemConstraint[T], T any](clock clockwork.Clock) Option[PT, T] {
 	return func(c *Config[PT, T]) {
 		c.clock = clock
@@ -142,7 +182,9 @@ func New[PT ItemConstraint[T], T any](
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		rand:     xrand.New(xrand.WithLock()),
+		done:     make(chan struct{}),
+		draining: make(chan struct{}),
 	}
 
 	for _, opt := range opts {
@@ -163,15 +205,49 @@ func New[PT ItemConstraint[T], T any](
 	}
 
 	p.createItem = makeAsyncCreateItemFunc(p)
-	if p.config.closeItem != nil {
-		p.closeItem = p.config.closeItem
-	} else {
-		p.closeItem = makeAsyncCloseItemFunc[PT, T](p)
+	closeItem := p.config.closeItem
+	if closeItem == nil {
+		closeItem = makeAsyncCloseItemFunc[PT, T](p)
+	}
+	p.closeItem = func(ctx context.Context, item PT) {
+		p.deleted.Add(1)
+		closeItem(ctx, item)
+	}
+
+	if p.config.minSize > 0 {
+		go p.maintainMinSize(xcontext.ValueOnly(ctx))
 	}
 
 	return p
 }
 
+// expiresAt returns jittered moment of end of lifetime for item created at now
+func (p *Pool[PT, T]) expiresAt(now time.Time) time.Time {
+	if p.config.maxLifetime <= 0 {
+		return time.Time{}
+	}
+
+	lifetime := p.config.maxLifetime
+	if jitter := int64(float64(lifetime) * maxLifetimeJitter); jitter > 0 {
+		lifetime -= time.Duration(p.rand.Int64(jitter))
+	}
+
+	return now.Add(lifetime)
+}
+
+// isExpired checks item usage limit, idle time to live and max lifetime
+func (p *Pool[PT, T]) isExpired(info itemInfo[PT, T]) bool {
+	if p.config.itemUsageLimit > 0 && *info.useCounter > p.config.itemUsageLimit {
+		return true
+	}
+
+	if p.config.idleTimeToLive > 0 && p.config.clock.Since(info.lastUsage) > p.config.idleTimeToLive {
+		return true
+	}
+
+	return !info.expiresAt.IsZero() && !p.config.clock.Now().Before(info.expiresAt)
+}
+
 // defaultCreateItem returns a new item
 func defaultCreateItem[T any, PT ItemConstraint[T]](context.Context) (PT, error) {
 	var item T
@@ -224,10 +300,15 @@ func makeAsyncCreateItemFunc[PT ItemConstraint[T], T any]( //nolint:funlen
 
 			newItem, err := p.config.createItem(createCtx)
 			if newItem != nil {
+				p.created.Add(1)
 				p.mu.WithLock(func() {
-					var useCounter uint64
+					var (
+						useCounter uint64
+						now        = p.config.clock.Now()
+					)
 					p.index[newItem] = itemInfo[PT, T]{
-						lastUsage:  p.config.clock.Now(),
+						lastUsage:  now,
+						expiresAt:  p.expiresAt(now),
 						useCounter: &useCounter,
 					}
 				})
@@ -280,9 +361,40 @@ func (p *Pool[PT, T]) stats() Stats {
 		Idle:             p.idle.Len(),
 		Wait:             p.waitQ.Len(),
 		CreateInProgress: p.createInProgress,
+		Created:          p.created.Load(),
+		Deleted:          p.deleted.Load(),
 	}
 }
 
+// SetLimit changes upper bound of pooled items at runtime.
+// Excess items are closed on return to the pool, waiters try to create new items if the limit is increased.
+func (p *Pool[PT, T]) SetLimit(limit int) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	select {
+	case <-p.done:
+		return
+	default:
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changeState(func() Stats {
+		if limit > p.config.limit {
+			// wake up all waiters for retry of getting item with new limit
+			for el := p.waitQ.Front(); el != nil; el = p.waitQ.Front() {
+				close(*p.waitQ.Remove(el))
+			}
+		}
+		p.config.limit = limit
+
+		return p.stats()
+	})
+}
+
 func (p *Pool[PT, T]) Stats() Stats {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
@@ -309,7 +421,7 @@ func makeAsyncCloseItemFunc[PT ItemConstraint[T], T any](
 }
 
 func (p *Pool[PT, T]) changeState(changeState func() Stats) {
-	if stats, onChange := changeState(), p.config.trace.OnChange; onChange != nil {
+	if stats, onChange := changeState(), p.config.statsTrace; onChange != nil {
 		onChange(stats)
 	}
 }
@@ -329,6 +441,8 @@ func (p *Pool[PT, T]) try(ctx context.Context, f func(ctx context.Context, item
 	select {
 	case <-p.done:
 		return xerrors.WithStackTrace(errClosedPool)
+	case <-p.draining:
+		return xerrors.WithStackTrace(errDrainingPool)
 	case <-ctx.Done():
 		return xerrors.WithStackTrace(ctx.Err())
 	default:
@@ -389,6 +503,48 @@ func (p *Pool[PT, T]) With(
 	return nil
 }
 
+// WithResult calls f with item from pool and returns result of f. Unlike With, WithResult supports hedged
+// attempts (see retry.WithHedging) because each attempt returns own result instead of modifying shared state
+func (p *Pool[PT, T]) WithResult(
+	ctx context.Context,
+	f func(ctx context.Context, item PT) (any, error),
+	opts ...retry.Option,
+) (_ any, finalErr error) {
+	var attempts atomic.Int64
+
+	if onWith := p.config.trace.OnWith; onWith != nil {
+		onDone := onWith(&ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/pool.(*Pool).WithResult"),
+		)
+		if onDone != nil {
+			defer func() {
+				onDone(int(attempts.Load()), finalErr)
+			}()
+		}
+	}
+
+	v, err := retry.RetryWithResult(ctx, func(ctx context.Context) (v any, _ error) {
+		attempts.Add(1)
+		err := p.try(ctx, func(ctx context.Context, item PT) (err error) {
+			v, err = f(ctx, item)
+
+			return err
+		})
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return v, nil
+	}, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(
+			fmt.Errorf("pool.WithResult failed with %d attempts: %w", attempts.Load(), err),
+		)
+	}
+
+	return v, nil
+}
+
 func (p *Pool[PT, T]) Close(ctx context.Context) (finalErr error) {
 	if onClose := p.config.trace.OnClose; onClose != nil {
 		onDone := onClose(&ctx,
@@ -442,6 +598,34 @@ func (p *Pool[PT, T]) Close(ctx context.Context) (finalErr error) {
 	}
 }
 
+// Drain stops handing out items from the pool and waits until all items in use
+// returns to the pool or ctx done. Pool must be closed after Drain
+func (p *Pool[PT, T]) Drain(ctx context.Context) error {
+	p.drainOnce.Do(func() {
+		close(p.draining)
+	})
+
+	ticker := p.config.clock.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		inUse := xsync.WithRLock(&p.mu, func() int {
+			return len(p.index) - p.idle.Len()
+		})
+		if inUse == 0 {
+			return nil
+		}
+
+		select {
+		case <-p.done:
+			return nil
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(fmt.Errorf("pool has %d items in use: %w", inUse, ctx.Err()))
+		case <-ticker.Chan():
+		}
+	}
+}
+
 // getWaitCh returns pointer to a channel of sessions.
 //
 // Note that returning a pointer reduces allocations on sync.Pool usage –
@@ -488,6 +672,31 @@ func (p *Pool[PT, T]) removeFirstIdle() PT {
 	return idle
 }
 
+// removeFirstIdleOnUnusedNode removes first idle item which node isn't used by concurrent (hedged) attempts
+// of operation. If all idle items are on used nodes - returns nil while pool can create new item (on other node),
+// otherwise removes first idle item.
+// p.mu must be held.
+func (p *Pool[PT, T]) removeFirstIdleOnUnusedNode(usedNodes *endpoint.UsedNodes) PT {
+	if usedNodes == nil {
+		return p.removeFirstIdle()
+	}
+
+	for el := p.idle.Front(); el != nil; el = el.Next() {
+		if item, has := any(el.Value).(nodeItem); !has || !usedNodes.Has(item.NodeID()) {
+			idle := el.Value
+			p.removeIdle(idle)
+
+			return idle
+		}
+	}
+
+	if len(p.index)+p.createInProgress < p.config.limit {
+		return nil
+	}
+
+	return p.removeFirstIdle()
+}
+
 // p.mu must be held.
 func (p *Pool[PT, T]) notifyAboutIdle(idle PT) (notified bool) {
 	for el := p.waitQ.Front(); el != nil; el = p.waitQ.Front() {
@@ -569,11 +778,20 @@ const maxAttempts = 100
 
 func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { //nolint:funlen
 	var (
-		start   = p.config.clock.Now()
-		attempt int
-		lastErr error
+		start     = p.config.clock.Now()
+		attempt   int
+		lastErr   error
+		usedNodes = endpoint.ContextUsedNodes(ctx)
 	)
 
+	if usedNodes != nil {
+		defer func() {
+			if item, has := any(item).(nodeItem); has && finalErr == nil {
+				usedNodes.Add(item.NodeID())
+			}
+		}()
+	}
+
 	if onGet := p.config.trace.OnGet; onGet != nil {
 		onDone := onGet(&ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/pool.(*Pool).getItem"),
@@ -589,11 +807,13 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { /
 		select {
 		case <-p.done:
 			return nil, xerrors.WithStackTrace(errClosedPool)
+		case <-p.draining:
+			return nil, xerrors.WithStackTrace(errDrainingPool)
 		default:
 		}
 
 		if item := xsync.WithLock(&p.mu, func() PT { //nolint:nestif
-			return p.removeFirstIdle()
+			return p.removeFirstIdleOnUnusedNode(usedNodes)
 		}); item != nil {
 			if item.IsAlive() {
 				info := xsync.WithLock(&p.mu, func() itemInfo[PT, T] {
@@ -607,8 +827,7 @@ func (p *Pool[PT, T]) getItem(ctx context.Context) (item PT, finalErr error) { /
 					return info
 				})
 
-				if (p.config.itemUsageLimit > 0 && *info.useCounter > p.config.itemUsageLimit) ||
-					(p.config.idleTimeToLive > 0 && p.config.clock.Since(info.lastUsage) > p.config.idleTimeToLive) {
+				if p.isExpired(info) {
 					p.closeItem(ctx, item)
 					p.mu.WithLock(func() {
 						p.changeState(func() Stats {
@@ -704,6 +923,17 @@ func (p *Pool[PT, T]) waitFromCh(ctx context.Context) (item PT, finalErr error)
 
 		return nil, xerrors.WithStackTrace(errClosedPool)
 
+	case <-p.draining:
+		p.mu.WithLock(func() {
+			p.changeState(func() Stats {
+				p.waitQ.Remove(el)
+
+				return p.stats()
+			})
+		})
+
+		return nil, xerrors.WithStackTrace(errDrainingPool)
+
 	case item, ok := <-*ch:
 		// Note that race may occur and some goroutine may try to write
 		// session into channel after it was enqueued but before it being
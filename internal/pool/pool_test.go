@@ -20,6 +20,7 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -74,8 +75,6 @@ var defaultTrace = &Trace{
 		return func(item any, err error) {
 		}
 	},
-	OnChange: func(stats Stats) {
-	},
 }
 
 func (p *testWaitChPool) GetOrNew() *chan *testItem {
@@ -177,6 +176,25 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 			)
 			require.EqualValues(t, 1, p.config.limit)
 		})
+		t.Run("SetLimit", func(t *testing.T) {
+			p := New[*testItem, testItem](rootCtx, WithLimit[*testItem, testItem](1),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			defer mustClose(t, p)
+			first := mustGetItem(t, p)
+			got := make(chan *testItem, 1)
+			go func() {
+				got <- mustGetItem(t, p)
+			}()
+			p.SetLimit(2)
+			require.EqualValues(t, 2, p.Stats().Limit)
+			second := <-got
+			p.SetLimit(1)
+			mustPutItem(t, p, first)
+			require.ErrorIs(t, p.putItem(rootCtx, second), errPoolIsOverflow)
+			require.EqualValues(t, 1, p.Stats().Index)
+			require.EqualValues(t, 2, p.Stats().Created)
+		})
 		t.Run("WithItemUsageLimit", func(t *testing.T) {
 			var newCounter int64
 			p := New[*testItem, testItem](rootCtx,
@@ -222,6 +240,65 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 			require.NoError(t, err)
 			require.EqualValues(t, p.config.limit, atomic.LoadInt64(&newCounter))
 		})
+		t.Run("WithItemMaxLifetime", func(t *testing.T) {
+			clock := clockwork.NewFakeClock()
+			p := New(rootCtx,
+				WithLimit[*testItem, testItem](1),
+				WithItemMaxLifetime[*testItem, testItem](time.Minute),
+				WithClock[*testItem, testItem](clock),
+				WithSyncCloseItem[*testItem, testItem](),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			defer mustClose(t, p)
+			first := mustGetItem(t, p)
+			mustPutItem(t, p, first)
+			clock.Advance(time.Minute * 8 / 10)
+			require.Same(t, first, mustGetItem(t, p))
+			mustPutItem(t, p, first)
+			clock.Advance(time.Minute * 2 / 10)
+			second := mustGetItem(t, p)
+			require.NotSame(t, first, second)
+			require.NotZero(t, first.closed.Len())
+			require.EqualValues(t, 2, p.Stats().Created)
+			mustPutItem(t, p, second)
+		})
+		t.Run("WithMinSize", func(t *testing.T) {
+			var (
+				alive      atomic.Bool
+				newCounter int64
+				clock      = clockwork.NewFakeClock()
+			)
+			alive.Store(true)
+			p := New(rootCtx,
+				WithLimit[*testItem, testItem](5),
+				WithMinSize[*testItem, testItem](3),
+				WithClock[*testItem, testItem](clock),
+				WithSyncCloseItem[*testItem, testItem](),
+				WithCreateItemFunc(func(context.Context) (*testItem, error) {
+					if atomic.AddInt64(&newCounter, 1) > 3 {
+						return &testItem{}, nil
+					}
+
+					return &testItem{
+						onIsAlive: alive.Load,
+					}, nil
+				}),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			defer mustClose(t, p)
+			require.Eventually(t, func() bool {
+				return p.Stats().Idle == 3
+			}, time.Second, time.Millisecond)
+			require.EqualValues(t, 3, atomic.LoadInt64(&newCounter))
+
+			alive.Store(false)
+			clock.BlockUntil(1)
+			clock.Advance(minSizeCheckInterval)
+			require.Eventually(t, func() bool {
+				return atomic.LoadInt64(&newCounter) == 6 && p.Stats().Idle == 3
+			}, time.Second, time.Millisecond)
+			require.EqualValues(t, 3, p.Stats().Index)
+		})
 	})
 	t.Run("Close", func(t *testing.T) {
 		counter := 0
@@ -477,6 +554,42 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 			}, xtest.StopAfter(3*time.Second))
 		})
 	})
+	t.Run("Drain", func(t *testing.T) {
+		t.Run("WaitItemsInUse", func(t *testing.T) {
+			p := New[*testItem, testItem](rootCtx, WithLimit[*testItem, testItem](2),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			defer mustClose(t, p)
+			item := mustGetItem(t, p)
+			drained := make(chan error, 1)
+			go func() {
+				drained <- p.Drain(rootCtx)
+			}()
+			require.Eventually(t, func() bool {
+				return errors.Is(p.try(rootCtx, func(ctx context.Context, item *testItem) error {
+					return nil
+				}), errDrainingPool)
+			}, time.Second, time.Millisecond)
+			select {
+			case <-drained:
+				t.Fatal("drain done with item in use")
+			default:
+			}
+			mustPutItem(t, p, item)
+			require.NoError(t, <-drained)
+		})
+		t.Run("ContextDone", func(t *testing.T) {
+			p := New[*testItem, testItem](rootCtx, WithLimit[*testItem, testItem](1),
+				WithTrace[*testItem, testItem](defaultTrace),
+			)
+			defer mustClose(t, p)
+			item := mustGetItem(t, p)
+			ctx, cancel := context.WithTimeout(rootCtx, 10*time.Millisecond)
+			defer cancel()
+			require.ErrorIs(t, p.Drain(ctx), context.DeadlineExceeded)
+			mustPutItem(t, p, item)
+		})
+	})
 	t.Run("Retry", func(t *testing.T) {
 		t.Run("CreateItem", func(t *testing.T) {
 			t.Run("context", func(t *testing.T) {
@@ -839,13 +952,11 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 		})
 		t.Run("Racy", func(t *testing.T) {
 			xtest.TestManyTimes(t, func(t testing.TB) {
-				trace := &Trace{
-					OnChange: func(stats Stats) {
-						require.GreaterOrEqual(t, stats.Limit, stats.Idle)
-					},
+				onChange := func(stats Stats) {
+					require.GreaterOrEqual(t, stats.Limit, stats.Idle)
 				}
 				p := New[*testItem, testItem](rootCtx,
-					WithTrace[*testItem, testItem](trace),
+					WithStatsTrace[*testItem, testItem](onChange),
 					// replace default async closer for sync testing
 					WithSyncCloseItem[*testItem, testItem](),
 				)
@@ -879,16 +990,14 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 		})
 		t.Run("ParallelCreation", func(t *testing.T) {
 			xtest.TestManyTimes(t, func(t testing.TB) {
-				trace := &Trace{
-					OnChange: func(stats Stats) {
-						require.Equal(t, DefaultLimit, stats.Limit)
-						require.LessOrEqual(t, stats.Idle, DefaultLimit)
-					},
+				onChange := func(stats Stats) {
+					require.Equal(t, DefaultLimit, stats.Limit)
+					require.LessOrEqual(t, stats.Idle, DefaultLimit)
 				}
 				p := New[*testItem, testItem](rootCtx,
 					WithCreateItemTimeout[*testItem, testItem](50*time.Millisecond),
 					WithCloseItemTimeout[*testItem, testItem](50*time.Millisecond),
-					WithTrace[*testItem, testItem](trace),
+					WithStatsTrace[*testItem, testItem](onChange),
 				)
 				var wg sync.WaitGroup
 				for range make([]struct{}, DefaultLimit*10) {
@@ -943,3 +1052,87 @@ func TestPool(t *testing.T) { //nolint:gocyclo
 		})
 	})
 }
+
+type testNodeItem struct {
+	nodeID uint32
+}
+
+func (t *testNodeItem) IsAlive() bool {
+	return true
+}
+
+func (t *testNodeItem) Close(context.Context) error {
+	return nil
+}
+
+func (t *testNodeItem) NodeID() uint32 {
+	return t.nodeID
+}
+
+func TestPoolUsedNodes(t *testing.T) {
+	newPool := func(t *testing.T, limit int, nodeIDs ...uint32) *Pool[*testNodeItem, testNodeItem] {
+		var created atomic.Uint32
+		p := New[*testNodeItem, testNodeItem](xtest.Context(t),
+			WithLimit[*testNodeItem, testNodeItem](limit),
+			WithCreateItemFunc(func(ctx context.Context) (*testNodeItem, error) {
+				return &testNodeItem{nodeID: 100 + created.Add(1)}, nil
+			}),
+		)
+		for _, nodeID := range nodeIDs {
+			item := &testNodeItem{nodeID: nodeID}
+			p.mu.WithLock(func() {
+				var useCounter uint64
+				p.index[item] = itemInfo[*testNodeItem, testNodeItem]{useCounter: &useCounter}
+				p.pushIdle(item, p.config.clock.Now())
+			})
+		}
+
+		return p
+	}
+	t.Run("IdleOnUnusedNode", func(t *testing.T) {
+		p := newPool(t, 10, 1, 2)
+		usedNodes := endpoint.NewUsedNodes()
+		usedNodes.Add(1)
+		item, err := p.getItem(endpoint.WithUsedNodes(context.Background(), usedNodes))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, item.NodeID())
+		require.True(t, usedNodes.Has(2))
+	})
+	t.Run("CreateOnAllNodesUsed", func(t *testing.T) {
+		p := newPool(t, 10, 1)
+		usedNodes := endpoint.NewUsedNodes()
+		usedNodes.Add(1)
+		item, err := p.getItem(endpoint.WithUsedNodes(context.Background(), usedNodes))
+		require.NoError(t, err)
+		require.EqualValues(t, 101, item.NodeID())
+		require.True(t, usedNodes.Has(101))
+	})
+	t.Run("IdleOnUsedNodeIfLimitReached", func(t *testing.T) {
+		p := newPool(t, 1, 1)
+		usedNodes := endpoint.NewUsedNodes()
+		usedNodes.Add(1)
+		item, err := p.getItem(endpoint.WithUsedNodes(context.Background(), usedNodes))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, item.NodeID())
+	})
+	t.Run("HedgedWithResult", func(t *testing.T) {
+		p := newPool(t, 10, 1, 2)
+		var (
+			attempts atomic.Int32
+			first    atomic.Uint32
+		)
+		v, err := p.WithResult(xtest.Context(t), func(ctx context.Context, item *testNodeItem) (any, error) {
+			if attempts.Add(1) == 1 {
+				first.Store(item.NodeID())
+				<-ctx.Done()
+
+				return nil, ctx.Err()
+			}
+
+			return item.NodeID(), nil
+		}, retry.WithIdempotent(true), retry.WithHedging(time.Millisecond, 2))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, attempts.Load())
+		require.NotEqual(t, first.Load(), v)
+	})
+}
@@ -6,4 +6,6 @@ type Stats struct {
 	Idle             int
 	Wait             int
 	CreateInProgress int
+	Created          uint64
+	Deleted          uint64
 }
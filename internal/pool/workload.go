@@ -0,0 +1,30 @@
+package pool
+
+import "context"
+
+type ctxWorkloadKey struct{}
+
+// WithWorkload returns a copy of parent context with workload label.
+// Workload label selects named sub-pool of sessions
+func WithWorkload(ctx context.Context, workload string) context.Context {
+	return context.WithValue(ctx, ctxWorkloadKey{}, workload)
+}
+
+// Workload returns workload label from context
+func Workload(ctx context.Context) (workload string, has bool) {
+	workload, has = ctx.Value(ctxWorkloadKey{}).(string)
+
+	return workload, has
+}
+
+// Select returns sub-pool for workload label from context or defaultPool
+// if context has no workload label or sub-pool with workload label is not defined
+func Select[T any](ctx context.Context, defaultPool T, subPools map[string]T) T {
+	if workload, has := Workload(ctx); has {
+		if p, has := subPools[workload]; has {
+			return p
+		}
+	}
+
+	return defaultPool
+}
@@ -0,0 +1,39 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelect(t *testing.T) {
+	subPools := map[string]string{
+		"batch": "batch pool",
+	}
+	for _, tt := range []struct {
+		name string
+		ctx  context.Context //nolint:containedctx
+		exp  string
+	}{
+		{
+			name: "NoWorkload",
+			ctx:  context.Background(),
+			exp:  "default pool",
+		},
+		{
+			name: "KnownWorkload",
+			ctx:  WithWorkload(context.Background(), "batch"),
+			exp:  "batch pool",
+		},
+		{
+			name: "UnknownWorkload",
+			ctx:  WithWorkload(context.Background(), "interactive"),
+			exp:  "default pool",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, Select(tt.ctx, "default pool", subPools))
+		})
+	}
+}
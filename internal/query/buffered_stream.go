@@ -0,0 +1,105 @@
+package query
+
+import (
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+type (
+	// bufferedStream reads response parts from stream in background ahead of consumer.
+	// Count of read ahead parts is limited with buffer size and count of rows in read ahead parts
+	// is limited with maxRows (if positive), so stream is not read from gRPC connection
+	// (and server stops sending by flow control) while buffer is full
+	bufferedStream struct {
+		Ydb_Query_V1.QueryService_ExecuteQueryClient
+
+		parts chan bufferedPart
+
+		maxRows  int
+		mu       xsync.Mutex
+		rows     int           // count of rows in buffered parts
+		consumed chan struct{} // signals about reading of part by consumer
+	}
+	bufferedPart struct {
+		part *Ydb_Query.ExecuteQueryResponsePart
+		err  error
+	}
+)
+
+func newBufferedStream(
+	stream Ydb_Query_V1.QueryService_ExecuteQueryClient, size, maxRows int, done <-chan struct{},
+) *bufferedStream {
+	if size <= 0 {
+		size = maxRows
+	}
+
+	s := &bufferedStream{
+		QueryService_ExecuteQueryClient: stream,
+		parts:                           make(chan bufferedPart, size),
+		maxRows:                         maxRows,
+		consumed:                        make(chan struct{}, 1),
+	}
+
+	go func() {
+		defer close(s.parts)
+
+		for {
+			if !s.waitFreeRows(done) {
+				return
+			}
+			part, err := stream.Recv()
+			s.mu.WithLock(func() {
+				s.rows += len(part.GetResultSet().GetRows())
+			})
+			select {
+			case <-done:
+				return
+			case s.parts <- bufferedPart{part: part, err: err}:
+				if err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return s
+}
+
+// waitFreeRows waits until count of rows in buffered parts becomes less than maxRows.
+// Returns false if stream done
+func (s *bufferedStream) waitFreeRows(done <-chan struct{}) bool {
+	for {
+		if s.maxRows <= 0 || xsync.WithLock(&s.mu, func() bool {
+			return s.rows < s.maxRows
+		}) {
+			return true
+		}
+		select {
+		case <-done:
+			return false
+		case <-s.consumed:
+		}
+	}
+}
+
+func (s *bufferedStream) Recv() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+	p, has := <-s.parts
+	if !has {
+		return nil, xerrors.WithStackTrace(io.EOF)
+	}
+
+	s.mu.WithLock(func() {
+		s.rows -= len(p.part.GetResultSet().GetRows())
+	})
+	select {
+	case s.consumed <- struct{}{}:
+	default:
+	}
+
+	return p.part, p.err
+}
@@ -0,0 +1,79 @@
+package query
+
+import (
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestBufferedStream(t *testing.T) {
+	t.Run("ReadAll", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{ResultSetIndex: 0}, nil)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{ResultSetIndex: 1}, nil)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{ResultSetIndex: 2}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+		s := newBufferedStream(stream, 2, 0, make(chan struct{}))
+		for i := int64(0); i < 3; i++ {
+			part, err := s.Recv()
+			require.NoError(t, err)
+			require.Equal(t, i, part.GetResultSetIndex())
+		}
+		_, err := s.Recv()
+		require.ErrorIs(t, err, io.EOF)
+		_, err = s.Recv()
+		require.ErrorIs(t, err, io.EOF)
+	})
+	t.Run("Closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{}, nil).AnyTimes()
+		done := make(chan struct{})
+		s := newBufferedStream(stream, 1, 0, done)
+		_, err := s.Recv()
+		require.NoError(t, err)
+		close(done)
+		for {
+			if _, err = s.Recv(); err != nil {
+				break
+			}
+		}
+		require.True(t, xerrors.Is(err, io.EOF))
+	})
+	t.Run("Rows", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		var received atomic.Int64
+		stream.EXPECT().Recv().DoAndReturn(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			received.Add(1)
+
+			return &Ydb_Query.ExecuteQueryResponsePart{
+				ResultSet: &Ydb.ResultSet{Rows: []*Ydb.Value{{}, {}}},
+			}, nil
+		}).AnyTimes()
+		done := make(chan struct{})
+		defer close(done)
+		s := newBufferedStream(stream, 0, 3, done)
+		// second part exceeds limit of rows, so reading ahead stops
+		require.Eventually(t, func() bool {
+			return received.Load() == 2
+		}, time.Second, time.Millisecond)
+		require.Never(t, func() bool {
+			return received.Load() > 2
+		}, 50*time.Millisecond, time.Millisecond)
+		_, err := s.Recv()
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			return received.Load() == 3
+		}, time.Second, time.Millisecond)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	operationClient "github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -39,12 +40,21 @@ type (
 		closer.Closer
 
 		Stats() pool.Stats
+		SetLimit(limit int)
+		Drain(ctx context.Context) error
 		With(ctx context.Context, f func(ctx context.Context, s *Session) error, opts ...retry.Option) error
+		WithResult(
+			ctx context.Context, f func(ctx context.Context, s *Session) (any, error), opts ...retry.Option,
+		) (any, error)
 	}
 	Client struct {
-		config *config.Config
-		client Ydb_Query_V1.QueryServiceClient
-		pool   sessionPool
+		config     *config.Config
+		client     Ydb_Query_V1.QueryServiceClient
+		operations *operationClient.Client
+		pool       sessionPool
+
+		// subPools are named sub-pools of sessions which selects by workload label from context
+		subPools map[string]sessionPool
 
 		done chan struct{}
 	}
@@ -100,6 +110,9 @@ func fetchScriptResults(ctx context.Context,
 func (c *Client) FetchScriptResults(ctx context.Context,
 	opID string, opts ...options.FetchScriptOption,
 ) (*options.FetchScriptResult, error) {
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
 	r, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*options.FetchScriptResult, error) {
 		r, err := fetchScriptResults(ctx, c.client, opID,
 			append(opts, func(request *options.FetchScriptResultsRequest) {
@@ -160,6 +173,9 @@ func (c *Client) ExecuteScript(
 ) (
 	op *options.ExecuteScriptOperation, err error,
 ) {
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
 	a := allocator.New()
 	defer a.Free()
 
@@ -184,11 +200,77 @@ func (c *Client) ExecuteScript(
 	return op, nil
 }
 
+// withDefaultTimeout limits ctx with default timeout of client if ctx has no deadline.
+// Timeout applies before detaching of query streams from ctx (see execute), so deadline of caller
+// always takes precedence over default timeout
+func (c *Client) withDefaultTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout := c.config.DefaultTimeout(); timeout > 0 {
+		if _, has := ctx.Deadline(); !has {
+			return xcontext.WithTimeout(ctx, timeout)
+		}
+	}
+
+	return ctx, func() {}
+}
+
+// sessionPool returns sub-pool of sessions for workload label from context or default pool
+func (c *Client) sessionPool(ctx context.Context) sessionPool {
+	return pool.Select(ctx, c.pool, c.subPools)
+}
+
+// Stats returns current state of default sessions pool
+func (c *Client) Stats() query.PoolStats {
+	stats := c.pool.Stats()
+
+	return query.PoolStats{
+		Limit:            stats.Limit,
+		Index:            stats.Index,
+		Idle:             stats.Idle,
+		InUse:            stats.Index - stats.Idle,
+		Wait:             stats.Wait,
+		CreateInProgress: stats.CreateInProgress,
+		Created:          stats.Created,
+		Deleted:          stats.Deleted,
+	}
+}
+
+// SetPoolLimit changes upper bound of sessions in default pool at runtime
+func (c *Client) SetPoolLimit(limit int) {
+	c.pool.SetLimit(limit)
+}
+
+// Drain stops handing out sessions from pools and waits for complete of operations with sessions in use
+func (c *Client) Drain(ctx context.Context) error {
+	var errs []error
+	if err := c.pool.Drain(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range c.subPools {
+		if err := p.Drain(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
+
 func (c *Client) Close(ctx context.Context) error {
 	close(c.done)
 
+	var errs []error
 	if err := c.pool.Close(ctx); err != nil {
-		return xerrors.WithStackTrace(err)
+		errs = append(errs, err)
+	}
+	for _, p := range c.subPools {
+		if err := p.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
 	}
 
 	return nil
@@ -221,10 +303,44 @@ func do(
 	return nil
 }
 
+// doWithResult executes op with session from pool and returns result of op. Unlike do, op may be called
+// concurrently in hedged attempts (see retry.WithHedging), so op must return own result of attempt
+func doWithResult[T any](
+	ctx context.Context,
+	pool sessionPool,
+	op func(ctx context.Context, s *Session) (T, error),
+	opts ...retry.Option,
+) (zeroValue T, finalErr error) {
+	v, err := pool.WithResult(ctx, func(ctx context.Context, s *Session) (any, error) {
+		s.SetStatus(session.StatusInUse)
+
+		v, err := op(ctx, s)
+		if err != nil {
+			s.SetStatus(session.StatusError)
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		s.SetStatus(session.StatusIdle)
+
+		return v, nil
+	}, opts...)
+	if err != nil {
+		return zeroValue, xerrors.WithStackTrace(err)
+	}
+
+	r, _ := v.(T)
+
+	return r, nil
+}
+
 func (c *Client) Do(ctx context.Context, op query.Operation, opts ...options.DoOption) (finalErr error) {
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
 	var (
 		settings = options.ParseDoOpts(c.config.Trace(), opts...)
 		onDone   = trace.QueryOnDo(settings.Trace(), &ctx,
@@ -236,11 +352,14 @@ func (c *Client) Do(ctx context.Context, op query.Operation, opts ...options.DoO
 		onDone(attempts, finalErr)
 	}()
 
-	err := do(ctx, c.pool,
+	err := do(ctx, c.sessionPool(ctx),
 		func(ctx context.Context, s *Session) error {
-			return op(ctx, s)
+			return xerrors.WithRecover(c.config.PanicCallback(), func() error {
+				return op(ctx, s)
+			})
 		},
 		append([]retry.Option{
+			retry.WithBudget(c.config.RetryBudget()),
 			retry.WithTrace(&trace.Retry{
 				OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
 					return func(info trace.RetryLoopDoneInfo) {
@@ -297,13 +416,13 @@ func doTx(
 func clientQueryRow(
 	ctx context.Context, pool sessionPool, q string, settings executeSettings, resultOpts ...resultOption,
 ) (row query.Row, finalErr error) {
-	err := do(ctx, pool, func(ctx context.Context, s *Session) (err error) {
-		row, err = s.queryRow(ctx, q, settings, resultOpts...)
+	row, err := doWithResult(ctx, pool, func(ctx context.Context, s *Session) (query.Row, error) {
+		row, err := s.queryRow(ctx, q, settings, resultOpts...)
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 
-		return nil
+		return row, nil
 	}, settings.RetryOpts()...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -317,6 +436,12 @@ func (c *Client) QueryRow(ctx context.Context, q string, opts ...options.Execute
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	ctx, cancelProfiles, opts := withTableProfiles(ctx, c.config.TableProfiles(), q, opts)
+	defer cancelProfiles()
+
 	onDone := trace.QueryOnQueryRow(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).QueryRow"),
 		q,
@@ -325,7 +450,7 @@ func (c *Client) QueryRow(ctx context.Context, q string, opts ...options.Execute
 		onDone(finalErr)
 	}()
 
-	row, err := clientQueryRow(ctx, c.pool, q, options.ExecuteSettings(opts...), withTrace(c.config.Trace()))
+	row, err := clientQueryRow(ctx, c.sessionPool(ctx), q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -333,10 +458,36 @@ func (c *Client) QueryRow(ctx context.Context, q string, opts ...options.Execute
 	return row, nil
 }
 
+// CancelOperation cancels long executing operation by operation ID
+func (c *Client) CancelOperation(ctx context.Context, opID string) error {
+	ctx, cancel := xcontext.WithDone(ctx, c.done)
+	defer cancel()
+
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	err := c.operations.Cancel(ctx, opID)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Explain returns parsed explain plan of query without execution
+func (c *Client) Explain(ctx context.Context, q string, opts ...options.Execute) (*query.Plan, error) {
+	plan, err := query.Explain(ctx, c, q, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return plan, nil
+}
+
 func clientExec(ctx context.Context, pool sessionPool, q string, opts ...options.Execute) (finalErr error) {
 	settings := options.ExecuteSettings(opts...)
 	err := do(ctx, pool, func(ctx context.Context, s *Session) (err error) {
-		streamResult, err := execute(ctx, s.ID(), s.client, q, settings, withTrace(s.trace))
+		streamResult, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, settings)
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
@@ -359,6 +510,12 @@ func (c *Client) Exec(ctx context.Context, q string, opts ...options.Execute) (f
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	ctx, cancelProfiles, opts := withTableProfiles(ctx, c.config.TableProfiles(), q, opts)
+	defer cancelProfiles()
+
 	onDone := trace.QueryOnExec(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).Exec"),
 		q,
@@ -367,7 +524,7 @@ func (c *Client) Exec(ctx context.Context, q string, opts ...options.Execute) (f
 		onDone(finalErr)
 	}()
 
-	err := clientExec(ctx, c.pool, q, opts...)
+	err := clientExec(ctx, c.sessionPool(ctx), q, opts...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -379,27 +536,25 @@ func clientQuery(ctx context.Context, pool sessionPool, q string, opts ...option
 	r query.Result, err error,
 ) {
 	settings := options.ExecuteSettings(opts...)
-	err = do(ctx, pool, func(ctx context.Context, s *Session) (err error) {
-		streamResult, err := execute(ctx, s.ID(), s.client, q,
-			options.ExecuteSettings(opts...), withTrace(s.trace),
-		)
+	r, err = doWithResult(ctx, pool, func(ctx context.Context, s *Session) (query.Result, error) {
+		streamResult, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, options.ExecuteSettings(opts...))
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 		defer func() {
 			_ = streamResult.Close(ctx)
 		}()
 
-		r, err = resultToMaterializedResult(ctx, streamResult)
+		r, err := resultToMaterializedResult(ctx, streamResult)
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 
-		return nil
+		return r, nil
 	}, settings.RetryOpts()...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -412,6 +567,12 @@ func (c *Client) Query(ctx context.Context, q string, opts ...options.Execute) (
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	ctx, cancelProfiles, opts := withTableProfiles(ctx, c.config.TableProfiles(), q, opts)
+	defer cancelProfiles()
+
 	onDone := trace.QueryOnQuery(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).Query"),
 		q,
@@ -420,7 +581,7 @@ func (c *Client) Query(ctx context.Context, q string, opts ...options.Execute) (
 		onDone(err)
 	}()
 
-	r, err = clientQuery(ctx, c.pool, q, opts...)
+	r, err = clientQuery(ctx, c.sessionPool(ctx), q, opts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -428,21 +589,92 @@ func (c *Client) Query(ctx context.Context, q string, opts ...options.Execute) (
 	return r, nil
 }
 
+func clientExecBatch(ctx context.Context, pool sessionPool, statements []query.Statement) (
+	[]query.BatchResult, error,
+) {
+	var (
+		results  = make([]query.BatchResult, len(statements))
+		executed = make([]bool, len(statements))
+	)
+	err := do(ctx, pool, func(ctx context.Context, s *Session) error {
+		for i := range statements {
+			if executed[i] {
+				continue
+			}
+
+			r, err := clientExecBatchStatement(ctx, s, statements[i])
+			if err != nil && !xerrors.IsRetryObjectValid(err) {
+				// session is broken, rest of statements will be executed on other session
+				return xerrors.WithStackTrace(err)
+			}
+			results[i] = query.BatchResult{Result: r, Err: err}
+			executed[i] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		for i := range results {
+			if !executed[i] {
+				results[i].Err = xerrors.WithStackTrace(err)
+			}
+		}
+
+		return results, xerrors.WithStackTrace(err)
+	}
+
+	return results, nil
+}
+
+func clientExecBatchStatement(ctx context.Context, s *Session, statement query.Statement) (query.Result, error) {
+	streamResult, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, statement.Query,
+		options.ExecuteSettings(statement.Options...),
+	)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = streamResult.Close(ctx)
+	}()
+
+	r, err := resultToMaterializedResult(ctx, streamResult)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r, nil
+}
+
+func (c *Client) ExecBatch(ctx context.Context, statements []query.Statement) ([]query.BatchResult, error) {
+	ctx, cancel := xcontext.WithDone(ctx, c.done)
+	defer cancel()
+
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	results, err := clientExecBatch(ctx, c.sessionPool(ctx), statements)
+	if err != nil {
+		return results, xerrors.WithStackTrace(err)
+	}
+
+	return results, nil
+}
+
 func clientQueryResultSet(
 	ctx context.Context, pool sessionPool, q string, settings executeSettings, resultOpts ...resultOption,
 ) (rs result.ClosableResultSet, finalErr error) {
-	err := do(ctx, pool, func(ctx context.Context, s *Session) error {
-		streamResult, err := execute(ctx, s.ID(), s.client, q, settings, resultOpts...)
+	rs, err := doWithResult(ctx, pool, func(ctx context.Context, s *Session) (result.ClosableResultSet, error) {
+		streamResult, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, settings, resultOpts...)
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 
-		rs, err = readMaterializedResultSet(ctx, streamResult)
+		rs, err := readMaterializedResultSet(ctx, streamResult)
 		if err != nil {
-			return xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(err)
 		}
 
-		return nil
+		return rs, nil
 	}, settings.RetryOpts()...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -458,6 +690,12 @@ func (c *Client) QueryResultSet(
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
+	ctx, cancelProfiles, opts := withTableProfiles(ctx, c.config.TableProfiles(), q, opts)
+	defer cancelProfiles()
+
 	onDone := trace.QueryOnQueryResultSet(c.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*Client).QueryResultSet"),
 		q,
@@ -466,7 +704,7 @@ func (c *Client) QueryResultSet(
 		onDone(finalErr)
 	}()
 
-	rs, err := clientQueryResultSet(ctx, c.pool, q, options.ExecuteSettings(opts...), withTrace(c.config.Trace()))
+	rs, err := clientQueryResultSet(ctx, c.sessionPool(ctx), q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -478,6 +716,9 @@ func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options
 	ctx, cancel := xcontext.WithDone(ctx, c.done)
 	defer cancel()
 
+	ctx, cancelTimeout := c.withDefaultTimeout(ctx)
+	defer cancelTimeout()
+
 	var (
 		settings = options.ParseDoTxOpts(c.config.Trace(), opts...)
 		onDone   = trace.QueryOnDoTx(settings.Trace(), &ctx,
@@ -489,10 +730,16 @@ func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...options
 		onDone(attempts, finalErr)
 	}()
 
-	err := doTx(ctx, c.pool, op,
+	err := doTx(ctx, c.sessionPool(ctx),
+		func(ctx context.Context, tx query.TxActor) error {
+			return xerrors.WithRecover(c.config.PanicCallback(), func() error {
+				return op(ctx, tx)
+			})
+		},
 		settings.TxSettings(),
 		append(
 			[]retry.Option{
+				retry.WithBudget(c.config.RetryBudget()),
 				retry.WithTrace(&trace.Retry{
 					OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
 						return func(info trace.RetryLoopDoneInfo) {
@@ -517,46 +764,72 @@ func New(ctx context.Context, cc grpc.ClientConnInterface, cfg *config.Config) *
 	)
 	defer onDone()
 
-	client := Ydb_Query_V1.NewQueryServiceClient(cc)
-
-	return &Client{
-		config: cfg,
-		client: client,
-		done:   make(chan struct{}),
-		pool: pool.New(ctx,
-			pool.WithLimit[*Session, Session](cfg.PoolLimit()),
-			pool.WithItemUsageLimit[*Session, Session](cfg.PoolSessionUsageLimit()),
-			pool.WithTrace[*Session, Session](poolTrace(cfg.Trace())),
-			pool.WithCreateItemTimeout[*Session, Session](cfg.SessionCreateTimeout()),
-			pool.WithCloseItemTimeout[*Session, Session](cfg.SessionDeleteTimeout()),
-			pool.WithIdleTimeToLive[*Session, Session](cfg.SessionIdleTimeToLive()),
-			pool.WithCreateItemFunc(func(ctx context.Context) (_ *Session, err error) {
-				var (
-					createCtx    context.Context
-					cancelCreate context.CancelFunc
-				)
-				if d := cfg.SessionCreateTimeout(); d > 0 {
-					createCtx, cancelCreate = xcontext.WithTimeout(ctx, d)
-				} else {
-					createCtx, cancelCreate = xcontext.WithCancel(ctx)
-				}
-				defer cancelCreate()
-
-				s, err := createSession(createCtx, client,
-					session.WithConn(cc),
-					session.WithDeleteTimeout(cfg.SessionDeleteTimeout()),
-					session.WithTrace(cfg.Trace()),
+	client := withInterceptors(Ydb_Query_V1.NewQueryServiceClient(cc), cfg.Interceptors())
+
+	c := &Client{
+		config:     cfg,
+		client:     client,
+		operations: operationClient.New(ctx, cc),
+		done:       make(chan struct{}),
+		pool: newPool(ctx, cc, client, cfg, cfg.PoolLimit(), cfg.PoolMinSize(),
+			pool.WithStatsTrace[*Session, Session](func(stats pool.Stats) {
+				trace.QueryOnPoolChange(cfg.Trace(),
+					stats.Limit, stats.Index, stats.Idle, stats.Wait, stats.CreateInProgress,
 				)
-				if err != nil {
-					return nil, xerrors.WithStackTrace(err)
-				}
-
-				s.laztTx = cfg.LazyTx()
-
-				return s, nil
 			}),
 		),
 	}
+
+	if subPools := cfg.SubPools(); len(subPools) > 0 {
+		c.subPools = make(map[string]sessionPool, len(subPools))
+		for name, limit := range subPools {
+			c.subPools[name] = newPool(ctx, cc, client, cfg, limit, 0)
+		}
+	}
+
+	return c
+}
+
+func newPool(
+	ctx context.Context, cc grpc.ClientConnInterface, client Ydb_Query_V1.QueryServiceClient,
+	cfg *config.Config, limit, minSize int, opts ...pool.Option[*Session, Session],
+) *pool.Pool[*Session, Session] {
+	return pool.New(ctx, append([]pool.Option[*Session, Session]{
+		pool.WithLimit[*Session, Session](limit),
+		pool.WithMinSize[*Session, Session](minSize),
+		pool.WithItemUsageLimit[*Session, Session](cfg.PoolSessionUsageLimit()),
+		pool.WithTrace[*Session, Session](poolTrace(cfg.Trace())),
+		pool.WithCreateItemTimeout[*Session, Session](cfg.SessionCreateTimeout()),
+		pool.WithCloseItemTimeout[*Session, Session](cfg.SessionDeleteTimeout()),
+		pool.WithIdleTimeToLive[*Session, Session](cfg.SessionIdleTimeToLive()),
+		pool.WithItemMaxLifetime[*Session, Session](cfg.SessionMaxLifetime()),
+		pool.WithCreateItemFunc(func(ctx context.Context) (_ *Session, err error) {
+			var (
+				createCtx    context.Context
+				cancelCreate context.CancelFunc
+			)
+			if d := cfg.SessionCreateTimeout(); d > 0 {
+				createCtx, cancelCreate = xcontext.WithTimeout(ctx, d)
+			} else {
+				createCtx, cancelCreate = xcontext.WithCancel(ctx)
+			}
+			defer cancelCreate()
+
+			s, err := createSession(createCtx, client,
+				session.WithConn(cc),
+				session.WithDeleteTimeout(cfg.SessionDeleteTimeout()),
+				session.WithTrace(cfg.Trace()),
+			)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			s.laztTx = cfg.LazyTx()
+			s.inFlight = newInFlightLimiter(cfg.SessionMaxInFlight())
+
+			return s, nil
+		}),
+	}, opts...)...)
 }
 
 func poolTrace(t *trace.Query) *pool.Trace {
@@ -603,8 +876,5 @@ func poolTrace(t *trace.Query) *pool.Trace {
 				onDone(item.(*Session), attempts, err) //nolint:forcetypeassert
 			}
 		},
-		OnChange: func(stats pool.Stats) {
-			trace.QueryOnPoolChange(t, stats.Limit, stats.Index, stats.Idle, stats.Wait, stats.CreateInProgress)
-		},
 	}
 }
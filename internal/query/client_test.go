@@ -6,6 +6,7 @@ import (
 	"io"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
@@ -20,6 +21,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/session"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
@@ -172,6 +174,80 @@ func TestClient(t *testing.T) {
 			require.Equal(t, 10, counter)
 		})
 	})
+	t.Run("SubPool", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			pool: testPool(ctx, func(ctx context.Context) (*Session, error) {
+				return newTestSession("default"), nil
+			}),
+			subPools: map[string]sessionPool{
+				"batch": testPool(ctx, func(ctx context.Context) (*Session, error) {
+					return newTestSession("batch"), nil
+				}),
+			},
+			done: make(chan struct{}),
+		}
+		for workloadCtx, exp := range map[context.Context]string{
+			ctx:                             "default",
+			pool.WithWorkload(ctx, "batch"): "batch",
+			pool.WithWorkload(ctx, "other"): "default",
+		} {
+			err := c.Do(workloadCtx, func(ctx context.Context, s query.Session) error {
+				require.Equal(t, exp, s.ID())
+
+				return nil
+			})
+			require.NoError(t, err)
+		}
+	})
+	t.Run("SubPoolStateTrace", func(t *testing.T) {
+		var limits []int
+		c := New(ctx, nil, config.New(
+			config.WithTrace(&trace.Query{
+				OnPoolChange: func(info trace.QueryPoolChange) {
+					limits = append(limits, info.Limit)
+				},
+			}),
+			config.WithSubPool("batch", 1),
+		))
+		defer func() {
+			_ = c.Close(ctx)
+		}()
+		c.pool.(*pool.Pool[*Session, Session]).SetLimit(2)              //nolint:forcetypeassert
+		c.subPools["batch"].(*pool.Pool[*Session, Session]).SetLimit(3) //nolint:forcetypeassert
+		require.Equal(t, []int{2}, limits)
+	})
+	t.Run("DefaultTimeout", func(t *testing.T) {
+		c := &Client{
+			config: config.New(config.WithDefaultTimeout(time.Minute)),
+			pool: testPool(ctx, func(ctx context.Context) (*Session, error) {
+				return newTestSession("default"), nil
+			}),
+			done: make(chan struct{}),
+		}
+		t.Run("WithoutDeadline", func(t *testing.T) {
+			err := c.Do(ctx, func(ctx context.Context, s query.Session) error {
+				deadline, has := ctx.Deadline()
+				require.True(t, has)
+				require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+				return nil
+			})
+			require.NoError(t, err)
+		})
+		t.Run("CallerDeadlineLongerThanDefault", func(t *testing.T) {
+			callerCtx, cancel := context.WithTimeout(ctx, time.Hour)
+			defer cancel()
+			err := c.Do(callerCtx, func(ctx context.Context, s query.Session) error {
+				deadline, has := ctx.Deadline()
+				require.True(t, has)
+				require.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Second)
+
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	})
 	t.Run("DoTx", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
 			t.Run("LazyTx", func(t *testing.T) {
@@ -844,6 +920,43 @@ func TestClient(t *testing.T) {
 			require.NoError(t, err)
 		})
 	})
+	t.Run("ExecBatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		results, err := clientExecBatch(ctx, testPool(ctx, func(ctx context.Context) (*Session, error) {
+			client := NewMockQueryServiceClient(ctrl)
+			for _, err := range []error{
+				nil,
+				// statuses of stream parts checks by connection of driver
+				xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR)),
+				nil,
+			} {
+				stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+				if err != nil {
+					stream.EXPECT().Recv().Return(nil, err).AnyTimes()
+				} else {
+					stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+						Status: Ydb.StatusIds_SUCCESS,
+					}, nil)
+					stream.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
+				}
+				client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+			}
+
+			return newTestSessionWithClient("123", client, true), nil
+		}), []query.Statement{
+			{Query: "UPSERT INTO a (id) VALUES (1)"},
+			{Query: "UPSERT INTO unknown (id) VALUES (1)"},
+			{Query: "UPSERT INTO b (id) VALUES (1)"},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Err)
+		require.NotNil(t, results[0].Result)
+		require.True(t, xerrors.IsOperationError(results[1].Err, Ydb.StatusIds_SCHEME_ERROR))
+		require.Nil(t, results[1].Result)
+		require.NoError(t, results[2].Err)
+		require.NotNil(t, results[2].Result)
+	})
 	t.Run("Query", func(t *testing.T) {
 		t.Run("HappyWay", func(t *testing.T) {
 			ctrl := gomock.NewController(t)
@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -14,18 +16,43 @@ const (
 	DefaultPoolMaxSize          = pool.DefaultLimit
 )
 
+// TableProfile is a set of execution settings which applies to statements referencing table (or tables
+// in directory) with given path
+type TableProfile struct {
+	// Path is a path of table or directory as it is written in statements
+	Path string
+
+	// Timeout limits time of statement execution including retries. Zero means no limit
+	Timeout time.Duration
+
+	// Options applies before options of call, so options of call override them
+	Options []options.Execute
+}
+
 type Config struct {
 	config.Common
 
 	poolLimit             int
+	poolMinSize           int
 	poolSessionUsageLimit uint64
 
 	sessionCreateTimeout   time.Duration
 	sessionDeleteTimeout   time.Duration
 	sessionIddleTimeToLive time.Duration
+	sessionMaxLifetime     time.Duration
 
 	lazyTx bool
 
+	sessionMaxInFlight int
+
+	defaultTimeout time.Duration
+
+	tableProfiles []TableProfile
+
+	interceptors []interceptor.Interceptor
+
+	subPools map[string]int
+
 	trace *trace.Query
 }
 
@@ -54,6 +81,16 @@ func (c *Config) Trace() *trace.Query {
 	return c.trace
 }
 
+// Interceptors returns interceptors of executing statements
+func (c *Config) Interceptors() []interceptor.Interceptor {
+	return c.interceptors
+}
+
+// SubPools returns limits of named sub-pools of sessions
+func (c *Config) SubPools() map[string]int {
+	return c.subPools
+}
+
 // PoolLimit is an upper bound of pooled sessions.
 // If PoolLimit is less than or equal to zero then the
 // DefaultPoolMaxSize variable is used as a pool limit.
@@ -61,6 +98,11 @@ func (c *Config) PoolLimit() int {
 	return c.poolLimit
 }
 
+// PoolMinSize is a number of idle sessions which the pool creates at start and maintains in background
+func (c *Config) PoolMinSize() int {
+	return c.poolMinSize
+}
+
 func (c *Config) PoolSessionUsageLimit() uint64 {
 	return c.poolSessionUsageLimit
 }
@@ -77,6 +119,11 @@ func (c *Config) SessionDeleteTimeout() time.Duration {
 	return c.sessionDeleteTimeout
 }
 
+// SessionMaxLifetime limits total lifetime of session
+func (c *Config) SessionMaxLifetime() time.Duration {
+	return c.sessionMaxLifetime
+}
+
 // SessionIdleTimeToLive limits maximum time to live of idle session
 // If idleTimeToLive is less than or equal to zero then sessions will not be closed by idle
 func (c *Config) SessionIdleTimeToLive() time.Duration {
@@ -86,3 +133,20 @@ func (c *Config) SessionIdleTimeToLive() time.Duration {
 func (c *Config) LazyTx() bool {
 	return c.lazyTx
 }
+
+// SessionMaxInFlight is a max count of concurrently executing queries per session.
+// Zero means no limit
+func (c *Config) SessionMaxInFlight() int {
+	return c.sessionMaxInFlight
+}
+
+// DefaultTimeout is a timeout of calls of query client with context without deadline.
+// Zero means no timeout
+func (c *Config) DefaultTimeout() time.Duration {
+	return c.defaultTimeout
+}
+
+// TableProfiles returns execution profiles of tables in order of registration
+func (c *Config) TableProfiles() []TableProfile {
+	return c.tableProfiles
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -34,6 +35,38 @@ func WithPoolLimit(size int) Option {
 	}
 }
 
+// WithPoolMinSize defines number of idle sessions which the pool creates at start
+// and maintains in background
+func WithPoolMinSize(size int) Option {
+	return func(c *Config) {
+		if size > 0 {
+			c.poolMinSize = size
+		}
+	}
+}
+
+// WithInterceptors appends interceptors of executing statements
+func WithInterceptors(interceptors ...interceptor.Interceptor) Option {
+	return func(c *Config) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// WithSubPool defines named sub-pool of sessions with independent size limit.
+// Sub-pool selects by workload label from context.
+// If limit is less than or equal to zero then the DefaultPoolMaxSize is used as a sub-pool limit.
+func WithSubPool(name string, limit int) Option {
+	return func(c *Config) {
+		if limit <= 0 {
+			limit = DefaultPoolMaxSize
+		}
+		if c.subPools == nil {
+			c.subPools = make(map[string]int)
+		}
+		c.subPools[name] = limit
+	}
+}
+
 func WithPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(c *Config) {
 		c.poolSessionUsageLimit = sessionUsageLimit
@@ -62,6 +95,15 @@ func WithSessionDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithSessionMaxLifetime limits total lifetime of session.
+// Actual lifetime of each session is jittered, so sessions are recycled gradually.
+// If maxLifetime is less than or equal to zero then sessions are not recycled by lifetime
+func WithSessionMaxLifetime(maxLifetime time.Duration) Option {
+	return func(c *Config) {
+		c.sessionMaxLifetime = maxLifetime
+	}
+}
+
 // WithSessionIdleTimeToLive limits maximum time to live of idle session
 // If idleTimeToLive is less than or equal to zero then sessions will not be closed by idle
 func WithSessionIdleTimeToLive(idleTimeToLive time.Duration) Option {
@@ -77,3 +119,33 @@ func WithLazyTx(lazyTx bool) Option {
 		c.lazyTx = lazyTx
 	}
 }
+
+// WithSessionMaxInFlight limits count of concurrently executing queries per session.
+// Queries over limit wait for completion of other queries of session. Non-positive value means no limit
+func WithSessionMaxInFlight(maxInFlight int) Option {
+	return func(c *Config) {
+		if maxInFlight > 0 {
+			c.sessionMaxInFlight = maxInFlight
+		} else {
+			c.sessionMaxInFlight = 0
+		}
+	}
+}
+
+// WithDefaultTimeout sets timeout of calls of query client (Do, DoTx, Exec, Query and others)
+// with context without deadline. Timeout of Do and DoTx limits all attempts of operation
+func WithDefaultTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		if timeout > 0 {
+			c.defaultTimeout = timeout
+		}
+	}
+}
+
+// WithTableProfile registers execution profile which applies to statements referencing table (or tables
+// in directory) with given path
+func WithTableProfile(profile TableProfile) Option {
+	return func(c *Config) {
+		c.tableProfiles = append(c.tableProfiles, profile)
+	}
+}
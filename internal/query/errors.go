@@ -16,4 +16,6 @@ var (
 	errNilOption               = errors.New("nil option")
 	ErrOptionNotForTxExecute   = errors.New("option is not for execute on transaction")
 	errExecuteOnCompletedTx    = errors.New("execute on completed transaction")
+	errResultSizeLimit         = errors.New("result size limit exceeded")
+	errResponsePartSizeLimit   = errors.New("response part size limit exceeded")
 )
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"io"
+	"sync"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
@@ -14,22 +15,29 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 type executeSettings interface {
 	ExecMode() options.ExecMode
 	StatsMode() options.StatsMode
 	StatsCallback() func(stats stats.QueryStats)
+	ProgressCallback() func(progress stats.ProgressStats)
 	TxControl() *query.TransactionControl
 	Syntax() options.Syntax
 	Params() *params.Parameters
 	CallOptions() []grpc.CallOption
 	RetryOpts() []retry.Option
+	ResponsePartLimitBytes() int64
+	MaxResultBytes() int64
+	StreamBufferParts() int
+	ResultBufferRows() int
 }
 
 type executeScriptConfig interface {
@@ -96,24 +104,70 @@ func queryFromText(
 }
 
 func execute(
-	ctx context.Context, sessionID string, c Ydb_Query_V1.QueryServiceClient,
-	q string, settings executeSettings, opts ...resultOption,
+	ctx context.Context, t *trace.Query, sessionID string, c Ydb_Query_V1.QueryServiceClient,
+	inFlight *inFlightLimiter, q string, settings executeSettings, opts ...resultOption,
 ) (
 	_ *streamResult, finalErr error,
 ) {
+	if t != nil {
+		onDone := trace.QueryOnExecuteQuery(t, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.execute"),
+			sessionID, q, settings.Params(),
+		)
+		// query is executed until result is closed: on error of reading of stream, on fully read
+		// stream or on stop of result. Done of trace calls once on first of these events
+		var doneOnce sync.Once
+		done := func(err error) {
+			doneOnce.Do(func() {
+				onDone(err)
+			})
+		}
+		defer func() {
+			if finalErr != nil {
+				done(finalErr)
+			}
+		}()
+		opts = append(opts,
+			withTrace(t),
+			onNextPartErr(func(err error) {
+				done(xerrors.HideEOF(err))
+			}),
+			onClose(func() {
+				done(nil)
+			}),
+		)
+	}
+
 	a := allocator.New()
 	defer a.Free()
 
 	request, callOptions := executeQueryRequest(a, sessionID, q, settings)
 
-	executeCtx := xcontext.ValueOnly(ctx)
+	release, err := inFlight.acquire(ctx)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	executeCtx, cancelExecute := xcontext.WithCancel(xcontext.ValueOnly(ctx))
 
 	stream, err := c.ExecuteQuery(executeCtx, request, callOptions...)
 	if err != nil {
+		cancelExecute()
+		release()
+
 		return nil, xerrors.WithStackTrace(err)
 	}
 
-	r, err := newResult(ctx, stream, append(opts, withStatsCallback(settings.StatsCallback()))...)
+	r, err := newResult(ctx, &inFlightStream{QueryService_ExecuteQueryClient: stream, release: release}, append(opts,
+		onClose(release),
+		onClose(cancelExecute),
+		withStatsCallback(settings.StatsCallback()),
+		withProgressCallback(settings.ProgressCallback()),
+		withStreamBufferParts(settings.StreamBufferParts()),
+		withResultBufferRows(settings.ResultBufferRows()),
+		withResponsePartLimitBytes(settings.ResponsePartLimitBytes()),
+		withMaxResultBytes(settings.MaxResultBytes()),
+	)...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
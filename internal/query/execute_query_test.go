@@ -2,12 +2,15 @@ package query
 
 import (
 	"context"
+	"errors"
 	"io"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	grpcCodes "google.golang.org/grpc/codes"
@@ -17,9 +20,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestExecute(t *testing.T) {
@@ -357,7 +362,7 @@ func TestExecute(t *testing.T) {
 		client := NewMockQueryServiceClient(ctrl)
 		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 		var txID string
-		r, err := execute(ctx, "123", client, "", options.ExecuteSettings(),
+		r, err := execute(ctx, nil, "123", client, nil, "", options.ExecuteSettings(),
 			onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 				txID = txMeta.GetId()
 			}),
@@ -471,7 +476,7 @@ func TestExecute(t *testing.T) {
 			client := NewMockQueryServiceClient(ctrl)
 			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(nil, grpcStatus.Error(grpcCodes.Unavailable, ""))
 			t.Log("execute")
-			_, err := execute(ctx, "123", client, "", options.ExecuteSettings())
+			_, err := execute(ctx, nil, "123", client, nil, "", options.ExecuteSettings())
 			require.Error(t, err)
 			require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
 		})
@@ -576,7 +581,7 @@ func TestExecute(t *testing.T) {
 			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
 			var txID string
-			r, err := execute(ctx, "123", client, "", options.ExecuteSettings(),
+			r, err := execute(ctx, nil, "123", client, nil, "", options.ExecuteSettings(),
 				onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 					txID = txMeta.GetId()
 				}),
@@ -640,7 +645,7 @@ func TestExecute(t *testing.T) {
 			client := NewMockQueryServiceClient(ctrl)
 			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
-			_, err := execute(ctx, "123", client, "", options.ExecuteSettings())
+			_, err := execute(ctx, nil, "123", client, nil, "", options.ExecuteSettings())
 			require.Error(t, err)
 			require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_UNAVAILABLE))
 		})
@@ -717,7 +722,7 @@ func TestExecute(t *testing.T) {
 			client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
 			t.Log("execute")
 			var txID string
-			r, err := execute(ctx, "123", client, "", options.ExecuteSettings(),
+			r, err := execute(ctx, nil, "123", client, nil, "", options.ExecuteSettings(),
 				onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 					txID = txMeta.GetId()
 				}),
@@ -760,6 +765,140 @@ func TestExecute(t *testing.T) {
 	})
 }
 
+func TestExecuteProgress(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	for _, rows := range []uint64{10, 20} {
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+			ExecStats: &Ydb_TableStats.QueryStats{
+				QueryPhases: []*Ydb_TableStats.QueryPhaseStats{{
+					TableAccess: []*Ydb_TableStats.TableAccessStats{{
+						Name:    "a",
+						Updates: &Ydb_TableStats.OperationStats{Rows: rows},
+					}},
+				}},
+			},
+		}, nil)
+	}
+	stream.EXPECT().Recv().Return(nil, io.EOF)
+	client := NewMockQueryServiceClient(ctrl)
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			require.Equal(t, Ydb_Query.StatsMode_STATS_MODE_BASIC, in.GetStatsMode())
+
+			return stream, nil
+		},
+	)
+	var progress []stats.ProgressStats
+	r, err := execute(ctx, nil, "123", client, nil, "UPSERT INTO a (id) VALUES (1)", options.ExecuteSettings(
+		options.WithProgress(func(p stats.ProgressStats) {
+			progress = append(progress, p)
+		}),
+	))
+	require.NoError(t, err)
+	require.NoError(t, readAll(ctx, r))
+	require.Len(t, progress, 2)
+	require.Equal(t, 0, progress[0].Part)
+	require.EqualValues(t, 10, progress[0].AffectedRows)
+	require.Equal(t, 1, progress[1].Part)
+	require.EqualValues(t, 20, progress[1].AffectedRows)
+}
+
+func TestExecuteStop(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	var streamCtx context.Context
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+		Status: Ydb.StatusIds_SUCCESS,
+	}, nil)
+	client := NewMockQueryServiceClient(ctrl)
+	client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *Ydb_Query.ExecuteQueryRequest, _ ...grpc.CallOption) (
+			Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+		) {
+			streamCtx = ctx
+
+			return stream, nil
+		},
+	)
+	r, err := execute(ctx, nil, "123", client, nil, "SELECT 1", options.ExecuteSettings())
+	require.NoError(t, err)
+	require.NoError(t, streamCtx.Err())
+	require.NoError(t, r.Stop(ctx))
+	require.ErrorIs(t, streamCtx.Err(), context.Canceled)
+	_, err = r.NextResultSet(ctx)
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestExecuteTrace(t *testing.T) {
+	executeTrace := func(dones *[]error) *trace.Query {
+		return &trace.Query{
+			OnExecuteQuery: func(trace.QueryExecuteQueryStartInfo) func(trace.QueryExecuteQueryDoneInfo) {
+				return func(info trace.QueryExecuteQueryDoneInfo) {
+					*dones = append(*dones, info.Error)
+				}
+			},
+		}
+	}
+	newClient := func(ctrl *gomock.Controller, parts ...*Ydb_Query.ExecuteQueryResponsePart) *MockQueryServiceClient {
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		for _, part := range parts {
+			stream.EXPECT().Recv().Return(part, nil)
+		}
+		stream.EXPECT().Recv().Return(nil, io.EOF).MaxTimes(1)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		return client
+	}
+	t.Run("ReadAll", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var dones []error
+		client := newClient(gomock.NewController(t),
+			&Ydb_Query.ExecuteQueryResponsePart{Status: Ydb.StatusIds_SUCCESS},
+			&Ydb_Query.ExecuteQueryResponsePart{Status: Ydb.StatusIds_SUCCESS},
+		)
+		r, err := execute(ctx, executeTrace(&dones), "123", client, nil, "SELECT 1", options.ExecuteSettings())
+		require.NoError(t, err)
+		require.Empty(t, dones)
+		require.NoError(t, readAll(ctx, r))
+		require.Equal(t, []error{nil}, dones)
+	})
+	t.Run("Stop", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var dones []error
+		client := newClient(gomock.NewController(t),
+			&Ydb_Query.ExecuteQueryResponsePart{Status: Ydb.StatusIds_SUCCESS},
+		)
+		r, err := execute(ctx, executeTrace(&dones), "123", client, nil, "SELECT 1", options.ExecuteSettings())
+		require.NoError(t, err)
+		require.Empty(t, dones)
+		require.NoError(t, r.Stop(ctx))
+		require.Equal(t, []error{nil}, dones)
+	})
+	t.Run("Error", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		var dones []error
+		ctrl := gomock.NewController(t)
+		errRecv := errors.New("test")
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{Status: Ydb.StatusIds_SUCCESS}, nil)
+		stream.EXPECT().Recv().Return(nil, errRecv)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+		r, err := execute(ctx, executeTrace(&dones), "123", client, nil, "SELECT 1", options.ExecuteSettings())
+		require.NoError(t, err)
+		require.ErrorIs(t, readAll(ctx, r), errRecv)
+		require.Len(t, dones, 1)
+		require.ErrorIs(t, dones[0], errRecv)
+	})
+}
+
 func TestExecuteQueryRequest(t *testing.T) {
 	a := allocator.New()
 	for _, tt := range []struct {
@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// inFlightLimiter limits count of concurrently executing queries of session
+	inFlightLimiter struct {
+		sem chan struct{}
+	}
+	// inFlightStream releases slot of query execution on the end of stream
+	inFlightStream struct {
+		Ydb_Query_V1.QueryService_ExecuteQueryClient
+
+		release func()
+	}
+)
+
+func newInFlightLimiter(limit int) *inFlightLimiter {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &inFlightLimiter{
+		sem: make(chan struct{}, limit),
+	}
+}
+
+// acquire takes slot for query execution. Nil limiter doesn't limit queries.
+// Returned release func is idempotent and must be called after the end of query execution
+func (l *inFlightLimiter) acquire(ctx context.Context) (release func(), _ error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, xerrors.WithStackTrace(ctx.Err())
+	case l.sem <- struct{}{}:
+		return sync.OnceFunc(func() {
+			<-l.sem
+		}), nil
+	}
+}
+
+func (s *inFlightStream) Recv() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+	part, err := s.QueryService_ExecuteQueryClient.Recv()
+	if err != nil {
+		// stream is ended (io.EOF) or failed, query isn't executing anymore
+		s.release()
+	}
+
+	return part, err
+}
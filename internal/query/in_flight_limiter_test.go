@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestInFlightLimiter(t *testing.T) {
+	t.Run("NoLimit", func(t *testing.T) {
+		require.Nil(t, newInFlightLimiter(0))
+		release, err := newInFlightLimiter(0).acquire(xtest.Context(t))
+		require.NoError(t, err)
+		release()
+	})
+	t.Run("Limit", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		limiter := newInFlightLimiter(1)
+
+		r, err := execute(ctx, nil, "123", client, limiter, "SELECT 1", options.ExecuteSettings())
+		require.NoError(t, err)
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = limiter.acquire(canceledCtx)
+		require.ErrorIs(t, err, context.Canceled)
+
+		require.NoError(t, r.Close(ctx))
+
+		release, err := limiter.acquire(ctx)
+		require.NoError(t, err)
+		release()
+		release()
+		require.Empty(t, limiter.sem)
+	})
+	t.Run("ReleaseOnStreamError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+			Status: Ydb.StatusIds_SUCCESS,
+		}, nil)
+		errStream := errors.New("stream failed")
+		stream.EXPECT().Recv().Return(nil, errStream)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).Return(stream, nil)
+
+		limiter := newInFlightLimiter(1)
+
+		// stream is read ahead in background, slot is released on the end of stream without reading of result
+		_, err := execute(ctx, nil, "123", client, limiter, "SELECT 1", options.ExecuteSettings(
+			options.WithStreamBufferParts(1),
+		))
+		require.NoError(t, err)
+
+		release, err := limiter.acquire(ctx)
+		require.NoError(t, err)
+		release()
+	})
+}
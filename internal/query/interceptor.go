@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// interceptedClient applies interceptors to statements which executes with ExecuteQuery call
+type interceptedClient struct {
+	Ydb_Query_V1.QueryServiceClient
+
+	interceptors []interceptor.Interceptor
+}
+
+func withInterceptors(
+	client Ydb_Query_V1.QueryServiceClient, interceptors []interceptor.Interceptor,
+) Ydb_Query_V1.QueryServiceClient {
+	if len(interceptors) == 0 {
+		return client
+	}
+
+	return &interceptedClient{
+		QueryServiceClient: client,
+		interceptors:       interceptors,
+	}
+}
+
+func requestParameters(request *Ydb_Query.ExecuteQueryRequest) *params.Parameters {
+	if len(request.GetParameters()) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(request.GetParameters()))
+	for name := range request.GetParameters() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make(params.Parameters, 0, len(names))
+	for _, name := range names {
+		tv := request.GetParameters()[name]
+		parameters = append(parameters, params.Named(name, value.FromYDB(tv.GetType(), tv.GetValue())))
+	}
+
+	return &parameters
+}
+
+func (c *interceptedClient) ExecuteQuery(
+	ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption,
+) (stream Ydb_Query_V1.QueryService_ExecuteQueryClient, _ error) {
+	parameters := requestParameters(request)
+
+	err := interceptor.Chain(interceptor.ExecutorFunc(func(ctx context.Context, stmt interceptor.Statement) (err error) {
+		if content := request.GetQueryContent(); content != nil {
+			content.Text = stmt.Query
+		}
+
+		if stmt.Params != parameters {
+			a := allocator.New()
+			defer a.Free()
+
+			request.Parameters = stmt.Params.ToYDB(a)
+		}
+
+		stream, err = c.QueryServiceClient.ExecuteQuery(ctx, request, opts...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}), c.interceptors...).Execute(ctx, interceptor.Statement{
+		Service:   interceptor.ServiceQuery,
+		SessionID: request.GetSessionId(),
+		Query:     request.GetQueryContent().GetText(),
+		Params:    parameters,
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return stream, nil
+}
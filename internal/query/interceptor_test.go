@@ -0,0 +1,75 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestInterceptedClient(t *testing.T) {
+	ctx := xtest.Context(t)
+	a := allocator.New()
+	defer a.Free()
+	t.Run("Rewrite", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		client.EXPECT().ExecuteQuery(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption) (
+				Ydb_Query_V1.QueryService_ExecuteQueryClient, error,
+			) {
+				require.Equal(t, "PRAGMA OrderedColumns;\nSELECT $a, $b", request.GetQueryContent().GetText())
+				require.Len(t, request.GetParameters(), 2)
+				require.EqualValues(t, 1, request.GetParameters()["$a"].GetValue().GetUint64Value())
+				require.EqualValues(t, "b", request.GetParameters()["$b"].GetValue().GetTextValue())
+
+				return nil, nil
+			},
+		)
+		request, _ := executeQueryRequest(a, "123", "SELECT $a, $b", options.ExecuteSettings(
+			options.WithParameters(params.Builder{}.Param("$a").Uint64(1).Build()),
+		))
+		_, err := withInterceptors(client, []interceptor.Interceptor{
+			func(next interceptor.Executor) interceptor.Executor {
+				return interceptor.ExecutorFunc(func(ctx context.Context, stmt interceptor.Statement) error {
+					require.Equal(t, interceptor.ServiceQuery, stmt.Service)
+					require.Equal(t, "123", stmt.SessionID)
+					require.Equal(t, 1, stmt.Params.Count())
+					stmt.Query = "PRAGMA OrderedColumns;\n" + stmt.Query
+					stmt.Params = params.Builder{}.
+						Param("$a").Uint64(1).
+						Param("$b").Text("b").
+						Build()
+
+					return next.Execute(ctx, stmt)
+				})
+			},
+		}).ExecuteQuery(ctx, request)
+		require.NoError(t, err)
+	})
+	t.Run("Veto", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := NewMockQueryServiceClient(ctrl)
+		errVeto := errors.New("veto")
+		request, _ := executeQueryRequest(a, "123", "DROP TABLE t", options.ExecuteSettings())
+		_, err := withInterceptors(client, []interceptor.Interceptor{
+			func(next interceptor.Executor) interceptor.Executor {
+				return interceptor.ExecutorFunc(func(ctx context.Context, stmt interceptor.Statement) error {
+					return errVeto
+				})
+			},
+		}).ExecuteQuery(ctx, request)
+		require.ErrorIs(t, err, errVeto)
+	})
+}
@@ -18,6 +18,11 @@ var (
 	_ Execute = syntaxOption(0)
 	_ Execute = statsModeOption{}
 	_ Execute = execModeOption(0)
+	_ Execute = responsePartLimitBytesOption(0)
+	_ Execute = maxResultBytesOption(0)
+	_ Execute = streamBufferPartsOption(0)
+	_ Execute = resultBufferRowsOption(0)
+	_ Execute = progressOption(nil)
 )
 
 type (
@@ -32,9 +37,15 @@ type (
 		execMode      ExecMode
 		statsMode     StatsMode
 		statsCallback func(queryStats stats.QueryStats)
+		progress      func(progress stats.ProgressStats)
 		callOptions   []grpc.CallOption
 		txControl     *tx.Control
 		retryOptions  []retry.Option
+
+		responsePartLimitBytes int64
+		maxResultBytes         int64
+		streamBufferParts      int
+		resultBufferRows       int
 	}
 
 	// Execute is an interface for execute method options
@@ -56,7 +67,12 @@ type (
 		mode     StatsMode
 		callback func(stats.QueryStats)
 	}
-	execModeOption = ExecMode
+	execModeOption               = ExecMode
+	responsePartLimitBytesOption int64
+	maxResultBytesOption         int64
+	streamBufferPartsOption      int
+	resultBufferRowsOption       int
+	progressOption               func(progress stats.ProgressStats)
 )
 
 func (s *executeSettings) RetryOpts() []retry.Option {
@@ -67,6 +83,10 @@ func (s *executeSettings) StatsCallback() func(stats.QueryStats) {
 	return s.statsCallback
 }
 
+func (s *executeSettings) ProgressCallback() func(stats.ProgressStats) {
+	return s.progress
+}
+
 func (t txCommitOption) applyExecuteOption(s *executeSettings) {
 	s.txControl.Commit = true
 }
@@ -137,6 +157,22 @@ func ExecuteSettings(opts ...Execute) *executeSettings {
 	return &settings
 }
 
+func (s *executeSettings) ResponsePartLimitBytes() int64 {
+	return s.responsePartLimitBytes
+}
+
+func (s *executeSettings) MaxResultBytes() int64 {
+	return s.maxResultBytes
+}
+
+func (s *executeSettings) StreamBufferParts() int {
+	return s.streamBufferParts
+}
+
+func (s *executeSettings) ResultBufferRows() int {
+	return s.resultBufferRows
+}
+
 func (s *executeSettings) TxControl() *tx.Control {
 	return s.txControl
 }
@@ -209,3 +245,98 @@ func WithCallOptions(opts ...grpc.CallOption) callOptionsOption {
 func WithTxControl(txControl *tx.Control) *txControlOption {
 	return (*txControlOption)(txControl)
 }
+
+func (limit responsePartLimitBytesOption) applyExecuteOption(s *executeSettings) {
+	s.responsePartLimitBytes = int64(limit)
+}
+
+// WithResponsePartLimitBytes limits size of single response part which client reads from ExecuteQuery stream.
+// Limit is applied on client side only. Stream is cancelled on exceeding of limit. Non-positive limit means no limit
+func WithResponsePartLimitBytes(limit int64) responsePartLimitBytesOption {
+	return responsePartLimitBytesOption(limit)
+}
+
+func (limit maxResultBytesOption) applyExecuteOption(s *executeSettings) {
+	s.maxResultBytes = int64(limit)
+}
+
+// WithMaxResultBytes limits total size of result (sum of sizes of all response parts) which client reads from
+// ExecuteQuery stream. Limit is applied on client side only. Stream is cancelled on exceeding of limit.
+// Non-positive limit means no limit
+func WithMaxResultBytes(limit int64) maxResultBytesOption {
+	return maxResultBytesOption(limit)
+}
+
+func (parts streamBufferPartsOption) applyExecuteOption(s *executeSettings) {
+	s.streamBufferParts = int(parts)
+}
+
+// WithStreamBufferParts defines count of response parts which client reads from ExecuteQuery stream
+// in background ahead of consumer. Non-positive value means reading of parts on demand only
+func WithStreamBufferParts(parts int) streamBufferPartsOption {
+	return streamBufferPartsOption(parts)
+}
+
+func (rows resultBufferRowsOption) applyExecuteOption(s *executeSettings) {
+	s.resultBufferRows = int(rows)
+}
+
+// WithResultBufferRows limits count of rows in response parts which client reads from ExecuteQuery stream
+// in background ahead of consumer. Next part is read only while buffered parts contain less rows than limit.
+// Non-positive value means no limit of rows
+func WithResultBufferRows(rows int) resultBufferRowsOption {
+	return resultBufferRowsOption(rows)
+}
+
+func (callback progressOption) applyExecuteOption(s *executeSettings) {
+	s.progress = callback
+	if s.statsMode == StatsModeNone {
+		s.statsMode = StatsModeBasic
+	}
+}
+
+// WithProgress defines callback which receives execution statistics of every response part
+// with statistics. If stats mode is none then stats mode switches to basic
+func WithProgress(callback func(progress stats.ProgressStats)) progressOption {
+	return callback
+}
+
+// TxModeOption is a transaction settings option which also can be used as execute option
+// with transaction control of the mode
+type TxModeOption interface {
+	tx.Option
+	Execute
+	ExecuteNoTx
+}
+
+var _ TxModeOption = txModeOption{}
+
+type txModeOption struct {
+	tx.Option
+
+	txControl func() *tx.Control
+}
+
+func (opt txModeOption) applyExecuteOption(s *executeSettings) {
+	s.txControl = opt.txControl()
+}
+
+func (opt txModeOption) thisOptionIsNotForExecuteOnTx() {}
+
+// WithStaleReadOnly returns stale read-only transaction mode option.
+// As execute option it sets transaction control with stale read-only mode and commit
+func WithStaleReadOnly() TxModeOption {
+	return txModeOption{
+		Option:    tx.WithStaleReadOnly(),
+		txControl: tx.StaleReadOnlyTxControl,
+	}
+}
+
+// WithSnapshotReadOnly returns snapshot read-only transaction mode option.
+// As execute option it sets transaction control with snapshot read-only mode and commit
+func WithSnapshotReadOnly() TxModeOption {
+	return txModeOption{
+		Option:    tx.WithSnapshotReadOnly(),
+		txControl: tx.SnapshotReadOnlyTxControl,
+	}
+}
@@ -0,0 +1,30 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+)
+
+func TestTxModeOption(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+
+	t.Run("StaleReadOnly", func(t *testing.T) {
+		txControl := ExecuteSettings(WithStaleReadOnly()).TxControl().ToYDB(a)
+		require.NotNil(t, txControl.GetBeginTx().GetStaleReadOnly())
+		require.True(t, txControl.GetCommitTx())
+
+		require.NotNil(t, tx.NewSettings(WithStaleReadOnly()).ToYDB(a).GetStaleReadOnly())
+	})
+	t.Run("SnapshotReadOnly", func(t *testing.T) {
+		txControl := ExecuteSettings(WithSnapshotReadOnly()).TxControl().ToYDB(a)
+		require.NotNil(t, txControl.GetBeginTx().GetSnapshotReadOnly())
+		require.True(t, txControl.GetCommitTx())
+
+		require.NotNil(t, tx.NewSettings(WithSnapshotReadOnly()).ToYDB(a).GetSnapshotReadOnly())
+	})
+}
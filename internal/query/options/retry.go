@@ -1,6 +1,8 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
@@ -100,6 +102,10 @@ func WithRetryBudget(b budget.Budget) RetryOptionsOption {
 	return []retry.Option{retry.WithBudget(b)}
 }
 
+func WithHedging(delay time.Duration, maxAttempts int) RetryOptionsOption {
+	return []retry.Option{retry.WithHedging(delay, maxAttempts)}
+}
+
 func ParseDoOpts(t *trace.Query, opts ...DoOption) (s *doSettings) {
 	s = &doSettings{
 		trace: t,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -37,8 +38,16 @@ type (
 		closed         chan struct{}
 		trace          *trace.Query
 		statsCallback  func(queryStats stats.QueryStats)
+		progress       func(progress stats.ProgressStats)
+		partsCount     int
 		onNextPartErr  []func(err error)
 		onTxMeta       []func(txMeta *Ydb_Query.TransactionMeta)
+		onClose        []func()
+		bufferParts    int
+		bufferRows     int
+		partSizeLimit  int64 // limit of size of single response part, non-positive means no limit
+		sizeLimit      int64 // limit of total size of read response parts, non-positive means no limit
+		size           int64
 	}
 	resultOption func(s *streamResult)
 )
@@ -60,6 +69,13 @@ func rangeResultSets(ctx context.Context, r result.Result) xiter.Seq2[result.Set
 	}
 }
 
+// MaterializedResult makes result from already read result sets
+func MaterializedResult(resultSets ...result.Set) *materializedResult {
+	return &materializedResult{
+		resultSets: resultSets,
+	}
+}
+
 func (r *materializedResult) ResultSets(ctx context.Context) xiter.Seq2[result.Set, error] {
 	return rangeResultSets(ctx, r)
 }
@@ -72,6 +88,10 @@ func (r *materializedResult) Close(ctx context.Context) error {
 	return nil
 }
 
+func (r *materializedResult) Stop(ctx context.Context) error {
+	return nil
+}
+
 func (r *materializedResult) NextResultSet(ctx context.Context) (result.Set, error) {
 	if r.idx == len(r.resultSets) {
 		return nil, xerrors.WithStackTrace(io.EOF)
@@ -90,18 +110,54 @@ func withTrace(t *trace.Query) resultOption {
 	}
 }
 
+func withStreamBufferParts(parts int) resultOption {
+	return func(s *streamResult) {
+		s.bufferParts = parts
+	}
+}
+
+func withResultBufferRows(rows int) resultOption {
+	return func(s *streamResult) {
+		s.bufferRows = rows
+	}
+}
+
+func withResponsePartLimitBytes(limit int64) resultOption {
+	return func(s *streamResult) {
+		s.partSizeLimit = limit
+	}
+}
+
+func withMaxResultBytes(limit int64) resultOption {
+	return func(s *streamResult) {
+		s.sizeLimit = limit
+	}
+}
+
 func withStatsCallback(callback func(queryStats stats.QueryStats)) resultOption {
 	return func(s *streamResult) {
 		s.statsCallback = callback
 	}
 }
 
+func withProgressCallback(callback func(progress stats.ProgressStats)) resultOption {
+	return func(s *streamResult) {
+		s.progress = callback
+	}
+}
+
 func onNextPartErr(callback func(err error)) resultOption {
 	return func(s *streamResult) {
 		s.onNextPartErr = append(s.onNextPartErr, callback)
 	}
 }
 
+func onClose(callback func()) resultOption {
+	return func(s *streamResult) {
+		s.onClose = append(s.onClose, callback)
+	}
+}
+
 func onTxMeta(callback func(txMeta *Ydb_Query.TransactionMeta)) resultOption {
 	return func(s *streamResult) {
 		s.onTxMeta = append(s.onTxMeta, callback)
@@ -118,9 +174,14 @@ func newResult(
 		closed:         make(chan struct{}),
 		resultSetIndex: -1,
 	}
+	// stream isn't reset on close because Stop may be called concurrently with reading of result,
+	// closed result cancels stream with its context (see onClose callbacks)
 	r.closeOnce = sync.OnceFunc(func() {
 		close(r.closed)
-		r.stream = nil
+
+		for _, callback := range r.onClose {
+			callback()
+		}
 	})
 
 	for _, opt := range opts {
@@ -129,6 +190,10 @@ func newResult(
 		}
 	}
 
+	if r.bufferParts > 0 || r.bufferRows > 0 {
+		r.stream = newBufferedStream(r.stream, r.bufferParts, r.bufferRows, r.closed)
+	}
+
 	if r.trace != nil {
 		onDone := trace.QueryOnResultNew(r.trace, &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.newResult"),
@@ -140,6 +205,8 @@ func newResult(
 
 	select {
 	case <-ctx.Done():
+		r.closeOnce()
+
 		return nil, xerrors.WithStackTrace(ctx.Err())
 	default:
 		part, err := r.nextPart(ctx)
@@ -165,7 +232,7 @@ func (r *streamResult) nextPart(ctx context.Context) (
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/query.(*streamResult).nextPart"),
 		)
 		defer func() {
-			onDone(part.GetExecStats(), err)
+			onDone(part.GetExecStats(), len(part.GetResultSet().GetRows()), err)
 		}()
 	}
 
@@ -174,13 +241,23 @@ func (r *streamResult) nextPart(ctx context.Context) (
 		return nil, xerrors.WithStackTrace(io.EOF)
 	default:
 		part, err = nextPart(r.stream)
+		if err == nil && (r.partSizeLimit > 0 || r.sizeLimit > 0) {
+			err = r.checkSize(part)
+		}
 		if err != nil {
-			r.closeOnce()
+			select {
+			case <-r.closed:
+				// result stopped concurrently, error of cancelled stream isn't an error of query
+				return nil, xerrors.WithStackTrace(io.EOF)
+			default:
+			}
 
 			for _, callback := range r.onNextPartErr {
 				callback(err)
 			}
 
+			r.closeOnce()
+
 			return nil, xerrors.WithStackTrace(err)
 		}
 
@@ -190,10 +267,34 @@ func (r *streamResult) nextPart(ctx context.Context) (
 			}
 		}
 
+		if execStats := part.GetExecStats(); execStats != nil && r.progress != nil {
+			r.progress(stats.FromProgress(r.partsCount, execStats))
+		}
+		r.partsCount++
+
 		return part, nil
 	}
 }
 
+// checkSize checks client side limits of size of response parts. Closing of result on exceeding of limit
+// cancels stream, so server stops sending of remaining parts
+func (r *streamResult) checkSize(part *Ydb_Query.ExecuteQueryResponsePart) error {
+	partSize := int64(proto.Size(part))
+	if r.partSizeLimit > 0 && partSize > r.partSizeLimit {
+		return xerrors.WithStackTrace(fmt.Errorf("read part of %d bytes with limit %d bytes: %w",
+			partSize, r.partSizeLimit, errResponsePartSizeLimit,
+		))
+	}
+	r.size += partSize
+	if r.sizeLimit > 0 && r.size > r.sizeLimit {
+		return xerrors.WithStackTrace(fmt.Errorf("read %d bytes with limit %d bytes: %w",
+			r.size, r.sizeLimit, errResultSizeLimit,
+		))
+	}
+
+	return nil
+}
+
 func nextPart(stream Ydb_Query_V1.QueryService_ExecuteQueryClient) (
 	part *Ydb_Query.ExecuteQueryResponsePart, err error,
 ) {
@@ -234,6 +335,15 @@ func (r *streamResult) Close(ctx context.Context) (finalErr error) {
 	}
 }
 
+// Stop closes result without reading of remaining parts. Closing of result cancels context of
+// ExecuteQuery stream which makes server to cancel query execution. Stop is safe for call
+// concurrently with reading of result, concurrent reading returns io.EOF
+func (r *streamResult) Stop(ctx context.Context) error {
+	r.closeOnce()
+
+	return nil
+}
+
 func (r *streamResult) nextResultSet(ctx context.Context) (_ *resultSet, err error) {
 	nextResultSetIndex := r.resultSetIndex + 1
 	for {
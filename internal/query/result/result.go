@@ -16,6 +16,12 @@ type (
 		// NextResultSet returns next result set
 		NextResultSet(ctx context.Context) (Set, error)
 
+		// Stop cancels query execution on server and closes result without reading of remaining parts.
+		// Unlike Close, Stop does not wait for the end of query execution
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Stop(ctx context.Context) error
+
 		// ResultSets is experimental API for range iterators available
 		// with Go version 1.23+
 		ResultSets(ctx context.Context) xiter.Seq2[Set, error]
@@ -37,5 +43,11 @@ type (
 		Scan(dst ...interface{}) error
 		ScanNamed(dst ...scanner.NamedDestination) error
 		ScanStruct(dst interface{}, opts ...scanner.ScanStructOption) error
+
+		// ScanToMap puts values of all columns into dst by column names with natural Go types
+		// (numbers, strings, time.Time, []any for lists, map[string]any for structs and so on)
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		ScanToMap(dst map[string]any) error
 	}
 )
@@ -13,6 +13,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -891,6 +892,121 @@ func TestResultNextResultSet(t *testing.T) {
 	})
 }
 
+func TestResultStopConcurrentlyWithRead(t *testing.T) {
+	ctx := xtest.Context(t)
+	ctrl := gomock.NewController(t)
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+	stream.EXPECT().Recv().Return(&Ydb_Query.ExecuteQueryResponsePart{
+		Status:         Ydb.StatusIds_SUCCESS,
+		ResultSetIndex: 0,
+	}, nil)
+	recvStarted := make(chan struct{})
+	stream.EXPECT().Recv().DoAndReturn(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+		close(recvStarted)
+		<-streamCtx.Done()
+
+		return nil, streamCtx.Err()
+	})
+	var partErr error
+	r, err := newResult(ctx, stream,
+		onClose(cancelStream),
+		onNextPartErr(func(err error) {
+			partErr = err
+		}),
+	)
+	require.NoError(t, err)
+
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := r.nextResultSet(ctx)
+		if err == nil {
+			_, err = r.nextResultSet(ctx)
+		}
+		readErr <- err
+	}()
+	<-recvStarted
+	require.NoError(t, r.Stop(ctx))
+	require.ErrorIs(t, <-readErr, io.EOF)
+	require.NoError(t, partErr)
+}
+
+func TestResultSizeLimits(t *testing.T) {
+	newPart := func(resultSetIndex int64, text string) *Ydb_Query.ExecuteQueryResponsePart {
+		return &Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: resultSetIndex,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "a",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+				}},
+				Rows: []*Ydb.Value{{
+					Items: []*Ydb.Value{{Value: &Ydb.Value_TextValue{TextValue: text}}},
+				}},
+			},
+		}
+	}
+	t.Run("UnderLimit", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(newPart(0, "1"), nil)
+		stream.EXPECT().Recv().Return(newPart(1, "2"), nil)
+		stream.EXPECT().Recv().Return(nil, io.EOF)
+		r, err := newResult(ctx, stream, withMaxResultBytes(1024))
+		require.NoError(t, err)
+		require.NoError(t, readAll(ctx, r))
+	})
+	t.Run("Exceeded", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(newPart(0, "1"), nil)
+		stream.EXPECT().Recv().Return(newPart(1, "2"), nil)
+		var (
+			closed  bool
+			partErr error
+		)
+		r, err := newResult(ctx, stream,
+			withMaxResultBytes(int64(proto.Size(newPart(0, "1")))+1),
+			onClose(func() {
+				closed = true
+			}),
+			onNextPartErr(func(err error) {
+				partErr = err
+			}),
+		)
+		require.NoError(t, err)
+		_, err = r.nextResultSet(ctx)
+		require.NoError(t, err)
+		_, err = r.nextResultSet(ctx)
+		require.ErrorIs(t, err, errResultSizeLimit)
+		require.ErrorIs(t, partErr, errResultSizeLimit)
+		require.True(t, closed)
+	})
+	t.Run("ResponsePartExceeded", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		stream := NewMockQueryService_ExecuteQueryClient(ctrl)
+		stream.EXPECT().Recv().Return(newPart(0, "1"), nil)
+		stream.EXPECT().Recv().Return(newPart(1, "22"), nil)
+		var closed bool
+		r, err := newResult(ctx, stream,
+			withResponsePartLimitBytes(int64(proto.Size(newPart(0, "1")))),
+			onClose(func() {
+				closed = true
+			}),
+		)
+		require.NoError(t, err)
+		_, err = r.nextResultSet(ctx)
+		require.NoError(t, err)
+		_, err = r.nextResultSet(ctx)
+		require.ErrorIs(t, err, errResponsePartSizeLimit)
+		require.True(t, closed)
+	})
+}
+
 func TestExactlyOneRowFromResult(t *testing.T) {
 	ctx := xtest.Context(t)
 	t.Run("HappyWay", func(t *testing.T) {
@@ -13,6 +13,7 @@ type Row struct {
 	indexedScanner scanner.IndexedScanner
 	namedScanner   scanner.NamedScanner
 	structScanner  scanner.StructScanner
+	mapScanner     scanner.MapScanner
 }
 
 func NewRow(columns []*Ydb.Column, v *Ydb.Value) *Row {
@@ -22,6 +23,7 @@ func NewRow(columns []*Ydb.Column, v *Ydb.Value) *Row {
 		indexedScanner: scanner.Indexed(data),
 		namedScanner:   scanner.Named(data),
 		structScanner:  scanner.Struct(data),
+		mapScanner:     scanner.Map(data),
 	}
 }
 
@@ -36,3 +38,7 @@ func (r Row) ScanNamed(dst ...scanner.NamedDestination) (err error) {
 func (r Row) ScanStruct(dst interface{}, opts ...scanner.ScanStructOption) (err error) {
 	return r.structScanner.ScanStruct(dst, opts...)
 }
+
+func (r Row) ScanToMap(dst map[string]any) (err error) {
+	return r.mapScanner.ScanMap(dst)
+}
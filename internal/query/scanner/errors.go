@@ -10,4 +10,5 @@ var (
 	errIncompatibleColumnsAndDestinations = errors.New("incompatible columns and destinations")
 	errDstTypeIsNotAPointer               = errors.New("dst type is not a pointer")
 	errDstTypeIsNotAPointerToStruct       = errors.New("dst type is not a pointer to struct")
+	errNilDestinationMap                  = errors.New("destination map is nil")
 )
@@ -0,0 +1,30 @@
+package scanner
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type MapScanner struct {
+	data *data
+}
+
+func Map(data *data) MapScanner {
+	return MapScanner{
+		data: data,
+	}
+}
+
+// ScanMap puts values of all columns of row into dst by column names.
+// Values converts to Go types with value.Any
+func (s MapScanner) ScanMap(dst map[string]any) error {
+	if dst == nil {
+		return xerrors.WithStackTrace(errNilDestinationMap)
+	}
+
+	for i := range s.data.columns {
+		dst[s.data.columns[i].GetName()] = value.Any(s.data.seekByIndex(i))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package scanner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+)
+
+func TestMap(t *testing.T) {
+	s := Map(Data(
+		[]*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+			},
+			{
+				Name: "name",
+				Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+					Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+				}}},
+			},
+			{
+				Name: "tags",
+				Type: &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{
+					Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}},
+				}}},
+			},
+		},
+		[]*Ydb.Value{
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}},
+			{Value: &Ydb.Value_NullFlagValue{}},
+			{Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Int32Value{Int32Value: 2}},
+				{Value: &Ydb.Value_Int32Value{Int32Value: 3}},
+			}},
+		},
+	))
+	dst := map[string]any{}
+	require.NoError(t, s.ScanMap(dst))
+	require.Equal(t, map[string]any{
+		"id":   uint64(1),
+		"name": nil,
+		"tags": []any{int32(2), int32(3)},
+	}, dst)
+	require.ErrorIs(t, s.ScanMap(nil), errNilDestinationMap)
+}
@@ -1,6 +1,7 @@
 package scanner
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -13,6 +14,7 @@ type scanStructSettings struct {
 	TagName                       string
 	AllowMissingColumnsFromSelect bool
 	AllowMissingFieldsInStruct    bool
+	UUIDByteOrder                 value.UUIDByteOrder
 }
 
 type StructScanner struct {
@@ -25,12 +27,79 @@ func Struct(data *data) StructScanner {
 	}
 }
 
+type structField struct {
+	name string
+	json bool
+	v    reflect.Value
+}
+
+// parseTag returns column name and json flag from tag like `sql:"name,json"`
+func parseTag(f reflect.StructField, tagName string) (name string, asJSON bool, hasTag bool) { //nolint:gocritic
+	tag, hasTag := f.Tag.Lookup(tagName)
+	if !hasTag {
+		return f.Name, false, false
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "json" {
+			asJSON = true
+		}
+	}
+	if name == "" && opts != "" {
+		name = f.Name
+	}
+
+	return name, asJSON, true
+}
+
 func fieldName(f reflect.StructField, tagName string) string { //nolint:gocritic
-	if name, has := f.Tag.Lookup(tagName); has {
-		return name
+	name, _, _ := parseTag(f, tagName)
+
+	return name
+}
+
+// structFields returns fields of struct including fields of embedded structs without tag
+func structFields(v reflect.Value, tagName string, fields []structField) []structField {
+	tt := v.Type()
+	for i := 0; i < tt.NumField(); i++ {
+		f := tt.Field(i)
+		name, asJSON, hasTag := parseTag(f, tagName)
+		if f.Anonymous && !hasTag && f.IsExported() && f.Type.Kind() == reflect.Struct {
+			fields = structFields(v.Field(i), tagName, fields)
+
+			continue
+		}
+		fields = append(fields, structField{
+			name: name,
+			json: asJSON,
+			v:    v.Field(i),
+		})
 	}
 
-	return f.Name
+	return fields
+}
+
+func castJSON(v value.Value, dst reflect.Value) error {
+	var data []byte
+	switch vv := value.Any(v).(type) {
+	case nil:
+		dst.SetZero()
+
+		return nil
+	case string:
+		data = []byte(vv)
+	case []byte:
+		data = vv
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w '%s' to json destination", value.ErrCannotCast, v.Type().Yql()))
+	}
+
+	if err := json.Unmarshal(data, dst.Addr().Interface()); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
 }
 
 func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (err error) {
@@ -51,19 +120,23 @@ func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (er
 	if ptr.Elem().Kind() != reflect.Struct {
 		return xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errDstTypeIsNotAPointerToStruct, ptr.Elem().Kind().String()))
 	}
-	tt := ptr.Elem().Type()
+	fields := structFields(ptr.Elem(), settings.TagName, nil)
 	missingColumns := make([]string, 0, len(s.data.columns))
-	existingFields := make(map[string]struct{}, tt.NumField())
-	for i := 0; i < tt.NumField(); i++ {
-		name := fieldName(tt.Field(i), settings.TagName)
-		v, err := s.data.seekByName(name)
+	existingFields := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		v, err := s.data.seekByName(f.name)
 		if err != nil {
-			missingColumns = append(missingColumns, name)
+			missingColumns = append(missingColumns, f.name)
 		} else {
-			if err = value.CastTo(v, ptr.Elem().Field(i).Addr().Interface()); err != nil {
-				return xerrors.WithStackTrace(fmt.Errorf("scan error on struct field name '%s': %w", name, err))
+			if f.json {
+				err = castJSON(v, f.v)
+			} else {
+				err = value.CastToWithUUIDByteOrder(v, f.v.Addr().Interface(), settings.UUIDByteOrder)
+			}
+			if err != nil {
+				return xerrors.WithStackTrace(fmt.Errorf("scan error on struct field name '%s': %w", f.name, err))
 			}
-			existingFields[name] = struct{}{}
+			existingFields[f.name] = struct{}{}
 		}
 	}
 
@@ -74,7 +147,7 @@ func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (er
 	}
 
 	if !settings.AllowMissingFieldsInStruct {
-		missingFields := make([]string, 0, tt.NumField())
+		missingFields := make([]string, 0, len(fields))
 		for _, c := range s.data.columns {
 			if _, has := existingFields[c.GetName()]; !has {
 				missingFields = append(missingFields, c.GetName())
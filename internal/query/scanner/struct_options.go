@@ -1,5 +1,9 @@
 package scanner
 
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
 type (
 	ScanStructOption interface {
 		applyScanStructOption(settings *scanStructSettings)
@@ -7,14 +11,20 @@ type (
 	tagName                       string
 	allowMissingColumnsFromSelect struct{}
 	allowMissingFieldsInStruct    struct{}
+	uuidByteOrder                 value.UUIDByteOrder
 )
 
 var (
 	_ ScanStructOption = tagName("")
 	_ ScanStructOption = allowMissingColumnsFromSelect{}
 	_ ScanStructOption = allowMissingFieldsInStruct{}
+	_ ScanStructOption = uuidByteOrder(0)
 )
 
+func (order uuidByteOrder) applyScanStructOption(settings *scanStructSettings) {
+	settings.UUIDByteOrder = value.UUIDByteOrder(order)
+}
+
 func (allowMissingFieldsInStruct) applyScanStructOption(settings *scanStructSettings) {
 	settings.AllowMissingFieldsInStruct = true
 }
@@ -38,3 +48,7 @@ func WithAllowMissingColumnsFromSelect() allowMissingColumnsFromSelect {
 func WithAllowMissingFieldsInStruct() allowMissingFieldsInStruct {
 	return allowMissingFieldsInStruct{}
 }
+
+func WithUUIDByteOrder(order value.UUIDByteOrder) uuidByteOrder {
+	return uuidByteOrder(order)
+}
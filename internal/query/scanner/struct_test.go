@@ -878,3 +878,104 @@ func TestScannerStructOrdering(t *testing.T) {
 	require.Equal(t, "B", row.B)
 	require.Equal(t, "C", row.C)
 }
+
+func TestStructNested(t *testing.T) {
+	type Base struct {
+		ID uint64 `sql:"id"`
+	}
+	type Payload struct {
+		A int    `json:"a"`
+		B string `json:"b"`
+	}
+	type dst struct {
+		Base
+
+		Tags    []int32          `sql:"tags"`
+		Props   map[string]int32 `sql:"props"`
+		Payload Payload          `sql:"payload,json"`
+		Extra   *Payload         `sql:",json"`
+	}
+	utf8Type := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}
+	int32Type := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}
+	s := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+			},
+			{
+				Name: "tags",
+				Type: &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{Item: int32Type}}},
+			},
+			{
+				Name: "props",
+				Type: &Ydb.Type{Type: &Ydb.Type_DictType{DictType: &Ydb.DictType{Key: utf8Type, Payload: int32Type}}},
+			},
+			{
+				Name: "payload",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON_DOCUMENT}},
+			},
+			{
+				Name: "Extra",
+				Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+					Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON}},
+				}}},
+			},
+		},
+		[]*Ydb.Value{
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}},
+			{Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Int32Value{Int32Value: 2}},
+				{Value: &Ydb.Value_Int32Value{Int32Value: 3}},
+			}},
+			{Pairs: []*Ydb.ValuePair{
+				{
+					Key:     &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: "x"}},
+					Payload: &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: 4}},
+				},
+			}},
+			{Value: &Ydb.Value_TextValue{TextValue: `{"a":5,"b":"c"}`}},
+			{Value: &Ydb.Value_NullFlagValue{}},
+		},
+	))
+	var v dst
+	require.NoError(t, s.ScanStruct(&v))
+	require.Equal(t, dst{
+		Base:    Base{ID: 1},
+		Tags:    []int32{2, 3},
+		Props:   map[string]int32{"x": 4},
+		Payload: Payload{A: 5, B: "c"},
+	}, v)
+}
+
+func TestStructUUIDByteOrder(t *testing.T) {
+	type uuid [16]byte
+	scanner := Struct(Data(
+		[]*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{
+					Type: &Ydb.Type_TypeId{
+						TypeId: Ydb.Type_UUID,
+					},
+				},
+			},
+		},
+		[]*Ydb.Value{
+			{
+				High_128: 0xffeeddccbbaa9988,
+				Value: &Ydb.Value_Low_128{
+					Low_128: 0x6677445500112233,
+				},
+			},
+		},
+	))
+	var row struct {
+		ID uuid `sql:"id"`
+	}
+	require.NoError(t, scanner.ScanStruct(&row, WithUUIDByteOrder(value.UUIDBigEndianByteOrder)))
+	require.Equal(t, uuid{
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}, row.ID)
+}
@@ -21,9 +21,10 @@ type (
 	Session struct {
 		session.Core
 
-		client Ydb_Query_V1.QueryServiceClient
-		trace  *trace.Query
-		laztTx bool
+		client   Ydb_Query_V1.QueryServiceClient
+		inFlight *inFlightLimiter // nil if count of in-flight queries isn't limited
+		trace    *trace.Query
+		laztTx   bool
 	}
 )
 
@@ -36,7 +37,7 @@ func (s *Session) QueryResultSet(
 		onDone(finalErr)
 	}()
 
-	r, err := execute(ctx, s.ID(), s.client, q, options.ExecuteSettings(opts...), withTrace(s.trace))
+	r, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -52,7 +53,7 @@ func (s *Session) QueryResultSet(
 func (s *Session) queryRow(
 	ctx context.Context, q string, settings executeSettings, resultOpts ...resultOption,
 ) (row query.Row, finalErr error) {
-	r, err := execute(ctx, s.ID(), s.client, q, settings, resultOpts...)
+	r, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -72,7 +73,7 @@ func (s *Session) QueryRow(ctx context.Context, q string, opts ...options.Execut
 		onDone(finalErr)
 	}()
 
-	row, err := s.queryRow(ctx, q, options.ExecuteSettings(opts...), withTrace(s.trace))
+	row, err := s.queryRow(ctx, q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -138,7 +139,7 @@ func (s *Session) Exec(
 		onDone(finalErr)
 	}()
 
-	r, err := execute(ctx, s.ID(), s.client, q, options.ExecuteSettings(opts...), withTrace(s.trace))
+	r, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -160,7 +161,7 @@ func (s *Session) Query(
 		onDone(finalErr)
 	}()
 
-	r, err := execute(ctx, s.ID(), s.client, q, options.ExecuteSettings(opts...), withTrace(s.trace))
+	r, err := execute(ctx, s.trace, s.ID(), s.client, s.inFlight, q, options.ExecuteSettings(opts...))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
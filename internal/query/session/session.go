@@ -132,9 +132,12 @@ func Open(
 
 	if core.cc != nil {
 		core.Client = Ydb_Query_V1.NewQueryServiceClient(
-			conn.WithContextModifier(core.cc, func(ctx context.Context) context.Context {
-				return balancerContext.WithNodeID(ctx, core.NodeID())
-			}),
+			conn.WithErrorModifier(
+				conn.WithContextModifier(core.cc, func(ctx context.Context) context.Context {
+					return balancerContext.WithNodeID(ctx, core.NodeID())
+				}),
+				core.checkBadSession,
+			),
 		)
 	}
 
@@ -242,6 +245,17 @@ func (c *core) deleteSession(ctx context.Context) (finalErr error) {
 	return nil
 }
 
+// checkBadSession invalidates broken session (so the pool will close it) and attaches identity of session to error
+func (c *core) checkBadSession(err error) error {
+	if !xerrors.IsBadSession(err) {
+		return err
+	}
+
+	c.SetStatus(StatusError)
+
+	return xerrors.WithSessionInfo(err, c.ID(), c.NodeID())
+}
+
 func (c *core) IsAlive() bool {
 	for _, check := range c.checks {
 		if !check(c) {
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+)
+
+// referencedNames returns identifiers (quoted with backticks or bare) from query text
+// skipping comments, string literals and parameters
+func referencedNames(q string) (names []string) {
+	for i := 0; i < len(q); i++ {
+		switch c := q[i]; {
+		case c == '-' && i+1 < len(q) && q[i+1] == '-':
+			if end := strings.IndexByte(q[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(q)
+			}
+		case c == '/' && i+1 < len(q) && q[i+1] == '*':
+			if end := strings.Index(q[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(q)
+			}
+		case c == '\'' || c == '"':
+			for i++; i < len(q) && q[i] != c; i++ {
+				if q[i] == '\\' {
+					i++
+				}
+			}
+		case c == '`':
+			end := strings.IndexByte(q[i+1:], '`')
+			if end < 0 {
+				return names
+			}
+			names = append(names, q[i+1:i+1+end])
+			i += end + 1
+		case c == '$' || isIdentifierStart(c):
+			start := i
+			for i+1 < len(q) && isIdentifierPart(q[i+1]) {
+				i++
+			}
+			if c != '$' {
+				names = append(names, q[start:i+1])
+			}
+		}
+	}
+
+	return names
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
+
+// tableProfileMatches checks whether name is a path of table or a path of table in directory of profile
+func tableProfileMatches(profile *config.TableProfile, name string) bool {
+	path := strings.TrimSuffix(profile.Path, "/")
+
+	return path != "" && (name == path || strings.HasPrefix(name, path+"/"))
+}
+
+// withTableProfiles applies profiles of tables which referenced in query.
+// Options of profiles prepends to options of call, timeout of context is a minimal timeout of profiles
+func withTableProfiles(
+	ctx context.Context, profiles []config.TableProfile, q string, opts []options.Execute,
+) (context.Context, context.CancelFunc, []options.Execute) {
+	if len(profiles) == 0 {
+		return ctx, func() {}, opts
+	}
+
+	var (
+		names       = referencedNames(q)
+		timeout     time.Duration
+		profileOpts []options.Execute
+	)
+	for i := range profiles {
+		for _, name := range names {
+			if tableProfileMatches(&profiles[i], name) {
+				profileOpts = append(profileOpts, profiles[i].Options...)
+				if t := profiles[i].Timeout; t > 0 && (timeout == 0 || t < timeout) {
+					timeout = t
+				}
+
+				break
+			}
+		}
+	}
+
+	if len(profileOpts) > 0 {
+		opts = append(profileOpts, opts...)
+	}
+
+	if timeout > 0 {
+		ctx, cancel := xcontext.WithTimeout(ctx, timeout)
+
+		return ctx, cancel, opts
+	}
+
+	return ctx, func() {}, opts
+}
@@ -0,0 +1,83 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
+)
+
+func TestReferencedNames(t *testing.T) {
+	for _, tt := range []struct {
+		q     string
+		names []string
+	}{
+		{
+			q:     "SELECT * FROM `dir/orders` WHERE id = $id",
+			names: []string{"SELECT", "FROM", "dir/orders", "WHERE", "id"},
+		},
+		{
+			q:     "-- `comment`\nUPSERT INTO users /* `other` */ SELECT 'users2', \"users3\"",
+			names: []string{"UPSERT", "INTO", "users", "SELECT"},
+		},
+		{
+			q:     "SELECT 'it\\'s' FROM t1",
+			names: []string{"SELECT", "FROM", "t1"},
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tt.names, referencedNames(tt.q))
+		})
+	}
+}
+
+func TestWithTableProfiles(t *testing.T) {
+	profiles := []config.TableProfile{
+		{
+			Path:    "dir/",
+			Timeout: time.Minute,
+			Options: []options.Execute{options.WithTxControl(tx.StaleReadOnlyTxControl())},
+		},
+		{
+			Path:    "users",
+			Timeout: time.Second,
+			Options: []options.Execute{options.WithTxControl(tx.SnapshotReadOnlyTxControl())},
+		},
+	}
+	t.Run("NoMatch", func(t *testing.T) {
+		ctx, cancel, opts := withTableProfiles(context.Background(), profiles, "SELECT * FROM other", nil)
+		defer cancel()
+		_, hasDeadline := ctx.Deadline()
+		require.False(t, hasDeadline)
+		require.Empty(t, opts)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		ctx, cancel, opts := withTableProfiles(context.Background(), profiles, "SELECT * FROM `dir/orders`", nil)
+		defer cancel()
+		deadline, hasDeadline := ctx.Deadline()
+		require.True(t, hasDeadline)
+		require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+		require.Len(t, opts, 1)
+	})
+	t.Run("Several", func(t *testing.T) {
+		ctx, cancel, opts := withTableProfiles(context.Background(), profiles,
+			"SELECT * FROM `dir/orders` JOIN users ON users.id = orders.user_id",
+			[]options.Execute{options.WithTxControl(tx.SerializableReadWriteTxControl())},
+		)
+		defer cancel()
+		deadline, _ := ctx.Deadline()
+		require.WithinDuration(t, time.Now().Add(time.Second), deadline, time.Second)
+		require.Len(t, opts, 3)
+		a := allocator.New()
+		defer a.Free()
+		require.Equal(t, tx.SerializableReadWriteTxControl().ToYDB(a).String(),
+			options.ExecuteSettings(opts...).TxControl().ToYDB(a).String(),
+		)
+	})
+}
@@ -95,7 +95,6 @@ func (tx *Transaction) QueryResultSet(
 	}
 
 	resultOpts := []resultOption{
-		withTrace(tx.s.trace),
 		onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 			tx.SetTxID(txMeta.GetId())
 		}),
@@ -114,7 +113,7 @@ func (tx *Transaction) QueryResultSet(
 			}),
 		)
 	}
-	r, err := execute(ctx, tx.s.ID(), tx.s.client, q, settings, resultOpts...)
+	r, err := execute(ctx, tx.s.trace, tx.s.ID(), tx.s.client, tx.s.inFlight, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -144,7 +143,6 @@ func (tx *Transaction) QueryRow(
 	)
 
 	resultOpts := []resultOption{
-		withTrace(tx.s.trace),
 		onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 			tx.SetTxID(txMeta.GetId())
 		}),
@@ -163,7 +161,7 @@ func (tx *Transaction) QueryRow(
 			}),
 		)
 	}
-	r, err := execute(ctx, tx.s.ID(), tx.s.client, q, settings, resultOpts...)
+	r, err := execute(ctx, tx.s.trace, tx.s.ID(), tx.s.client, tx.s.inFlight, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -209,7 +207,6 @@ func (tx *Transaction) Exec(ctx context.Context, q string, opts ...options.Execu
 	}
 
 	resultOpts := []resultOption{
-		withTrace(tx.s.trace),
 		onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 			tx.SetTxID(txMeta.GetId())
 		}),
@@ -229,7 +226,7 @@ func (tx *Transaction) Exec(ctx context.Context, q string, opts ...options.Execu
 		)
 	}
 
-	r, err := execute(ctx, tx.s.ID(), tx.s.client, q, settings, resultOpts...)
+	r, err := execute(ctx, tx.s.trace, tx.s.ID(), tx.s.client, tx.s.inFlight, q, settings, resultOpts...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -278,7 +275,6 @@ func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Exec
 	}
 
 	resultOpts := []resultOption{
-		withTrace(tx.s.trace),
 		onTxMeta(func(txMeta *Ydb_Query.TransactionMeta) {
 			tx.SetTxID(txMeta.GetId())
 		}),
@@ -297,7 +293,7 @@ func (tx *Transaction) Query(ctx context.Context, q string, opts ...options.Exec
 			}),
 		)
 	}
-	r, err := execute(ctx, tx.s.ID(), tx.s.client, q, settings, resultOpts...)
+	r, err := execute(ctx, tx.s.trace, tx.s.ID(), tx.s.client, tx.s.inFlight, q, settings, resultOpts...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
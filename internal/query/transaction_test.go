@@ -513,6 +513,10 @@ func (s testExecuteSettings) StatsCallback() func(stats stats.QueryStats) {
 	return nil
 }
 
+func (s testExecuteSettings) ProgressCallback() func(progress stats.ProgressStats) {
+	return nil
+}
+
 func (s testExecuteSettings) ExecMode() options.ExecMode {
 	return s.execMode
 }
@@ -537,6 +541,22 @@ func (s testExecuteSettings) CallOptions() []grpc.CallOption {
 	return s.callOptions
 }
 
+func (s testExecuteSettings) ResponsePartLimitBytes() int64 {
+	return 0
+}
+
+func (s testExecuteSettings) MaxResultBytes() int64 {
+	return 0
+}
+
+func (s testExecuteSettings) StreamBufferParts() int {
+	return 0
+}
+
+func (s testExecuteSettings) ResultBufferRows() int {
+	return 0
+}
+
 var _ executeSettings = testExecuteSettings{}
 
 type txMock func() *internal.Control
@@ -27,6 +27,8 @@ var (
 type Client struct {
 	config  config.Config
 	service Ydb_RateLimiter_V1.RateLimiterServiceClient
+	cache   *localCache
+	waiters waitQueues
 }
 
 func (c *Client) Close(ctx context.Context) error {
@@ -38,10 +40,15 @@ func (c *Client) Close(ctx context.Context) error {
 }
 
 func New(ctx context.Context, cc grpc.ClientConnInterface, config config.Config) *Client {
-	return &Client{
+	c := &Client{
 		config:  config,
 		service: Ydb_RateLimiter_V1.NewRateLimiterServiceClient(cc),
 	}
+	if ttl := config.LocalCacheTTL(); ttl > 0 {
+		c.cache = newLocalCache(ttl, config.LocalCachePrefetchFactor())
+	}
+
+	return c
 }
 
 func (c *Client) CreateResource(
@@ -328,6 +335,40 @@ func (c *Client) AcquireResource(
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
 	}
+
+	acquireOptions := options.NewAcquire(opts...)
+	if acquireOptions.Type() == options.AcquireTypeAcquire && acquireOptions.WaitForCapacity() {
+		return c.waitForCapacity(ctx, coordinationNodePath, resourcePath, amount, opts...)
+	}
+
+	return c.acquire(ctx, coordinationNodePath, resourcePath, amount, opts...)
+}
+
+func (c *Client) acquire(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	amount uint64,
+	opts ...options.AcquireOption,
+) (err error) {
+	if c.cache != nil && options.NewAcquire(opts...).Type() == options.AcquireTypeAcquire {
+		return c.cache.acquire(ctx, coordinationNodePath, resourcePath, amount,
+			func(ctx context.Context, amount uint64) error {
+				return c.acquireResourceWithRetry(ctx, coordinationNodePath, resourcePath, amount, opts...)
+			},
+		)
+	}
+
+	return c.acquireResourceWithRetry(ctx, coordinationNodePath, resourcePath, amount, opts...)
+}
+
+func (c *Client) acquireResourceWithRetry(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	amount uint64,
+	opts ...options.AcquireOption,
+) (err error) {
 	call := func(ctx context.Context) error {
 		return xerrors.WithStackTrace(c.acquireResource(ctx, coordinationNodePath, resourcePath, amount, opts...))
 	}
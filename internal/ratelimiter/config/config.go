@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -10,6 +12,9 @@ type Config struct {
 	config.Common
 
 	trace *trace.Ratelimiter
+
+	localCacheTTL            time.Duration
+	localCachePrefetchFactor uint64
 }
 
 // Trace returns trace over ratelimiter calls
@@ -17,8 +22,35 @@ func (c Config) Trace() *trace.Ratelimiter {
 	return c.trace
 }
 
+// LocalCacheTTL returns lifetime of locally cached quota leases.
+// Zero value mean local cache disabled
+func (c Config) LocalCacheTTL() time.Duration {
+	return c.localCacheTTL
+}
+
+// LocalCachePrefetchFactor returns size of batch of quota which acquires from server relative to
+// requested amount. Zero value mean default factor
+func (c Config) LocalCachePrefetchFactor() uint64 {
+	return c.localCachePrefetchFactor
+}
+
 type Option func(c *Config)
 
+// WithLocalCache enables local cache of quota leases with ttl
+func WithLocalCache(ttl time.Duration) Option {
+	return func(c *Config) {
+		c.localCacheTTL = ttl
+	}
+}
+
+// WithLocalCachePrefetchFactor defines size of batch of quota which acquires from server relative
+// to requested amount
+func WithLocalCachePrefetchFactor(factor uint64) Option {
+	return func(c *Config) {
+		c.localCachePrefetchFactor = factor
+	}
+}
+
 // WithTrace appends ratelimiter trace to early defined traces
 func WithTrace(trace trace.Ratelimiter, opts ...trace.RatelimiterComposeOption) Option {
 	return func(c *Config) {
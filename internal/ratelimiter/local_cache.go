@@ -0,0 +1,184 @@
+package ratelimiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+const (
+	// defaultLocalCachePrefetchFactor defines size of acquired from server batch relative to requested amount
+	defaultLocalCachePrefetchFactor = 10
+	// maxLocalCachePrefetchFactor caps prefetch factor, so refill of lease doesn't wait on server too long
+	maxLocalCachePrefetchFactor = 100
+)
+
+type (
+	// localCache serves acquire requests from leases of quota, which acquired from server in batches
+	localCache struct {
+		ttl            time.Duration
+		prefetchFactor uint64
+		clock          clockwork.Clock
+
+		m       xsync.Mutex
+		leases  map[localCacheKey]*lease
+		evictAt time.Time // time of next eviction of expired leases
+	}
+	localCacheKey struct {
+		coordinationNodePath string
+		resourcePath         string
+	}
+	lease struct {
+		m         xsync.Mutex
+		available uint64
+		expiresAt time.Time
+		acquiring empty.Chan // not nil while request to server is in progress, closed on its completion
+		evicted   bool       // lease removed from cache, holder must take actual lease from cache
+	}
+	acquireFunc func(ctx context.Context, amount uint64) error
+)
+
+func newLocalCache(ttl time.Duration, prefetchFactor uint64) *localCache {
+	switch {
+	case prefetchFactor == 0:
+		prefetchFactor = defaultLocalCachePrefetchFactor
+	case prefetchFactor > maxLocalCachePrefetchFactor:
+		prefetchFactor = maxLocalCachePrefetchFactor
+	}
+
+	return &localCache{
+		ttl:            ttl,
+		prefetchFactor: prefetchFactor,
+		clock:          clockwork.NewRealClock(),
+		leases:         make(map[localCacheKey]*lease),
+	}
+}
+
+func (c *localCache) lease(coordinationNodePath, resourcePath string) *lease {
+	key := localCacheKey{
+		coordinationNodePath: coordinationNodePath,
+		resourcePath:         resourcePath,
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.evictExpired()
+
+	l, has := c.leases[key]
+	if !has {
+		l = &lease{}
+		c.leases[key] = l
+	}
+
+	return l
+}
+
+// evictExpired removes expired leases without requests to server in progress, so cache doesn't hold
+// leases of resources which aren't acquired anymore. Eviction runs not often than once per ttl.
+// c.m must be held
+func (c *localCache) evictExpired() {
+	now := c.clock.Now()
+	if now.Before(c.evictAt) {
+		return
+	}
+	c.evictAt = now.Add(c.ttl)
+
+	for key, l := range c.leases {
+		l.m.WithLock(func() {
+			if l.acquiring == nil && !now.Before(l.expiresAt) {
+				l.evicted = true
+				delete(c.leases, key)
+			}
+		})
+	}
+}
+
+// acquire takes amount from lease of the resource or acquires new lease from server with acquireFromServer.
+// Concurrent acquires of the resource wait one request to server instead of sending own requests.
+// Lock of lease isn't held while request to server is in progress, so waiters may leave on context cancellation
+func (c *localCache) acquire(
+	ctx context.Context,
+	coordinationNodePath, resourcePath string,
+	amount uint64,
+	acquireFromServer acquireFunc,
+) error {
+	l := c.lease(coordinationNodePath, resourcePath)
+
+	for {
+		l.m.Lock()
+		if l.evicted {
+			l.m.Unlock()
+			l = c.lease(coordinationNodePath, resourcePath)
+
+			continue
+		}
+		if !c.clock.Now().Before(l.expiresAt) {
+			l.available = 0
+		}
+		if l.available >= amount {
+			l.available -= amount
+			l.m.Unlock()
+
+			return nil
+		}
+		if acquiring := l.acquiring; acquiring != nil {
+			l.m.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return xerrors.WithStackTrace(ctx.Err())
+			case <-acquiring:
+				// check lease again, it may be refilled
+				continue
+			}
+		}
+		acquiring := make(empty.Chan)
+		l.acquiring = acquiring
+		l.m.Unlock()
+
+		return c.acquireFromServer(ctx, l, acquiring, amount, acquireFromServer)
+	}
+}
+
+// acquireFromServer requests new batch of quota and puts surplus of batch to lease
+func (c *localCache) acquireFromServer(
+	ctx context.Context,
+	l *lease,
+	acquiring empty.Chan,
+	amount uint64,
+	acquireFromServer acquireFunc,
+) error {
+	batch := amount * c.prefetchFactor
+	if batch/c.prefetchFactor != amount {
+		// overflow
+		batch = amount
+	}
+
+	err := acquireFromServer(ctx, batch)
+
+	l.m.Lock()
+	defer l.m.Unlock()
+
+	l.acquiring = nil
+	close(acquiring)
+
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	now := c.clock.Now()
+	if !now.Before(l.expiresAt) {
+		l.available = 0
+	}
+	// rest of not expired lease stays available
+	l.available = l.available + batch - amount
+	l.expiresAt = now.Add(c.ttl)
+
+	return nil
+}
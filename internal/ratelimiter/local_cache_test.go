@@ -0,0 +1,136 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalCache(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	c := newLocalCache(time.Second, 0)
+	c.clock = clock
+
+	var requests []uint64
+	acquireFromServer := func(ctx context.Context, amount uint64) error {
+		requests = append(requests, amount)
+
+		return nil
+	}
+
+	for i := 0; i < defaultLocalCachePrefetchFactor; i++ {
+		require.NoError(t, c.acquire(ctx, "node", "resource", 1, acquireFromServer))
+	}
+	require.Equal(t, []uint64{defaultLocalCachePrefetchFactor}, requests)
+
+	// lease exhausted
+	require.NoError(t, c.acquire(ctx, "node", "resource", 1, acquireFromServer))
+	require.Equal(t, []uint64{defaultLocalCachePrefetchFactor, defaultLocalCachePrefetchFactor}, requests)
+
+	// other resource has own lease
+	require.NoError(t, c.acquire(ctx, "node", "other", 2, acquireFromServer))
+	require.Len(t, requests, 3)
+	require.Equal(t, uint64(2*defaultLocalCachePrefetchFactor), requests[2])
+
+	// lease expired
+	clock.Advance(time.Second)
+	require.NoError(t, c.acquire(ctx, "node", "resource", 1, acquireFromServer))
+	require.Len(t, requests, 4)
+
+	t.Run("ServerError", func(t *testing.T) {
+		errAcquire := errors.New("acquire failed")
+		err := c.acquire(ctx, "node", "failed", 1, func(ctx context.Context, amount uint64) error {
+			return errAcquire
+		})
+		require.ErrorIs(t, err, errAcquire)
+		require.Zero(t, c.lease("node", "failed").available)
+	})
+	t.Run("WaitRequestInProgress", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var count atomic.Int32
+		blocking := func(ctx context.Context, amount uint64) error {
+			if count.Add(1) == 1 {
+				close(started)
+				<-release
+			}
+
+			return nil
+		}
+
+		leaderDone := make(chan error, 1)
+		go func() {
+			leaderDone <- c.acquire(ctx, "node", "blocking", 1, blocking)
+		}()
+		<-started
+
+		// waiter leaves on context cancellation while request to server is in progress
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, c.acquire(cancelledCtx, "node", "blocking", 1, blocking), context.Canceled)
+
+		waiterDone := make(chan error, 1)
+		go func() {
+			waiterDone <- c.acquire(ctx, "node", "blocking", 1, blocking)
+		}()
+		close(release)
+		require.NoError(t, <-leaderDone)
+		require.NoError(t, <-waiterDone)
+
+		// waiter takes surplus of leader batch without own request
+		require.EqualValues(t, 1, count.Load())
+		require.EqualValues(t, defaultLocalCachePrefetchFactor-2, c.lease("node", "blocking").available)
+	})
+}
+
+func TestLocalCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	c := newLocalCache(time.Second, 0)
+	c.clock = clock
+	acquireFromServer := func(ctx context.Context, amount uint64) error {
+		return nil
+	}
+
+	require.NoError(t, c.acquire(ctx, "node", "resource", 1, acquireFromServer))
+	evicted := c.lease("node", "resource")
+	require.Len(t, c.leases, 1)
+
+	clock.Advance(time.Second)
+	require.NoError(t, c.acquire(ctx, "node", "other", 1, acquireFromServer))
+	require.Len(t, c.leases, 1)
+	require.True(t, evicted.evicted)
+
+	// acquire of evicted resource makes new lease
+	require.NoError(t, c.acquire(ctx, "node", "resource", 1, acquireFromServer))
+	require.NotSame(t, evicted, c.lease("node", "resource"))
+	require.EqualValues(t, defaultLocalCachePrefetchFactor-1, c.lease("node", "resource").available)
+}
+
+func TestLocalCachePrefetchFactor(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		factor uint64
+		batch  uint64
+	}{
+		{factor: 0, batch: 2 * defaultLocalCachePrefetchFactor},
+		{factor: 1, batch: 2},
+		{factor: 3, batch: 6},
+		{factor: 1000, batch: 2 * maxLocalCachePrefetchFactor},
+	} {
+		var requests []uint64
+		c := newLocalCache(time.Second, tt.factor)
+		require.NoError(t, c.acquire(ctx, "node", "resource", 2, func(ctx context.Context, amount uint64) error {
+			requests = append(requests, amount)
+
+			return nil
+		}))
+		require.Equal(t, []uint64{tt.batch}, requests)
+	}
+}
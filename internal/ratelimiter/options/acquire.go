@@ -24,12 +24,16 @@ type Acquire interface {
 
 	// OperationCancelAfter defines operation CancelAfter for acquire request
 	OperationCancelAfter() time.Duration
+
+	// WaitForCapacity defines blocking of acquire request until quota is available
+	WaitForCapacity() bool
 }
 
 type acquireOptionsHolder struct {
 	acquireType          AcquireType
 	operationTimeout     time.Duration
 	operationCancelAfter time.Duration
+	waitForCapacity      bool
 }
 
 func (h *acquireOptionsHolder) OperationTimeout() time.Duration {
@@ -44,6 +48,10 @@ func (h *acquireOptionsHolder) Type() AcquireType {
 	return h.acquireType
 }
 
+func (h *acquireOptionsHolder) WaitForCapacity() bool {
+	return h.waitForCapacity
+}
+
 type AcquireOption func(h *acquireOptionsHolder)
 
 func WithAcquire() AcquireOption {
@@ -58,6 +66,12 @@ func WithReport() AcquireOption {
 	}
 }
 
+func WithWaitForCapacity() AcquireOption {
+	return func(h *acquireOptionsHolder) {
+		h.waitForCapacity = true
+	}
+}
+
 func WithOperationTimeout(operationTimeout time.Duration) AcquireOption {
 	return func(h *acquireOptionsHolder) {
 		h.operationTimeout = operationTimeout
@@ -0,0 +1,97 @@
+package ratelimiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	ratelimiterErrors "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/errors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// waitQueues contains queues of waiting acquires per resource.
+// Queue is a channel with capacity 1: blocked senders of channel are woken up in order of arrival,
+// so only head of queue sends acquire requests to server
+type waitQueues struct {
+	m      xsync.Mutex
+	queues map[localCacheKey]chan struct{}
+}
+
+func (q *waitQueues) queue(coordinationNodePath, resourcePath string) chan struct{} {
+	key := localCacheKey{
+		coordinationNodePath: coordinationNodePath,
+		resourcePath:         resourcePath,
+	}
+
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	if q.queues == nil {
+		q.queues = make(map[localCacheKey]chan struct{})
+	}
+
+	queue, has := q.queues[key]
+	if !has {
+		queue = make(chan struct{}, 1)
+		q.queues[key] = queue
+	}
+
+	return queue
+}
+
+func (c *Client) waitForCapacity(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	amount uint64,
+	opts ...options.AcquireOption,
+) error {
+	return waitForCapacity(ctx, c.waiters.queue(coordinationNodePath, resourcePath), opts,
+		func(ctx context.Context, opts ...options.AcquireOption) error {
+			return c.acquire(ctx, coordinationNodePath, resourcePath, amount, opts...)
+		},
+	)
+}
+
+// waitForCapacity repeats acquire requests until quota is acquired or ctx is done
+func waitForCapacity(
+	ctx context.Context,
+	queue chan struct{},
+	opts []options.AcquireOption,
+	acquire func(ctx context.Context, opts ...options.AcquireOption) error,
+) error {
+	select {
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	case queue <- struct{}{}:
+	}
+	defer func() {
+		<-queue
+	}()
+
+	for attempt := 0; ; attempt++ {
+		attemptOpts := opts
+		if deadline, has := ctx.Deadline(); has {
+			// server holds acquire request until quota is available or operation timeout
+			if timeout := time.Until(deadline) - options.DefaultDecrease; timeout > 0 {
+				attemptOpts = append(append([]options.AcquireOption{}, opts...), options.WithOperationTimeout(timeout))
+			}
+		}
+
+		err := acquire(ctx, attemptOpts...)
+		if err == nil || !ratelimiterErrors.IsAcquireError(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff.Fast.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ratelimiterErrors "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/errors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
+)
+
+func TestWaitForCapacity(t *testing.T) {
+	errNoQuota := ratelimiterErrors.NewAcquire(1, errors.New("timeout"))
+
+	t.Run("RetryUntilAcquired", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		var (
+			attempts int
+			timeouts []time.Duration
+		)
+		err := waitForCapacity(ctx, make(chan struct{}, 1), nil,
+			func(ctx context.Context, opts ...options.AcquireOption) error {
+				attempts++
+				timeouts = append(timeouts, options.NewAcquire(opts...).OperationTimeout())
+				if attempts < 3 {
+					return errNoQuota
+				}
+
+				return nil
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+		for _, timeout := range timeouts {
+			require.Greater(t, timeout, time.Duration(0))
+			require.LessOrEqual(t, timeout, time.Minute-options.DefaultDecrease)
+		}
+	})
+
+	t.Run("NonAcquireError", func(t *testing.T) {
+		errOther := errors.New("other")
+		attempts := 0
+		err := waitForCapacity(context.Background(), make(chan struct{}, 1), nil,
+			func(ctx context.Context, opts ...options.AcquireOption) error {
+				attempts++
+
+				return errOther
+			},
+		)
+		require.ErrorIs(t, err, errOther)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		err := waitForCapacity(ctx, make(chan struct{}, 1), nil,
+			func(ctx context.Context, opts ...options.AcquireOption) error {
+				return errNoQuota
+			},
+		)
+		require.True(t, ratelimiterErrors.IsAcquireError(err))
+	})
+
+	t.Run("Queue", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		queue := make(chan struct{}, 1)
+		queue <- struct{}{}
+		err := waitForCapacity(ctx, queue, nil, func(ctx context.Context, opts ...options.AcquireOption) error {
+			t.Fatal("acquire must not be called while queue is busy")
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
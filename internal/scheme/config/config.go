@@ -1,7 +1,10 @@
 package config
 
 import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -11,6 +14,10 @@ type Config struct {
 
 	databaseName string
 	trace        *trace.Scheme
+
+	tableClient        func() table.Client
+	topicClient        func() topic.Client
+	coordinationClient func() coordination.Client
 }
 
 // Trace returns trace over scheme client calls
@@ -18,6 +25,22 @@ func (c *Config) Trace() *trace.Scheme {
 	return c.trace
 }
 
+// TableClient returns getter of table client for operations with tables in recursive operations
+func (c *Config) TableClient() func() table.Client {
+	return c.tableClient
+}
+
+// TopicClient returns getter of topic client for operations with topics in recursive operations
+func (c *Config) TopicClient() func() topic.Client {
+	return c.topicClient
+}
+
+// CoordinationClient returns getter of coordination client for operations with coordination nodes
+// in recursive operations
+func (c *Config) CoordinationClient() func() coordination.Client {
+	return c.coordinationClient
+}
+
 // Database returns database name
 func (c *Config) Database() string {
 	return c.databaseName
@@ -39,6 +62,27 @@ func WithDatabaseName(dbName string) Option {
 	}
 }
 
+// WithTableClient sets getter of table client
+func WithTableClient(tableClient func() table.Client) Option {
+	return func(c *Config) {
+		c.tableClient = tableClient
+	}
+}
+
+// WithTopicClient sets getter of topic client
+func WithTopicClient(topicClient func() topic.Client) Option {
+	return func(c *Config) {
+		c.topicClient = topicClient
+	}
+}
+
+// WithCoordinationClient sets getter of coordination client
+func WithCoordinationClient(coordinationClient func() coordination.Client) Option {
+	return func(c *Config) {
+		c.coordinationClient = coordinationClient
+	}
+}
+
 // With applies common configuration params
 func With(config config.Common) Option {
 	return func(c *Config) {
@@ -0,0 +1,71 @@
+package scheme
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func (c *Client) DescribeAny(ctx context.Context, path string) (d scheme.Description, _ error) {
+	if c == nil {
+		return d, xerrors.WithStackTrace(errNilClient)
+	}
+
+	entry, err := c.DescribePath(ctx, path)
+	if err != nil {
+		return d, xerrors.WithStackTrace(err)
+	}
+	d.Entry = entry
+
+	switch entry.Type {
+	case scheme.EntryDirectory, scheme.EntryDatabase:
+		directory, err := c.ListDirectory(ctx, path)
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+		d.Directory = &directory
+	case scheme.EntryTable, scheme.EntryColumnTable:
+		tableClient, err := c.tableClient()
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+
+		var description options.Description
+		err = tableClient.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+			description, err = s.DescribeTable(ctx, path)
+
+			return err
+		}, table.WithIdempotent())
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+		d.Table = &description
+	case scheme.EntryTopic:
+		topicClient, err := c.topicClient()
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+
+		description, err := topicClient.Describe(ctx, path)
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+		d.Topic = &description
+	case scheme.EntryCoordinationNode:
+		coordinationClient, err := c.coordinationClient()
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+
+		_, config, err := coordinationClient.DescribeNode(ctx, path)
+		if err != nil {
+			return d, xerrors.WithStackTrace(err)
+		}
+		d.CoordinationNode = config
+	}
+
+	return d, nil
+}
@@ -0,0 +1,62 @@
+package scheme
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+)
+
+func TestDescribeAny(t *testing.T) {
+	ctx := context.Background()
+	service := newRecursiveTestTree(t)
+	service.entries["/db/topic"] = &Ydb_Scheme.Entry{Name: "topic", Type: Ydb_Scheme.Entry_TOPIC}
+	service.entries["/db/a/node"] = &Ydb_Scheme.Entry{Name: "node", Type: Ydb_Scheme.Entry_COORDINATION_NODE}
+	service.entries["/db/view"] = &Ydb_Scheme.Entry{Name: "view", Type: Ydb_Scheme.Entry_VIEW}
+	client := service.newClient(
+		config.WithTopicClient(func() topic.Client { return &topicClientStub{} }),
+		config.WithCoordinationClient(func() coordination.Client { return &coordinationClientStub{} }),
+	)
+
+	t.Run("Directory", func(t *testing.T) {
+		d, err := client.DescribeAny(ctx, "/db/a")
+		require.NoError(t, err)
+		require.Equal(t, scheme.EntryDirectory, d.Type)
+		require.NotNil(t, d.Directory)
+		require.Len(t, d.Directory.Children, 2)
+		require.Nil(t, d.Table)
+		require.Nil(t, d.Topic)
+		require.Nil(t, d.CoordinationNode)
+	})
+	t.Run("Topic", func(t *testing.T) {
+		d, err := client.DescribeAny(ctx, "/db/topic")
+		require.NoError(t, err)
+		require.True(t, d.IsTopic())
+		require.NotNil(t, d.Topic)
+		require.Equal(t, "/db/topic", d.Topic.Path)
+		require.Nil(t, d.Directory)
+	})
+	t.Run("CoordinationNode", func(t *testing.T) {
+		d, err := client.DescribeAny(ctx, "/db/a/node")
+		require.NoError(t, err)
+		require.True(t, d.IsCoordinationNode())
+		require.NotNil(t, d.CoordinationNode)
+		require.Equal(t, "/db/a/node", d.CoordinationNode.Path)
+	})
+	t.Run("View", func(t *testing.T) {
+		d, err := client.DescribeAny(ctx, "/db/view")
+		require.NoError(t, err)
+		require.True(t, d.IsView())
+		require.Equal(t, "view", d.Name)
+	})
+	t.Run("ClientNotSet", func(t *testing.T) {
+		_, err := service.newClient().DescribeAny(ctx, "/db/topic")
+		require.ErrorIs(t, err, errClientNotSet)
+	})
+}
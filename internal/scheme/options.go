@@ -1,6 +1,10 @@
 package scheme
 
-import "github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
 
 type permissionsDesc struct {
 	clear   bool
@@ -14,3 +18,71 @@ func (p *permissionsDesc) SetClear(clear bool) {
 func (p *permissionsDesc) AppendAction(action *Ydb_Scheme.PermissionsAction) {
 	p.actions = append(p.actions, action)
 }
+
+const defaultRecursiveConcurrency = 10
+
+type recursiveDesc struct {
+	concurrency int
+	progress    func(scheme.RecursiveProgress)
+}
+
+func newRecursiveDesc(opts ...scheme.RecursiveOption) *recursiveDesc {
+	desc := &recursiveDesc{
+		concurrency: defaultRecursiveConcurrency,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(desc)
+		}
+	}
+
+	return desc
+}
+
+func (d *recursiveDesc) SetConcurrency(concurrency int) {
+	if concurrency > 0 {
+		d.concurrency = concurrency
+	}
+}
+
+func (d *recursiveDesc) SetProgress(progress func(scheme.RecursiveProgress)) {
+	d.progress = progress
+}
+
+type walkDesc struct {
+	entryTypes map[scheme.EntryType]struct{}
+	maxDepth   int
+}
+
+func newWalkDesc(opts ...scheme.WalkOption) *walkDesc {
+	desc := &walkDesc{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(desc)
+		}
+	}
+
+	return desc
+}
+
+func (d *walkDesc) AppendEntryTypes(types ...scheme.EntryType) {
+	if d.entryTypes == nil {
+		d.entryTypes = make(map[scheme.EntryType]struct{}, len(types))
+	}
+	for _, t := range types {
+		d.entryTypes[t] = struct{}{}
+	}
+}
+
+func (d *walkDesc) SetMaxDepth(depth int) {
+	d.maxDepth = depth
+}
+
+func (d *walkDesc) match(entryType scheme.EntryType) bool {
+	if d.entryTypes == nil {
+		return true
+	}
+	_, has := d.entryTypes[entryType]
+
+	return has
+}
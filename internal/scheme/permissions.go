@@ -0,0 +1,44 @@
+package scheme
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func (c *Client) GrantPermissions(ctx context.Context, path, subject string, permissionNames ...string) error {
+	return xerrors.WithStackTrace(c.ModifyPermissions(ctx, path,
+		scheme.WithGrantPermissions(scheme.Permissions{
+			Subject:         subject,
+			PermissionNames: permissionNames,
+		}),
+	))
+}
+
+func (c *Client) RevokePermissions(ctx context.Context, path, subject string, permissionNames ...string) error {
+	return xerrors.WithStackTrace(c.ModifyPermissions(ctx, path,
+		scheme.WithRevokePermissions(scheme.Permissions{
+			Subject:         subject,
+			PermissionNames: permissionNames,
+		}),
+	))
+}
+
+func (c *Client) SetOwner(ctx context.Context, path, owner string) error {
+	return xerrors.WithStackTrace(c.ModifyPermissions(ctx, path, scheme.WithChangeOwner(owner)))
+}
+
+func (c *Client) DescribePermissions(ctx context.Context, path string) (d scheme.PermissionsDescription, _ error) {
+	e, err := c.DescribePath(ctx, path)
+	if err != nil {
+		return d, xerrors.WithStackTrace(err)
+	}
+
+	return scheme.PermissionsDescription{
+		Path:                 path,
+		Owner:                e.Owner,
+		Permissions:          e.Permissions,
+		EffectivePermissions: e.EffectivePermissions,
+	}, nil
+}
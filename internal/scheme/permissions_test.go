@@ -0,0 +1,99 @@
+package scheme
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+type permissionsServiceStub struct {
+	Ydb_Scheme_V1.SchemeServiceClient
+
+	requests []*Ydb_Scheme.ModifyPermissionsRequest
+}
+
+func (s *permissionsServiceStub) ModifyPermissions(
+	ctx context.Context, in *Ydb_Scheme.ModifyPermissionsRequest, opts ...grpc.CallOption,
+) (*Ydb_Scheme.ModifyPermissionsResponse, error) {
+	s.requests = append(s.requests, in)
+
+	return &Ydb_Scheme.ModifyPermissionsResponse{}, nil
+}
+
+func TestPermissionsHelpers(t *testing.T) {
+	ctx := context.Background()
+	service := &permissionsServiceStub{}
+	client := &Client{
+		config:  config.New(),
+		service: service,
+	}
+
+	require.NoError(t, client.GrantPermissions(ctx, "/db/table", "user",
+		scheme.PermissionGenericRead, scheme.PermissionGenericWrite,
+	))
+	require.NoError(t, client.RevokePermissions(ctx, "/db/table", "user", scheme.PermissionGenericWrite))
+	require.NoError(t, client.SetOwner(ctx, "/db/table", "admin"))
+
+	expected := []*Ydb_Scheme.PermissionsAction{
+		{
+			Action: &Ydb_Scheme.PermissionsAction_Grant{
+				Grant: &Ydb_Scheme.Permissions{
+					Subject:         "user",
+					PermissionNames: []string{"ydb.generic.read", "ydb.generic.write"},
+				},
+			},
+		},
+		{
+			Action: &Ydb_Scheme.PermissionsAction_Revoke{
+				Revoke: &Ydb_Scheme.Permissions{
+					Subject:         "user",
+					PermissionNames: []string{"ydb.generic.write"},
+				},
+			},
+		},
+		{
+			Action: &Ydb_Scheme.PermissionsAction_ChangeOwner{
+				ChangeOwner: "admin",
+			},
+		},
+	}
+	require.Len(t, service.requests, len(expected))
+	for i, request := range service.requests {
+		require.Equal(t, "/db/table", request.GetPath())
+		require.Len(t, request.GetActions(), 1)
+		require.True(t, proto.Equal(expected[i], request.GetActions()[0]))
+	}
+}
+
+func TestDescribePermissions(t *testing.T) {
+	service := newRecursiveTestTree(t)
+	service.entries["/db/a"].Owner = "admin"
+	service.entries["/db/a"].Permissions = []*Ydb_Scheme.Permissions{
+		{Subject: "user", PermissionNames: []string{scheme.PermissionGenericRead}},
+	}
+	service.entries["/db/a"].EffectivePermissions = []*Ydb_Scheme.Permissions{
+		{Subject: "user", PermissionNames: []string{scheme.PermissionGenericRead}},
+		{Subject: "user", PermissionNames: []string{scheme.PermissionGenericList}},
+		{Subject: "other", PermissionNames: []string{scheme.PermissionGenericFull}},
+	}
+
+	d, err := service.newClient().DescribePermissions(context.Background(), "/db/a")
+	require.NoError(t, err)
+	require.Equal(t, "/db/a", d.Path)
+	require.Equal(t, "admin", d.Owner)
+	require.Equal(t, []scheme.Permissions{
+		{Subject: "user", PermissionNames: []string{scheme.PermissionGenericRead}},
+	}, d.Permissions)
+	require.Equal(t,
+		[]string{scheme.PermissionGenericRead, scheme.PermissionGenericList},
+		d.SubjectPermissions("user"),
+	)
+}
@@ -0,0 +1,340 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+const sysDirectory = ".sys"
+
+var (
+	errUnsupportedEntryType = xerrors.Wrap(errors.New("unsupported entry type for recursive operation"))
+	errClientNotSet         = xerrors.Wrap(errors.New("client for recursive operation not set"))
+)
+
+type recursiveEntry struct {
+	path      string
+	entryType scheme.EntryType
+}
+
+type recursiveTree struct {
+	// dirs contains directories of the tree in pre-order
+	dirs           []string
+	entries        []recursiveEntry
+	rootIsDatabase bool
+}
+
+// listRecursive lists all entries of tree with root path. System directories skipped
+func (c *Client) listRecursive(ctx context.Context, root string) (tree recursiveTree, _ error) {
+	entry, err := c.DescribePath(ctx, root)
+	if err != nil {
+		return tree, xerrors.WithStackTrace(err)
+	}
+	if !entry.IsDirectory() && !entry.IsDatabase() {
+		tree.entries = append(tree.entries, recursiveEntry{path: root, entryType: entry.Type})
+
+		return tree, nil
+	}
+	tree.rootIsDatabase = entry.IsDatabase()
+	tree.dirs = append(tree.dirs, root)
+
+	err = c.walk(ctx, root, 1, newWalkDesc(), func(entryPath string, e scheme.Entry) error {
+		if e.IsDirectory() {
+			tree.dirs = append(tree.dirs, entryPath)
+		} else {
+			tree.entries = append(tree.entries, recursiveEntry{path: entryPath, entryType: e.Type})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return tree, xerrors.WithStackTrace(err)
+	}
+
+	return tree, nil
+}
+
+type recursiveProgress struct {
+	m        xsync.Mutex
+	done     int
+	total    int
+	callback func(scheme.RecursiveProgress)
+}
+
+func (p *recursiveProgress) entryDone(entryPath string, entryType scheme.EntryType) {
+	if p.callback == nil {
+		return
+	}
+
+	p.m.WithLock(func() {
+		p.done++
+		p.callback(scheme.RecursiveProgress{
+			Path:  entryPath,
+			Type:  entryType,
+			Done:  p.done,
+			Total: p.total,
+		})
+	})
+}
+
+func forEachEntry(
+	ctx context.Context, concurrency int, entries []recursiveEntry,
+	f func(ctx context.Context, entry recursiveEntry) error,
+) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+	for i := range entries {
+		entry := entries[i]
+		g.Go(func() error {
+			return f(ctx, entry)
+		})
+	}
+
+	return g.Wait()
+}
+
+func (c *Client) RemoveRecursive(ctx context.Context, path string, opts ...scheme.RecursiveOption) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	desc := newRecursiveDesc(opts...)
+
+	tree, err := c.listRecursive(ctx, path)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	dirs := tree.dirs
+	if tree.rootIsDatabase {
+		dirs = dirs[1:]
+	}
+
+	progress := &recursiveProgress{
+		total:    len(tree.entries) + len(dirs),
+		callback: desc.progress,
+	}
+
+	err = forEachEntry(ctx, desc.concurrency, tree.entries, func(ctx context.Context, entry recursiveEntry) error {
+		if err := c.removeEntry(ctx, entry); err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("failed to remove %s %q: %w", entry.entryType, entry.path, err),
+			)
+		}
+		progress.entryDone(entry.path, entry.entryType)
+
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	// nested directories removes before parents
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := c.RemoveDirectory(ctx, dirs[i]); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("failed to remove directory %q: %w", dirs[i], err))
+		}
+		progress.entryDone(dirs[i], scheme.EntryDirectory)
+	}
+
+	return nil
+}
+
+func (c *Client) removeEntry(ctx context.Context, entry recursiveEntry) error {
+	switch entry.entryType {
+	case scheme.EntryTable, scheme.EntryColumnTable:
+		tableClient, err := c.tableClient()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return tableClient.Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.DropTable(ctx, entry.path)
+		}, table.WithIdempotent())
+	case scheme.EntryTopic:
+		topicClient, err := c.topicClient()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return topicClient.Drop(ctx, entry.path)
+	case scheme.EntryCoordinationNode:
+		coordinationClient, err := c.coordinationClient()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return coordinationClient.DropNode(ctx, entry.path)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedEntryType, entry.entryType))
+	}
+}
+
+func (c *Client) CopyRecursive(ctx context.Context, src, dst string, opts ...scheme.RecursiveOption) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	desc := newRecursiveDesc(opts...)
+	src, dst = path.Clean(src), path.Clean(dst)
+
+	tree, err := c.listRecursive(ctx, src)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	dstPath := func(srcPath string) string {
+		return path.Join(dst, strings.TrimPrefix(srcPath, src))
+	}
+
+	progress := &recursiveProgress{
+		total:    len(tree.entries) + len(tree.dirs),
+		callback: desc.progress,
+	}
+
+	for _, dir := range tree.dirs {
+		if err := c.MakeDirectory(ctx, dstPath(dir)); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("failed to make directory %q: %w", dstPath(dir), err))
+		}
+		progress.entryDone(dir, scheme.EntryDirectory)
+	}
+
+	var (
+		tables []recursiveEntry
+		others []recursiveEntry
+	)
+	for _, entry := range tree.entries {
+		if entry.entryType == scheme.EntryTable || entry.entryType == scheme.EntryColumnTable {
+			tables = append(tables, entry)
+		} else {
+			others = append(others, entry)
+		}
+	}
+
+	// all tables copies with single request for consistent snapshot of data
+	if len(tables) > 0 {
+		if err := c.copyTables(ctx, tables, dstPath); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		for _, entry := range tables {
+			progress.entryDone(entry.path, entry.entryType)
+		}
+	}
+
+	err = forEachEntry(ctx, desc.concurrency, others, func(ctx context.Context, entry recursiveEntry) error {
+		if err := c.copyEntry(ctx, entry, dstPath(entry.path)); err != nil {
+			return xerrors.WithStackTrace(
+				fmt.Errorf("failed to copy %s %q: %w", entry.entryType, entry.path, err),
+			)
+		}
+		progress.entryDone(entry.path, entry.entryType)
+
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *Client) copyTables(ctx context.Context, tables []recursiveEntry, dstPath func(string) string) error {
+	tableClient, err := c.tableClient()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	items := make([]options.CopyTablesOption, len(tables))
+	for i, entry := range tables {
+		items[i] = options.CopyTablesItem(entry.path, dstPath(entry.path), false)
+	}
+
+	err = tableClient.Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.CopyTables(ctx, items...)
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("failed to copy tables: %w", err))
+	}
+
+	return nil
+}
+
+func (c *Client) copyEntry(ctx context.Context, entry recursiveEntry, dst string) error {
+	switch entry.entryType {
+	case scheme.EntryTopic:
+		topicClient, err := c.topicClient()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		description, err := topicClient.Describe(ctx, entry.path)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return topicClient.Create(ctx, dst,
+			topicoptions.CreateWithMinActivePartitions(description.PartitionSettings.MinActivePartitions),
+			topicoptions.CreateWithPartitionCountLimit(description.PartitionSettings.PartitionCountLimit),
+			topicoptions.CreateWithRetentionPeriod(description.RetentionPeriod),
+			topicoptions.CreateWithRetentionStorageMB(description.RetentionStorageMB),
+			topicoptions.CreateWithSupportedCodecs(description.SupportedCodecs...),
+			topicoptions.CreateWithPartitionWriteSpeedBytesPerSecond(description.PartitionWriteSpeedBytesPerSecond),
+			topicoptions.CreateWithPartitionWriteBurstBytes(description.PartitionWriteBurstBytes),
+			topicoptions.CreateWithAttributes(description.Attributes),
+			topicoptions.CreateWithConsumer(description.Consumers...),
+			topicoptions.CreateWithMeteringMode(description.MeteringMode),
+		)
+	case scheme.EntryCoordinationNode:
+		coordinationClient, err := c.coordinationClient()
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		_, config, err := coordinationClient.DescribeNode(ctx, entry.path)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return coordinationClient.CreateNode(ctx, dst, *config)
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedEntryType, entry.entryType))
+	}
+}
+
+func (c *Client) tableClient() (table.Client, error) {
+	if getter := c.config.TableClient(); getter != nil {
+		return getter(), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: table", errClientNotSet))
+}
+
+func (c *Client) topicClient() (topic.Client, error) {
+	if getter := c.config.TopicClient(); getter != nil {
+		return getter(), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: topic", errClientNotSet))
+}
+
+func (c *Client) coordinationClient() (coordination.Client, error) {
+	if getter := c.config.CoordinationClient(); getter != nil {
+		return getter(), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: coordination", errClientNotSet))
+}
@@ -0,0 +1,218 @@
+package scheme
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+type schemeServiceStub struct {
+	Ydb_Scheme_V1.SchemeServiceClient
+
+	t           *testing.T
+	entries     map[string]*Ydb_Scheme.Entry
+	children    map[string][]*Ydb_Scheme.Entry
+	removedDirs []string
+	madeDirs    []string
+}
+
+func operationResult(t *testing.T, result proto.Message) *Ydb_Operations.Operation {
+	anyResult, err := anypb.New(result)
+	require.NoError(t, err)
+
+	return &Ydb_Operations.Operation{
+		Ready:  true,
+		Status: Ydb.StatusIds_SUCCESS,
+		Result: anyResult,
+	}
+}
+
+func (s *schemeServiceStub) newClient(opts ...config.Option) *Client {
+	return &Client{
+		config:  config.New(opts...),
+		service: s,
+	}
+}
+
+func (s *schemeServiceStub) DescribePath(
+	ctx context.Context, in *Ydb_Scheme.DescribePathRequest, opts ...grpc.CallOption,
+) (*Ydb_Scheme.DescribePathResponse, error) {
+	return &Ydb_Scheme.DescribePathResponse{
+		Operation: operationResult(s.t, &Ydb_Scheme.DescribePathResult{Self: s.entries[in.GetPath()]}),
+	}, nil
+}
+
+func (s *schemeServiceStub) ListDirectory(
+	ctx context.Context, in *Ydb_Scheme.ListDirectoryRequest, opts ...grpc.CallOption,
+) (*Ydb_Scheme.ListDirectoryResponse, error) {
+	return &Ydb_Scheme.ListDirectoryResponse{
+		Operation: operationResult(s.t, &Ydb_Scheme.ListDirectoryResult{
+			Self:     s.entries[in.GetPath()],
+			Children: s.children[in.GetPath()],
+		}),
+	}, nil
+}
+
+func (s *schemeServiceStub) RemoveDirectory(
+	ctx context.Context, in *Ydb_Scheme.RemoveDirectoryRequest, opts ...grpc.CallOption,
+) (*Ydb_Scheme.RemoveDirectoryResponse, error) {
+	s.removedDirs = append(s.removedDirs, in.GetPath())
+
+	return &Ydb_Scheme.RemoveDirectoryResponse{}, nil
+}
+
+func (s *schemeServiceStub) MakeDirectory(
+	ctx context.Context, in *Ydb_Scheme.MakeDirectoryRequest, opts ...grpc.CallOption,
+) (*Ydb_Scheme.MakeDirectoryResponse, error) {
+	s.madeDirs = append(s.madeDirs, in.GetPath())
+
+	return &Ydb_Scheme.MakeDirectoryResponse{}, nil
+}
+
+type topicClientStub struct {
+	topic.Client
+
+	m       xsync.Mutex
+	dropped []string
+	created []string
+}
+
+func (c *topicClientStub) Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error {
+	c.m.WithLock(func() {
+		c.dropped = append(c.dropped, path)
+	})
+
+	return nil
+}
+
+func (c *topicClientStub) Describe(
+	ctx context.Context, path string, opts ...topicoptions.DescribeOption,
+) (topictypes.TopicDescription, error) {
+	return topictypes.TopicDescription{Path: path}, nil
+}
+
+func (c *topicClientStub) Create(ctx context.Context, path string, opts ...topicoptions.CreateOption) error {
+	c.m.WithLock(func() {
+		c.created = append(c.created, path)
+	})
+
+	return nil
+}
+
+type coordinationClientStub struct {
+	coordination.Client
+
+	m       xsync.Mutex
+	dropped []string
+	created []string
+}
+
+func (c *coordinationClientStub) DropNode(ctx context.Context, path string) error {
+	c.m.WithLock(func() {
+		c.dropped = append(c.dropped, path)
+	})
+
+	return nil
+}
+
+func (c *coordinationClientStub) DescribeNode(
+	ctx context.Context, path string,
+) (*scheme.Entry, *coordination.NodeConfig, error) {
+	return &scheme.Entry{Name: path}, &coordination.NodeConfig{Path: path}, nil
+}
+
+func (c *coordinationClientStub) CreateNode(ctx context.Context, path string, config coordination.NodeConfig) error {
+	c.m.WithLock(func() {
+		c.created = append(c.created, path)
+	})
+
+	return nil
+}
+
+func newRecursiveTestTree(t *testing.T) *schemeServiceStub {
+	entry := func(name string, entryType Ydb_Scheme.Entry_Type) *Ydb_Scheme.Entry {
+		return &Ydb_Scheme.Entry{Name: name, Type: entryType}
+	}
+
+	return &schemeServiceStub{
+		t: t,
+		entries: map[string]*Ydb_Scheme.Entry{
+			"/db":     entry("db", Ydb_Scheme.Entry_DATABASE),
+			"/db/a":   entry("a", Ydb_Scheme.Entry_DIRECTORY),
+			"/db/a/b": entry("b", Ydb_Scheme.Entry_DIRECTORY),
+		},
+		children: map[string][]*Ydb_Scheme.Entry{
+			"/db": {
+				entry("a", Ydb_Scheme.Entry_DIRECTORY),
+				entry(".sys", Ydb_Scheme.Entry_DIRECTORY),
+				entry("topic", Ydb_Scheme.Entry_TOPIC),
+			},
+			"/db/a": {
+				entry("node", Ydb_Scheme.Entry_COORDINATION_NODE),
+				entry("b", Ydb_Scheme.Entry_DIRECTORY),
+			},
+		},
+	}
+}
+
+func TestRemoveRecursive(t *testing.T) {
+	ctx := context.Background()
+	service := newRecursiveTestTree(t)
+	topicClient := &topicClientStub{}
+	coordinationClient := &coordinationClientStub{}
+	client := service.newClient(
+		config.WithTopicClient(func() topic.Client { return topicClient }),
+		config.WithCoordinationClient(func() coordination.Client { return coordinationClient }),
+	)
+
+	var progress []scheme.RecursiveProgress
+	err := client.RemoveRecursive(ctx, "/db", scheme.WithRecursiveProgress(func(p scheme.RecursiveProgress) {
+		progress = append(progress, p)
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"/db/topic"}, topicClient.dropped)
+	require.Equal(t, []string{"/db/a/node"}, coordinationClient.dropped)
+	require.Equal(t, []string{"/db/a/b", "/db/a"}, service.removedDirs)
+	require.Len(t, progress, 4)
+	require.Equal(t, 4, progress[3].Done)
+	require.Equal(t, 4, progress[3].Total)
+
+	t.Run("ClientNotSet", func(t *testing.T) {
+		err := newRecursiveTestTree(t).newClient().RemoveRecursive(ctx, "/db/a")
+		require.ErrorIs(t, err, errClientNotSet)
+	})
+}
+
+func TestCopyRecursive(t *testing.T) {
+	ctx := context.Background()
+	service := newRecursiveTestTree(t)
+	topicClient := &topicClientStub{}
+	coordinationClient := &coordinationClientStub{}
+	client := service.newClient(
+		config.WithTopicClient(func() topic.Client { return topicClient }),
+		config.WithCoordinationClient(func() coordination.Client { return coordinationClient }),
+	)
+
+	require.NoError(t, client.CopyRecursive(ctx, "/db/", "/backup", scheme.WithRecursiveConcurrency(1)))
+	sort.Strings(service.madeDirs)
+	require.Equal(t, []string{"/backup", "/backup/a", "/backup/a/b"}, service.madeDirs)
+	require.Equal(t, []string{"/backup/topic"}, topicClient.created)
+	require.Equal(t, []string{"/backup/a/node"}, coordinationClient.created)
+}
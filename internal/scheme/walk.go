@@ -0,0 +1,55 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func (c *Client) Walk(ctx context.Context, root string, f scheme.WalkFunc, opts ...scheme.WalkOption) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return xerrors.WithStackTrace(c.walk(ctx, path.Clean(root), 1, newWalkDesc(opts...), f))
+}
+
+func (c *Client) walk(ctx context.Context, dirPath string, depth int, desc *walkDesc, f scheme.WalkFunc) error {
+	dir, err := c.ListDirectory(ctx, dirPath)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("failed to list directory %q: %w", dirPath, err))
+	}
+
+	for i := range dir.Children {
+		child := &dir.Children[i]
+		if child.Name == sysDirectory {
+			continue
+		}
+
+		childPath := path.Join(dirPath, child.Name)
+		if desc.match(child.Type) {
+			if err := f(childPath, *child); err != nil {
+				switch {
+				case !errors.Is(err, scheme.SkipDir):
+					return err
+				case child.IsDirectory():
+					continue
+				default:
+					return nil
+				}
+			}
+		}
+
+		if child.IsDirectory() && (desc.maxDepth == 0 || depth < desc.maxDepth) {
+			if err := c.walk(ctx, childPath, depth+1, desc, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
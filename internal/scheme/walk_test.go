@@ -0,0 +1,66 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func TestWalk(t *testing.T) {
+	ctx := context.Background()
+	client := newRecursiveTestTree(t).newClient()
+
+	walk := func(opts ...scheme.WalkOption) (paths []string, _ error) {
+		err := client.Walk(ctx, "/db", func(path string, e scheme.Entry) error {
+			paths = append(paths, path)
+
+			return nil
+		}, opts...)
+
+		return paths, err
+	}
+
+	t.Run("All", func(t *testing.T) {
+		paths, err := walk()
+		require.NoError(t, err)
+		require.Equal(t, []string{"/db/a", "/db/a/node", "/db/a/b", "/db/topic"}, paths)
+	})
+
+	t.Run("EntryTypes", func(t *testing.T) {
+		paths, err := walk(scheme.WithWalkEntryTypes(scheme.EntryCoordinationNode, scheme.EntryTopic))
+		require.NoError(t, err)
+		require.Equal(t, []string{"/db/a/node", "/db/topic"}, paths)
+	})
+
+	t.Run("MaxDepth", func(t *testing.T) {
+		paths, err := walk(scheme.WithWalkMaxDepth(1))
+		require.NoError(t, err)
+		require.Equal(t, []string{"/db/a", "/db/topic"}, paths)
+	})
+
+	t.Run("SkipDir", func(t *testing.T) {
+		var paths []string
+		err := client.Walk(ctx, "/db", func(path string, e scheme.Entry) error {
+			paths = append(paths, path)
+			if e.IsDirectory() {
+				return scheme.SkipDir
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"/db/a", "/db/topic"}, paths)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		errStop := errors.New("stop")
+		err := client.Walk(ctx, "/db", func(path string, e scheme.Entry) error {
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+	})
+}
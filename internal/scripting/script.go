@@ -0,0 +1,270 @@
+package scripting
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+)
+
+const scriptHashBytes = 8
+
+var (
+	errInvalidResumeToken  = xerrors.Wrap(errors.New("invalid resume token of script"))
+	errResumeTokenMismatch = xerrors.Wrap(errors.New("resume token issued for other script"))
+)
+
+// preludeKeywords are first keywords of statements without results which prepends to each executed statement
+var preludeKeywords = map[string]struct{}{
+	"DECLARE": {},
+	"PRAGMA":  {},
+	"USE":     {},
+	"IMPORT":  {},
+	"EXPORT":  {},
+	"DEFINE":  {},
+}
+
+type scriptStatement struct {
+	text    string
+	prelude bool
+}
+
+func (c *Client) ExecuteScript(
+	ctx context.Context,
+	script string,
+	opts ...scripting.ScriptOption,
+) xiter.Seq2[*scripting.ScriptResult, error] {
+	settings := scripting.ScriptSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	return func(yield func(*scripting.ScriptResult, error) bool) {
+		if c == nil {
+			yield(nil, xerrors.WithStackTrace(errNilClient))
+
+			return
+		}
+
+		hash := scriptHash(script)
+		start := 0
+		if settings.ResumeToken != "" {
+			var err error
+			start, err = parseResumeToken(settings.ResumeToken, hash)
+			if err != nil {
+				yield(nil, xerrors.WithStackTrace(err))
+
+				return
+			}
+		}
+
+		var (
+			prelude strings.Builder
+			index   int
+		)
+		for _, statement := range splitScript(script) {
+			if statement.prelude {
+				prelude.WriteString(statement.text)
+				prelude.WriteString(";\n")
+
+				continue
+			}
+
+			if index < start {
+				index++
+
+				continue
+			}
+
+			r, err := c.Execute(ctx, prelude.String()+statement.text, settings.Parameters)
+			if err != nil {
+				yield(&scripting.ScriptResult{
+					Index:       index,
+					Statement:   statement.text,
+					ResumeToken: resumeToken(hash, index),
+				}, xerrors.WithStackTrace(fmt.Errorf("statement %d failed: %w", index, err)))
+
+				return
+			}
+			if !yield(&scripting.ScriptResult{
+				Index:       index,
+				Statement:   statement.text,
+				Result:      r,
+				ResumeToken: resumeToken(hash, index+1),
+			}, nil) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func scriptHash(script string) string {
+	sum := sha256.Sum256([]byte(script))
+
+	return hex.EncodeToString(sum[:scriptHashBytes])
+}
+
+func resumeToken(hash string, index int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(hash + ":" + strconv.Itoa(index)))
+}
+
+func parseResumeToken(token, hash string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, xerrors.WithStackTrace(fmt.Errorf("%w: %w", errInvalidResumeToken, err))
+	}
+	tokenHash, index, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return 0, xerrors.WithStackTrace(errInvalidResumeToken)
+	}
+	if tokenHash != hash {
+		return 0, xerrors.WithStackTrace(errResumeTokenMismatch)
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 0 {
+		return 0, xerrors.WithStackTrace(errInvalidResumeToken)
+	}
+
+	return i, nil
+}
+
+// splitScript splits YQL script to statements by semicolons outside of literals, comments
+// and DEFINE ... END DEFINE, BEGIN ... END DO blocks
+//
+//nolint:funlen
+func splitScript(script string) (statements []scriptStatement) {
+	var (
+		start     int
+		depth     int
+		word      strings.Builder
+		prevWord  string
+		firstWord string
+		hasCode   bool
+	)
+	flushWord := func() {
+		if word.Len() == 0 {
+			return
+		}
+		w := strings.ToUpper(word.String())
+		word.Reset()
+		switch {
+		case w == "DEFINE" && prevWord == "END", w == "DO" && prevWord == "END":
+			depth--
+		case w == "DEFINE", w == "BEGIN":
+			depth++
+		}
+		if firstWord == "" {
+			firstWord = w
+		}
+		prevWord = w
+	}
+	appendStatement := func(end int) {
+		flushWord()
+		if text := strings.TrimSpace(script[start:end]); hasCode && text != "" {
+			_, prelude := preludeKeywords[firstWord]
+			statements = append(statements, scriptStatement{
+				text:    text,
+				prelude: prelude || strings.HasPrefix(firstWord, "$"),
+			})
+		}
+		start, depth, prevWord, firstWord, hasCode = end+1, 0, "", "", false
+	}
+
+	for pos := 0; pos < len(script); {
+		r, width := utf8.DecodeRuneInString(script[pos:])
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			flushWord()
+			hasCode = true
+			pos = skipQuoted(script, pos+width, r)
+		case strings.HasPrefix(script[pos:], "@@"):
+			flushWord()
+			hasCode = true
+			pos = skipMultilineString(script, pos+2) //nolint:gomnd
+		case strings.HasPrefix(script[pos:], "--"):
+			flushWord()
+			if i := strings.IndexByte(script[pos:], '\n'); i >= 0 {
+				pos += i + 1
+			} else {
+				pos = len(script)
+			}
+		case strings.HasPrefix(script[pos:], "/*"):
+			flushWord()
+			if i := strings.Index(script[pos+2:], "*/"); i >= 0 {
+				pos += i + 4 //nolint:gomnd
+			} else {
+				pos = len(script)
+			}
+		case r == ';':
+			flushWord()
+			if depth <= 0 {
+				appendStatement(pos)
+			}
+			pos += width
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || (r == '$' && word.Len() == 0):
+			hasCode = true
+			word.WriteRune(r)
+			pos += width
+		default:
+			flushWord()
+			if !unicode.IsSpace(r) {
+				hasCode = true
+			}
+			pos += width
+		}
+	}
+	appendStatement(len(script))
+
+	return statements
+}
+
+// skipQuoted returns position after closing quote. Quote escapes with backslash or doubling
+func skipQuoted(script string, pos int, quote rune) int {
+	for pos < len(script) {
+		r, width := utf8.DecodeRuneInString(script[pos:])
+		pos += width
+		switch r {
+		case '\\':
+			_, width = utf8.DecodeRuneInString(script[pos:])
+			pos += width
+		case quote:
+			if next, width := utf8.DecodeRuneInString(script[pos:]); next == quote {
+				pos += width
+
+				continue
+			}
+
+			return pos
+		}
+	}
+
+	return pos
+}
+
+// skipMultilineString returns position after closing @@ of multiline string. @@@@ inside of string is escaped @@
+func skipMultilineString(script string, pos int) int {
+	for {
+		i := strings.Index(script[pos:], "@@")
+		if i < 0 {
+			return len(script)
+		}
+		pos += i + 2 //nolint:gomnd
+		if !strings.HasPrefix(script[pos:], "@@") {
+			return pos
+		}
+		pos += 2 //nolint:gomnd
+	}
+}
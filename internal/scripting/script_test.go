@@ -0,0 +1,154 @@
+package scripting
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scripting_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scripting"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scripting/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+)
+
+func TestSplitScript(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		script     string
+		statements []scriptStatement
+	}{
+		{
+			name:   "Simple",
+			script: "SELECT 1; SELECT 2;\n",
+			statements: []scriptStatement{
+				{text: "SELECT 1"},
+				{text: "SELECT 2"},
+			},
+		},
+		{
+			name: "LiteralsAndComments",
+			script: `-- comment; with semicolon
+SELECT ';', "a;b", ` + "`t;1`" + `, @@x;@@@@y@@ /* c; */;
+/* only comment; */`,
+			statements: []scriptStatement{
+				{text: "-- comment; with semicolon\nSELECT ';', \"a;b\", `t;1`, @@x;@@@@y@@ /* c; */"},
+			},
+		},
+		{
+			name: "Prelude",
+			script: `PRAGMA TablePathPrefix("/local");
+DECLARE $id AS Uint64;
+$t = SELECT * FROM t WHERE id = $id;
+UPSERT INTO t SELECT * FROM $t;`,
+			statements: []scriptStatement{
+				{text: `PRAGMA TablePathPrefix("/local")`, prelude: true},
+				{text: "DECLARE $id AS Uint64", prelude: true},
+				{text: "$t = SELECT * FROM t WHERE id = $id", prelude: true},
+				{text: "UPSERT INTO t SELECT * FROM $t"},
+			},
+		},
+		{
+			name: "Blocks",
+			script: `DEFINE ACTION $a() AS SELECT 1; SELECT 2; END DEFINE;
+EVALUATE FOR $i IN [1, 2] DO BEGIN SELECT $i; END DO;
+DO $a();`,
+			statements: []scriptStatement{
+				{text: "DEFINE ACTION $a() AS SELECT 1; SELECT 2; END DEFINE", prelude: true},
+				{text: "EVALUATE FOR $i IN [1, 2] DO BEGIN SELECT $i; END DO"},
+				{text: "DO $a()"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.statements, splitScript(tt.script))
+		})
+	}
+}
+
+type scriptingServiceStub struct {
+	Ydb_Scripting_V1.ScriptingServiceClient
+
+	scripts []string
+	fail    map[int]error
+}
+
+func (s *scriptingServiceStub) ExecuteYql(
+	ctx context.Context, in *Ydb_Scripting.ExecuteYqlRequest, opts ...grpc.CallOption,
+) (*Ydb_Scripting.ExecuteYqlResponse, error) {
+	s.scripts = append(s.scripts, in.GetScript())
+	if err, has := s.fail[len(s.scripts)-1]; has {
+		return nil, err
+	}
+	result, err := anypb.New(&Ydb_Scripting.ExecuteYqlResult{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_Scripting.ExecuteYqlResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func TestExecuteScript(t *testing.T) {
+	const script = "DECLARE $id AS Uint64; SELECT 1; SELECT 2; SELECT 3;"
+
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+	service := &scriptingServiceStub{fail: map[int]error{1: errFailed}}
+	c := &Client{
+		config:  config.New(),
+		service: service,
+	}
+
+	var (
+		results []*scripting.ScriptResult
+		token   string
+	)
+	c.ExecuteScript(ctx, script)(func(res *scripting.ScriptResult, err error) bool {
+		if err != nil {
+			require.ErrorIs(t, err, errFailed)
+			require.Nil(t, res.Result)
+			token = res.ResumeToken
+
+			return true
+		}
+		results = append(results, res)
+
+		return true
+	})
+	require.Len(t, results, 1)
+	require.Equal(t, 0, results[0].Index)
+	require.Equal(t, "SELECT 1", results[0].Statement)
+	require.Equal(t, []string{
+		"DECLARE $id AS Uint64;\nSELECT 1",
+		"DECLARE $id AS Uint64;\nSELECT 2",
+	}, service.scripts)
+
+	results = results[:0]
+	c.ExecuteScript(ctx, script, scripting.WithResumeToken(token))(func(res *scripting.ScriptResult, err error) bool {
+		require.NoError(t, err)
+		results = append(results, res)
+
+		return true
+	})
+	require.Len(t, results, 2)
+	require.Equal(t, 1, results[0].Index)
+	require.Equal(t, "SELECT 2", results[0].Statement)
+	require.Equal(t, 2, results[1].Index)
+
+	c.ExecuteScript(ctx, "SELECT 4;", scripting.WithResumeToken(token))(func(_ *scripting.ScriptResult, err error) bool {
+		require.ErrorIs(t, err, errResumeTokenMismatch)
+
+		return true
+	})
+}
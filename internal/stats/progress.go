@@ -0,0 +1,51 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+)
+
+// ProgressStats holds intermediate statistics of query execution
+// which reported by server within response part of query result stream
+type ProgressStats struct {
+	// Part is an ordinal number of response part (starts from zero)
+	Part int
+	// AffectedRows is a sum of updated and deleted rows over all phases
+	AffectedRows uint64
+	// Phases holds statistics of execution phases
+	Phases        []Phase
+	TotalDuration time.Duration
+	TotalCPUTime  time.Duration
+}
+
+func FromProgress(part int, pb *Ydb_TableStats.QueryStats) ProgressStats {
+	progress := ProgressStats{
+		Part:          part,
+		Phases:        make([]Phase, 0, len(pb.GetQueryPhases())),
+		TotalDuration: fromUs(pb.GetTotalDurationUs()),
+		TotalCPUTime:  fromUs(pb.GetTotalCpuTimeUs()),
+	}
+	for _, phasePb := range pb.GetQueryPhases() {
+		phase := Phase{
+			Duration:       fromUs(phasePb.GetDurationUs()),
+			TableAccess:    make([]TableAccess, 0, len(phasePb.GetTableAccess())),
+			CPUTime:        fromUs(phasePb.GetCpuTimeUs()),
+			AffectedShards: phasePb.GetAffectedShards(),
+			LiteralPhase:   phasePb.GetLiteralPhase(),
+		}
+		for _, tablePb := range phasePb.GetTableAccess() {
+			phase.TableAccess = append(phase.TableAccess, TableAccess{
+				Name:            tablePb.GetName(),
+				Reads:           fromOperationStats(tablePb.GetReads()),
+				Updates:         fromOperationStats(tablePb.GetUpdates()),
+				Deletes:         fromOperationStats(tablePb.GetDeletes()),
+				PartitionsCount: tablePb.GetPartitionsCount(),
+			})
+			progress.AffectedRows += tablePb.GetUpdates().GetRows() + tablePb.GetDeletes().GetRows()
+		}
+		progress.Phases = append(progress.Phases, phase)
+	}
+
+	return progress
+}
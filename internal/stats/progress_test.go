@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+)
+
+func TestFromProgress(t *testing.T) {
+	p := FromProgress(2, &Ydb_TableStats.QueryStats{
+		QueryPhases: []*Ydb_TableStats.QueryPhaseStats{
+			{
+				DurationUs: 10,
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:    "a",
+						Reads:   &Ydb_TableStats.OperationStats{Rows: 100},
+						Updates: &Ydb_TableStats.OperationStats{Rows: 300},
+					},
+					{
+						Name:    "b",
+						Deletes: &Ydb_TableStats.OperationStats{Rows: 500},
+					},
+				},
+				AffectedShards: 3,
+			},
+			{
+				DurationUs:   11,
+				LiteralPhase: true,
+			},
+		},
+		TotalDurationUs: 30,
+		TotalCpuTimeUs:  40,
+	})
+	require.Equal(t, 2, p.Part)
+	require.EqualValues(t, 800, p.AffectedRows)
+	require.Equal(t, 30*time.Microsecond, p.TotalDuration)
+	require.Equal(t, 40*time.Microsecond, p.TotalCPUTime)
+	require.Len(t, p.Phases, 2)
+	require.Len(t, p.Phases[0].TableAccess, 2)
+	require.EqualValues(t, 3, p.Phases[0].AffectedShards)
+	require.Equal(t, "b", p.Phases[0].TableAccess[1].Name)
+	require.True(t, p.Phases[1].LiteralPhase)
+}
@@ -0,0 +1,88 @@
+package table
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func (c *Client) BulkUpsert(
+	ctx context.Context, tableName string, data table.BulkUpsertData, opts ...options.BulkUpsertOption,
+) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	if c.isClosed() {
+		return xerrors.WithStackTrace(errClosedClient)
+	}
+
+	var (
+		a           = allocator.New()
+		chunkSize   = options.DefaultBulkUpsertChunkSize
+		callOptions []grpc.CallOption
+	)
+	defer a.Free()
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if size, ok := opt.(options.BulkUpsertChunkSizeOption); ok {
+			chunkSize = int(size)
+		}
+		callOptions = append(callOptions, opt.ApplyBulkUpsertOption()...)
+	}
+
+	requests, err := data.ToYDB(a, tableName, chunkSize)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	service := Ydb_Table_V1.NewTableServiceClient(c.cc)
+	for _, request := range requests {
+		if err := c.bulkUpsertChunk(ctx, service, request, callOptions...); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) bulkUpsertChunk(
+	ctx context.Context,
+	service Ydb_Table_V1.TableServiceClient,
+	request *Ydb_Table.BulkUpsertRequest,
+	callOptions ...grpc.CallOption,
+) error {
+	call := func(ctx context.Context) error {
+		request.OperationParams = operation.Params(
+			ctx,
+			c.config.OperationTimeout(),
+			c.config.OperationCancelAfter(),
+			operation.ModeSync,
+		)
+		_, err := service.BulkUpsert(ctx, request, callOptions...)
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+
+	return retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+}
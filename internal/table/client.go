@@ -24,61 +24,78 @@ func New(ctx context.Context, cc grpc.ClientConnInterface, config *config.Config
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.New"),
 	)
 
-	return &Client{
+	c := &Client{
 		clock:  config.Clock(),
 		config: config,
 		cc:     cc,
 		build: func(ctx context.Context) (s *session, err error) {
 			return newSession(ctx, cc, config)
 		},
-		pool: pool.New[*session, session](ctx,
-			pool.WithLimit[*session, session](config.SizeLimit()),
-			pool.WithItemUsageLimit[*session, session](config.SessionUsageLimit()),
-			pool.WithIdleTimeToLive[*session, session](config.IdleThreshold()),
-			pool.WithCreateItemTimeout[*session, session](config.CreateSessionTimeout()),
-			pool.WithCloseItemTimeout[*session, session](config.DeleteTimeout()),
-			pool.WithClock[*session, session](config.Clock()),
-			pool.WithCreateItemFunc[*session, session](func(ctx context.Context) (*session, error) {
-				return newSession(ctx, cc, config)
-			}),
-			pool.WithTrace[*session, session](&pool.Trace{
-				OnNew: func(ctx *context.Context, call stack.Caller) func(limit int) {
-					return func(limit int) {
-						onDone(limit)
-					}
-				},
-				OnPut: func(ctx *context.Context, call stack.Caller, item any) func(err error) {
-					onDone := trace.TableOnPoolPut( //nolint:forcetypeassert
-						config.Trace(), ctx, call, item.(*session),
-					)
-
-					return func(err error) {
-						onDone(err)
-					}
-				},
-				OnGet: func(ctx *context.Context, call stack.Caller) func(item any, attempts int, err error) {
-					onDone := trace.TableOnPoolGet(config.Trace(), ctx, call)
-
-					return func(item any, attempts int, err error) {
-						onDone(item.(*session), attempts, err) //nolint:forcetypeassert
-					}
-				},
-				OnWith: func(ctx *context.Context, call stack.Caller) func(attempts int, err error) {
-					onDone := trace.TableOnPoolWith(config.Trace(), ctx, call)
-
-					return func(attempts int, err error) {
-						onDone(attempts, err)
-					}
-				},
-				OnChange: func(stats pool.Stats) {
-					trace.TableOnPoolStateChange(config.Trace(),
-						stats.Limit, stats.Index, stats.Idle, stats.Wait, stats.CreateInProgress, stats.Index,
-					)
-				},
+		pool: newPool(ctx, cc, config, config.SizeLimit(), config.MinSize(), onDone,
+			pool.WithStatsTrace[*session, session](func(stats pool.Stats) {
+				trace.TableOnPoolStateChange(config.Trace(),
+					stats.Limit, stats.Index, stats.Idle, stats.Wait, stats.CreateInProgress, stats.Index,
+				)
 			}),
 		),
 		done: make(chan struct{}),
 	}
+
+	if subPools := config.SubPools(); len(subPools) > 0 {
+		c.subPools = make(map[string]sessionPool, len(subPools))
+		for name, limit := range subPools {
+			c.subPools[name] = newPool(ctx, cc, config, limit, 0, nil)
+		}
+	}
+
+	return c
+}
+
+func newPool(
+	ctx context.Context, cc grpc.ClientConnInterface, config *config.Config, limit, minSize int, onNew func(limit int),
+	opts ...pool.Option[*session, session],
+) *pool.Pool[*session, session] {
+	return pool.New[*session, session](ctx, append([]pool.Option[*session, session]{
+		pool.WithLimit[*session, session](limit),
+		pool.WithMinSize[*session, session](minSize),
+		pool.WithItemUsageLimit[*session, session](config.SessionUsageLimit()),
+		pool.WithIdleTimeToLive[*session, session](config.IdleThreshold()),
+		pool.WithItemMaxLifetime[*session, session](config.SessionMaxLifetime()),
+		pool.WithCreateItemTimeout[*session, session](config.CreateSessionTimeout()),
+		pool.WithCloseItemTimeout[*session, session](config.DeleteTimeout()),
+		pool.WithClock[*session, session](config.Clock()),
+		pool.WithCreateItemFunc[*session, session](func(ctx context.Context) (*session, error) {
+			return newSession(ctx, cc, config)
+		}),
+		pool.WithTrace[*session, session](&pool.Trace{
+			OnNew: func(ctx *context.Context, call stack.Caller) func(limit int) {
+				return onNew
+			},
+			OnPut: func(ctx *context.Context, call stack.Caller, item any) func(err error) {
+				onDone := trace.TableOnPoolPut( //nolint:forcetypeassert
+					config.Trace(), ctx, call, item.(*session),
+				)
+
+				return func(err error) {
+					onDone(err)
+				}
+			},
+			OnGet: func(ctx *context.Context, call stack.Caller) func(item any, attempts int, err error) {
+				onDone := trace.TableOnPoolGet(config.Trace(), ctx, call)
+
+				return func(item any, attempts int, err error) {
+					onDone(item.(*session), attempts, err) //nolint:forcetypeassert
+				}
+			},
+			OnWith: func(ctx *context.Context, call stack.Caller) func(attempts int, err error) {
+				onDone := trace.TableOnPoolWith(config.Trace(), ctx, call)
+
+				return func(attempts int, err error) {
+					onDone(attempts, err)
+				}
+			},
+		}),
+	}, opts...)...)
 }
 
 // Client is a set of session instances that may be reused.
@@ -91,6 +108,9 @@ type Client struct {
 	clock  clockwork.Clock
 	pool   sessionPool
 	done   chan struct{}
+
+	// subPools are named sub-pools of sessions which selects by workload label from context
+	subPools map[string]sessionPool
 }
 
 func (c *Client) CreateSession(ctx context.Context, opts ...table.Option) (_ table.ClosableSession, err error) {
@@ -156,6 +176,33 @@ func (c *Client) isClosed() bool {
 	}
 }
 
+// PoolStats returns current state of default sessions pool
+func (c *Client) PoolStats() pool.Stats {
+	return c.pool.Stats()
+}
+
+// Drain stops handing out sessions from pools and waits for complete of operations with sessions in use
+func (c *Client) Drain(ctx context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	var errs []error
+	if err := c.pool.Drain(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range c.subPools {
+		if err := p.Drain(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
+
 // Close deletes all stored sessions inside Client.
 // It also stops all underlying timers and goroutines.
 // It returns first error occurred during stale sessions' deletion.
@@ -174,7 +221,30 @@ func (c *Client) Close(ctx context.Context) (err error) {
 		onDone(err)
 	}()
 
-	return c.pool.Close(ctx)
+	var errs []error
+	if err := c.pool.Close(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range c.subPools {
+		if err := p.Close(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return nil
+}
+
+// SetPoolLimit changes upper bound of sessions in default pool at runtime
+func (c *Client) SetPoolLimit(limit int) {
+	c.pool.SetLimit(limit)
+}
+
+// sessionPool returns sub-pool of sessions for workload label from context or default pool
+func (c *Client) sessionPool(ctx context.Context) sessionPool {
+	return pool.Select(ctx, c.pool, c.subPools)
 }
 
 // Do provide the best effort for execute operation
@@ -201,7 +271,7 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 		onDone(attempts, finalErr)
 	}()
 
-	err := do(ctx, c.pool, c.config, op, func(err error) {
+	err := do(ctx, c.sessionPool(ctx), c.config, op, func(err error) {
 		attempts++
 	}, config.RetryOptions...)
 	if err != nil {
@@ -230,7 +300,7 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 		onDone(attempts, finalErr)
 	}()
 
-	return retryBackoff(ctx, c.pool, func(ctx context.Context, s table.Session) (err error) {
+	return retryBackoff(ctx, c.sessionPool(ctx), func(ctx context.Context, s table.Session) (err error) {
 		attempts++
 
 		tx, err := s.BeginTransaction(ctx, config.TxSettings)
@@ -258,13 +328,7 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 }
 
 func executeTxOperation(ctx context.Context, c *Client, op table.TxOperation, tx table.Transaction) (err error) {
-	if panicCallback := c.config.PanicCallback(); panicCallback != nil {
-		defer func() {
-			if e := recover(); e != nil {
-				panicCallback(e)
-			}
-		}()
-	}
-
-	return op(xcontext.MarkRetryCall(ctx), tx)
+	return xerrors.WithRecover(c.config.PanicCallback(), func() error {
+		return op(xcontext.MarkRetryCall(ctx), tx)
+	})
 }
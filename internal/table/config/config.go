@@ -6,6 +6,7 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -62,12 +63,53 @@ func WithSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithMinSize defines number of idle sessions which the pool creates at start
+// and maintains in background
+func WithMinSize(minSize int) Option {
+	return func(c *Config) {
+		if minSize > 0 {
+			c.minSize = minSize
+		}
+	}
+}
+
+// WithInterceptors appends interceptors of executing statements
+func WithInterceptors(interceptors ...interceptor.Interceptor) Option {
+	return func(c *Config) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// WithSubPool defines named sub-pool of sessions with independent size limit.
+// Sub-pool selects by workload label from context.
+// If limit is less than or equal to zero then the DefaultSessionPoolSizeLimit is used as a sub-pool limit.
+func WithSubPool(name string, limit int) Option {
+	return func(c *Config) {
+		if limit <= 0 {
+			limit = DefaultSessionPoolSizeLimit
+		}
+		if c.subPools == nil {
+			c.subPools = make(map[string]int)
+		}
+		c.subPools[name] = limit
+	}
+}
+
 func WithPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(c *Config) {
 		c.sessionUsageLimit = sessionUsageLimit
 	}
 }
 
+// WithSessionMaxLifetime limits total lifetime of session.
+// Actual lifetime of each session is jittered, so sessions are recycled gradually.
+// If maxLifetime is less than or equal to zero then sessions are not recycled by lifetime
+func WithSessionMaxLifetime(maxLifetime time.Duration) Option {
+	return func(c *Config) {
+		c.sessionMaxLifetime = maxLifetime
+	}
+}
+
 // WithKeepAliveMinSize defines lower bound for sessions in the pool. If there are more sessions open, then
 // the excess idle ones will be closed and removed after IdleKeepAliveThreshold is reached for each of them.
 // If keepAliveMinSize is less than zero, then no sessions will be preserved
@@ -154,6 +196,15 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithExecuteDataQueryOverQueryService makes table sessions to execute data queries (ExecuteDataQuery
+// and Execute of transactions) with ExecuteQuery call of query service instead of table service.
+// Prepared statements and scheme queries are still executed with table service
+func WithExecuteDataQueryOverQueryService(enabled bool) Option {
+	return func(c *Config) {
+		c.executeDataQueryOverQueryService = enabled
+	}
+}
+
 // WithClock replaces default clock
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -165,8 +216,13 @@ func WithClock(clock clockwork.Clock) Option {
 type Config struct {
 	config.Common
 
-	sizeLimit         int
-	sessionUsageLimit uint64
+	sizeLimit          int
+	minSize            int
+	sessionUsageLimit  uint64
+	sessionMaxLifetime time.Duration
+	subPools           map[string]int
+
+	interceptors []interceptor.Interceptor
 
 	createSessionTimeout time.Duration
 	deleteTimeout        time.Duration
@@ -174,6 +230,8 @@ type Config struct {
 
 	ignoreTruncated bool
 
+	executeDataQueryOverQueryService bool
+
 	trace *trace.Table
 
 	clock clockwork.Clock
@@ -196,6 +254,21 @@ func (c *Config) SizeLimit() int {
 	return c.sizeLimit
 }
 
+// MinSize is a number of idle sessions which the pool creates at start and maintains in background
+func (c *Config) MinSize() int {
+	return c.minSize
+}
+
+// Interceptors returns interceptors of executing statements
+func (c *Config) Interceptors() []interceptor.Interceptor {
+	return c.interceptors
+}
+
+// SubPools returns limits of named sub-pools of sessions
+func (c *Config) SubPools() map[string]int {
+	return c.subPools
+}
+
 func (c *Config) SessionUsageLimit() uint64 {
 	return c.sessionUsageLimit
 }
@@ -217,6 +290,11 @@ func (c *Config) IgnoreTruncated() bool {
 	return c.ignoreTruncated
 }
 
+// ExecuteDataQueryOverQueryService specifies that data queries executes with query service
+func (c *Config) ExecuteDataQueryOverQueryService() bool {
+	return c.executeDataQueryOverQueryService
+}
+
 // IdleKeepAliveThreshold is a number of keepAlive messages to call before the
 // session is removed if it is an excess session (see KeepAliveMinSize)
 // This means that session will be deleted after the expiration of lifetime = IdleThreshold * IdleKeepAliveThreshold
@@ -231,6 +309,11 @@ func (c *Config) IdleKeepAliveThreshold() int {
 	return DefaultIdleKeepAliveThreshold
 }
 
+// SessionMaxLifetime limits total lifetime of session
+func (c *Config) SessionMaxLifetime() time.Duration {
+	return c.sessionMaxLifetime
+}
+
 // IdleThreshold is a maximum duration between any activity within session.
 // If this threshold reached, idle session will be closed
 //
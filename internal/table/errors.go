@@ -19,4 +19,7 @@ var (
 
 	// errParamsRequired returned by a Client instance to indicate that required params is not defined
 	errParamsRequired = xerrors.Wrap(errors.New("params required"))
+
+	// errWrongKeys returned by session.DeleteRows if keys is not a list of structs
+	errWrongKeys = xerrors.Wrap(errors.New("keys must be a List of Struct values"))
 )
@@ -0,0 +1,147 @@
+package table
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// executeDataQueryOverQueryService executes data query with ExecuteQuery call of query service
+// in the same session and makes result of table service from received response parts
+func (s *session) executeDataQueryOverQueryService(
+	ctx context.Context, request *Ydb_Table.ExecuteDataQueryRequest, callOptions ...grpc.CallOption,
+) (_ *Ydb_Table.ExecuteQueryResult, err error) {
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := s.queryService.ExecuteQuery(ctx, &Ydb_Query.ExecuteQueryRequest{
+		SessionId: request.GetSessionId(),
+		ExecMode:  Ydb_Query.ExecMode_EXEC_MODE_EXECUTE,
+		TxControl: queryTxControl(request.GetTxControl()),
+		Query: &Ydb_Query.ExecuteQueryRequest_QueryContent{
+			QueryContent: &Ydb_Query.QueryContent{
+				Syntax: Ydb_Query.Syntax_SYNTAX_YQL_V1,
+				Text:   request.GetQuery().GetYqlText(),
+			},
+		},
+		Parameters: request.GetParameters(),
+		StatsMode:  queryStatsMode(request.GetCollectStats()),
+	}, callOptions...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	result := &Ydb_Table.ExecuteQueryResult{}
+	for {
+		part, err := stream.Recv()
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return result, nil
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		if part.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(part)))
+		}
+
+		if txMeta := part.GetTxMeta(); txMeta != nil {
+			result.TxMeta = &Ydb_Table.TransactionMeta{
+				Id: txMeta.GetId(),
+			}
+		}
+
+		if execStats := part.GetExecStats(); execStats != nil {
+			result.QueryStats = execStats
+		}
+
+		if rs := part.GetResultSet(); rs != nil {
+			idx := int(part.GetResultSetIndex())
+			for len(result.GetResultSets()) <= idx {
+				result.ResultSets = append(result.ResultSets, &Ydb.ResultSet{})
+			}
+			target := result.GetResultSets()[idx]
+			if len(target.GetColumns()) == 0 {
+				target.Columns = rs.GetColumns()
+			}
+			target.Rows = append(target.GetRows(), rs.GetRows()...)
+			target.Truncated = target.GetTruncated() || rs.GetTruncated()
+		}
+	}
+}
+
+func queryTxControl(txControl *Ydb_Table.TransactionControl) *Ydb_Query.TransactionControl {
+	if txControl == nil {
+		return nil
+	}
+
+	control := &Ydb_Query.TransactionControl{
+		CommitTx: txControl.GetCommitTx(),
+	}
+	switch selector := txControl.GetTxSelector().(type) {
+	case *Ydb_Table.TransactionControl_TxId:
+		control.TxSelector = &Ydb_Query.TransactionControl_TxId{
+			TxId: selector.TxId,
+		}
+	case *Ydb_Table.TransactionControl_BeginTx:
+		control.TxSelector = &Ydb_Query.TransactionControl_BeginTx{
+			BeginTx: queryTxSettings(selector.BeginTx),
+		}
+	}
+
+	return control
+}
+
+func queryTxSettings(settings *Ydb_Table.TransactionSettings) *Ydb_Query.TransactionSettings {
+	switch mode := settings.GetTxMode().(type) {
+	case *Ydb_Table.TransactionSettings_OnlineReadOnly:
+		return &Ydb_Query.TransactionSettings{
+			TxMode: &Ydb_Query.TransactionSettings_OnlineReadOnly{
+				OnlineReadOnly: &Ydb_Query.OnlineModeSettings{
+					AllowInconsistentReads: mode.OnlineReadOnly.GetAllowInconsistentReads(),
+				},
+			},
+		}
+	case *Ydb_Table.TransactionSettings_StaleReadOnly:
+		return &Ydb_Query.TransactionSettings{
+			TxMode: &Ydb_Query.TransactionSettings_StaleReadOnly{
+				StaleReadOnly: &Ydb_Query.StaleModeSettings{},
+			},
+		}
+	case *Ydb_Table.TransactionSettings_SnapshotReadOnly:
+		return &Ydb_Query.TransactionSettings{
+			TxMode: &Ydb_Query.TransactionSettings_SnapshotReadOnly{
+				SnapshotReadOnly: &Ydb_Query.SnapshotModeSettings{},
+			},
+		}
+	default:
+		return &Ydb_Query.TransactionSettings{
+			TxMode: &Ydb_Query.TransactionSettings_SerializableReadWrite{
+				SerializableReadWrite: &Ydb_Query.SerializableModeSettings{},
+			},
+		}
+	}
+}
+
+func queryStatsMode(mode Ydb_Table.QueryStatsCollection_Mode) Ydb_Query.StatsMode {
+	switch mode {
+	case Ydb_Table.QueryStatsCollection_STATS_COLLECTION_NONE:
+		return Ydb_Query.StatsMode_STATS_MODE_NONE
+	case Ydb_Table.QueryStatsCollection_STATS_COLLECTION_BASIC:
+		return Ydb_Query.StatsMode_STATS_MODE_BASIC
+	case Ydb_Table.QueryStatsCollection_STATS_COLLECTION_FULL:
+		return Ydb_Query.StatsMode_STATS_MODE_FULL
+	case Ydb_Table.QueryStatsCollection_STATS_COLLECTION_PROFILE:
+		return Ydb_Query.StatsMode_STATS_MODE_PROFILE
+	default:
+		return Ydb_Query.StatsMode_STATS_MODE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,105 @@
+package table
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type queryServiceStub struct {
+	Ydb_Query_V1.QueryServiceClient
+
+	request *Ydb_Query.ExecuteQueryRequest
+	parts   []*Ydb_Query.ExecuteQueryResponsePart
+}
+
+type executeQueryStreamStub struct {
+	Ydb_Query_V1.QueryService_ExecuteQueryClient
+
+	parts []*Ydb_Query.ExecuteQueryResponsePart
+}
+
+func (s *queryServiceStub) ExecuteQuery(
+	ctx context.Context, in *Ydb_Query.ExecuteQueryRequest, opts ...grpc.CallOption,
+) (Ydb_Query_V1.QueryService_ExecuteQueryClient, error) {
+	s.request = in
+
+	return &executeQueryStreamStub{parts: s.parts}, nil
+}
+
+func (s *executeQueryStreamStub) Recv() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+	if len(s.parts) == 0 {
+		return nil, io.EOF
+	}
+	part := s.parts[0]
+	s.parts = s.parts[1:]
+
+	return part, nil
+}
+
+func TestExecuteDataQueryOverQueryService(t *testing.T) {
+	column := &Ydb.Column{
+		Name: "a",
+		Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}},
+	}
+	row := func(v int32) *Ydb.Value {
+		return &Ydb.Value{Items: []*Ydb.Value{{Value: &Ydb.Value_Int32Value{Int32Value: v}}}}
+	}
+	queryService := &queryServiceStub{
+		parts: []*Ydb_Query.ExecuteQueryResponsePart{
+			{
+				Status:         Ydb.StatusIds_SUCCESS,
+				ResultSetIndex: 0,
+				ResultSet:      &Ydb.ResultSet{Columns: []*Ydb.Column{column}, Rows: []*Ydb.Value{row(1)}},
+				TxMeta:         &Ydb_Query.TransactionMeta{Id: "tx-1"},
+			},
+			{
+				Status:         Ydb.StatusIds_SUCCESS,
+				ResultSetIndex: 0,
+				ResultSet:      &Ydb.ResultSet{Rows: []*Ydb.Value{row(2)}},
+			},
+		},
+	}
+	var overQueryService bool
+	s := &session{
+		id: "session-1",
+		config: config.New(
+			config.WithExecuteDataQueryOverQueryService(true),
+			config.WithTrace(&trace.Table{
+				OnSessionQueryExecute: func(info trace.TableExecuteDataQueryStartInfo) func(
+					trace.TableExecuteDataQueryDoneInfo,
+				) {
+					overQueryService = info.OverQueryService
+
+					return nil
+				},
+			}),
+		),
+		queryService: queryService,
+	}
+
+	tx, res, err := s.Execute(context.Background(),
+		table.TxControl(table.BeginTx(table.WithOnlineReadOnly(table.WithInconsistentReads()))),
+		"SELECT a FROM t", nil,
+	)
+	require.NoError(t, err)
+	require.True(t, overQueryService)
+	require.Equal(t, "tx-1", tx.ID())
+	require.Equal(t, "session-1", queryService.request.GetSessionId())
+	require.Equal(t, "SELECT a FROM t", queryService.request.GetQueryContent().GetText())
+	require.True(t, queryService.request.GetTxControl().GetBeginTx().GetOnlineReadOnly().GetAllowInconsistentReads())
+
+	require.True(t, res.NextResultSet(context.Background()))
+	require.EqualValues(t, 2, res.CurrentResultSet().RowCount())
+	require.NoError(t, res.Err())
+}
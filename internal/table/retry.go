@@ -18,6 +18,8 @@ type sessionPool interface {
 	closer.Closer
 
 	Stats() pool.Stats
+	SetLimit(limit int)
+	Drain(ctx context.Context) error
 	With(ctx context.Context, f func(ctx context.Context, s *session) error, opts ...retry.Option) error
 }
 
@@ -37,17 +39,9 @@ func do(
 				}
 			}()
 
-			err = func() error {
-				if panicCallback := config.PanicCallback(); panicCallback != nil {
-					defer func() {
-						if e := recover(); e != nil {
-							panicCallback(e)
-						}
-					}()
-				}
-
+			err = xerrors.WithRecover(config.PanicCallback(), func() error {
 				return op(xcontext.MarkRetryCall(ctx), s)
-			}()
+			})
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
@@ -7,10 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	internalConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pool"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -157,6 +159,26 @@ func TestDoCreateSessionError(t *testing.T) {
 	})
 }
 
+func TestDoPanicRecover(t *testing.T) {
+	var (
+		recovered interface{}
+		common    internalConfig.Common
+	)
+	internalConfig.SetPanicCallback(&common, func(e interface{}) {
+		recovered = e
+	})
+	err := do(xtest.Context(t), SingleSession(simpleSession(t)),
+		config.New(config.With(common)),
+		func(ctx context.Context, s table.Session) error {
+			panic("test panic")
+		},
+		nil,
+	)
+	require.Error(t, err)
+	require.True(t, xerrors.IsPanic(err))
+	require.Equal(t, "test panic", recovered)
+}
+
 func TestDoImmediateReturn(t *testing.T) {
 	for _, testErr := range []error{
 		xerrors.Operation(
@@ -484,6 +506,12 @@ func (s *singleSession) Stats() pool.Stats {
 	}
 }
 
+func (s *singleSession) SetLimit(int) {}
+
+func (s *singleSession) Drain(context.Context) error {
+	return nil
+}
+
 func (s *singleSession) With(ctx context.Context,
 	f func(ctx context.Context, s *session) error, opts ...retry.Option,
 ) error {
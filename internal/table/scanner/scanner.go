@@ -767,6 +767,26 @@ func (s *valueScanner) setByte(dst *[]byte) {
 	}
 }
 
+// tryUnmarshalJSON decodes Json or JsonDocument value into Go value (struct, map, slice, etc.)
+func (s *valueScanner) tryUnmarshalJSON(v interface{}) bool {
+	var data []byte
+	switch t := s.getType(); {
+	case t != internalTypes.JSON && t != internalTypes.JSONDocument:
+		return false
+	case s.isNull():
+		data = []byte("null")
+	case t == internalTypes.JSON:
+		data = s.converter.JSON()
+	default:
+		data = s.converter.JSONDocument()
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		_ = s.errorf(0, "json.Unmarshal error: %w", err)
+	}
+
+	return true
+}
+
 func (s *valueScanner) trySetByteArray(v interface{}, optional, def bool) bool {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() == reflect.Ptr {
@@ -853,6 +873,10 @@ func (s *valueScanner) scanRequired(v interface{}) {
 		*v = s.value()
 	case *decimal.Decimal:
 		*v = s.unwrapDecimal()
+	case value.ValueUnmarshaler:
+		if err := v.UnmarshalYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueUnmarshaler error: %w", err)
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -877,7 +901,7 @@ func (s *valueScanner) scanRequired(v interface{}) {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
 	default:
-		ok := s.trySetByteArray(v, false, false)
+		ok := s.trySetByteArray(v, false, false) || s.tryUnmarshalJSON(v)
 		if !ok {
 			_ = s.errorf(0, "scan row failed: type %T is unknown", v)
 		}
@@ -1045,6 +1069,10 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 			src := s.unwrapDecimal()
 			*v = &src
 		}
+	case value.ValueUnmarshaler:
+		if err := v.UnmarshalYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueUnmarshaler error: %w", err)
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -1079,7 +1107,7 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		}
 	default:
 		s.unwrap()
-		ok := s.trySetByteArray(v, true, false)
+		ok := s.trySetByteArray(v, true, false) || s.tryUnmarshalJSON(v)
 		if !ok {
 			rv := reflect.TypeOf(v)
 			if rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Ptr {
@@ -1137,6 +1165,10 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		if err != nil {
 			_ = s.errorf(0, "sql.Scanner error: %w", err)
 		}
+	case value.ValueUnmarshaler:
+		if err := v.UnmarshalYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueUnmarshaler error: %w", err)
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
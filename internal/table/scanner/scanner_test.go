@@ -777,3 +777,25 @@ func TestScanToJsonUnmarshaller(t *testing.T) {
 		}
 	}
 }
+
+func TestScanJSONToGoValue(t *testing.T) {
+	s := initScanner()
+	set, _ := getResultSet(2, []*column{{
+		name:   "json",
+		typeID: Ydb.Type_JSON,
+	}, {
+		name:     "jsondocument",
+		typeID:   Ydb.Type_JSON_DOCUMENT,
+		optional: true,
+	}})
+	s.reset(set)
+	for s.NextRow() {
+		var (
+			v    json.Number
+			vPtr *json.Number
+		)
+		require.NoError(t, s.Scan(indexed.Required(&v), indexed.Optional(&vPtr)))
+		require.NotEmpty(t, v)
+		require.NotNil(t, vPtr)
+	}
+}
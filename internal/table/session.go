@@ -9,6 +9,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
@@ -20,6 +21,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/interceptor"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
@@ -49,6 +51,7 @@ type session struct {
 	onClose      []func(s *session)
 	id           string
 	tableService Ydb_Table_V1.TableServiceClient
+	queryService Ydb_Query_V1.QueryServiceClient
 	status       table.SessionStatus
 	config       *config.Config
 	lastUsage    atomic.Int64
@@ -153,7 +156,7 @@ func newSession(ctx context.Context, cc grpc.ClientConnInterface, config *config
 	}
 	s.lastUsage.Store(time.Now().Unix())
 
-	s.tableService = Ydb_Table_V1.NewTableServiceClient(
+	sessionConn := conn.WithErrorModifier(
 		conn.WithBeforeFunc(
 			conn.WithContextModifier(cc, func(ctx context.Context) context.Context {
 				return meta.WithTrailerCallback(balancerContext.WithNodeID(ctx, s.NodeID()), s.checkCloseHint)
@@ -162,7 +165,10 @@ func newSession(ctx context.Context, cc grpc.ClientConnInterface, config *config
 				s.lastUsage.Store(time.Now().Unix())
 			},
 		),
+		s.checkBadSession,
 	)
+	s.tableService = Ydb_Table_V1.NewTableServiceClient(sessionConn)
+	s.queryService = Ydb_Query_V1.NewQueryServiceClient(sessionConn)
 
 	return s, nil
 }
@@ -509,6 +515,21 @@ func (s *session) DropTable(
 	return xerrors.WithStackTrace(err)
 }
 
+// checkBadSession invalidates broken session (so the pool will close it) and attaches identity of session to error
+func (s *session) checkBadSession(err error) error {
+	if !xerrors.IsBadSession(err) {
+		return err
+	}
+
+	s.statusMtx.Lock()
+	if s.status != table.SessionClosed {
+		s.status = table.SessionClosing
+	}
+	s.statusMtx.Unlock()
+
+	return xerrors.WithSessionInfo(err, s.ID(), s.NodeID())
+}
+
 func (s *session) checkError(err error) {
 	if err == nil {
 		return
@@ -792,6 +813,48 @@ func (s *session) Execute(
 	opts ...options.ExecuteDataQueryOption,
 ) (
 	txr table.Transaction, r result.Result, err error,
+) {
+	err = s.intercept(ctx, query, parameters, func(ctx context.Context, query string, parameters *params.Parameters) (
+		err error,
+	) {
+		txr, r, err = s.execute(ctx, txControl, query, parameters, opts...)
+
+		return err
+	})
+	if err != nil {
+		return txr, r, xerrors.WithStackTrace(err)
+	}
+
+	return txr, r, nil
+}
+
+// intercept calls execute of statement through configured interceptors
+func (s *session) intercept(ctx context.Context, query string, parameters *params.Parameters,
+	execute func(ctx context.Context, query string, parameters *params.Parameters) error,
+) error {
+	interceptors := s.config.Interceptors()
+	if len(interceptors) == 0 {
+		return execute(ctx, query, parameters)
+	}
+
+	return interceptor.Chain(interceptor.ExecutorFunc(func(ctx context.Context, stmt interceptor.Statement) error {
+		return execute(ctx, stmt.Query, stmt.Params)
+	}), interceptors...).Execute(ctx, interceptor.Statement{
+		Service:   interceptor.ServiceTable,
+		SessionID: s.id,
+		Query:     query,
+		Params:    parameters,
+	})
+}
+
+func (s *session) execute(
+	ctx context.Context,
+	txControl *table.TransactionControl,
+	query string,
+	parameters *params.Parameters,
+	opts ...options.ExecuteDataQueryOption,
+) (
+	txr table.Transaction, r result.Result, err error,
 ) {
 	var (
 		a       = allocator.New()
@@ -827,6 +890,7 @@ func (s *session) Execute(
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*session).Execute"),
 		s, q, parameters,
 		request.QueryCachePolicy.GetKeepInCache(),
+		s.config.ExecuteDataQueryOverQueryService(),
 	)
 	defer func() {
 		onDone(txr, false, r, err)
@@ -875,6 +939,10 @@ func (s *session) executeDataQuery(
 	_ *Ydb_Table.ExecuteQueryResult,
 	err error,
 ) {
+	if s.config.ExecuteDataQueryOverQueryService() && request.GetQuery().GetYqlText() != "" {
+		return s.executeDataQueryOverQueryService(ctx, request, callOptions...)
+	}
+
 	var (
 		result   = a.TableExecuteQueryResult()
 		response *Ydb_Table.ExecuteDataQueryResponse
@@ -1171,6 +1239,56 @@ func (s *session) ReadRows(
 	), nil
 }
 
+// DeleteRows deletes rows of table at given path by primary keys in batches.
+// Each batch deletes in separated transaction.
+func (s *session) DeleteRows(
+	ctx context.Context,
+	path string,
+	keys value.Value,
+	opts ...options.DeleteRowsOption,
+) (err error) {
+	desc := options.DeleteRowsDesc{
+		BatchSize: options.DefaultDeleteRowsBatchSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyDeleteRowsOption(&desc)
+		}
+	}
+
+	list, ok := keys.(interface{ ListItems() []value.Value })
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: got %s", errWrongKeys, keys.Type().Yql()))
+	}
+	listType, ok := keys.Type().(*types.List)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: got %s", errWrongKeys, keys.Type().Yql()))
+	}
+	if _, ok := listType.ItemType().(*types.Struct); !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: got %s", errWrongKeys, keys.Type().Yql()))
+	}
+
+	query := fmt.Sprintf("DECLARE $keys AS %s;\nDELETE FROM `%s` ON SELECT * FROM AS_TABLE($keys);",
+		keys.Type().Yql(), path,
+	)
+	for items := list.ListItems(); len(items) > 0; {
+		n := len(items)
+		if desc.BatchSize > 0 && desc.BatchSize < n {
+			n = desc.BatchSize
+		}
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(), query,
+			params.Builder{}.Param("$keys").Any(value.ListValue(items[:n]...)).Build(),
+		)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		_ = res.Close()
+		items = items[n:]
+	}
+
+	return nil
+}
+
 // StreamExecuteScanQuery scan-reads table at given path with given options.
 //
 // Note that given ctx controls the lifetime of the whole read, not only this
@@ -1183,6 +1301,26 @@ func (s *session) StreamExecuteScanQuery(
 	query string,
 	parameters *params.Parameters,
 	opts ...options.ExecuteScanQueryOption,
+) (r result.StreamResult, err error) {
+	err = s.intercept(ctx, query, parameters, func(ctx context.Context, query string, parameters *params.Parameters) (
+		err error,
+	) {
+		r, err = s.streamExecuteScanQuery(ctx, query, parameters, opts...)
+
+		return err
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r, nil
+}
+
+func (s *session) streamExecuteScanQuery(
+	ctx context.Context,
+	query string,
+	parameters *params.Parameters,
+	opts ...options.ExecuteScanQueryOption,
 ) (_ result.StreamResult, err error) {
 	var (
 		a      = allocator.New()
@@ -26,6 +26,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -86,6 +87,24 @@ func TestSessionKeepAlive(t *testing.T) {
 	}
 }
 
+func TestSessionCheckBadSession(t *testing.T) {
+	s := &session{
+		id:     "ydb://session/3?node_id=5&id=test",
+		status: table.SessionReady,
+	}
+	testErr := errors.New("test")
+	require.Equal(t, testErr, s.checkBadSession(testErr))
+	require.Equal(t, table.SessionReady, s.Status())
+
+	err := s.checkBadSession(xerrors.WithStackTrace(xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION))))
+	require.Equal(t, table.SessionClosing, s.Status())
+	require.False(t, s.IsAlive())
+	sessionErr := xerrors.ToSessionError(err)
+	require.NotNil(t, sessionErr)
+	require.Equal(t, s.ID(), sessionErr.SessionID())
+	require.EqualValues(t, 5, sessionErr.NodeID())
+}
+
 func TestSessionDescribeTable(t *testing.T) {
 	ctx, cancel := xcontext.WithCancel(context.Background())
 	defer cancel()
@@ -894,3 +913,54 @@ func TestRenameTables(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRows(t *testing.T) {
+	type request struct {
+		query    string
+		keys     int
+		commitTx bool
+	}
+	var requests []request
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableExecuteDataQuery: func(r interface{}) (proto.Message, error) {
+					req := r.(*Ydb_Table.ExecuteDataQueryRequest)
+					requests = append(requests, request{
+						query:    req.GetQuery().GetYqlText(),
+						keys:     len(req.GetParameters()["$keys"].GetValue().GetItems()),
+						commitTx: req.GetTxControl().GetCommitTx(),
+					})
+
+					return &Ydb_Table.ExecuteQueryResult{}, nil
+				},
+			},
+		),
+	), config.New())
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+		config:       config.New(),
+	}
+	ctx, cancel := xcontext.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	keys := make([]value.Value, 0, 5)
+	for i := 0; i < 5; i++ {
+		keys = append(keys, value.StructValue(value.StructValueField{Name: "id", V: value.Uint64Value(uint64(i))}))
+	}
+	err := s.DeleteRows(ctx, "/local/series", value.ListValue(keys...), options.WithDeleteRowsBatchSize(2))
+	require.NoError(t, err)
+	query := "DECLARE $keys AS List<Struct<'id':Uint64>>;\n" +
+		"DELETE FROM `/local/series` ON SELECT * FROM AS_TABLE($keys);"
+	require.Equal(t, []request{
+		{query: query, keys: 2, commitTx: true},
+		{query: query, keys: 2, commitTx: true},
+		{query: query, keys: 1, commitTx: true},
+	}, requests)
+
+	err = s.DeleteRows(ctx, "/local/series", value.ListValue(value.Uint64Value(1)))
+	require.ErrorIs(t, err, errWrongKeys)
+	err = s.DeleteRows(ctx, "/local/series", value.Uint64Value(1))
+	require.ErrorIs(t, err, errWrongKeys)
+	require.Len(t, requests, 3)
+}
@@ -65,6 +65,7 @@ func (s *statement) Execute(
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/v3/internal/table.(*statement).Execute"),
 		s.session, s.query, parameters,
 		request.QueryCachePolicy.GetKeepInCache(),
+		false,
 	)
 	defer func() {
 		onDone(txr, true, r, err)
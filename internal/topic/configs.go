@@ -1,11 +1,28 @@
 package topic
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 type Config struct {
 	config.Common
 	Trace *trace.Topic
+
+	// AutoCreate enables creation of missing topics and consumers on start of readers and writers
+	AutoCreate *AutoCreateConfig
+
+	// DefaultWriteTimeout limits Write of writers if context of Write has no deadline
+	DefaultWriteTimeout time.Duration
+}
+
+// AutoCreateConfig describes topics and consumers created on start of readers and writers
+type AutoCreateConfig struct {
+	// CreateTopic fills request of creation of missing topic
+	CreateTopic func(req *rawtopic.CreateTopicRequest)
+	// Consumer fills settings of missing consumer of reader, name of the consumer sets by reader
+	Consumer func(consumer *rawtopic.Consumer)
 }
@@ -0,0 +1,115 @@
+package topicclientinternal
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// autoCreator creates missing topic and consumer before first successful connection of reader or writer
+type autoCreator struct {
+	c        *Client
+	paths    []string
+	consumer string
+
+	done atomic.Bool
+}
+
+func (c *Client) newAutoCreator(paths []string, consumer string) *autoCreator {
+	if c.cfg.AutoCreate == nil {
+		return nil
+	}
+
+	return &autoCreator{
+		c:        c,
+		paths:    paths,
+		consumer: consumer,
+	}
+}
+
+func (a *autoCreator) ensure(ctx context.Context) error {
+	if a == nil || a.done.Load() {
+		return nil
+	}
+
+	for _, path := range a.paths {
+		if err := a.c.ensureTopic(ctx, path, a.consumer); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to auto create topic %q: %w", path, err))
+		}
+	}
+	a.done.Store(true)
+
+	return nil
+}
+
+// ensureTopic creates topic with consumer if topic not exists or adds consumer to existing topic if it is missing
+func (c *Client) ensureTopic(ctx context.Context, path, consumer string) error {
+	description, err := c.rawClient.DescribeTopic(ctx, rawtopic.DescribeTopicRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+	})
+	switch {
+	case err == nil:
+		return c.ensureConsumer(ctx, path, consumer, description.Consumers)
+	case !xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR):
+		return xerrors.WithStackTrace(err)
+	}
+
+	req := &rawtopic.CreateTopicRequest{}
+	c.cfg.AutoCreate.CreateTopic(req)
+	req.OperationParams = c.defaultOperationParams
+	req.Path = path
+	if consumer != "" {
+		req.Consumers = append(req.Consumers, c.autoCreateConsumer(consumer))
+	}
+
+	_, err = c.rawClient.CreateTopic(ctx, req)
+	if !xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return xerrors.WithStackTrace(err)
+	}
+
+	// topic created concurrently by other reader or writer, it may be created without the consumer
+	description, err = c.rawClient.DescribeTopic(ctx, rawtopic.DescribeTopicRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return c.ensureConsumer(ctx, path, consumer, description.Consumers)
+}
+
+func (c *Client) ensureConsumer(ctx context.Context, path, consumer string, existed []rawtopic.Consumer) error {
+	if consumer == "" {
+		return nil
+	}
+	for i := range existed {
+		if existed[i].Name == consumer {
+			return nil
+		}
+	}
+
+	_, err := c.rawClient.AlterTopic(ctx, &rawtopic.AlterTopicRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		AddConsumers:    []rawtopic.Consumer{c.autoCreateConsumer(consumer)},
+	})
+	if xerrors.IsOperationError(err, Ydb.StatusIds_ALREADY_EXISTS) {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(err)
+}
+
+func (c *Client) autoCreateConsumer(name string) rawtopic.Consumer {
+	consumer := rawtopic.Consumer{Name: name}
+	c.cfg.AutoCreate.Consumer(&consumer)
+
+	return consumer
+}
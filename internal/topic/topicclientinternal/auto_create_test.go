@@ -0,0 +1,118 @@
+package topicclientinternal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type topicServiceStub struct {
+	Ydb_Topic_V1.TopicServiceClient
+
+	topics map[string]*Ydb_Topic.DescribeTopicResult
+}
+
+func (s *topicServiceStub) DescribeTopic(
+	ctx context.Context, in *Ydb_Topic.DescribeTopicRequest, opts ...grpc.CallOption,
+) (*Ydb_Topic.DescribeTopicResponse, error) {
+	description, ok := s.topics[in.GetPath()]
+	if !ok {
+		// operation errors returns by connection of driver
+		return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+	}
+	result, err := anypb.New(description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_Topic.DescribeTopicResponse{
+		Operation: &Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_SUCCESS, Result: result},
+	}, nil
+}
+
+func (s *topicServiceStub) CreateTopic(
+	ctx context.Context, in *Ydb_Topic.CreateTopicRequest, opts ...grpc.CallOption,
+) (*Ydb_Topic.CreateTopicResponse, error) {
+	s.topics[in.GetPath()] = &Ydb_Topic.DescribeTopicResult{
+		Self:                 &Ydb_Scheme.Entry{Name: in.GetPath()},
+		PartitioningSettings: &Ydb_Topic.PartitioningSettings{},
+		RetentionPeriod:      in.GetRetentionPeriod(),
+		Consumers:            in.GetConsumers(),
+	}
+
+	return &Ydb_Topic.CreateTopicResponse{
+		Operation: &Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_SUCCESS},
+	}, nil
+}
+
+func (s *topicServiceStub) AlterTopic(
+	ctx context.Context, in *Ydb_Topic.AlterTopicRequest, opts ...grpc.CallOption,
+) (*Ydb_Topic.AlterTopicResponse, error) {
+	description := s.topics[in.GetPath()]
+	description.Consumers = append(description.Consumers, in.GetAddConsumers()...)
+
+	return &Ydb_Topic.AlterTopicResponse{
+		Operation: &Ydb_Operations.Operation{Ready: true, Status: Ydb.StatusIds_SUCCESS},
+	}, nil
+}
+
+func TestAutoCreator(t *testing.T) {
+	ctx := context.Background()
+	service := &topicServiceStub{topics: map[string]*Ydb_Topic.DescribeTopicResult{
+		"existed": {
+			Self:                 &Ydb_Scheme.Entry{Name: "existed"},
+			PartitioningSettings: &Ydb_Topic.PartitioningSettings{},
+		},
+	}}
+	c := &Client{
+		cfg: topic.Config{
+			AutoCreate: &topic.AutoCreateConfig{
+				CreateTopic: func(req *rawtopic.CreateTopicRequest) {
+					req.RetentionPeriod = 3600_000_000_000
+				},
+				Consumer: func(consumer *rawtopic.Consumer) {
+					consumer.Important = true
+				},
+			},
+		},
+		rawClient: rawtopic.NewClient(service),
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		require.Nil(t, (&Client{}).newAutoCreator([]string{"topic"}, "consumer"))
+		require.NoError(t, (*autoCreator)(nil).ensure(ctx))
+	})
+	t.Run("CreateTopicWithConsumer", func(t *testing.T) {
+		require.NoError(t, c.newAutoCreator([]string{"created"}, "consumer").ensure(ctx))
+		require.Contains(t, service.topics, "created")
+		require.EqualValues(t, 3600, service.topics["created"].GetRetentionPeriod().GetSeconds())
+		require.Len(t, service.topics["created"].GetConsumers(), 1)
+		require.Equal(t, "consumer", service.topics["created"].GetConsumers()[0].GetName())
+		require.True(t, service.topics["created"].GetConsumers()[0].GetImportant())
+	})
+	t.Run("AddConsumer", func(t *testing.T) {
+		creator := c.newAutoCreator([]string{"existed"}, "consumer")
+		require.NoError(t, creator.ensure(ctx))
+		require.NoError(t, creator.ensure(ctx))
+		require.NoError(t, c.newAutoCreator([]string{"existed"}, "consumer").ensure(ctx))
+		require.Len(t, service.topics["existed"].GetConsumers(), 1)
+		require.Equal(t, "consumer", service.topics["existed"].GetConsumers()[0].GetName())
+	})
+	t.Run("Writer", func(t *testing.T) {
+		require.NoError(t, c.newAutoCreator([]string{"writer"}, "").ensure(ctx))
+		require.Contains(t, service.topics, "writer")
+		require.Empty(t, service.topics["writer"].GetConsumers())
+	})
+}
@@ -33,6 +33,8 @@ type Client struct {
 	cred                   credentials.Credentials
 	defaultOperationParams rawydb.OperationParams
 	rawClient              rawtopic.Client
+
+	closers closers
 }
 
 func New(
@@ -221,6 +223,7 @@ func (c *Client) StartListener(
 	cfg := topiclistenerinternal.NewStreamListenerConfig()
 
 	cfg.Consumer = consumer
+	cfg.PanicCallback = c.cfg.PanicCallback()
 
 	cfg.Selectors = make([]*topicreadercommon.PublicReadSelector, len(readSelectors))
 	for i := range readSelectors {
@@ -239,7 +242,28 @@ func (c *Client) StartListener(
 		return nil, err
 	}
 
-	return topiclistener.NewTopicListener(&c.rawClient, &cfg, handler)
+	closerID, removeCloser := c.closers.reserve()
+	cfg.OnClose = removeCloser
+
+	if cfg.DeadLetter != nil {
+		writer, err := topicwriterinternal.NewWriterReconnector(c.createWriterConfig(cfg.DeadLetter.TargetTopic, nil))
+		if err != nil {
+			return nil, err
+		}
+		cfg.DeadLetter.Writer = writer
+	}
+
+	listener, err := topiclistener.NewTopicListener(&c.rawClient, &cfg, handler)
+	if err != nil {
+		if cfg.DeadLetter != nil {
+			_ = cfg.DeadLetter.Writer.Close(context.Background())
+		}
+
+		return nil, err
+	}
+	c.closers.set(closerID, listener.Close)
+
+	return listener, nil
 }
 
 // StartReader create new topic reader and start pull messages from server
@@ -249,9 +273,19 @@ func (c *Client) StartReader(
 	readSelectors topicoptions.ReadSelectors,
 	opts ...topicoptions.ReaderOption,
 ) (*topicreader.Reader, error) {
+	paths := make([]string, len(readSelectors))
+	for i := range readSelectors {
+		paths[i] = readSelectors[i].Path
+	}
+	autoCreator := c.newAutoCreator(paths, consumer)
+
 	var connector topicreaderinternal.TopicSteamReaderConnect = func(ctx context.Context) (
 		topicreadercommon.RawTopicReaderStream, error,
 	) {
+		if err := autoCreator.ensure(ctx); err != nil {
+			return nil, err
+		}
+
 		return c.rawClient.StreamRead(ctx)
 	}
 
@@ -263,24 +297,36 @@ func (c *Client) StartReader(
 	}
 	opts = append(defaultOpts, opts...)
 
+	closerID, removeCloser := c.closers.reserve()
+	opts = append(opts, topicreaderinternal.WithOnClose(removeCloser))
+
 	internalReader, err := topicreaderinternal.NewReader(&c.rawClient, connector, consumer, readSelectors, opts...)
 	if err != nil {
 		return nil, err
 	}
 	trace.TopicOnReaderStart(internalReader.Tracer(), internalReader.ID(), consumer, err)
 
-	return topicreader.NewReader(internalReader), nil
+	reader := topicreader.NewReader(internalReader)
+	c.closers.set(closerID, reader.Close)
+
+	return reader, nil
 }
 
 // StartWriter create new topic writer wrapper
 func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption) (*topicwriter.Writer, error) {
+	closerID, removeCloser := c.closers.reserve()
+	opts = append(opts, topicwriterinternal.WithOnClose(removeCloser))
+
 	cfg := c.createWriterConfig(topicPath, opts)
 	writer, err := topicwriterinternal.NewWriterReconnector(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	return topicwriter.NewWriter(writer), nil
+	publicWriter := topicwriter.NewWriter(writer)
+	c.closers.set(closerID, publicWriter.Close)
+
+	return publicWriter, nil
 }
 
 func (c *Client) StartTransactionalWriter(
@@ -308,10 +354,16 @@ func (c *Client) createWriterConfig(
 	topicPath string,
 	opts []topicoptions.WriterOption,
 ) topicwriterinternal.WriterReconnectorConfig {
+	autoCreator := c.newAutoCreator([]string{topicPath}, "")
+
 	var connector topicwriterinternal.ConnectFunc = func(ctx context.Context) (
 		topicwriterinternal.RawTopicWriterStream,
 		error,
 	) {
+		if err := autoCreator.ensure(ctx); err != nil {
+			return nil, err
+		}
+
 		return c.rawClient.StreamWrite(ctx)
 	}
 
@@ -321,6 +373,7 @@ func (c *Client) createWriterConfig(
 		topicwriterinternal.WithCommonConfig(c.cfg.Common),
 		topicwriterinternal.WithTrace(c.cfg.Trace),
 		topicwriterinternal.WithCredentials(c.cred),
+		topicwriterinternal.WithDefaultWriteTimeout(c.cfg.DefaultWriteTimeout),
 	}
 
 	options = append(options, opts...)
@@ -0,0 +1,72 @@
+package topicclientinternal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+// closers tracks started readers, listeners and writers for graceful close on drain of client
+type closers struct {
+	mu     xsync.Mutex
+	nextID uint64
+	items  map[uint64]func(ctx context.Context) error
+}
+
+// reserve returns id of closer and callback for remove closer on close of object by user
+func (c *closers) reserve() (id uint64, remove func()) {
+	c.mu.WithLock(func() {
+		c.nextID++
+		id = c.nextID
+	})
+
+	return id, func() {
+		c.mu.WithLock(func() {
+			delete(c.items, id)
+		})
+	}
+}
+
+func (c *closers) set(id uint64, closeFunc func(ctx context.Context) error) {
+	c.mu.WithLock(func() {
+		if c.items == nil {
+			c.items = make(map[uint64]func(ctx context.Context) error)
+		}
+		c.items[id] = closeFunc
+	})
+}
+
+func (c *closers) closeAll(ctx context.Context) error {
+	var items []func(ctx context.Context) error
+	c.mu.WithLock(func() {
+		for _, closeFunc := range c.items {
+			items = append(items, closeFunc)
+		}
+	})
+
+	errs := make([]error, len(items))
+	var wg sync.WaitGroup
+	wg.Add(len(items))
+	for i := range items {
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = items[i](ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// Drain closes started readers, listeners and writers.
+// Readers flush commits of offsets and writers flush messages on close
+func (c *Client) Drain(ctx context.Context) error {
+	return c.closers.closeAll(ctx)
+}
@@ -0,0 +1,39 @@
+package topicclientinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClosers(t *testing.T) {
+	var (
+		c      closers
+		closed []uint64
+	)
+	id1, _ := c.reserve()
+	c.set(id1, func(ctx context.Context) error {
+		closed = append(closed, id1)
+
+		return nil
+	})
+	id2, remove2 := c.reserve()
+	c.set(id2, func(ctx context.Context) error {
+		t.Fatal("closer removed on close by user")
+
+		return nil
+	})
+	remove2()
+
+	require.NoError(t, c.closeAll(context.Background()))
+	require.Equal(t, []uint64{id1}, closed)
+
+	testErr := errors.New("test")
+	id3, _ := c.reserve()
+	c.set(id3, func(ctx context.Context) error {
+		return testErr
+	})
+	require.ErrorIs(t, c.closeAll(context.Background()), testErr)
+}
@@ -0,0 +1,136 @@
+package topiclistenerinternal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Metadata keys of messages which republished to dead-letter topic
+const (
+	DeadLetterMetadataAttempts        = "ydb-dead-letter-attempts"
+	DeadLetterMetadataError           = "ydb-dead-letter-error"
+	DeadLetterMetadataSourceTopic     = "ydb-dead-letter-source-topic"
+	DeadLetterMetadataSourcePartition = "ydb-dead-letter-source-partition"
+	DeadLetterMetadataSourceOffset    = "ydb-dead-letter-source-offset"
+)
+
+var errDeadLetterWriterNotSet = errors.New("ydb: dead-letter writer not set")
+
+// DeadLetterWriter writes messages to dead-letter topic
+type DeadLetterWriter interface {
+	Write(ctx context.Context, messages []topicwriterinternal.PublicMessage) error
+	Flush(ctx context.Context) error
+	Close(ctx context.Context) error
+}
+
+// DeadLetterPolicy describes republishing of messages to dead-letter topic
+// if handler of read messages returns error maxAttempts times for the batch
+type DeadLetterPolicy struct {
+	TargetTopic string
+	MaxAttempts int
+
+	// Writer set by topic client on start of the listener
+	Writer DeadLetterWriter
+}
+
+func (p *DeadLetterPolicy) validate() error {
+	var errs []error
+	if p.TargetTopic == "" {
+		errs = append(errs, errors.New("empty target topic of dead-letter policy"))
+	}
+	if p.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Errorf(
+			"max attempts of dead-letter policy should be greater then 0, now: %v",
+			p.MaxAttempts,
+		))
+	}
+
+	return errors.Join(errs...)
+}
+
+// onReadMessagesWithDeadLetter calls handler for the batch up to MaxAttempts times,
+// then republishes messages of the batch to dead-letter topic and commits the batch
+func (l *streamListener) onReadMessagesWithDeadLetter(batch *topicreadercommon.PublicBatch) error {
+	policy := l.cfg.DeadLetter
+
+	contents := make([][]byte, len(batch.Messages))
+	for i, mess := range batch.Messages {
+		content, err := io.ReadAll(mess)
+		if err != nil {
+			// messages with undecodable content pass to handler as is, without retries
+			topicreadercommon.MessageSetData(mess, content, err)
+			for j := range batch.Messages[:i] {
+				topicreadercommon.MessageSetData(batch.Messages[j], contents[j], nil)
+			}
+
+			return l.onReadMessages(batch)
+		}
+		contents[i] = content
+	}
+
+	var handlerErr error
+	for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+		for i, mess := range batch.Messages {
+			topicreadercommon.MessageSetData(mess, contents[i], nil)
+		}
+
+		handlerErr = l.onReadMessages(batch)
+		if handlerErr == nil {
+			return nil
+		}
+	}
+
+	if err := l.sendToDeadLetter(batch, contents, handlerErr); err != nil {
+		return err
+	}
+
+	return l.sendCommit(batch)
+}
+
+func (l *streamListener) sendToDeadLetter(
+	batch *topicreadercommon.PublicBatch,
+	contents [][]byte,
+	handlerErr error,
+) error {
+	policy := l.cfg.DeadLetter
+	if policy.Writer == nil {
+		return xerrors.WithStackTrace(errDeadLetterWriterNotSet)
+	}
+
+	messages := make([]topicwriterinternal.PublicMessage, len(batch.Messages))
+	for i, mess := range batch.Messages {
+		metadata := make(map[string][]byte, len(mess.Metadata))
+		for key, val := range mess.Metadata {
+			metadata[key] = val
+		}
+		metadata[DeadLetterMetadataAttempts] = []byte(strconv.Itoa(policy.MaxAttempts))
+		metadata[DeadLetterMetadataError] = []byte(handlerErr.Error())
+		metadata[DeadLetterMetadataSourceTopic] = []byte(mess.Topic())
+		metadata[DeadLetterMetadataSourcePartition] = []byte(strconv.FormatInt(mess.PartitionID(), 10))
+		metadata[DeadLetterMetadataSourceOffset] = []byte(strconv.FormatInt(mess.Offset, 10))
+
+		messages[i] = topicwriterinternal.PublicMessage{
+			CreatedAt: mess.CreatedAt,
+			Data:      bytes.NewReader(contents[i]),
+			Metadata:  metadata,
+		}
+	}
+
+	ctx := l.background.Context()
+	if err := policy.Writer.Write(ctx, messages); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to write messages to dead-letter topic: %w", err))
+	}
+	if err := policy.Writer.Flush(ctx); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to flush messages to dead-letter topic: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package topiclistenerinternal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/rekby/fixenv"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+)
+
+type deadLetterWriterStub struct {
+	messages []topicwriterinternal.PublicMessage
+	flushed  bool
+}
+
+func (w *deadLetterWriterStub) Write(ctx context.Context, messages []topicwriterinternal.PublicMessage) error {
+	w.messages = append(w.messages, messages...)
+
+	return nil
+}
+
+func (w *deadLetterWriterStub) Flush(ctx context.Context) error {
+	w.flushed = true
+
+	return nil
+}
+
+func (w *deadLetterWriterStub) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestStreamListener_DeadLetter(t *testing.T) {
+	const (
+		maxAttempts = 3
+		startOffset = 86
+	)
+
+	readResponse := func(e fixenv.Env) *rawtopicreader.ReadResponse {
+		return &rawtopicreader.ReadResponse{
+			ServerMessageMetadata: rawtopiccommon.ServerMessageMetadata{
+				Status: rawydb.StatusSuccess,
+			},
+			BytesSize: 10,
+			PartitionData: []rawtopicreader.PartitionData{
+				{
+					PartitionSessionID: PartitionSession(e).StreamPartitionSessionID,
+					Batches: []rawtopicreader.Batch{
+						{
+							Codec: rawtopiccommon.CodecRaw,
+							MessageData: []rawtopicreader.MessageData{
+								{
+									Offset: startOffset,
+									Data:   []byte("poison"),
+									MetadataItems: []rawtopiccommon.MetadataItem{
+										{Key: "key", Value: []byte("value")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("RepublishAfterMaxAttempts", func(t *testing.T) {
+		e := fixenv.New(t)
+		writer := &deadLetterWriterStub{}
+		StreamListener(e).cfg.Decoders = topicreadercommon.NewDecoderMap()
+		StreamListener(e).cfg.DeadLetter = &DeadLetterPolicy{
+			TargetTopic: "dlq",
+			MaxAttempts: maxAttempts,
+			Writer:      writer,
+		}
+		PartitionSession(e).SetLastReceivedMessageOffset(startOffset - 1)
+
+		handlerErr := errors.New("processing failed")
+		EventHandlerMock(e).EXPECT().OnReadMessages(gomock.Any(), gomock.Any()).DoAndReturn(func(
+			ctx context.Context,
+			event *PublicReadMessages,
+		) error {
+			content, err := io.ReadAll(event.Batch.Messages[0])
+			require.NoError(t, err)
+			require.Equal(t, "poison", string(content))
+
+			return handlerErr
+		}).Times(maxAttempts)
+
+		commitCounter := 0
+		StreamMock(e).EXPECT().Send(gomock.Any()).DoAndReturn(func(message rawtopicreader.ClientMessage) error {
+			commitCounter++
+			require.IsType(t, &rawtopicreader.CommitOffsetRequest{}, message)
+
+			return nil
+		})
+
+		require.NoError(t, StreamListener(e).onReadResponse(readResponse(e)))
+		require.Equal(t, 1, commitCounter)
+		require.True(t, writer.flushed)
+		require.Len(t, writer.messages, 1)
+
+		mess := writer.messages[0]
+		content, err := io.ReadAll(mess.Data)
+		require.NoError(t, err)
+		require.Equal(t, "poison", string(content))
+		require.Equal(t, "value", string(mess.Metadata["key"]))
+		require.Equal(t, "3", string(mess.Metadata[DeadLetterMetadataAttempts]))
+		require.Equal(t, handlerErr.Error(), string(mess.Metadata[DeadLetterMetadataError]))
+		require.Equal(t, "86", string(mess.Metadata[DeadLetterMetadataSourceOffset]))
+	})
+
+	t.Run("SuccessAfterRetry", func(t *testing.T) {
+		e := fixenv.New(t)
+		writer := &deadLetterWriterStub{}
+		StreamListener(e).cfg.Decoders = topicreadercommon.NewDecoderMap()
+		StreamListener(e).cfg.DeadLetter = &DeadLetterPolicy{
+			TargetTopic: "dlq",
+			MaxAttempts: maxAttempts,
+			Writer:      writer,
+		}
+		PartitionSession(e).SetLastReceivedMessageOffset(startOffset - 1)
+
+		attempts := 0
+		EventHandlerMock(e).EXPECT().OnReadMessages(gomock.Any(), gomock.Any()).DoAndReturn(func(
+			ctx context.Context,
+			event *PublicReadMessages,
+		) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("temporary failure")
+			}
+
+			return nil
+		}).Times(2)
+
+		require.NoError(t, StreamListener(e).onReadResponse(readResponse(e)))
+		require.Empty(t, writer.messages)
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		cfg := NewStreamListenerConfig()
+		cfg.Consumer = "consumer"
+		cfg.Selectors = []*topicreadercommon.PublicReadSelector{{Path: "topic"}}
+		cfg.DeadLetter = &DeadLetterPolicy{}
+		require.Error(t, cfg.Validate())
+
+		cfg.DeadLetter = &DeadLetterPolicy{TargetTopic: "dlq", MaxAttempts: 1}
+		require.NoError(t, cfg.Validate())
+	})
+}
@@ -14,7 +14,12 @@ type StreamListenerConfig struct {
 	Selectors              []*topicreadercommon.PublicReadSelector
 	Consumer               string
 	ConnectWithoutConsumer bool
+	PanicCallback          func(e interface{})
+	DeadLetter             *DeadLetterPolicy
 	readerID               int64
+
+	// OnClose calls on close of listener
+	OnClose func()
 }
 
 func NewStreamListenerConfig() StreamListenerConfig {
@@ -49,6 +54,12 @@ func (cfg *StreamListenerConfig) Validate() error {
 		))
 	}
 
+	if cfg.DeadLetter != nil {
+		if err := cfg.DeadLetter.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if len(errs) > 0 {
 		return xerrors.WithStackTrace(xerrors.Wrap(fmt.Errorf(
 			"ydb: topic listener config validation failed: %w",
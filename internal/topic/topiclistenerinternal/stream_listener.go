@@ -298,7 +298,9 @@ func (l *streamListener) onStartPartitionRequest(
 		},
 	)
 
-	err := l.handler.OnStartPartitionSessionRequest(ctx, event)
+	err := l.callHandler(func() error {
+		return l.handler.OnStartPartitionSessionRequest(ctx, event)
+	})
 	if err != nil {
 		return err
 	}
@@ -356,7 +358,9 @@ func (l *streamListener) onStopPartitionRequest(
 		m.CommittedOffset.ToInt64(),
 	)
 
-	if err = l.handler.OnStopPartitionSessionRequest(handlerCtx, event); err != nil {
+	if err = l.callHandler(func() error {
+		return l.handler.OnStopPartitionSessionRequest(handlerCtx, event)
+	}); err != nil {
 		return err
 	}
 
@@ -390,11 +394,12 @@ func (l *streamListener) onReadResponse(m *rawtopicreader.ReadResponse) error {
 	}
 
 	for _, batch := range batches {
-		if err = l.handler.OnReadMessages(batch.Context(), NewPublicReadMessages(
-			topicreadercommon.BatchGetPartitionSession(batch).ToPublic(),
-			batch,
-			l,
-		)); err != nil {
+		if l.cfg.DeadLetter != nil {
+			err = l.onReadMessagesWithDeadLetter(batch)
+		} else {
+			err = l.onReadMessages(batch)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -403,6 +408,21 @@ func (l *streamListener) onReadResponse(m *rawtopicreader.ReadResponse) error {
 	return nil
 }
 
+func (l *streamListener) onReadMessages(batch *topicreadercommon.PublicBatch) error {
+	return l.callHandler(func() error {
+		return l.handler.OnReadMessages(batch.Context(), NewPublicReadMessages(
+			topicreadercommon.BatchGetPartitionSession(batch).ToPublic(),
+			batch,
+			l,
+		))
+	})
+}
+
+// callHandler calls user handler and converts panic in handler into error which stops the stream
+func (l *streamListener) callHandler(f func() error) error {
+	return xerrors.WithRecover(l.cfg.PanicCallback, f)
+}
+
 func (l *streamListener) sendCommit(b *topicreadercommon.PublicBatch) error {
 	commitRanges := topicreadercommon.CommitRanges{
 		Ranges: []topicreadercommon.CommitRange{topicreadercommon.GetCommitRange(b)},
@@ -45,6 +45,10 @@ func NewTopicListenerReconnector(
 }
 
 func (lr *TopicListenerReconnector) Close(ctx context.Context, reason error) error {
+	if lr.streamConfig.OnClose != nil {
+		defer lr.streamConfig.OnClose()
+	}
+
 	var closeErrors []error
 	err := lr.background.Close(ctx, reason)
 	closeErrors = append(closeErrors, err)
@@ -60,6 +64,10 @@ func (lr *TopicListenerReconnector) Close(ctx context.Context, reason error) err
 		}
 	}
 
+	if lr.streamConfig.DeadLetter != nil && lr.streamConfig.DeadLetter.Writer != nil {
+		closeErrors = append(closeErrors, lr.streamConfig.DeadLetter.Writer.Close(ctx))
+	}
+
 	return errors.Join(closeErrors...)
 }
 
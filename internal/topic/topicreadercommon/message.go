@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
@@ -94,6 +95,17 @@ func createReader(decoders DecoderMap, codec rawtopiccommon.Codec, rawBytes []by
 	return newOneTimeReader(reader)
 }
 
+// MessageSetData replaces content of the message by uncompressed data for read it again.
+// Non nil readErr returns from read after the data.
+func MessageSetData(m *PublicMessage, data []byte, readErr error) {
+	var reader io.Reader = bytes.NewReader(data)
+	if readErr != nil {
+		reader = io.MultiReader(reader, errorReader{err: readErr})
+	}
+	m.data = newOneTimeReader(reader)
+	m.dataConsumed = false
+}
+
 type errorReader struct {
 	err error
 }
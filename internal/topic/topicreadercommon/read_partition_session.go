@@ -23,6 +23,8 @@ type PartitionSession struct {
 
 	lastReceivedOffsetEndVal atomic.Int64
 	committedOffsetVal       atomic.Int64
+	endOffsetVal             atomic.Int64
+	lagExceeded              atomic.Bool
 }
 
 func NewPartitionSession(
@@ -49,6 +51,7 @@ func NewPartitionSession(
 	}
 	res.committedOffsetVal.Store(committedOffset.ToInt64())
 	res.lastReceivedOffsetEndVal.Store(committedOffset.ToInt64() - 1)
+	res.endOffsetVal.Store(committedOffset.ToInt64())
 
 	return res
 }
@@ -102,6 +105,46 @@ func (s *PartitionSession) SetLastReceivedMessageOffset(v rawtopiccommon.Offset)
 	s.lastReceivedOffsetEndVal.Store(v.ToInt64())
 }
 
+// EndOffset returns known offset after last message of the partition
+func (s *PartitionSession) EndOffset() rawtopiccommon.Offset {
+	v := s.endOffsetVal.Load()
+
+	var res rawtopiccommon.Offset
+	res.FromInt64(v)
+
+	return res
+}
+
+// SetEndOffsetForward set new end offset if new offset greater, then old
+func (s *PartitionSession) SetEndOffsetForward(v rawtopiccommon.Offset) {
+	newVal := int64(v)
+	for {
+		old := s.endOffsetVal.Load()
+		if newVal <= old {
+			return
+		}
+
+		if s.endOffsetVal.CompareAndSwap(old, newVal) {
+			return
+		}
+	}
+}
+
+// Lag returns count of messages between committed offset and end of the partition
+func (s *PartitionSession) Lag() int64 {
+	lag := s.EndOffset().ToInt64() - s.CommittedOffset().ToInt64()
+	if lag < 0 {
+		return 0
+	}
+
+	return lag
+}
+
+// SetLagExceeded stores state of exceed lag threshold and returns true if state changed to exceeded
+func (s *PartitionSession) SetLagExceeded(exceeded bool) (becameExceeded bool) {
+	return s.lagExceeded.Swap(exceeded) != exceeded && exceeded
+}
+
 func (s *PartitionSession) ToPublic() PublicPartitionSession {
 	return PublicPartitionSession{
 		PartitionSessionID: s.ClientPartitionSessionID,
@@ -192,6 +192,44 @@ func (c *MockbatchedStreamReaderReadMessageBatchCall) DoAndReturn(f func(context
 	return c
 }
 
+// Stats mocks base method.
+func (m *MockbatchedStreamReader) Stats() PublicReaderStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(PublicReaderStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockbatchedStreamReaderMockRecorder) Stats() *MockbatchedStreamReaderStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockbatchedStreamReader)(nil).Stats))
+	return &MockbatchedStreamReaderStatsCall{Call: call}
+}
+
+// MockbatchedStreamReaderStatsCall wrap *gomock.Call
+type MockbatchedStreamReaderStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockbatchedStreamReaderStatsCall) Return(arg0 PublicReaderStats) *MockbatchedStreamReaderStatsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockbatchedStreamReaderStatsCall) Do(f func() PublicReaderStats) *MockbatchedStreamReaderStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockbatchedStreamReaderStatsCall) DoAndReturn(f func() PublicReaderStats) *MockbatchedStreamReaderStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WaitInit mocks base method.
 func (m *MockbatchedStreamReader) WaitInit(ctx context.Context) error {
 	m.ctrl.T.Helper()
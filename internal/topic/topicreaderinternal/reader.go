@@ -33,6 +33,8 @@ type Reader struct {
 	defaultBatchConfig ReadMessageBatchOptions
 	tracer             *trace.Topic
 	readerID           int64
+	onClose            func()
+	unmarshaler        PublicUnmarshaler
 }
 
 type ReadMessageBatchOptions struct {
@@ -100,6 +102,8 @@ func NewReader(
 		defaultBatchConfig: cfg.DefaultBatchConfig,
 		tracer:             cfg.Trace,
 		readerID:           readerID,
+		onClose:            cfg.onClose,
+		unmarshaler:        cfg.Unmarshaler,
 	}
 
 	return res, nil
@@ -117,7 +121,16 @@ func (r *Reader) Tracer() *trace.Topic {
 	return r.tracer
 }
 
+// Stats returns state of partitions assigned to the reader
+func (r *Reader) Stats() PublicReaderStats {
+	return r.reader.Stats()
+}
+
 func (r *Reader) Close(ctx context.Context) error {
+	if r.onClose != nil {
+		defer r.onClose()
+	}
+
 	return r.reader.CloseWithError(ctx, xerrors.WithStackTrace(errReaderClosed))
 }
 
@@ -241,6 +254,10 @@ type ReaderConfig struct {
 	RetrySettings      topic.RetrySettings
 	DefaultBatchConfig ReadMessageBatchOptions
 	topicStreamReaderConfig
+
+	Unmarshaler PublicUnmarshaler
+
+	onClose func()
 }
 
 type PublicReaderOption func(cfg *ReaderConfig)
@@ -260,6 +277,13 @@ func WithTrace(tracer *trace.Topic) PublicReaderOption {
 	}
 }
 
+// WithOnClose sets callback which calls on close of reader
+func WithOnClose(onClose func()) PublicReaderOption {
+	return func(cfg *ReaderConfig) {
+		cfg.onClose = onClose
+	}
+}
+
 func convertNewParamsToStreamConfig(
 	consumer string,
 	readSelectors []topicreadercommon.PublicReadSelector,
@@ -0,0 +1,46 @@
+package topicreaderinternal
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+)
+
+// PublicReaderStats contains state of partitions assigned to the reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PublicReaderStats struct {
+	Partitions []PublicPartitionStats
+}
+
+// PublicPartitionStats contains read progress of the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PublicPartitionStats struct {
+	PartitionSessionID int64
+	TopicPath          string
+	PartitionID        int64
+
+	// CommittedOffset is offset of first not committed message
+	CommittedOffset int64
+
+	// ReadOffset is offset of next message for receive from server
+	ReadOffset int64
+
+	// EndOffset is known offset after last message of the partition.
+	// It updates on receive messages and partition status from server.
+	EndOffset int64
+
+	// Lag is count of not committed messages: EndOffset - CommittedOffset
+	Lag int64
+}
+
+func newPublicPartitionStats(session *topicreadercommon.PartitionSession) PublicPartitionStats {
+	return PublicPartitionStats{
+		PartitionSessionID: session.ClientPartitionSessionID,
+		TopicPath:          session.Topic,
+		PartitionID:        session.PartitionID,
+		CommittedOffset:    session.CommittedOffset().ToInt64(),
+		ReadOffset:         session.LastReceivedMessageOffset().ToInt64() + 1,
+		EndOffset:          session.EndOffset().ToInt64(),
+		Lag:                session.Lag(),
+	}
+}
@@ -36,6 +36,9 @@ var (
 	errTopicSelectorsEmpty           = xerrors.Wrap(errors.New("ydb: topic selector for topic reader is empty, see arguments on topic starts"))                             //nolint:lll
 )
 
+// DefaultPartitionStatusInterval is a default interval of requests of status of assigned partitions
+const DefaultPartitionStatusInterval = 10 * time.Second
+
 var clientSessionCounter atomic.Int64
 
 type partitionSessionID = rawtopicreader.PartitionSessionID
@@ -69,6 +72,8 @@ type topicStreamReaderImpl struct {
 type topicStreamReaderConfig struct {
 	CommitterBatchTimeLag           time.Duration
 	CommitterBatchCounterTrigger    int
+	LagThreshold                    int64
+	PartitionStatusInterval         time.Duration
 	BaseContext                     context.Context //nolint:containedctx
 	BufferSizeProtoBytes            int
 	Cred                            credentials.Credentials
@@ -84,14 +89,15 @@ type topicStreamReaderConfig struct {
 
 func newTopicStreamReaderConfig() topicStreamReaderConfig {
 	return topicStreamReaderConfig{
-		BaseContext:           context.Background(),
-		BufferSizeProtoBytes:  topicreadercommon.DefaultBufferSize,
-		Cred:                  credentials.NewAnonymousCredentials(),
-		CredUpdateInterval:    time.Hour,
-		CommitMode:            topicreadercommon.CommitModeAsync,
-		CommitterBatchTimeLag: time.Second,
-		Decoders:              topicreadercommon.NewDecoderMap(),
-		Trace:                 &trace.Topic{},
+		BaseContext:             context.Background(),
+		BufferSizeProtoBytes:    topicreadercommon.DefaultBufferSize,
+		Cred:                    credentials.NewAnonymousCredentials(),
+		CredUpdateInterval:      time.Hour,
+		CommitMode:              topicreadercommon.CommitModeAsync,
+		CommitterBatchTimeLag:   time.Second,
+		PartitionStatusInterval: DefaultPartitionStatusInterval,
+		Decoders:                topicreadercommon.NewDecoderMap(),
+		Trace:                   &trace.Topic{},
 	}
 }
 
@@ -591,6 +597,9 @@ func (r *topicStreamReaderImpl) startBackgroundWorkers() error {
 	r.backgroundWorkers.Start("readMessagesLoop", r.readMessagesLoop)
 	r.backgroundWorkers.Start("dataRequestLoop", r.dataRequestLoop)
 	r.backgroundWorkers.Start("updateTokenLoop", r.updateTokenLoop)
+	if r.cfg.PartitionStatusInterval > 0 {
+		r.backgroundWorkers.Start("partitionStatusLoop", r.partitionStatusLoop)
+	}
 
 	r.backgroundWorkers.Start("consumeRawMessageFromBuffer", r.consumeRawMessageFromBuffer)
 
@@ -711,6 +720,8 @@ func (r *topicStreamReaderImpl) readMessagesLoop(ctx context.Context) {
 
 		case *rawtopicreader.UpdateTokenResponse:
 			r.onUpdateTokenResponse(m)
+		case *rawtopicreader.PartitionSessionStatusResponse:
+			r.onPartitionSessionStatusResponse(m)
 		default:
 			trace.TopicOnReaderUnknownGrpcMessage(
 				r.cfg.Trace,
@@ -791,6 +802,37 @@ func (r *topicStreamReaderImpl) updateTokenLoop(ctx context.Context) {
 	}
 }
 
+// partitionStatusLoop periodically requests status of assigned partitions for update of end offsets.
+// End offsets of idle partitions don't change on read responses, so lag is known from status responses only
+func (r *topicStreamReaderImpl) partitionStatusLoop(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PartitionStatusInterval)
+	defer ticker.Stop()
+
+	readerCancel := ctx.Done()
+	for {
+		select {
+		case <-readerCancel:
+			return
+		case <-ticker.C:
+			if err := r.requestPartitionsStatus(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (r *topicStreamReaderImpl) requestPartitionsStatus() error {
+	for _, session := range r.sessionController.GetAll() {
+		if err := r.send(&rawtopicreader.PartitionSessionStatusRequest{
+			PartitionSessionID: session.StreamPartitionSessionID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse) (err error) {
 	resCapacity := r.addRestBufferBytes(-msg.BytesSize)
 	onDone := trace.TopicOnReaderReceiveDataResponse(r.cfg.Trace, r.readConnectionID, resCapacity, msg)
@@ -804,6 +846,10 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 	}
 
 	for i := range batches {
+		session := topicreadercommon.BatchGetPartitionSession(batches[i])
+		session.SetEndOffsetForward(topicreadercommon.GetCommitRange(batches[i]).CommitOffsetEnd)
+		r.checkPartitionLag(session)
+
 		if err := r.batcher.PushBatches(batches[i]); err != nil {
 			return err
 		}
@@ -812,6 +858,53 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 	return nil
 }
 
+func (r *topicStreamReaderImpl) onPartitionSessionStatusResponse(m *rawtopicreader.PartitionSessionStatusResponse) {
+	session, err := r.sessionController.Get(m.PartitionSessionID)
+	if err != nil {
+		// status of removed session
+		return
+	}
+
+	session.SetEndOffsetForward(m.PartitionOffsets.End)
+	r.checkPartitionLag(session)
+}
+
+// checkPartitionLag calls trace when read lag of the partition exceeds threshold
+func (r *topicStreamReaderImpl) checkPartitionLag(session *topicreadercommon.PartitionSession) {
+	if r.cfg.LagThreshold <= 0 {
+		return
+	}
+
+	lag := session.Lag()
+	if session.SetLagExceeded(lag > r.cfg.LagThreshold) {
+		trace.TopicOnReaderPartitionLag(
+			r.cfg.Trace,
+			r.readConnectionID,
+			session.Context(),
+			session.Topic,
+			session.PartitionID,
+			session.StreamPartitionSessionID.ToInt64(),
+			session.CommittedOffset().ToInt64(),
+			session.EndOffset().ToInt64(),
+			lag,
+			r.cfg.LagThreshold,
+		)
+	}
+}
+
+// Stats returns state of partitions assigned to the stream reader
+func (r *topicStreamReaderImpl) Stats() PublicReaderStats {
+	sessions := r.sessionController.GetAll()
+	res := PublicReaderStats{
+		Partitions: make([]PublicPartitionStats, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		res.Partitions = append(res.Partitions, newPublicPartitionStats(session))
+	}
+
+	return res
+}
+
 func (r *topicStreamReaderImpl) CloseWithError(ctx context.Context, reason error) (closeErr error) {
 	onDone := trace.TopicOnReaderClose(r.cfg.Trace, r.readConnectionID, reason)
 	defer onDone(closeErr)
@@ -861,6 +954,7 @@ func (r *topicStreamReaderImpl) onCommitResponse(msg *rawtopicreader.CommitOffse
 			return fmt.Errorf("ydb: can't found session on commit response: %w", err)
 		}
 		partition.SetCommittedOffsetForward(commit.CommittedOffset)
+		r.checkPartitionLag(partition)
 
 		trace.TopicOnReaderCommittedNotify(
 			r.cfg.Trace,
@@ -903,6 +997,7 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequest(m *rawtopicreader
 		clientSessionCounter.Add(1),
 		m.CommittedOffset,
 	)
+	session.SetEndOffsetForward(m.PartitionOffsets.End)
 	if err := r.sessionController.Add(session); err != nil {
 		return err
 	}
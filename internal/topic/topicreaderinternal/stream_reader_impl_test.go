@@ -1026,6 +1026,7 @@ func newTopicReaderTestEnv(t testing.TB) streamEnv {
 	cfg.BaseContext = ctx
 	cfg.BufferSizeProtoBytes = initialBufferSizeBytes
 	cfg.CommitterBatchTimeLag = 0
+	cfg.PartitionStatusInterval = 0
 
 	topicClientMock := NewMockTopicClient(mc)
 	reader := newTopicStreamReaderStopped(topicClientMock, topicreadercommon.NextReaderID(), stream, cfg)
@@ -1168,6 +1169,60 @@ func (e *streamEnv) closeStream() {
 	})
 }
 
+func TestTopicStreamReaderImpl_StatsAndLag(t *testing.T) {
+	e := newTopicReaderTestEnv(t)
+
+	var lagEvents []trace.TopicReaderPartitionLagInfo
+	e.reader.cfg.LagThreshold = 10
+	e.reader.cfg.Trace = &trace.Topic{
+		OnReaderPartitionLag: func(info trace.TopicReaderPartitionLagInfo) {
+			lagEvents = append(lagEvents, info)
+		},
+	}
+
+	committedOffset := e.partitionSession.CommittedOffset().ToInt64()
+	endOffset := committedOffset + 20
+
+	e.stream.EXPECT().Send(&rawtopicreader.PartitionSessionStatusRequest{
+		PartitionSessionID: e.partitionSessionID,
+	}).Return(nil)
+	require.NoError(t, e.reader.requestPartitionsStatus())
+
+	status := &rawtopicreader.PartitionSessionStatusResponse{PartitionSessionID: e.partitionSessionID}
+	status.PartitionOffsets.End.FromInt64(endOffset)
+	e.reader.onPartitionSessionStatusResponse(status)
+	e.reader.onPartitionSessionStatusResponse(status)
+
+	require.Len(t, lagEvents, 1)
+	require.Equal(t, int64(20), lagEvents[0].Lag)
+	require.Equal(t, int64(10), lagEvents[0].Threshold)
+	require.Equal(t, endOffset, lagEvents[0].EndOffset)
+
+	stats := e.reader.Stats()
+	require.Len(t, stats.Partitions, 1)
+	require.Equal(t, PublicPartitionStats{
+		PartitionSessionID: e.partitionSession.ClientPartitionSessionID,
+		TopicPath:          e.partitionSession.Topic,
+		PartitionID:        e.partitionSession.PartitionID,
+		CommittedOffset:    committedOffset,
+		ReadOffset:         committedOffset,
+		EndOffset:          endOffset,
+		Lag:                20,
+	}, stats.Partitions[0])
+
+	commit := &rawtopicreader.CommitOffsetResponse{
+		PartitionsCommittedOffsets: []rawtopicreader.PartitionCommittedOffset{
+			{PartitionSessionID: e.partitionSessionID},
+		},
+	}
+	commit.PartitionsCommittedOffsets[0].CommittedOffset.FromInt64(endOffset)
+	require.NoError(t, e.reader.onCommitResponse(commit))
+	require.Equal(t, int64(0), e.reader.Stats().Partitions[0].Lag)
+
+	e.reader.onPartitionSessionStatusResponse(status)
+	require.Len(t, lagEvents, 1)
+}
+
 func TestUpdateCommitInTransaction(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		e := newTopicReaderTestEnv(t)
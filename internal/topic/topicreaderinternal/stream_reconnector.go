@@ -195,6 +195,19 @@ func (r *readerReconnector) Commit(
 	return err
 }
 
+// Stats returns state of partitions assigned to current stream reader
+func (r *readerReconnector) Stats() PublicReaderStats {
+	var stream batchedStreamReader
+	r.m.WithRLock(func() {
+		stream = r.streamVal
+	})
+	if stream == nil {
+		return PublicReaderStats{}
+	}
+
+	return stream.Stats()
+}
+
 func (r *readerReconnector) CloseWithError(ctx context.Context, reason error) error {
 	var closeErr error
 	r.closeOnce.Do(func() {
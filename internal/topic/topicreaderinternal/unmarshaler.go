@@ -0,0 +1,40 @@
+package topicreaderinternal
+
+import (
+	"errors"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// MetadataContentType is a key of message metadata with content type of message data
+const MetadataContentType = "content-type"
+
+var errUnmarshalerNotSet = errors.New("ydb: unmarshaler of the reader not set")
+
+// PublicUnmarshaler deserializes data of messages to values
+type PublicUnmarshaler interface {
+	// Unmarshal deserializes data with content type (from message metadata, may be empty) to dst
+	Unmarshal(contentType string, data []byte, dst interface{}) error
+}
+
+func WithUnmarshaler(unmarshaler PublicUnmarshaler) PublicReaderOption {
+	return func(cfg *ReaderConfig) {
+		cfg.Unmarshaler = unmarshaler
+	}
+}
+
+// UnmarshalMessage reads data of message and deserializes it to dst with unmarshaler of the reader
+func (r *Reader) UnmarshalMessage(mess *topicreadercommon.PublicMessage, dst interface{}) error {
+	if r.unmarshaler == nil {
+		return xerrors.WithStackTrace(errUnmarshalerNotSet)
+	}
+
+	data, err := io.ReadAll(mess)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(r.unmarshaler.Unmarshal(string(mess.Metadata[MetadataContentType]), data, dst))
+}
@@ -0,0 +1,218 @@
+package topicserde
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Content types of built-in serializers
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeAvro     = "application/avro"
+)
+
+// wire format of messages with schema id from registry: magic byte and 4 bytes of schema id (big endian)
+const (
+	wireMagicByte   = 0
+	wireHeaderBytes = 5
+)
+
+var (
+	errNotProtoMessage       = errors.New("ydb: value is not a proto.Message")
+	errUnexpectedContentType = errors.New("ydb: unexpected content type of message")
+	errBadWireFormat         = errors.New("ydb: message data hasn't schema registry header")
+	errNoSchema              = errors.New("ydb: avro schema not defined")
+)
+
+// PublicSchemaRegistry resolves schemas of messages to ids and back
+type PublicSchemaRegistry interface {
+	// SchemaID returns id of the schema, the schema may be registered on call
+	SchemaID(schema string) (uint32, error)
+	// Schema returns schema by id
+	Schema(id uint32) (string, error)
+}
+
+func checkContentType(expected, actual string) error {
+	if actual != "" && actual != expected {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %q, expected %q", errUnexpectedContentType, actual, expected))
+	}
+
+	return nil
+}
+
+// JSON serializes values with encoding/json
+type JSON struct{}
+
+func (JSON) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (JSON) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return data, nil
+}
+
+func (JSON) Unmarshal(contentType string, data []byte, dst interface{}) error {
+	if err := checkContentType(ContentTypeJSON, contentType); err != nil {
+		return err
+	}
+
+	return xerrors.WithStackTrace(json.Unmarshal(data, dst))
+}
+
+// Protobuf serializes proto.Message values
+type Protobuf struct{}
+
+func (Protobuf) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (Protobuf) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errNotProtoMessage, v))
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return data, nil
+}
+
+func (Protobuf) Unmarshal(contentType string, data []byte, dst interface{}) error {
+	if err := checkContentType(ContentTypeProtobuf, contentType); err != nil {
+		return err
+	}
+	m, ok := dst.(proto.Message)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %T", errNotProtoMessage, dst))
+	}
+
+	return xerrors.WithStackTrace(proto.Unmarshal(data, m))
+}
+
+// Avro serializes values with avro schema. Values converts to avro through JSON representation,
+// so values must be JSON-compatible with the schema (unions in avro JSON encoding).
+// With schema registry data prefixes with magic byte and id of the schema (Confluent wire format),
+// reader resolves schema of message by the id.
+type Avro struct {
+	schema   string
+	registry PublicSchemaRegistry
+
+	m      sync.Mutex
+	codecs map[string]*goavro.Codec
+}
+
+// NewAvro makes avro serializer. Schema may be empty for readers with registry
+func NewAvro(schema string, registry PublicSchemaRegistry) *Avro {
+	return &Avro{
+		schema:   schema,
+		registry: registry,
+		codecs:   make(map[string]*goavro.Codec),
+	}
+}
+
+func (a *Avro) codec(schema string) (*goavro.Codec, error) {
+	if schema == "" {
+		return nil, xerrors.WithStackTrace(errNoSchema)
+	}
+
+	a.m.Lock()
+	defer a.m.Unlock()
+
+	if codec, ok := a.codecs[schema]; ok {
+		return codec, nil
+	}
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	a.codecs[schema] = codec
+
+	return codec, nil
+}
+
+func (a *Avro) ContentType() string {
+	return ContentTypeAvro
+}
+
+func (a *Avro) Marshal(v interface{}) ([]byte, error) {
+	codec, err := a.codec(a.schema)
+	if err != nil {
+		return nil, err
+	}
+	textual, err := json.Marshal(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	native, _, err := codec.NativeFromTextual(textual)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var header []byte
+	if a.registry != nil {
+		id, err := a.registry.SchemaID(a.schema)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		header = make([]byte, wireHeaderBytes)
+		header[0] = wireMagicByte
+		binary.BigEndian.PutUint32(header[1:], id)
+	}
+
+	data, err := codec.BinaryFromNative(header, native)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return data, nil
+}
+
+func (a *Avro) Unmarshal(contentType string, data []byte, dst interface{}) error {
+	if err := checkContentType(ContentTypeAvro, contentType); err != nil {
+		return err
+	}
+
+	schema := a.schema
+	if a.registry != nil {
+		if len(data) < wireHeaderBytes || data[0] != wireMagicByte {
+			return xerrors.WithStackTrace(errBadWireFormat)
+		}
+		var err error
+		schema, err = a.registry.Schema(binary.BigEndian.Uint32(data[1:wireHeaderBytes]))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		data = data[wireHeaderBytes:]
+	}
+
+	codec, err := a.codec(schema)
+	if err != nil {
+		return err
+	}
+	native, _, err := codec.NativeFromBinary(data)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	textual, err := codec.TextualFromNative(nil, native)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(json.Unmarshal(textual, dst))
+}
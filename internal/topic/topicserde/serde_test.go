@@ -0,0 +1,89 @@
+package topicserde
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type schemaRegistryStub struct {
+	schemas []string
+}
+
+func (r *schemaRegistryStub) SchemaID(schema string) (uint32, error) {
+	for i, s := range r.schemas {
+		if s == schema {
+			return uint32(i), nil
+		}
+	}
+	r.schemas = append(r.schemas, schema)
+
+	return uint32(len(r.schemas) - 1), nil
+}
+
+func (r *schemaRegistryStub) Schema(id uint32) (string, error) {
+	return r.schemas[id], nil
+}
+
+type user struct {
+	Name string `json:"name"`
+	Age  int    `json:"age"`
+}
+
+const userSchema = `{"type":"record","name":"User","fields":[{"name":"name","type":"string"},{"name":"age","type":"int"}]}`
+
+func TestJSON(t *testing.T) {
+	data, err := JSON{}.Marshal(user{Name: "test", Age: 42})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name":"test","age":42}`, string(data))
+
+	var dst user
+	require.NoError(t, JSON{}.Unmarshal(ContentTypeJSON, data, &dst))
+	require.Equal(t, user{Name: "test", Age: 42}, dst)
+
+	require.ErrorIs(t, JSON{}.Unmarshal(ContentTypeAvro, data, &dst), errUnexpectedContentType)
+	require.NoError(t, JSON{}.Unmarshal("", data, &dst))
+}
+
+func TestProtobuf(t *testing.T) {
+	data, err := Protobuf{}.Marshal(wrapperspb.String("test"))
+	require.NoError(t, err)
+
+	dst := &wrapperspb.StringValue{}
+	require.NoError(t, Protobuf{}.Unmarshal(ContentTypeProtobuf, data, dst))
+	require.Equal(t, "test", dst.GetValue())
+
+	_, err = Protobuf{}.Marshal("test")
+	require.ErrorIs(t, err, errNotProtoMessage)
+}
+
+func TestAvro(t *testing.T) {
+	t.Run("WithoutRegistry", func(t *testing.T) {
+		a := NewAvro(userSchema, nil)
+		data, err := a.Marshal(user{Name: "test", Age: 42})
+		require.NoError(t, err)
+
+		var dst user
+		require.NoError(t, a.Unmarshal(ContentTypeAvro, data, &dst))
+		require.Equal(t, user{Name: "test", Age: 42}, dst)
+	})
+	t.Run("WithRegistry", func(t *testing.T) {
+		registry := &schemaRegistryStub{schemas: []string{"other"}}
+		data, err := NewAvro(userSchema, registry).Marshal(user{Name: "test", Age: 42})
+		require.NoError(t, err)
+		require.Equal(t, byte(wireMagicByte), data[0])
+		require.Equal(t, uint32(1), binary.BigEndian.Uint32(data[1:wireHeaderBytes]))
+
+		var dst user
+		require.NoError(t, NewAvro("", registry).Unmarshal(ContentTypeAvro, data, &dst))
+		require.Equal(t, user{Name: "test", Age: 42}, dst)
+
+		require.ErrorIs(t, NewAvro("", registry).Unmarshal(ContentTypeAvro, []byte{1}, &dst), errBadWireFormat)
+	})
+	t.Run("NoSchema", func(t *testing.T) {
+		_, err := NewAvro("", nil).Marshal(user{})
+		require.ErrorIs(t, err, errNoSchema)
+	})
+}
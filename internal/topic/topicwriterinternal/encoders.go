@@ -110,8 +110,18 @@ func NewEncoderSelector(
 	return res
 }
 
+// CompressMessages compresses messages with codec override of messages or with selected codec of the writer.
+// All messages must have same codec override.
 func (s *EncoderSelector) CompressMessages(messages []messageWithDataContent) (rawtopiccommon.Codec, error) {
-	codec, err := s.selectCodec(messages)
+	var (
+		codec rawtopiccommon.Codec
+		err   error
+	)
+	if len(messages) > 0 && messages[0].codecOverride() != rawtopiccommon.CodecUNSPECIFIED {
+		codec = messages[0].codecOverride()
+	} else {
+		codec, err = s.selectCodec(messages)
+	}
 	if err == nil {
 		onCompressDone := trace.TopicOnWriterCompressMessages(
 			s.tracer,
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -206,3 +207,24 @@ func TestCompressMessages(t *testing.T) {
 		require.Error(t, cacheMessages(messages, rawtopiccommon.CodecGzip, parallelCount))
 	})
 }
+
+func TestEncoderSelector_CompressMessagesWithCodecOverride(t *testing.T) {
+	s := NewEncoderSelector(testCommonEncoders, rawtopiccommon.SupportedCodecs{
+		rawtopiccommon.CodecRaw,
+	}, 1, &trace.Topic{}, "", "")
+
+	var messages []messageWithDataContent
+	for i := 0; i < 3; i++ {
+		messages = append(messages, newMessageDataWithContent(PublicMessage{
+			Data:  strings.NewReader("asdf"),
+			Codec: topictypes.CodecGzip,
+		}, testCommonEncoders))
+	}
+
+	codec, err := s.CompressMessages(messages)
+	require.NoError(t, err)
+	require.Equal(t, rawtopiccommon.CodecGzip, codec)
+	for i := range messages {
+		require.Equal(t, rawtopiccommon.CodecGzip, messages[i].bufCodec)
+	}
+}
@@ -0,0 +1,50 @@
+package topicwriterinternal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// MetadataContentType is a key of message metadata with content type of message data
+const MetadataContentType = "content-type"
+
+var errMarshalerNotSet = errors.New("ydb: marshaler of the writer not set")
+
+// PublicMarshaler serializes values to data of messages
+type PublicMarshaler interface {
+	// ContentType returns content type of serialized data, which writes to metadata of messages
+	ContentType() string
+	// Marshal serializes value to bytes
+	Marshal(v interface{}) ([]byte, error)
+}
+
+func WithMarshaler(marshaler PublicMarshaler) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.Marshaler = marshaler
+	}
+}
+
+// MarshalMessages makes messages from values with marshaler of the writer
+func (w *WriterReconnector) MarshalMessages(values []interface{}) ([]PublicMessage, error) {
+	if w.cfg.Marshaler == nil {
+		return nil, xerrors.WithStackTrace(errMarshalerNotSet)
+	}
+
+	contentType := []byte(w.cfg.Marshaler.ContentType())
+	messages := make([]PublicMessage, len(values))
+	for i, v := range values {
+		data, err := w.cfg.Marshaler.Marshal(v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("ydb: failed to marshal value %d: %w", i, err))
+		}
+		messages[i] = PublicMessage{
+			Data:     bytes.NewReader(data),
+			Metadata: map[string][]byte{MetadataContentType: contentType},
+		}
+	}
+
+	return messages, nil
+}
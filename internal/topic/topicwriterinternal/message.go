@@ -11,6 +11,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 var errNoRawContent = xerrors.Wrap(errors.New("ydb: internal state error - no raw message content"))
@@ -21,6 +22,12 @@ type PublicMessage struct {
 	Data      io.Reader
 	Metadata  map[string][]byte
 
+	// Codec overrides codec of the writer for the message, zero value mean codec of the writer.
+	// Codec must be supported by the writer encoders and allowed for the topic.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Codec topictypes.Codec
+
 	tx tx.Transaction
 
 	// partitioning at level message available by protocol, but doesn't available by current server implementation
@@ -71,6 +78,10 @@ type messageWithDataContent struct {
 	BufUncompressedSize int
 }
 
+func (m *messageWithDataContent) codecOverride() rawtopiccommon.Codec {
+	return rawtopiccommon.Codec(m.Codec)
+}
+
 func (m *messageWithDataContent) GetEncodedBytes(codec rawtopiccommon.Codec) ([]byte, error) {
 	if codec == rawtopiccommon.CodecRaw {
 		return m.getRawBytes()
@@ -32,6 +32,9 @@ const (
 type messageQueue struct {
 	OnAckReceived func(count int)
 
+	// OnAckedSeqNo calls with max SeqNo of received acks
+	OnAckedSeqNo func(seqNo int64)
+
 	hasNewMessages    empty.Chan
 	closedErr         error
 	acksReceivedEvent xsync.EventBroadcast
@@ -148,6 +151,7 @@ func (q *messageQueue) addMessageNeedLock(
 
 func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	ackReceivedCounter := 0
+	maxAckedSeqNo := int64(-1)
 	q.m.Lock()
 	defer func() {
 		q.m.Unlock()
@@ -155,6 +159,9 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 		if q.OnAckReceived != nil {
 			q.OnAckReceived(ackReceivedCounter)
 		}
+		if q.OnAckedSeqNo != nil && maxAckedSeqNo >= 0 {
+			q.OnAckedSeqNo(maxAckedSeqNo)
+		}
 	}()
 	if q.closed {
 		return xerrors.WithStackTrace(errAckOnClosedMessageQueue)
@@ -165,6 +172,7 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 			return err
 		}
 		ackReceivedCounter++
+		maxAckedSeqNo = max(maxAckedSeqNo, acks[i].SeqNo)
 	}
 
 	q.acksReceivedEvent.Broadcast()
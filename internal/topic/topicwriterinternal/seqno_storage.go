@@ -0,0 +1,95 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const seqNoSaveRetryDelay = time.Second
+
+// PublicSeqNoStorage is a persistent storage of SeqNo of last acknowledged message of producer.
+// Implementation must be safe for concurrent use.
+type PublicSeqNoStorage interface {
+	// LoadSeqNo returns stored SeqNo for the producer or negative value if nothing stored yet
+	LoadSeqNo(ctx context.Context, producerID string) (int64, error)
+
+	// SaveSeqNo stores SeqNo for the producer
+	SaveSeqNo(ctx context.Context, producerID string, seqNo int64) error
+}
+
+func (w *WriterReconnector) loadStoredSeqNo(ctx context.Context) error {
+	if w.cfg.SeqNoStorage == nil {
+		return nil
+	}
+
+	seqNo, err := w.cfg.SeqNoStorage.LoadSeqNo(ctx, w.cfg.producerID)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: failed to load seqno from storage: %w", err))
+	}
+
+	w.m.WithLock(func() {
+		w.storedSeqNo = max(seqNo, -1)
+	})
+
+	return nil
+}
+
+func (w *WriterReconnector) onAckedSeqNo(seqNo int64) {
+	for {
+		prev := w.ackedSeqNo.Load()
+		if seqNo <= prev {
+			return
+		}
+		if w.ackedSeqNo.CompareAndSwap(prev, seqNo) {
+			break
+		}
+	}
+
+	select {
+	case w.seqNoSaveSignal <- empty.Struct{}:
+	default:
+	}
+}
+
+// seqNoStorageLoop saves acknowledged SeqNo to the storage in background.
+// Saves are coalesced: only the last acknowledged SeqNo is saved.
+func (w *WriterReconnector) seqNoStorageLoop(ctx context.Context) {
+	savedSeqNo := int64(-1)
+
+	save := func(ctx context.Context) {
+		seqNo := w.ackedSeqNo.Load()
+		if seqNo <= savedSeqNo {
+			return
+		}
+		if err := w.cfg.SeqNoStorage.SaveSeqNo(ctx, w.cfg.producerID, seqNo); err != nil {
+			// will be saved on next ack or retry
+			return
+		}
+		savedSeqNo = seqNo
+	}
+
+	timer := time.NewTimer(seqNoSaveRetryDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// flush last acknowledged SeqNo (acks may be received while close of the writer)
+			flushCtx, cancel := xcontext.WithTimeout(xcontext.ValueOnly(ctx), w.cfg.connectTimeout)
+			save(flushCtx)
+			cancel()
+
+			return
+		case <-w.seqNoSaveSignal:
+		case <-timer.C:
+		}
+
+		save(ctx)
+		timer.Reset(seqNoSaveRetryDelay)
+	}
+}
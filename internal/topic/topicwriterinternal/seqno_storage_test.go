@@ -0,0 +1,143 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testSeqNoStorage struct {
+	m       sync.Mutex
+	seqNos  map[string]int64
+	saveErr error
+	loadErr error
+}
+
+func newTestSeqNoStorage() *testSeqNoStorage {
+	return &testSeqNoStorage{seqNos: make(map[string]int64)}
+}
+
+func (s *testSeqNoStorage) LoadSeqNo(ctx context.Context, producerID string) (int64, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.loadErr != nil {
+		return 0, s.loadErr
+	}
+	if seqNo, ok := s.seqNos[producerID]; ok {
+		return seqNo, nil
+	}
+
+	return -1, nil
+}
+
+func (s *testSeqNoStorage) SaveSeqNo(ctx context.Context, producerID string, seqNo int64) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.seqNos[producerID] = seqNo
+
+	return nil
+}
+
+func TestWriterSeqNoStorage(t *testing.T) {
+	t.Run("LoadStoredSeqNo", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		storage := newTestSeqNoStorage()
+		storage.seqNos["test-producer-id"] = 10
+
+		w := newTestWriterStopped(WithSeqNoStorage(storage))
+		require.NoError(t, w.loadStoredSeqNo(ctx))
+
+		w.onWriterChange(&SingleStreamWriter{
+			ReceivedLastSeqNum:  5,
+			LastSeqNumRequested: true,
+		})
+		require.Equal(t, int64(10), w.lastSeqNo)
+
+		info, err := w.WaitInit(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), info.LastSeqNum)
+	})
+	t.Run("ServerSeqNoGreaterThanStored", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		storage := newTestSeqNoStorage()
+		storage.seqNos["test-producer-id"] = 3
+
+		w := newTestWriterStopped(WithSeqNoStorage(storage))
+		require.NoError(t, w.loadStoredSeqNo(ctx))
+
+		w.onWriterChange(&SingleStreamWriter{
+			ReceivedLastSeqNum:  7,
+			LastSeqNumRequested: true,
+		})
+		require.Equal(t, int64(7), w.lastSeqNo)
+	})
+	t.Run("LoadError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		testErr := errors.New("test")
+		storage := newTestSeqNoStorage()
+		storage.loadErr = testErr
+
+		w := newTestWriterStopped(WithSeqNoStorage(storage))
+		require.ErrorIs(t, w.loadStoredSeqNo(ctx), testErr)
+	})
+	t.Run("SaveAckedSeqNo", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		storage := newTestSeqNoStorage()
+
+		w := newTestWriterStopped(WithSeqNoStorage(storage))
+		require.NoError(t, w.loadStoredSeqNo(ctx))
+
+		loopDone := make(chan struct{})
+		go func() {
+			defer close(loopDone)
+			w.seqNoStorageLoop(ctx)
+		}()
+
+		w.onAckedSeqNo(3)
+		w.onAckedSeqNo(2)
+		xtest.SpinWaitCondition(t, &storage.m, func() bool {
+			return storage.seqNos["test-producer-id"] == 3
+		})
+
+		// acked seqno must be saved on stop of the writer
+		storage.m.Lock()
+		storage.saveErr = errors.New("test")
+		storage.m.Unlock()
+		w.onAckedSeqNo(5)
+
+		storage.m.Lock()
+		storage.saveErr = nil
+		storage.m.Unlock()
+		cancel()
+		xtest.WaitChannelClosed(t, loopDone)
+
+		storage.m.Lock()
+		defer storage.m.Unlock()
+		require.Equal(t, int64(5), storage.seqNos["test-producer-id"])
+	})
+	t.Run("QueueReportsAckedSeqNo", func(t *testing.T) {
+		q := newMessageQueue()
+		var acked []int64
+		q.OnAckedSeqNo = func(seqNo int64) {
+			acked = append(acked, seqNo)
+		}
+
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2, 3)))
+		require.NoError(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+			{SeqNo: 2},
+			{SeqNo: 1},
+		}))
+		require.Equal(t, []int64{2}, acked)
+	})
+}
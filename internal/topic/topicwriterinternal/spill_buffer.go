@@ -0,0 +1,268 @@
+package topicwriterinternal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+const spillReplayRetryDelay = time.Second
+
+var errSpillBufferWithWaitServerAck = xerrors.Wrap(errors.New("ydb: spill buffer can't be used with sync write (wait server ack)")) //nolint:lll
+
+// PublicSpillBuffer is a storage of messages, which can't be put to the writer queue at the moment
+// (queue is full or writer wasn't connected yet).
+// Buffer is a FIFO queue, implementation must be safe for concurrent use.
+type PublicSpillBuffer interface {
+	// Push appends messages to the tail of the buffer atomically.
+	// Push must return error if the messages can't be stored (e.g. size limit exceeded)
+	Push(messages []PublicSpilledMessage) error
+
+	// Peek returns up to limit messages from the head of the buffer without removing them
+	Peek(limit int) ([]PublicSpilledMessage, error)
+
+	// Pop removes count messages from the head of the buffer
+	Pop(count int) error
+
+	// Len returns count of messages in the buffer
+	Len() int
+}
+
+// PublicSpilledMessage is a message stored in spill buffer
+type PublicSpilledMessage struct {
+	SeqNo     int64             `json:"seq_no,omitempty"`
+	CreatedAt time.Time         `json:"created_at,omitempty"`
+	Data      []byte            `json:"data,omitempty"`
+	Metadata  map[string][]byte `json:"metadata,omitempty"`
+
+	// Codec is codec override of the message, it is checked against codecs allowed for the topic on replay
+	Codec topictypes.Codec `json:"codec,omitempty"`
+}
+
+func (m *PublicSpilledMessage) toPublicMessage() PublicMessage {
+	return PublicMessage{
+		SeqNo:     m.SeqNo,
+		CreatedAt: m.CreatedAt,
+		Data:      bytes.NewReader(m.Data),
+		Metadata:  m.Metadata,
+		Codec:     m.Codec,
+	}
+}
+
+func (w *WriterReconnector) canSpill(messages []PublicMessage) bool {
+	if w.cfg.SpillBuffer == nil {
+		return false
+	}
+	for i := range messages {
+		if messages[i].tx != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// acquireOrSpill acquires place in the writer queue for the messages if it is possible without waiting and there are
+// no spilled messages before. Otherwise, it spills the messages to the buffer and returns false.
+func (w *WriterReconnector) acquireOrSpill(messages []PublicMessage, weight int64) (acquired bool, _ error) {
+	w.spillMutex.Lock()
+	defer w.spillMutex.Unlock()
+
+	if w.cfg.SpillBuffer.Len() == 0 && w.firstConnectionHandled.Load() && w.semaphore.TryAcquire(weight) {
+		return true, nil
+	}
+
+	spilled := make([]PublicSpilledMessage, 0, len(messages))
+	for i := range messages {
+		var data []byte
+		if messages[i].Data != nil {
+			var err error
+			data, err = io.ReadAll(messages[i].Data)
+			if err != nil {
+				return false, xerrors.WithStackTrace(err)
+			}
+		}
+		if len(data) > w.cfg.MaxMessageSize {
+			return false, xerrors.WithStackTrace(fmt.Errorf("message size bytes %v: %w", len(data), errLargeMessage))
+		}
+		if codec := rawtopiccommon.Codec(messages[i].Codec); codec != rawtopiccommon.CodecUNSPECIFIED &&
+			!w.encodersMap.IsSupported(codec) {
+			return false, xerrors.WithStackTrace(fmt.Errorf("codec %v: %w", codec, errUnsupportedMessageCodec))
+		}
+		spilled = append(spilled, PublicSpilledMessage{
+			SeqNo:     messages[i].SeqNo,
+			CreatedAt: messages[i].CreatedAt,
+			Data:      data,
+			Metadata:  messages[i].Metadata,
+			Codec:     messages[i].Codec,
+		})
+	}
+
+	if err := w.cfg.SpillBuffer.Push(spilled); err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+
+	select {
+	case w.spillReplaySignal <- empty.Struct{}:
+	default:
+	}
+
+	return false, nil
+}
+
+func (w *WriterReconnector) spillReplayLoop(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.spillReplaySignal:
+		case <-timer.C:
+		}
+
+		if err := w.replaySpilled(ctx); err != nil && ctx.Err() == nil {
+			if xerrors.Is(err, errUnsupportedMessageCodec, errLargeMessage) {
+				// spilled messages can't be written to the topic, retry will fail forever
+				_ = w.close(ctx, err)
+
+				return
+			}
+			// spilled messages stay in the buffer, retry later
+			timer.Reset(spillReplayRetryDelay)
+		}
+	}
+}
+
+// replaySpilled moves messages from the spill buffer to the writer queue in order of spilling
+func (w *WriterReconnector) replaySpilled(ctx context.Context) error {
+	for {
+		if w.cfg.SpillBuffer.Len() == 0 {
+			w.spillMutex.Lock()
+			w.notifySpillDrainedWithLock()
+			w.spillMutex.Unlock()
+
+			return nil
+		}
+
+		spilled, err := w.cfg.SpillBuffer.Peek(w.cfg.MaxQueueLen)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		messages := make([]PublicMessage, len(spilled))
+		for i := range spilled {
+			messages[i] = spilled[i].toPublicMessage()
+		}
+
+		if err = w.waitFirstInitResponse(ctx); err != nil {
+			return err
+		}
+
+		weight := int64(len(messages))
+		if err = w.semaphore.Acquire(ctx, weight); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		err = w.moveSpilledToQueue(messages, &weight)
+		if weight != 0 {
+			w.semaphore.Release(weight)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (w *WriterReconnector) moveSpilledToQueue(messages []PublicMessage, weight *int64) error {
+	messagesSlice, err := w.createMessagesWithContent(messages)
+	if err != nil {
+		return err
+	}
+
+	if err = w.checkSpilledMessages(messagesSlice); err != nil {
+		return err
+	}
+
+	w.spillMutex.Lock()
+	defer w.spillMutex.Unlock()
+
+	if _, err = w.addMessageToInternalQueueWithLock(messagesSlice, weight); err != nil {
+		return err
+	}
+
+	// messages will be duplicated on next replay if pop failed
+	if err = w.cfg.SpillBuffer.Pop(len(messages)); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if w.cfg.SpillBuffer.Len() == 0 {
+		w.notifySpillDrainedWithLock()
+	}
+
+	return nil
+}
+
+// checkSpilledMessages checks messages restored from the spill buffer, the buffer may be filled
+// by previous writer with other settings or topic codecs may be changed since spilling
+func (w *WriterReconnector) checkSpilledMessages(messages []messageWithDataContent) error {
+	if err := w.checkMessages(messages); err != nil {
+		return err
+	}
+
+	w.m.RLock()
+	defer w.m.RUnlock()
+
+	for i := range messages {
+		if codec := messages[i].codecOverride(); codec != rawtopiccommon.CodecUNSPECIFIED &&
+			!w.codecsFromServer.AllowedByCodecsList(codec) {
+			return xerrors.WithStackTrace(fmt.Errorf("codec %v isn't allowed for topic: %w", codec, errUnsupportedMessageCodec))
+		}
+	}
+
+	return nil
+}
+
+func (w *WriterReconnector) notifySpillDrainedWithLock() {
+	if w.spillDrained != nil {
+		close(w.spillDrained)
+		w.spillDrained = nil
+	}
+}
+
+// waitSpillDrained waits until all spilled messages are moved to the writer queue
+func (w *WriterReconnector) waitSpillDrained(ctx context.Context) error {
+	if w.cfg.SpillBuffer == nil {
+		return nil
+	}
+
+	w.spillMutex.Lock()
+	if w.cfg.SpillBuffer.Len() == 0 {
+		w.spillMutex.Unlock()
+
+		return nil
+	}
+	if w.spillDrained == nil {
+		w.spillDrained = make(empty.Chan)
+	}
+	drained := w.spillDrained
+	w.spillMutex.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-w.background.Done():
+		return xerrors.WithStackTrace(w.background.CloseReason())
+	case <-ctx.Done():
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+}
@@ -0,0 +1,124 @@
+package topicwriterinternal
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+func TestWriterSpillBuffer(t *testing.T) {
+	t.Run("SpillAndReplayInOrder", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		buffer, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer buffer.Close()
+
+		w := newTestWriterStopped(WithMaxQueueLen(2), WithSpillBuffer(buffer))
+		w.cfg.AutoSetCreatedTime = false
+
+		// writer is not connected yet, messages are spilled without waiting
+		require.NoError(t, w.Write(ctx, newTestMessages(1, 2)))
+		require.NoError(t, w.Write(ctx, newTestMessages(3)))
+		require.Equal(t, 3, buffer.Len())
+		require.Empty(t, w.queue.messagesByOrder)
+
+		w.firstConnectionHandled.Store(true)
+
+		// buffer is not empty, new messages must be spilled after previous ones
+		require.NoError(t, w.Write(ctx, newTestMessages(4)))
+		require.Equal(t, 4, buffer.Len())
+
+		// queue has room for two messages only, emulate acks for replay of the rest
+		replayed := make(chan error, 1)
+		go func() {
+			replayed <- w.replaySpilled(ctx)
+		}()
+		xtest.SpinWaitCondition(t, &w.queue.m, func() bool {
+			return len(w.queue.messagesByOrder) == 2
+		})
+		w.onAckReceived(2)
+		require.NoError(t, <-replayed)
+		require.Equal(t, 0, buffer.Len())
+		require.NoError(t, w.waitSpillDrained(ctx))
+
+		orders := make([]int, 0, len(w.queue.messagesByOrder))
+		for order := range w.queue.messagesByOrder {
+			orders = append(orders, order)
+		}
+		sort.Ints(orders)
+		var seqNos []int64
+		for _, order := range orders {
+			seqNos = append(seqNos, w.queue.messagesByOrder[order].SeqNo)
+		}
+		require.Equal(t, []int64{1, 2, 3, 4}, seqNos)
+	})
+	t.Run("WriteToQueueWithEmptyBuffer", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		buffer, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer buffer.Close()
+
+		w := newTestWriterStopped(WithSpillBuffer(buffer))
+		w.cfg.AutoSetCreatedTime = false
+		w.firstConnectionHandled.Store(true)
+
+		require.NoError(t, w.Write(ctx, []PublicMessage{{SeqNo: 1, Data: bytes.NewReader([]byte{1})}}))
+		require.Equal(t, 0, buffer.Len())
+		require.Len(t, w.queue.messagesByOrder, 1)
+	})
+	t.Run("CodecPersisted", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		buffer, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer buffer.Close()
+
+		w := newTestWriterStopped(WithSpillBuffer(buffer))
+		w.cfg.AutoSetCreatedTime = false
+
+		require.NoError(t, w.Write(ctx, []PublicMessage{
+			{SeqNo: 1, Data: bytes.NewReader([]byte{1}), Codec: topictypes.CodecGzip},
+		}))
+		spilled, err := buffer.Peek(1)
+		require.NoError(t, err)
+		require.Len(t, spilled, 1)
+		require.Equal(t, topictypes.CodecGzip, spilled[0].Codec)
+
+		w.firstConnectionHandled.Store(true)
+		require.NoError(t, w.replaySpilled(ctx))
+		require.Len(t, w.queue.messagesByOrder, 1)
+		for _, mess := range w.queue.messagesByOrder {
+			require.Equal(t, rawtopiccommon.CodecGzip, mess.codecOverride())
+		}
+	})
+	t.Run("CodecNotAllowedForTopicOnReplay", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		buffer, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer buffer.Close()
+
+		require.NoError(t, buffer.Push([]PublicSpilledMessage{{SeqNo: 1, Data: []byte{1}, Codec: topictypes.CodecGzip}}))
+
+		w := newTestWriterStopped(WithSpillBuffer(buffer))
+		w.cfg.AutoSetCreatedTime = false
+		w.codecsFromServer = rawtopiccommon.SupportedCodecs{rawtopiccommon.CodecRaw}
+		w.firstConnectionHandled.Store(true)
+
+		require.ErrorIs(t, w.replaySpilled(ctx), errUnsupportedMessageCodec)
+		require.Equal(t, 1, buffer.Len())
+		require.Empty(t, w.queue.messagesByOrder)
+	})
+	t.Run("WithWaitServerAck", func(t *testing.T) {
+		buffer, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer buffer.Close()
+
+		cfg := NewWriterReconnectorConfig(WithSpillBuffer(buffer), WithWaitAckOnWrite(true))
+		require.ErrorIs(t, cfg.validate(), errSpillBufferWithWaitServerAck)
+	})
+}
@@ -0,0 +1,366 @@
+package topicwriterinternal
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	spillDataFileName = "spill.data"
+	spillHeadFileName = "spill.head"
+
+	spillRecordHeaderSize = 4
+	spillHeadSize         = 16 // offset of the first record and end of data (if not zero)
+
+	// spillCompactMinBytes is a min size of consumed records at the beginning of the file for compaction
+	spillCompactMinBytes = 1024 * 1024
+
+	defaultSpillMaxBytes = 256 * 1024 * 1024
+)
+
+var (
+	PublicErrSpillBufferFull   = xerrors.Wrap(errors.New("ydb: spill buffer of topic writer is full"))
+	errSpillBufferClosed       = xerrors.Wrap(errors.New("ydb: spill buffer of topic writer is closed"))
+	errSpillBufferCorruptedLen = xerrors.Wrap(errors.New("ydb: corrupted length of spill buffer record"))
+)
+
+// PublicSpillSyncPolicy defines when the file spill buffer calls fsync
+type PublicSpillSyncPolicy int
+
+const (
+	// PublicSpillSyncNone doesn't call fsync, the data is written to disk by OS.
+	// Messages may be lost on OS crash or power loss, but survive process restart.
+	PublicSpillSyncNone = PublicSpillSyncPolicy(iota)
+
+	// PublicSpillSyncAlways calls fsync on every push to and pop from the buffer
+	PublicSpillSyncAlways
+)
+
+type PublicFileSpillBufferOption func(b *PublicFileSpillBuffer)
+
+// WithSpillMaxBytes limits size of messages in the spill buffer. Zero means no limit
+func WithSpillMaxBytes(maxBytes int64) PublicFileSpillBufferOption {
+	return func(b *PublicFileSpillBuffer) {
+		if maxBytes >= 0 {
+			b.maxBytes = maxBytes
+		}
+	}
+}
+
+// WithSpillSyncPolicy defines fsync policy of the spill buffer
+func WithSpillSyncPolicy(policy PublicSpillSyncPolicy) PublicFileSpillBufferOption {
+	return func(b *PublicFileSpillBuffer) {
+		b.syncPolicy = policy
+	}
+}
+
+// PublicFileSpillBuffer stores spilled messages in append-only file of length-prefixed records.
+// Offset of the first record is stored in separated head file, so the messages survive restart of process.
+// File is truncated every time the buffer becomes empty and compacted (records move to the beginning
+// of the file) when consumed records take more space than the rest.
+type PublicFileSpillBuffer struct {
+	maxBytes   int64
+	syncPolicy PublicSpillSyncPolicy
+
+	m       sync.Mutex
+	data    *os.File
+	head    *os.File
+	offsets []int64 // offsets of records from head to end
+	end     int64
+	closed  bool
+}
+
+func NewPublicFileSpillBuffer(dir string, opts ...PublicFileSpillBufferOption) (_ *PublicFileSpillBuffer, err error) {
+	b := &PublicFileSpillBuffer{
+		maxBytes: defaultSpillMaxBytes,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+
+	if err = os.MkdirAll(dir, 0o700); err != nil { //nolint:gomnd
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	b.data, err = os.OpenFile(filepath.Join(dir, spillDataFileName), os.O_RDWR|os.O_CREATE, 0o600) //nolint:gomnd
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	b.head, err = os.OpenFile(filepath.Join(dir, spillHeadFileName), os.O_RDWR|os.O_CREATE, 0o600) //nolint:gomnd
+	if err != nil {
+		_ = b.data.Close()
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if err = b.load(); err != nil {
+		_ = b.Close()
+
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// load restores offsets of records after restart and drops partially written tail record
+func (b *PublicFileSpillBuffer) load() error {
+	var headBuf [spillHeadSize]byte
+	if _, err := b.head.ReadAt(headBuf[:], 0); err != nil && !errors.Is(err, io.EOF) {
+		return xerrors.WithStackTrace(err)
+	}
+	headOffset := int64(binary.LittleEndian.Uint64(headBuf[:8]))
+	dataEnd := int64(binary.LittleEndian.Uint64(headBuf[8:]))
+
+	stat, err := b.data.Stat()
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	size := stat.Size()
+	if dataEnd > 0 && dataEnd < size {
+		// compaction was interrupted after move of records, data after the records is stale
+		size = dataEnd
+	}
+
+	offset := headOffset
+	for offset+spillRecordHeaderSize <= size {
+		var header [spillRecordHeaderSize]byte
+		if _, err = b.data.ReadAt(header[:], offset); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		next := offset + spillRecordHeaderSize + int64(binary.LittleEndian.Uint32(header[:]))
+		if next > size {
+			break
+		}
+		b.offsets = append(b.offsets, offset)
+		offset = next
+	}
+	b.end = offset
+
+	if offset != stat.Size() || len(b.offsets) == 0 {
+		return b.truncateTail()
+	}
+	if dataEnd > 0 {
+		return b.writeHead(headOffset, 0)
+	}
+
+	return nil
+}
+
+func (b *PublicFileSpillBuffer) truncateTail() error {
+	if len(b.offsets) == 0 {
+		b.end = 0
+		if err := b.writeHead(0, 0); err != nil {
+			return err
+		}
+	}
+
+	if err := b.data.Truncate(b.end); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err := b.sync(b.data); err != nil {
+		return err
+	}
+	if len(b.offsets) == 0 {
+		return nil
+	}
+
+	// drops end of data stored by compaction
+	return b.writeHead(b.offsets[0], 0)
+}
+
+// compact moves records to the beginning of the file if consumed records take more space than the rest.
+// Records are copied to free space before the first record, so the file is consistent after crash at any step:
+// the head points to old records until the copy completed, then head points to moved records and limits
+// the data by end of moved records until the file truncated
+func (b *PublicFileSpillBuffer) compact() error {
+	if len(b.offsets) == 0 {
+		return nil
+	}
+	consumed := b.offsets[0]
+	live := b.end - consumed
+	if consumed < spillCompactMinBytes || consumed < live {
+		return nil
+	}
+
+	buf := make([]byte, live)
+	if _, err := b.data.ReadAt(buf, consumed); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if _, err := b.data.WriteAt(buf, 0); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if err := b.sync(b.data); err != nil {
+		return err
+	}
+	if err := b.writeHead(0, live); err != nil {
+		return err
+	}
+
+	for i := range b.offsets {
+		b.offsets[i] -= consumed
+	}
+	b.end = live
+
+	return b.truncateTail()
+}
+
+// writeHead stores offset of the first record. Not zero dataEnd means that data after dataEnd is stale
+func (b *PublicFileSpillBuffer) writeHead(offset, dataEnd int64) error {
+	var headBuf [spillHeadSize]byte
+	binary.LittleEndian.PutUint64(headBuf[:8], uint64(offset))
+	binary.LittleEndian.PutUint64(headBuf[8:], uint64(dataEnd))
+	if _, err := b.head.WriteAt(headBuf[:], 0); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return b.sync(b.head)
+}
+
+func (b *PublicFileSpillBuffer) sync(f *os.File) error {
+	if b.syncPolicy != PublicSpillSyncAlways {
+		return nil
+	}
+	if err := f.Sync(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (b *PublicFileSpillBuffer) Push(messages []PublicSpilledMessage) error {
+	var buf []byte
+	offsets := make([]int64, 0, len(messages))
+	for i := range messages {
+		payload, err := json.Marshal(&messages[i])
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		offsets = append(offsets, int64(len(buf)))
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(payload)))
+		buf = append(buf, payload...)
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.closed {
+		return xerrors.WithStackTrace(errSpillBufferClosed)
+	}
+	if size := b.size(); b.maxBytes > 0 && size+int64(len(buf)) > b.maxBytes {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: size %v, max size %v, try to add %v bytes",
+			PublicErrSpillBufferFull, size, b.maxBytes, len(buf),
+		))
+	}
+
+	if _, err := b.data.WriteAt(buf, b.end); err != nil {
+		// the tail will be truncated or overwritten by next push
+		return xerrors.WithStackTrace(err)
+	}
+	if err := b.sync(b.data); err != nil {
+		return err
+	}
+
+	for _, offset := range offsets {
+		b.offsets = append(b.offsets, b.end+offset)
+	}
+	b.end += int64(len(buf))
+
+	return nil
+}
+
+func (b *PublicFileSpillBuffer) Peek(limit int) ([]PublicSpilledMessage, error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.closed {
+		return nil, xerrors.WithStackTrace(errSpillBufferClosed)
+	}
+
+	if limit > len(b.offsets) {
+		limit = len(b.offsets)
+	}
+
+	messages := make([]PublicSpilledMessage, limit)
+	for i := range messages {
+		var header [spillRecordHeaderSize]byte
+		if _, err := b.data.ReadAt(header[:], b.offsets[i]); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		size := int64(binary.LittleEndian.Uint32(header[:]))
+		if b.offsets[i]+spillRecordHeaderSize+size > b.end {
+			return nil, xerrors.WithStackTrace(errSpillBufferCorruptedLen)
+		}
+		payload := make([]byte, size)
+		if _, err := b.data.ReadAt(payload, b.offsets[i]+spillRecordHeaderSize); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if err := json.Unmarshal(payload, &messages[i]); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	return messages, nil
+}
+
+func (b *PublicFileSpillBuffer) Pop(count int) error {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.closed {
+		return xerrors.WithStackTrace(errSpillBufferClosed)
+	}
+
+	if count > len(b.offsets) {
+		count = len(b.offsets)
+	}
+	b.offsets = b.offsets[count:]
+
+	if len(b.offsets) == 0 {
+		return b.truncateTail()
+	}
+
+	if err := b.writeHead(b.offsets[0], 0); err != nil {
+		return err
+	}
+
+	return b.compact()
+}
+
+// size returns size of not consumed records
+func (b *PublicFileSpillBuffer) size() int64 {
+	if len(b.offsets) == 0 {
+		return 0
+	}
+
+	return b.end - b.offsets[0]
+}
+
+func (b *PublicFileSpillBuffer) Len() int {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	return len(b.offsets)
+}
+
+// Close closes files of the buffer. Messages stay in files and will be available on next open of the buffer
+func (b *PublicFileSpillBuffer) Close() error {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+
+	return xerrors.Join(b.data.Close(), b.head.Close())
+}
@@ -0,0 +1,140 @@
+package topicwriterinternal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestFileSpillBuffer(t *testing.T) {
+	t.Run("PushPeekPop", func(t *testing.T) {
+		b, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		defer b.Close()
+
+		createdAt := time.Unix(10, 0).UTC()
+		require.NoError(t, b.Push([]PublicSpilledMessage{
+			{SeqNo: 1, CreatedAt: createdAt, Data: []byte("1"), Metadata: map[string][]byte{"k": []byte("v")}},
+			{SeqNo: 2, Data: []byte("2")},
+		}))
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 3, Data: []byte("3")}}))
+		require.Equal(t, 3, b.Len())
+
+		messages, err := b.Peek(2)
+		require.NoError(t, err)
+		require.Equal(t, []PublicSpilledMessage{
+			{SeqNo: 1, CreatedAt: createdAt, Data: []byte("1"), Metadata: map[string][]byte{"k": []byte("v")}},
+			{SeqNo: 2, Data: []byte("2")},
+		}, messages)
+
+		require.NoError(t, b.Pop(2))
+		messages, err = b.Peek(10)
+		require.NoError(t, err)
+		require.Equal(t, []PublicSpilledMessage{{SeqNo: 3, Data: []byte("3")}}, messages)
+
+		require.NoError(t, b.Pop(10))
+		require.Equal(t, 0, b.Len())
+		require.Equal(t, int64(0), b.end)
+	})
+	t.Run("Reopen", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewPublicFileSpillBuffer(dir, WithSpillSyncPolicy(PublicSpillSyncAlways))
+		require.NoError(t, err)
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 1}, {SeqNo: 2}, {SeqNo: 3}}))
+		require.NoError(t, b.Pop(1))
+		require.NoError(t, b.Close())
+
+		// emulate crash in the middle of writing of record
+		f, err := os.OpenFile(filepath.Join(dir, spillDataFileName), os.O_APPEND|os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = f.Write([]byte{100, 0, 0, 0, '{'})
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		b, err = NewPublicFileSpillBuffer(dir)
+		require.NoError(t, err)
+		defer b.Close()
+
+		require.Equal(t, 2, b.Len())
+		messages, err := b.Peek(10)
+		require.NoError(t, err)
+		require.Equal(t, []PublicSpilledMessage{{SeqNo: 2}, {SeqNo: 3}}, messages)
+
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 4}}))
+		messages, err = b.Peek(10)
+		require.NoError(t, err)
+		require.Equal(t, []PublicSpilledMessage{{SeqNo: 2}, {SeqNo: 3}, {SeqNo: 4}}, messages)
+	})
+	t.Run("MaxBytes", func(t *testing.T) {
+		b, err := NewPublicFileSpillBuffer(t.TempDir(), WithSpillMaxBytes(128))
+		require.NoError(t, err)
+		defer b.Close()
+
+		require.NoError(t, b.Push([]PublicSpilledMessage{{Data: make([]byte, 10)}}))
+		err = b.Push([]PublicSpilledMessage{{Data: make([]byte, 100)}})
+		require.ErrorIs(t, err, PublicErrSpillBufferFull)
+		require.Equal(t, 1, b.Len())
+
+		require.NoError(t, b.Pop(1))
+		require.NoError(t, b.Push([]PublicSpilledMessage{{Data: make([]byte, 10)}}))
+	})
+	t.Run("NeverDrained", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewPublicFileSpillBuffer(dir, WithSpillMaxBytes(512*1024))
+		require.NoError(t, err)
+		defer b.Close()
+
+		data := make([]byte, 100*1024)
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 0, Data: data}}))
+		for i := 1; i < 100; i++ {
+			// buffer always has one message, consumed records must not be accounted for max bytes
+			require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: int64(i), Data: data}}))
+			require.NoError(t, b.Pop(1))
+
+			stat, err := os.Stat(filepath.Join(dir, spillDataFileName))
+			require.NoError(t, err)
+			require.Less(t, stat.Size(), int64(2*spillCompactMinBytes))
+		}
+		messages, err := b.Peek(10)
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, int64(99), messages[0].SeqNo)
+	})
+	t.Run("InterruptedCompaction", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewPublicFileSpillBuffer(dir)
+		require.NoError(t, err)
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 1, Data: make([]byte, 100)}}))
+		require.NoError(t, b.Push([]PublicSpilledMessage{{SeqNo: 2}}))
+		require.NoError(t, b.Pop(1))
+
+		// emulate crash after move of records and write of head before truncate of data file
+		live := make([]byte, b.end-b.offsets[0])
+		_, err = b.data.ReadAt(live, b.offsets[0])
+		require.NoError(t, err)
+		_, err = b.data.WriteAt(live, 0)
+		require.NoError(t, err)
+		require.NoError(t, b.writeHead(0, int64(len(live))))
+		require.NoError(t, b.Close())
+
+		b, err = NewPublicFileSpillBuffer(dir)
+		require.NoError(t, err)
+		defer b.Close()
+
+		messages, err := b.Peek(10)
+		require.NoError(t, err)
+		require.Equal(t, []PublicSpilledMessage{{SeqNo: 2}}, messages)
+		require.Equal(t, int64(len(live)), b.end)
+	})
+	t.Run("Closed", func(t *testing.T) {
+		b, err := NewPublicFileSpillBuffer(t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, b.Close())
+		require.True(t, xerrors.Is(b.Push([]PublicSpilledMessage{{}}), errSpillBufferClosed))
+	})
+}
@@ -83,12 +83,26 @@ func WithConnectFunc(connect ConnectFunc) PublicWriterOption {
 	}
 }
 
+// WithOnClose sets callback which calls on close of writer
+func WithOnClose(onClose func()) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.onClose = onClose
+	}
+}
+
 func WithConnectTimeout(timeout time.Duration) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.connectTimeout = timeout
 	}
 }
 
+// WithDefaultWriteTimeout limits Write if context of Write has no deadline
+func WithDefaultWriteTimeout(timeout time.Duration) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.defaultWriteTimeout = timeout
+	}
+}
+
 func WithAutosetCreatedTime(enable bool) PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.AutoSetCreatedTime = enable
@@ -161,3 +175,17 @@ func WithClock(clock clockwork.Clock) PublicWriterOption {
 		cfg.clock = clock
 	}
 }
+
+// WithSeqNoStorage sets storage for persisting of SeqNo of last acknowledged message
+func WithSeqNoStorage(storage PublicSeqNoStorage) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.SeqNoStorage = storage
+	}
+}
+
+// WithSpillBuffer sets buffer for messages which can't be put to the writer queue without waiting
+func WithSpillBuffer(buffer PublicSpillBuffer) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.SpillBuffer = buffer
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/big"
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -36,6 +37,7 @@ var (
 	errNonZeroSeqNo                                = xerrors.Wrap(errors.New("ydb: non zero seqno for auto set seqno mode"))                         //nolint:lll
 	errNonZeroCreatedAt                            = xerrors.Wrap(errors.New("ydb: non zero Message.CreatedAt and set auto fill created at option")) //nolint:lll
 	errNoAllowedCodecs                             = xerrors.Wrap(errors.New("ydb: no allowed codecs for write to topic"))
+	errUnsupportedMessageCodec                     = xerrors.Wrap(errors.New("ydb: unsupported codec of message"))
 	errLargeMessage                                = xerrors.Wrap(errors.New("ydb: message uncompressed size more, then limit"))                                                                                                                                                                                             //nolint:lll
 	PublicErrMessagesPutToInternalQueueBeforeError = xerrors.Wrap(errors.New("ydb: the messages was put to internal buffer before the error happened. It mean about the messages can be delivered to the server"))                                                                                                           //nolint:lll
 	errDiffetentTransactions                       = xerrors.Wrap(errors.New("ydb: internal writer has messages from different trasactions. It is internal logic error, write issue please: https://github.com/ydb-platform/ydb-go-sdk/issues/new?assignees=&labels=bug&projects=&template=01_BUG_REPORT.md&title=bug%3A+")) //nolint:lll
@@ -61,8 +63,13 @@ type WriterReconnectorConfig struct {
 	AutoSetCreatedTime           bool
 	OnWriterInitResponseCallback PublicOnWriterInitResponseCallback
 	RetrySettings                topic.RetrySettings
+	SpillBuffer                  PublicSpillBuffer
+	SeqNoStorage                 PublicSeqNoStorage
+	Marshaler                    PublicMarshaler
 
-	connectTimeout time.Duration
+	connectTimeout      time.Duration
+	defaultWriteTimeout time.Duration
+	onClose             func()
 }
 
 func (cfg *WriterReconnectorConfig) validate() error {
@@ -70,6 +77,9 @@ func (cfg *WriterReconnectorConfig) validate() error {
 		cfg.producerID != cfg.defaultPartitioning.MessageGroupID {
 		return xerrors.WithStackTrace(errProducerIDNotEqualMessageGroupID)
 	}
+	if cfg.SpillBuffer != nil && cfg.WaitServerAck {
+		return xerrors.WithStackTrace(errSpillBufferWithWaitServerAck)
+	}
 
 	return nil
 }
@@ -128,8 +138,16 @@ type WriterReconnector struct {
 	initInfo                       InitialInfo
 	m                              xsync.RWMutex
 	sessionID                      string
+	codecsFromServer               rawtopiccommon.SupportedCodecs // codecs of topic from last init response
 	firstConnectionHandled         atomic.Bool
 	initDone                       bool
+	spillMutex                     sync.Mutex // guards moving messages between spill buffer and queue
+	spillDrained                   empty.Chan
+	spillReplaySignal              empty.Chan
+	transactions                   sync.Map // transactions with callbacks registered by WriteWithTx
+	storedSeqNo                    int64    // SeqNo loaded from SeqNoStorage on start
+	ackedSeqNo                     atomic.Int64
+	seqNoSaveSignal                empty.Chan
 }
 
 func NewWriterReconnector(
@@ -158,9 +176,16 @@ func newWriterReconnectorStopped(
 		encodersMap:                    NewEncoderMap(),
 		writerInstanceID:               writerInstanceID.String(),
 		retrySettings:                  cfg.RetrySettings,
+		spillReplaySignal:              make(empty.Chan, 1),
+		storedSeqNo:                    -1,
+		seqNoSaveSignal:                make(empty.Chan, 1),
 	}
+	res.ackedSeqNo.Store(-1)
 
 	res.queue.OnAckReceived = res.onAckReceived
+	if cfg.SeqNoStorage != nil {
+		res.queue.OnAckedSeqNo = res.onAckedSeqNo
+	}
 
 	for codec, creator := range cfg.AdditionalEncoders {
 		res.encodersMap.AddEncoder(codec, creator)
@@ -207,6 +232,12 @@ func (w *WriterReconnector) fillFields(messages []messageWithDataContent) error
 func (w *WriterReconnector) start() {
 	name := fmt.Sprintf("writer %q", w.cfg.topic)
 	w.background.Start(name+", sendloop", w.connectionLoop)
+	if w.cfg.SpillBuffer != nil {
+		w.background.Start(name+", spill replay", w.spillReplayLoop)
+	}
+	if w.cfg.SeqNoStorage != nil {
+		w.background.Start(name+", seqno storage", w.seqNoStorageLoop)
+	}
 }
 
 func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage) (resErr error) {
@@ -219,6 +250,11 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 	if len(messages) == 0 {
 		return nil
 	}
+	if _, has := ctx.Deadline(); !has && w.cfg.defaultWriteTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = xcontext.WithTimeout(ctx, w.cfg.defaultWriteTimeout)
+		defer cancel()
+	}
 
 	semaphoreWeight := int64(len(messages))
 	if semaphoreWeight > int64(w.cfg.MaxQueueLen) {
@@ -228,7 +264,12 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			semaphoreWeight,
 		))
 	}
-	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
+	if w.canSpill(messages) {
+		acquired, err := w.acquireOrSpill(messages, semaphoreWeight)
+		if err != nil || !acquired {
+			return err
+		}
+	} else if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
 		return xerrors.WithStackTrace(
 			fmt.Errorf("ydb: add new messages exceed max queue size limit. Add count: %v, max size: %v",
 				semaphoreWeight,
@@ -304,6 +345,10 @@ func (w *WriterReconnector) checkMessages(messages []messageWithDataContent) err
 		if size > w.cfg.MaxMessageSize {
 			return xerrors.WithStackTrace(fmt.Errorf("message size bytes %v: %w", size, errLargeMessage))
 		}
+		if codec := messages[i].codecOverride(); codec != rawtopiccommon.CodecUNSPECIFIED &&
+			!w.encodersMap.IsSupported(codec) {
+			return xerrors.WithStackTrace(fmt.Errorf("codec %v: %w", codec, errUnsupportedMessageCodec))
+		}
 	}
 
 	return nil
@@ -344,16 +389,32 @@ func (w *WriterReconnector) createMessagesWithContent(messages []PublicMessage)
 }
 
 func (w *WriterReconnector) Flush(ctx context.Context) error {
+	if err := w.waitSpillDrained(ctx); err != nil {
+		return err
+	}
+
 	return w.queue.WaitLastWritten(ctx)
 }
 
 func (w *WriterReconnector) Close(ctx context.Context) error {
+	if w.cfg.onClose != nil {
+		defer w.cfg.onClose()
+	}
+
+	// spilled messages must be moved to the queue before it stops accept new messages,
+	// messages which were not moved stay in spill buffer
+	spillErr := w.waitSpillDrained(ctx)
+
 	reason := xerrors.WithStackTrace(errStopWriterReconnector)
 	w.queue.StopAddNewMessages(reason)
 
-	flushErr := w.Flush(ctx) //nolint:ifshort,nolintlint
+	flushErr := w.queue.WaitLastWritten(ctx) //nolint:ifshort,nolintlint
 	closeErr := w.close(ctx, reason)
 
+	if spillErr != nil {
+		return spillErr
+	}
+
 	if flushErr != nil {
 		return flushErr
 	}
@@ -398,6 +459,12 @@ func (w *WriterReconnector) connectionLoop(ctx context.Context) {
 	var prevAttemptTime time.Time
 	var startOfRetries time.Time
 
+	if err := w.loadStoredSeqNo(ctx); err != nil {
+		_ = w.close(ctx, err)
+
+		return
+	}
+
 	for {
 		if ctx.Err() != nil {
 			return
@@ -549,6 +616,7 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 			return
 		}
 		w.sessionID = writerStream.SessionID
+		w.codecsFromServer = writerStream.CodecsFromServer
 
 		if !w.firstConnectionHandled.CompareAndSwap(false, true) {
 			return
@@ -559,6 +627,8 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 		if writerStream.LastSeqNumRequested {
 			w.lastSeqNo = writerStream.ReceivedLastSeqNum
 		}
+		// server may forget producer (e.g. after retention of topic), stored SeqNo keeps numbering monotonic
+		w.lastSeqNo = max(w.lastSeqNo, w.storedSeqNo)
 	})
 
 	if isFirstInit {
@@ -696,6 +766,26 @@ func splitMessagesByBufCodec(messages []messageWithDataContent) (res [][]message
 	return res
 }
 
+// splitMessagesByCodecOverride splits messages to groups of sequential messages with same codec override
+func splitMessagesByCodecOverride(messages []messageWithDataContent) (res [][]messageWithDataContent) {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	currentGroupStart := 0
+	currentCodec := messages[0].codecOverride()
+	for i := range messages {
+		if messages[i].codecOverride() != currentCodec {
+			res = append(res, messages[currentGroupStart:i:i])
+			currentGroupStart = i
+			currentCodec = messages[i].codecOverride()
+		}
+	}
+	res = append(res, messages[currentGroupStart:len(messages):len(messages)])
+
+	return res
+}
+
 func createWriteRequest(messages []messageWithDataContent, targetCodec rawtopiccommon.Codec) (
 	res rawtopicwriter.WriteRequest,
 	err error,
@@ -24,6 +24,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 var testCommonEncoders = NewEncoderMap()
@@ -826,6 +827,43 @@ func TestSplitMessagesByBufCodec(t *testing.T) {
 	}
 }
 
+func TestSplitMessagesByCodecOverride(t *testing.T) {
+	codecs := []topictypes.Codec{
+		0, 0, topictypes.CodecGzip, topictypes.CodecGzip, 0, topictypes.CodecRaw,
+	}
+	var messages []messageWithDataContent
+	for index, codec := range codecs {
+		mess := newTestMessageWithDataContent(index)
+		mess.Codec = codec
+		messages = append(messages, mess)
+	}
+
+	groups := splitMessagesByCodecOverride(messages)
+	require.Len(t, groups, 4)
+	expectedNum := 0
+	for _, group := range groups {
+		require.Len(t, group, cap(group))
+		for _, mess := range group {
+			require.Equal(t, codecs[expectedNum], mess.Codec)
+			require.Equal(t, group[0].Codec, mess.Codec)
+			expectedNum++
+		}
+	}
+	require.Equal(t, len(codecs), expectedNum)
+	require.Nil(t, splitMessagesByCodecOverride(nil))
+}
+
+func TestWriterReconnector_CheckMessagesCodecOverride(t *testing.T) {
+	w := newTestWriterStopped()
+
+	messages := newTestMessagesWithContent(1)
+	messages[0].Codec = topictypes.CodecGzip
+	require.NoError(t, w.checkMessages(messages))
+
+	messages[0].Codec = topictypes.CodecZstd
+	require.ErrorIs(t, w.checkMessages(messages), errUnsupportedMessageCodec)
+}
+
 func TestCalculateAllowedCodecs(t *testing.T) {
 	customCodecSupported := rawtopiccommon.Codec(rawtopiccommon.CodecCustomerFirst)
 	customCodecUnsupported := rawtopiccommon.Codec(rawtopiccommon.CodecCustomerFirst + 1)
@@ -225,30 +225,37 @@ func (w *SingleStreamWriter) sendMessagesFromQueueToStreamLoop(ctx context.Conte
 			return
 		}
 
-		targetCodec, err := w.Encoder.CompressMessages(messages)
-		if err != nil {
-			_ = w.close(ctx, err)
+		for _, group := range splitMessagesByCodecOverride(messages) {
+			if err = w.sendMessages(group); err != nil {
+				_ = w.close(ctx, err)
 
-			return
+				return
+			}
 		}
+	}
+}
 
-		onSentComplete := trace.TopicOnWriterSendMessages(
-			w.cfg.Tracer,
-			w.cfg.reconnectorInstanceID,
-			w.SessionID,
-			targetCodec.ToInt32(),
-			messages[0].SeqNo,
-			len(messages),
-		)
-		err = sendMessagesToStream(w.cfg.stream, targetCodec, messages)
-		onSentComplete(err)
-		if err != nil {
-			err = xerrors.WithStackTrace(fmt.Errorf("ydb: error send message to topic stream: %w", err))
-			_ = w.close(ctx, err)
+func (w *SingleStreamWriter) sendMessages(messages []messageWithDataContent) error {
+	targetCodec, err := w.Encoder.CompressMessages(messages)
+	if err != nil {
+		return err
+	}
 
-			return
-		}
+	onSentComplete := trace.TopicOnWriterSendMessages(
+		w.cfg.Tracer,
+		w.cfg.reconnectorInstanceID,
+		w.SessionID,
+		targetCodec.ToInt32(),
+		messages[0].SeqNo,
+		len(messages),
+	)
+	err = sendMessagesToStream(w.cfg.stream, targetCodec, messages)
+	onSentComplete(err)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("ydb: error send message to topic stream: %w", err))
 	}
+
+	return nil
 }
 
 func (w *SingleStreamWriter) updateTokenLoop(ctx context.Context) {
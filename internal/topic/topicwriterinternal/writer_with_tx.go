@@ -0,0 +1,59 @@
+package topicwriterinternal
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// WriteWithTx writes messages within the transaction. Messages become visible to readers
+// after commit of the transaction only. Commit of the transaction waits acks of written messages.
+// Unlike WriterWithTransaction the writer isn't bound to the transaction and stays open after
+// the transaction completed
+func (w *WriterReconnector) WriteWithTx(
+	ctx context.Context, transaction tx.Transaction, messages []PublicMessage,
+) error {
+	if err := transaction.UnLazy(ctx); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if _, registered := w.transactions.LoadOrStore(transaction, struct{}{}); !registered {
+		transaction.OnBeforeCommit(func(ctx context.Context) (err error) {
+			return w.onBeforeCommitTransaction(ctx, transaction)
+		})
+		transaction.OnCompleted(func(error) {
+			w.transactions.Delete(transaction)
+		})
+	}
+
+	for i := range messages {
+		messages[i].tx = transaction
+	}
+
+	return w.Write(ctx, messages)
+}
+
+func (w *WriterReconnector) onBeforeCommitTransaction(ctx context.Context, transaction tx.Transaction) (err error) {
+	tracer := w.cfg.Tracer
+	if tracer == nil {
+		tracer = &trace.Topic{}
+	}
+	traceCtx := ctx
+	onDone := trace.TopicOnWriterBeforeCommitTransaction(
+		tracer,
+		&traceCtx,
+		transaction.SessionID(),
+		w.GetSessionID(),
+		transaction.ID(),
+	)
+	ctx = traceCtx
+
+	defer func() {
+		onDone(err, w.GetSessionID())
+	}()
+
+	// wait acks of messages written within the transaction
+	return w.Flush(ctx)
+}
@@ -0,0 +1,68 @@
+package topicwriterinternal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testTransaction struct {
+	tx.Identifier
+
+	unLazy         int
+	onBeforeCommit []tx.OnTransactionBeforeCommit
+	onCompleted    []tx.OnTransactionCompletedFunc
+}
+
+func (t *testTransaction) UnLazy(context.Context) error {
+	t.unLazy++
+
+	return nil
+}
+
+func (t *testTransaction) SessionID() string {
+	return "session-id"
+}
+
+func (t *testTransaction) OnBeforeCommit(f tx.OnTransactionBeforeCommit) {
+	t.onBeforeCommit = append(t.onBeforeCommit, f)
+}
+
+func (t *testTransaction) OnCompleted(f tx.OnTransactionCompletedFunc) {
+	t.onCompleted = append(t.onCompleted, f)
+}
+
+func (t *testTransaction) Rollback(context.Context) error {
+	return nil
+}
+
+func TestWriterReconnector_WriteWithTx(t *testing.T) {
+	ctx := xtest.Context(t)
+	w := newWriterReconnectorStopped(NewWriterReconnectorConfig(WithAutoSetSeqNo(true)))
+	w.firstConnectionHandled.Store(true)
+
+	transaction := &testTransaction{Identifier: tx.ID("tx-id")}
+	require.NoError(t, w.WriteWithTx(ctx, transaction, newTestMessages(0)))
+	require.NoError(t, w.WriteWithTx(ctx, transaction, newTestMessages(0)))
+	require.NoError(t, w.Write(ctx, newTestMessages(0)))
+
+	require.Equal(t, 2, transaction.unLazy)
+	require.Len(t, transaction.onBeforeCommit, 1)
+	require.Len(t, transaction.onCompleted, 1)
+	require.Len(t, w.queue.messagesByOrder, 3)
+	var withTx int
+	for _, m := range w.queue.messagesByOrder {
+		if m.tx == transaction {
+			withTx++
+		}
+	}
+	require.Equal(t, 2, withTx)
+
+	transaction.onCompleted[0](nil)
+	_, registered := w.transactions.Load(transaction)
+	require.False(t, registered)
+}
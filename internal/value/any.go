@@ -0,0 +1,121 @@
+package value
+
+import "reflect"
+
+// Any converts value to the natural Go representation:
+//   - numbers and booleans to the same Go types
+//   - Date, Datetime and Timestamp to time.Time, Interval to time.Duration
+//   - Text, Json, JsonDocument, DyNumber and Tz* types to string
+//   - Bytes and Yson to []byte, Uuid to [16]byte
+//   - Optional to nil or to the representation of inner value
+//   - List, Set and Tuple to []any, Dict to map[any]any, Struct to map[string]any
+//
+// Keys of Dict which have no comparable Go representation (Tuple, List, Struct and other containers)
+// converts to YQL literal of the key, such as `(1,"a"u)`
+//
+// Other values (Decimal, Variant, Void, Pg) returns as is
+func Any(v Value) any { //nolint:funlen,gocyclo
+	switch vv := v.(type) {
+	case nil:
+		return nil
+	case boolValue:
+		return bool(vv)
+	case int8Value:
+		return int8(vv)
+	case int16Value:
+		return int16(vv)
+	case int32Value:
+		return int32(vv)
+	case int64Value:
+		return int64(vv)
+	case uint8Value:
+		return uint8(vv)
+	case uint16Value:
+		return uint16(vv)
+	case uint32Value:
+		return uint32(vv)
+	case uint64Value:
+		return uint64(vv)
+	case *floatValue:
+		return vv.value
+	case *doubleValue:
+		return vv.value
+	case dateValue:
+		return DateToTime(uint32(vv))
+	case datetimeValue:
+		return DatetimeToTime(uint32(vv))
+	case timestampValue:
+		return TimestampToTime(uint64(vv))
+	case intervalValue:
+		return IntervalToDuration(int64(vv))
+	case textValue:
+		return string(vv)
+	case jsonValue:
+		return string(vv)
+	case jsonDocumentValue:
+		return string(vv)
+	case dyNumberValue:
+		return string(vv)
+	case tzDateValue:
+		return string(vv)
+	case tzDatetimeValue:
+		return string(vv)
+	case tzTimestampValue:
+		return string(vv)
+	case bytesValue:
+		return []byte(vv)
+	case ysonValue:
+		return []byte(vv)
+	case *uuidValue:
+		return vv.value
+	case *optionalValue:
+		if vv.value == nil {
+			return nil
+		}
+
+		return Any(vv.value)
+	case *listValue:
+		return anyItems(vv.items)
+	case *setValue:
+		return anyItems(vv.items)
+	case *tupleValue:
+		return anyItems(vv.items)
+	case *dictValue:
+		values := make(map[any]any, len(vv.values))
+		for i := range vv.values {
+			values[anyDictKey(vv.values[i].K)] = Any(vv.values[i].V)
+		}
+
+		return values
+	case *structValue:
+		fields := make(map[string]any, len(vv.fields))
+		for i := range vv.fields {
+			fields[vv.fields[i].Name] = Any(vv.fields[i].V)
+		}
+
+		return fields
+	default:
+		return v
+	}
+}
+
+func anyItems(items []Value) []any {
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		values = append(values, Any(item))
+	}
+
+	return values
+}
+
+func anyDictKey(k Value) any {
+	key := Any(k)
+	if b, ok := key.([]byte); ok {
+		return string(b)
+	}
+	if key != nil && !reflect.ValueOf(key).Comparable() {
+		return k.Yql()
+	}
+
+	return key
+}
@@ -0,0 +1,56 @@
+package value
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAny(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	for _, tt := range []struct {
+		v   Value
+		exp any
+	}{
+		{v: BoolValue(true), exp: true},
+		{v: Int8Value(-1), exp: int8(-1)},
+		{v: Uint64Value(1), exp: uint64(1)},
+		{v: DoubleValue(1.5), exp: 1.5},
+		{v: TextValue("a"), exp: "a"},
+		{v: BytesValue([]byte("b")), exp: []byte("b")},
+		{v: TimestampValueFromTime(now), exp: now},
+		{v: IntervalValueFromDuration(time.Second), exp: time.Second},
+		{v: NullValue(TextValue("").Type()), exp: nil},
+		{v: OptionalValue(Int32Value(1)), exp: int32(1)},
+		{v: ListValue(Int32Value(1), Int32Value(2)), exp: []any{int32(1), int32(2)}},
+		{v: TupleValue(Int32Value(1), TextValue("a")), exp: []any{int32(1), "a"}},
+		{
+			v:   DictValue(DictValueField{K: BytesValue([]byte("k")), V: Int32Value(1)}),
+			exp: map[any]any{"k": int32(1)},
+		},
+		{
+			v: DictValue(DictValueField{
+				K: TupleValue(Int32Value(1), TextValue("a")),
+				V: ListValue(Int32Value(2)),
+			}),
+			exp: map[any]any{`(1,"a"u)`: []any{int32(2)}},
+		},
+		{
+			v: DictValue(DictValueField{
+				K: OptionalValue(StructValue(StructValueField{Name: "a", V: Int32Value(1)})),
+				V: Int32Value(1),
+			}),
+			exp: map[any]any{"Just(<|`a`:1|>)": int32(1)},
+		},
+		{
+			v:   StructValue(StructValueField{Name: "a", V: Int32Value(1)}),
+			exp: map[string]any{"a": int32(1)},
+		},
+		{v: VoidValue(), exp: VoidValue()},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			require.Equal(t, tt.exp, Any(tt.v))
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package value
 
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
 func CastTo(v Value, dst interface{}) error {
 	if dst == nil {
 		return errNilDestination
@@ -9,6 +16,45 @@ func CastTo(v Value, dst interface{}) error {
 
 		return nil
 	}
+	if unmarshaler, has := dst.(ValueUnmarshaler); has {
+		if err := unmarshaler.UnmarshalYDBValue(v); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
 
 	return v.castTo(dst)
 }
+
+// castItemsToSlice casts items of list (or set) to elements of new slice and stores it into dst
+func castItemsToSlice(items []Value, dst reflect.Value) error {
+	slice := reflect.MakeSlice(dst.Type(), len(items), len(items))
+	for i := range items {
+		if err := CastTo(items[i], slice.Index(i).Addr().Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cast list item %d failed: %w", i, err))
+		}
+	}
+	dst.Set(slice)
+
+	return nil
+}
+
+// castDictToMap casts pairs of dict to keys and values of new map and stores it into dst
+func castDictToMap(values []DictValueField, dst reflect.Value) error {
+	m := reflect.MakeMapWithSize(dst.Type(), len(values))
+	for i := range values {
+		k := reflect.New(dst.Type().Key())
+		if err := CastTo(values[i].K, k.Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cast dict key %s failed: %w", values[i].K.Yql(), err))
+		}
+		v := reflect.New(dst.Type().Elem())
+		if err := CastTo(values[i].V, v.Interface()); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("cast dict value of key %s failed: %w", values[i].K.Yql(), err))
+		}
+		m.SetMapIndex(k.Elem(), v.Elem())
+	}
+	dst.Set(m)
+
+	return nil
+}
@@ -1,12 +1,14 @@
 package value
 
 import (
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 )
 
@@ -53,6 +55,27 @@ func TestCastTo(t *testing.T) {
 			exp:   TextValue("test"),
 			err:   nil,
 		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: DecimalValueFromBigInt(big.NewInt(-12345), 22, 2),
+			dst:   ptr[decimal.Decimal](),
+			exp:   *decimal.NewFromBigInt(big.NewInt(-12345), 22, 2),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: DecimalValueFromBigInt(big.NewInt(-12345), 22, 2),
+			dst:   ptr[string](),
+			exp:   "-123.45",
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: DecimalValueFromBigInt(big.NewInt(-12345), 22, 2),
+			dst:   ptr[big.Rat](),
+			exp:   *big.NewRat(-12345, 100),
+			err:   nil,
+		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: OptionalValue(TextValue("test")),
@@ -158,6 +181,33 @@ func TestCastTo(t *testing.T) {
 			exp:   value2ptr([]byte(`{"test": "text"}"`)),
 			err:   nil,
 		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONDocumentValue(`{"test":"text"}`),
+			dst:   ptr[map[string]string](),
+			exp:   map[string]string{"test": "text"},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONValue(`{"test":"text"}`),
+			dst:   ptr[struct{ Test string }](),
+			exp:   struct{ Test string }{Test: "text"},
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: OptionalValue(JSONValue(`{"test":"text"}`)),
+			dst:   ptr[*map[string]string](),
+			exp:   value2ptr(map[string]string{"test": "text"}),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: JSONValue(`{"test":"text"`),
+			dst:   ptr[map[string]string](),
+			err:   ErrCannotCast,
+		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: JSONDocumentValue(`{"test":"text"}"`),
@@ -356,18 +406,39 @@ func TestCastTo(t *testing.T) {
 			exp:   time.Date(2024, 1, 2, 3, 4, 5, 0, loadLocation(t, "Europe/Moscow")),
 			err:   nil,
 		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: TzTimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, loadLocation(t, "Europe/Moscow"))),
+			dst:   ptr[string](),
+			exp:   "2024-01-02T03:04:05.000000,Europe/Moscow",
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: TzDatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, loadLocation(t, "Europe/Moscow"))),
+			dst:   ptr[time.Time](),
+			exp:   time.Date(2024, 1, 2, 3, 4, 5, 0, loadLocation(t, "Europe/Moscow")),
+			err:   nil,
+		},
+		{
+			name:  xtest.CurrentFileLine(),
+			value: TzDateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, loadLocation(t, "Europe/Moscow"))),
+			dst:   ptr[time.Time](),
+			exp:   time.Date(2024, 1, 2, 0, 0, 0, 0, loadLocation(t, "Europe/Moscow")),
+			err:   nil,
+		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: TzTimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
 			dst:   ptr[string](),
-			exp:   "2024-01-02T03:04:05.000000Z",
+			exp:   "2024-01-02T03:04:05.000000,UTC",
 			err:   nil,
 		},
 		{
 			name:  xtest.CurrentFileLine(),
 			value: TzTimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
 			dst:   ptr[[]byte](),
-			exp:   []byte("2024-01-02T03:04:05.000000Z"),
+			exp:   []byte("2024-01-02T03:04:05.000000,UTC"),
 			err:   nil,
 		},
 		{
@@ -425,3 +496,24 @@ func TestCastTo(t *testing.T) {
 		})
 	}
 }
+
+type testMoney struct {
+	cents int64
+}
+
+func (m *testMoney) UnmarshalYDBValue(v Value) error {
+	var units decimal.Decimal
+	if err := CastTo(v, &units); err != nil {
+		return err
+	}
+	m.cents = units.BigInt().Int64()
+
+	return nil
+}
+
+func TestCastToValueUnmarshaler(t *testing.T) {
+	var m testMoney
+	require.NoError(t, CastTo(DecimalValueFromBigInt(big.NewInt(12345), 22, 2), &m))
+	require.EqualValues(t, 12345, m.cents)
+	require.ErrorIs(t, CastTo(TextValue("test"), &m), ErrCannotCast)
+}
@@ -6,4 +6,5 @@ var (
 	ErrCannotCast                   = errors.New("cast failed")
 	errDestinationTypeIsNotAPointer = errors.New("destination type is not a pointer")
 	errNilDestination               = errors.New("destination is nil")
+	errUnsupportedTimeZone          = errors.New("time zone can't be represented as IANA time zone name")
 )
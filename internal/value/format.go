@@ -0,0 +1,188 @@
+package value
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const redacted = "<redacted>"
+
+type (
+	formatOptions struct {
+		maxItems       int
+		maxBytes       int
+		timeLayout     string
+		redactedFields map[string]struct{}
+	}
+	FormatOption func(o *formatOptions)
+)
+
+// WithFormatMaxItems limits count of rendered items of collections (list, set, dict, tuple).
+// Non-positive value means no limit
+func WithFormatMaxItems(maxItems int) FormatOption {
+	return func(o *formatOptions) {
+		o.maxItems = maxItems
+	}
+}
+
+// WithFormatMaxBytes limits count of rendered bytes of string values (text, bytes, json, yson).
+// Non-positive value means no limit
+func WithFormatMaxBytes(maxBytes int) FormatOption {
+	return func(o *formatOptions) {
+		o.maxBytes = maxBytes
+	}
+}
+
+// WithFormatTimeLayout defines layout for rendering of date, datetime and timestamp values
+func WithFormatTimeLayout(layout string) FormatOption {
+	return func(o *formatOptions) {
+		o.timeLayout = layout
+	}
+}
+
+// WithFormatRedactedFields defines names of struct fields which values must be hidden
+func WithFormatRedactedFields(names ...string) FormatOption {
+	return func(o *formatOptions) {
+		if o.redactedFields == nil {
+			o.redactedFields = make(map[string]struct{}, len(names))
+		}
+		for _, name := range names {
+			o.redactedFields[name] = struct{}{}
+		}
+	}
+}
+
+// Format renders value as a text in YQL-like form with given options.
+// Without options Format returns the same result as Value.Yql()
+func Format(v Value, opts ...FormatOption) string {
+	if v == nil {
+		return "NULL"
+	}
+
+	var o formatOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	return o.format(v).Yql()
+}
+
+// formattedValue overrides YQL representation of value
+type formattedValue struct {
+	Value
+
+	yql string
+}
+
+func (v formattedValue) Yql() string {
+	return v.yql
+}
+
+// format makes value which Yql() renders v with options. Rendering of values is reused from their Yql(),
+// format replaces only parts of value which are affected by options
+func (o *formatOptions) format(v Value) Value {
+	switch vv := v.(type) {
+	case *listValue:
+		items, more := o.formatItems(vv.items)
+
+		return withMore(&listValue{t: vv.t, items: items}, more)
+	case *setValue:
+		items, more := o.formatItems(vv.items)
+
+		return withMore(&setValue{t: vv.t, items: items}, more)
+	case *tupleValue:
+		items, more := o.formatItems(vv.items)
+
+		return withMore(&tupleValue{t: vv.t, items: items}, more)
+	case *dictValue:
+		n, more := o.limit(len(vv.values))
+		values := make([]DictValueField, n)
+		for i := range values {
+			values[i] = DictValueField{K: o.format(vv.values[i].K), V: o.format(vv.values[i].V)}
+		}
+
+		return withMore(&dictValue{t: vv.t, values: values}, more)
+	case *structValue:
+		fields := make([]StructValueField, len(vv.fields))
+		for i := range vv.fields {
+			fields[i] = StructValueField{Name: vv.fields[i].Name, V: o.format(vv.fields[i].V)}
+			if _, has := o.redactedFields[vv.fields[i].Name]; has {
+				fields[i].V = formattedValue{Value: vv.fields[i].V, yql: redacted}
+			}
+		}
+
+		return &structValue{t: vv.t, fields: fields}
+	case *optionalValue:
+		if vv.value == nil {
+			return vv
+		}
+
+		return &optionalValue{innerType: vv.innerType, value: o.format(vv.value)}
+	case *variantValue:
+		return &variantValue{innerType: vv.innerType, value: o.format(vv.value), idx: vv.idx}
+	case textValue:
+		return textValue(o.truncate(string(vv)))
+	case bytesValue:
+		return bytesValue(o.truncate(string(vv)))
+	case jsonValue:
+		return jsonValue(o.truncate(string(vv)))
+	case jsonDocumentValue:
+		return jsonDocumentValue(o.truncate(string(vv)))
+	case ysonValue:
+		return ysonValue(o.truncate(string(vv)))
+	case tzDateValue, tzDatetimeValue, tzTimestampValue:
+		// values with timezone are rendered as is with location of value
+		return vv
+	default:
+		var t time.Time
+		if o.timeLayout != "" && CastTo(v, &t) == nil {
+			return formattedValue{Value: v, yql: fmt.Sprintf("%s(%q)", v.Type().Yql(), t.UTC().Format(o.timeLayout))}
+		}
+
+		return v
+	}
+}
+
+func (o *formatOptions) formatItems(items []Value) (_ []Value, more int) {
+	n, more := o.limit(len(items))
+	formatted := make([]Value, n)
+	for i := range formatted {
+		formatted[i] = o.format(items[i])
+	}
+
+	return formatted, more
+}
+
+// limit returns count of rendered items of collection and count of skipped items
+func (o *formatOptions) limit(total int) (n, more int) {
+	if o.maxItems > 0 && total > o.maxItems {
+		return o.maxItems, total - o.maxItems
+	}
+
+	return total, 0
+}
+
+// withMore appends mark of skipped items to the end of collection
+func withMore(v Value, more int) Value {
+	if more == 0 {
+		return v
+	}
+
+	yql := v.Yql()
+
+	return formattedValue{
+		Value: v,
+		yql:   yql[:len(yql)-1] + ",...(" + strconv.Itoa(more) + " more)" + yql[len(yql)-1:],
+	}
+}
+
+func (o *formatOptions) truncate(s string) string {
+	if o.maxBytes > 0 && len(s) > o.maxBytes {
+		return s[:o.maxBytes] + "..."
+	}
+
+	return s
+}
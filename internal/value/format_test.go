@@ -0,0 +1,60 @@
+package value
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestFormat(t *testing.T) {
+	v := StructValue(
+		StructValueField{Name: "id", V: Uint64Value(1)},
+		StructValueField{Name: "password", V: TextValue("secret")},
+		StructValueField{Name: "tags", V: ListValue(TextValue("a"), TextValue("b"), TextValue("c"))},
+		StructValueField{Name: "payload", V: BytesValue([]byte("0123456789"))},
+		StructValueField{Name: "created", V: TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+		StructValueField{Name: "props", V: OptionalValue(DictValue(
+			DictValueField{K: TextValue("x"), V: Int32Value(1)},
+			DictValueField{K: TextValue("y"), V: Int32Value(2)},
+		))},
+	)
+	t.Run("Default", func(t *testing.T) {
+		require.Equal(t, v.Yql(), Format(v))
+	})
+	t.Run("WithOptions", func(t *testing.T) {
+		require.Equal(t,
+			"<|`created`:Timestamp(\"2024-01-02\"),`id`:1ul,`password`:<redacted>,"+
+				"`payload`:\"0123...\",`props`:Just({\"x\"u:1,...(1 more)}),"+
+				"`tags`:[\"a\"u,...(2 more)]|>",
+			Format(v,
+				WithFormatMaxItems(1),
+				WithFormatMaxBytes(4),
+				WithFormatTimeLayout(time.DateOnly),
+				WithFormatRedactedFields("password"),
+			),
+		)
+	})
+	t.Run("NestedCollections", func(t *testing.T) {
+		v := TupleValue(
+			SetValue(Int32Value(1), Int32Value(2), Int32Value(3)),
+			DatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			OptionalValue(TextValue("abcdef")),
+			NullValue(types.Text),
+		)
+		require.Equal(t, v.Yql(), Format(v))
+		require.Equal(t,
+			"({1,2,...(1 more)},Datetime(\"2024-01-02\"),...(2 more))",
+			Format(v, WithFormatMaxItems(2), WithFormatTimeLayout(time.DateOnly)),
+		)
+		require.Equal(t,
+			"({1,2,3},Datetime(\"2024-01-02T03:04:05Z\"),Just(\"abc...\"u),Nothing(Optional<Utf8>))",
+			Format(v, WithFormatMaxBytes(3)),
+		)
+	})
+	t.Run("Nil", func(t *testing.T) {
+		require.Equal(t, "NULL", Format(nil))
+	})
+}
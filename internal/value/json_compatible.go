@@ -0,0 +1,126 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// JSONCompatible converts value to the Go representation which encodes with encoding/json
+// without loss of precision:
+//   - integers and booleans to the same Go types, finite floats to float32 or float64
+//   - Decimal, DyNumber and non-finite floats to string
+//   - Date to string in format 2006-01-02, Datetime and Timestamp to time.Time in UTC
+//   - Interval to string in format of time.Duration
+//   - Text, Bytes, Yson and Tz* types to string, Uuid to canonical string
+//   - Json and JsonDocument to json.RawMessage
+//   - Optional and Void to nil or to the representation of inner value
+//   - List, Set and Tuple to []any, Struct to map[string]any
+//   - Dict with string keys to map[string]any, other Dict to []any of pairs [key, value]
+//   - Variant to the representation of inner value
+//
+// Other values converts to its YQL representation
+func JSONCompatible(v Value) any { //nolint:funlen,gocyclo
+	switch vv := v.(type) {
+	case nil, voidValue, *voidValue:
+		return nil
+	case *floatValue:
+		if f := float64(vv.value); math.IsNaN(f) || math.IsInf(f, 0) {
+			return vv.Yql()
+		}
+
+		return vv.value
+	case *doubleValue:
+		if math.IsNaN(vv.value) || math.IsInf(vv.value, 0) {
+			return vv.Yql()
+		}
+
+		return vv.value
+	case *decimalValue:
+		return decimal.Format(decimal.FromInt128(vv.value, vv.Precision(), vv.Scale()), vv.Precision(), vv.Scale())
+	case dateValue:
+		return DateToTime(uint32(vv)).Format(LayoutDate)
+	case datetimeValue:
+		return DatetimeToTime(uint32(vv)).UTC()
+	case timestampValue:
+		return TimestampToTime(uint64(vv)).UTC()
+	case intervalValue:
+		return IntervalToDuration(int64(vv)).String()
+	case bytesValue:
+		return xstring.FromBytes(vv)
+	case ysonValue:
+		return xstring.FromBytes(vv)
+	case jsonValue:
+		return rawJSON(string(vv))
+	case jsonDocumentValue:
+		return rawJSON(string(vv))
+	case *uuidValue:
+		return uuid.UUID(UUIDBigEndianByteOrder.FromNative(vv.value)).String()
+	case *optionalValue:
+		return JSONCompatible(vv.value)
+	case *listValue:
+		return jsonCompatibleItems(vv.items)
+	case *setValue:
+		return jsonCompatibleItems(vv.items)
+	case *tupleValue:
+		return jsonCompatibleItems(vv.items)
+	case *dictValue:
+		return jsonCompatibleDict(vv)
+	case *structValue:
+		fields := make(map[string]any, len(vv.fields))
+		for i := range vv.fields {
+			fields[vv.fields[i].Name] = JSONCompatible(vv.fields[i].V)
+		}
+
+		return fields
+	case *variantValue:
+		return JSONCompatible(vv.value)
+	default:
+		switch x := Any(v).(type) {
+		case bool, int8, int16, int32, int64, uint8, uint16, uint32, uint64, string, time.Time:
+			return x
+		default:
+			return v.Yql()
+		}
+	}
+}
+
+func rawJSON(s string) any {
+	if !json.Valid(xstring.ToBytes(s)) {
+		return s
+	}
+
+	return json.RawMessage(s)
+}
+
+func jsonCompatibleItems(items []Value) []any {
+	values := make([]any, 0, len(items))
+	for _, item := range items {
+		values = append(values, JSONCompatible(item))
+	}
+
+	return values
+}
+
+func jsonCompatibleDict(v *dictValue) any {
+	values := make(map[string]any, len(v.values))
+	for i := range v.values {
+		k, ok := JSONCompatible(v.values[i].K).(string)
+		if !ok {
+			pairs := make([]any, 0, len(v.values))
+			for j := range v.values {
+				pairs = append(pairs, []any{JSONCompatible(v.values[j].K), JSONCompatible(v.values[j].V)})
+			}
+
+			return pairs
+		}
+		values[k] = JSONCompatible(v.values[i].V)
+	}
+
+	return values
+}
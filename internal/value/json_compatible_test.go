@@ -0,0 +1,59 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONCompatible(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	for _, tt := range []struct {
+		v   Value
+		exp any
+	}{
+		{v: BoolValue(true), exp: true},
+		{v: Uint64Value(1), exp: uint64(1)},
+		{v: DoubleValue(1.5), exp: 1.5},
+		{v: DoubleValue(math.Inf(1)), exp: DoubleValue(math.Inf(1)).Yql()},
+		{v: DecimalValueFromBigInt(big.NewInt(1500000000), 22, 9), exp: "1.500000000"},
+		{v: TextValue("a"), exp: "a"},
+		{v: BytesValue([]byte("b")), exp: "b"},
+		{v: DateValueFromTime(now), exp: "2023-11-14"},
+		{v: TimestampValueFromTime(now), exp: now.UTC()},
+		{v: IntervalValueFromDuration(time.Second), exp: "1s"},
+		{v: JSONValue(`{"a":1}`), exp: json.RawMessage(`{"a":1}`)},
+		{v: JSONDocumentValue(`{`), exp: `{`},
+		{
+			v: UUIDValueWithByteOrder(
+				uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), UUIDBigEndianByteOrder,
+			),
+			exp: "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		},
+		{v: NullValue(TextValue("").Type()), exp: nil},
+		{v: OptionalValue(Int32Value(1)), exp: int32(1)},
+		{v: ListValue(Int32Value(1), Int32Value(2)), exp: []any{int32(1), int32(2)}},
+		{
+			v:   DictValue(DictValueField{K: BytesValue([]byte("k")), V: Int32Value(1)}),
+			exp: map[string]any{"k": int32(1)},
+		},
+		{
+			v:   DictValue(DictValueField{K: Int32Value(1), V: TextValue("a")}),
+			exp: []any{[]any{int32(1), "a"}},
+		},
+		{
+			v:   StructValue(StructValueField{Name: "a", V: Int32Value(1)}),
+			exp: map[string]any{"a": int32(1)},
+		},
+		{v: VoidValue(), exp: nil},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			require.Equal(t, tt.exp, JSONCompatible(tt.v))
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package value
+
+type (
+	// ValueMarshaler is the interface implemented by types that can convert themselves into YDB value
+	ValueMarshaler interface {
+		MarshalYDBValue() (Value, error)
+	}
+
+	// ValueUnmarshaler is the interface implemented by types that can convert themselves from YDB value
+	ValueUnmarshaler interface {
+		UnmarshalYDBValue(v Value) error
+	}
+)
@@ -0,0 +1,385 @@
+package value
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	errUnsupportedGoType = errors.New("unsupported go type")
+	errNotAStruct        = errors.New("not a struct")
+	errNilInterface      = errors.New("nil interface value")
+	errRecursiveGoType   = errors.New("recursive go type")
+
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDuration = reflect.TypeOf(time.Duration(0))
+	typeOfUUID     = reflect.TypeOf([16]byte{})
+	typeOfBytes    = reflect.TypeOf([]byte(nil))
+	typeOfValue    = reflect.TypeOf((*Value)(nil)).Elem()
+)
+
+// StructFieldsFromGo makes struct fields from fields of Go struct (or pointer to struct).
+// Names of fields defines by tag with tagName (name of Go field by default). Fields of embedded
+// structs without tag are inlined. Fields with tag "-" are skipped. Fields with tag option "json"
+// marshals to JsonDocument values
+func StructFieldsFromGo(v interface{}, tagName string) ([]StructValueField, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %T", errNotAStruct, v))
+	}
+
+	visited, err := visitGoStruct(nil, rv.Type())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return structFieldsFromGo(rv, tagName, visited, nil)
+}
+
+// goTypes is a set of go struct types which are converting now, helps to detect recursive types
+type goTypes map[reflect.Type]struct{}
+
+func visitGoStruct(visited goTypes, t reflect.Type) (goTypes, error) {
+	if _, has := visited[t]; has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errRecursiveGoType, t))
+	}
+	next := make(goTypes, len(visited)+1)
+	for k := range visited {
+		next[k] = struct{}{}
+	}
+	next[t] = struct{}{}
+
+	return next, nil
+}
+
+// goStructField describes how field of go struct converts to field of struct value
+type goStructField struct {
+	name   string
+	json   bool
+	inline bool
+}
+
+func goStructFieldFromTag(f reflect.StructField, tagName string) (_ goStructField, ok bool) {
+	tag, hasTag := f.Tag.Lookup(tagName)
+	if tag == "-" || (!f.IsExported() && !f.Anonymous) {
+		return goStructField{}, false
+	}
+	if f.Anonymous && !hasTag && f.Type.Kind() == reflect.Struct {
+		return goStructField{inline: true}, true
+	}
+	if !f.IsExported() {
+		return goStructField{}, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+
+	return goStructField{name: name, json: opts == "json"}, true
+}
+
+func structFieldsFromGo(rv reflect.Value, tagName string, visited goTypes, fields []StructValueField) (
+	_ []StructValueField, err error,
+) {
+	tt := rv.Type()
+	for i := 0; i < tt.NumField(); i++ {
+		f := tt.Field(i)
+		sf, ok := goStructFieldFromTag(f, tagName)
+		if !ok {
+			continue
+		}
+		if sf.inline {
+			fields, err = structFieldsFromGo(rv.Field(i), tagName, visited, fields)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			continue
+		}
+		var v Value
+		if sf.json {
+			v, err = jsonFromGo(rv.Field(i))
+		} else {
+			v, err = fromGo(rv.Field(i), tagName, visited)
+		}
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w", f.Name, err))
+		}
+		fields = append(fields, StructValueField{
+			Name: sf.name,
+			V:    v,
+		})
+	}
+
+	return fields, nil
+}
+
+func structTypeFieldsFromGo(t reflect.Type, tagName string, visited goTypes, fields []types.StructField) (
+	_ []types.StructField, err error,
+) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		sf, ok := goStructFieldFromTag(f, tagName)
+		if !ok {
+			continue
+		}
+		if sf.inline {
+			fields, err = structTypeFieldsFromGo(f.Type, tagName, visited, fields)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			continue
+		}
+		var ft types.Type
+		switch {
+		case sf.json && f.Type.Kind() == reflect.Pointer:
+			ft = types.NewOptional(types.JSONDocument)
+		case sf.json:
+			ft = types.JSONDocument
+		default:
+			ft, err = typeFromGo(f.Type, tagName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(fmt.Errorf("field '%s': %w", f.Name, err))
+			}
+		}
+		fields = append(fields, types.StructField{
+			Name: sf.name,
+			T:    ft,
+		})
+	}
+
+	return fields, nil
+}
+
+func jsonFromGo(rv reflect.Value) (Value, error) {
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return NullValue(types.JSONDocument), nil
+	}
+
+	data, err := json.Marshal(rv.Interface())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if rv.Kind() == reflect.Pointer {
+		return OptionalValue(JSONDocumentValue(string(data))), nil
+	}
+
+	return JSONDocumentValue(string(data)), nil
+}
+
+// FromGo makes value from Go value. Pointers makes optional values, slices makes lists,
+// maps makes dicts and structs makes struct values (with names of fields by tagName).
+// Nil interfaces and recursive types are not supported
+func FromGo(rv reflect.Value, tagName string) (Value, error) {
+	return fromGo(rv, tagName, nil)
+}
+
+func fromGo(rv reflect.Value, tagName string, visited goTypes) (Value, error) { //nolint:funlen,gocyclo
+	if rv.Kind() == reflect.Interface && rv.IsNil() {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errNilInterface, rv.Type()))
+	}
+	if rv.Type().Implements(typeOfValue) && !(rv.Kind() == reflect.Pointer && rv.IsNil()) {
+		return rv.Interface().(Value), nil //nolint:forcetypeassert
+	}
+
+	switch rv.Type() {
+	case typeOfTime:
+		return TimestampValueFromTime(rv.Interface().(time.Time)), nil //nolint:forcetypeassert
+	case typeOfDuration:
+		return IntervalValueFromDuration(time.Duration(rv.Int())), nil
+	case typeOfUUID:
+		return UUIDValue(rv.Interface().([16]byte)), nil //nolint:forcetypeassert
+	case typeOfBytes:
+		return BytesValue(rv.Bytes()), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return BoolValue(rv.Bool()), nil
+	case reflect.Int8:
+		return Int8Value(int8(rv.Int())), nil
+	case reflect.Int16:
+		return Int16Value(int16(rv.Int())), nil
+	case reflect.Int32, reflect.Int:
+		return Int32Value(int32(rv.Int())), nil
+	case reflect.Int64:
+		return Int64Value(rv.Int()), nil
+	case reflect.Uint8:
+		return Uint8Value(uint8(rv.Uint())), nil
+	case reflect.Uint16:
+		return Uint16Value(uint16(rv.Uint())), nil
+	case reflect.Uint32, reflect.Uint:
+		return Uint32Value(uint32(rv.Uint())), nil
+	case reflect.Uint64:
+		return Uint64Value(rv.Uint()), nil
+	case reflect.Float32:
+		return FloatValue(float32(rv.Float())), nil
+	case reflect.Float64:
+		return DoubleValue(rv.Float()), nil
+	case reflect.String:
+		return TextValue(rv.String()), nil
+	case reflect.Pointer:
+		if rv.IsNil() {
+			t, err := typeFromGo(rv.Type().Elem(), tagName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+
+			return NullValue(t), nil
+		}
+		v, err := fromGo(rv.Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return OptionalValue(v), nil
+	case reflect.Slice, reflect.Array:
+		t, err := typeFromGo(rv.Type().Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		items := make([]Value, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			item, err := fromGo(rv.Index(i), tagName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			items = append(items, item)
+		}
+
+		return &listValue{
+			t:     types.NewList(t),
+			items: items,
+		}, nil
+	case reflect.Map:
+		kt, err := typeFromGo(rv.Type().Key(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		vt, err := typeFromGo(rv.Type().Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		values := make([]DictValueField, 0, rv.Len())
+		for it := rv.MapRange(); it.Next(); {
+			k, err := fromGo(it.Key(), tagName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			v, err := fromGo(it.Value(), tagName, visited)
+			if err != nil {
+				return nil, xerrors.WithStackTrace(err)
+			}
+			values = append(values, DictValueField{K: k, V: v})
+		}
+		dict := DictValue(values...)
+		dict.t = types.NewDict(kt, vt)
+
+		return dict, nil
+	case reflect.Struct:
+		visited, err := visitGoStruct(visited, rv.Type())
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		fields, err := structFieldsFromGo(rv, tagName, visited, nil)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return StructValue(fields...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedGoType, rv.Type()))
+	}
+}
+
+// TypeFromGo returns YDB type which FromGo makes from values of Go type t
+func TypeFromGo(t reflect.Type, tagName string) (types.Type, error) {
+	return typeFromGo(t, tagName, nil)
+}
+
+func typeFromGo(t reflect.Type, tagName string, visited goTypes) (types.Type, error) { //nolint:funlen,gocyclo
+	switch t {
+	case typeOfTime:
+		return types.Timestamp, nil
+	case typeOfDuration:
+		return types.Interval, nil
+	case typeOfUUID:
+		return types.UUID, nil
+	case typeOfBytes:
+		return types.Bytes, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return types.Bool, nil
+	case reflect.Int8:
+		return types.Int8, nil
+	case reflect.Int16:
+		return types.Int16, nil
+	case reflect.Int32, reflect.Int:
+		return types.Int32, nil
+	case reflect.Int64:
+		return types.Int64, nil
+	case reflect.Uint8:
+		return types.Uint8, nil
+	case reflect.Uint16:
+		return types.Uint16, nil
+	case reflect.Uint32, reflect.Uint:
+		return types.Uint32, nil
+	case reflect.Uint64:
+		return types.Uint64, nil
+	case reflect.Float32:
+		return types.Float, nil
+	case reflect.Float64:
+		return types.Double, nil
+	case reflect.String:
+		return types.Text, nil
+	case reflect.Pointer:
+		inner, err := typeFromGo(t.Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewOptional(inner), nil
+	case reflect.Slice, reflect.Array:
+		inner, err := typeFromGo(t.Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewList(inner), nil
+	case reflect.Map:
+		kt, err := typeFromGo(t.Key(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		vt, err := typeFromGo(t.Elem(), tagName, visited)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewDict(kt, vt), nil
+	case reflect.Struct:
+		visited, err := visitGoStruct(visited, t)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		fields, err := structTypeFieldsFromGo(t, tagName, visited, nil)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return types.NewStruct(fields...), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errUnsupportedGoType, t))
+	}
+}
@@ -0,0 +1,55 @@
+package value
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestFromGoErrors(t *testing.T) {
+	t.Run("NilInterface", func(t *testing.T) {
+		_, err := StructFieldsFromGo(struct {
+			V Value
+		}{}, "sql")
+		require.ErrorIs(t, err, errNilInterface)
+	})
+	t.Run("InterfaceType", func(t *testing.T) {
+		_, err := TypeFromGo(reflect.TypeOf(struct {
+			V Value
+		}{}), "sql")
+		require.ErrorIs(t, err, errUnsupportedGoType)
+	})
+	t.Run("RecursiveType", func(t *testing.T) {
+		type node struct {
+			ID   int32
+			Next *node
+		}
+		_, err := TypeFromGo(reflect.TypeOf(node{}), "sql")
+		require.ErrorIs(t, err, errRecursiveGoType)
+
+		_, err = StructFieldsFromGo(node{ID: 1}, "sql")
+		require.ErrorIs(t, err, errRecursiveGoType)
+
+		_, err = StructFieldsFromGo(node{ID: 1, Next: &node{ID: 2}}, "sql")
+		require.ErrorIs(t, err, errRecursiveGoType)
+	})
+	t.Run("SameTypeInSiblingFields", func(t *testing.T) {
+		type item struct {
+			ID int32
+		}
+		tt, err := TypeFromGo(reflect.TypeOf(struct {
+			A item
+			B *item
+		}{}), "sql")
+		require.NoError(t, err)
+		require.Equal(t, types.NewStruct(
+			types.StructField{Name: "A", T: types.NewStruct(types.StructField{Name: "ID", T: types.Int32})},
+			types.StructField{Name: "B", T: types.NewOptional(
+				types.NewStruct(types.StructField{Name: "ID", T: types.Int32}),
+			)},
+		), tt)
+	})
+}
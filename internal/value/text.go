@@ -0,0 +1,210 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02T15:04:05Z"
+)
+
+var errTextNotSupported = errors.New("text representation not supported")
+
+// Text returns plain text representation of primitive value (without type annotations and quotes of YQL)
+// in format which YDB parses in CSV data. isNull is true for NULL values
+func Text(v Value) (text string, isNull bool, _ error) {
+	switch vv := v.(type) {
+	case *optionalValue:
+		if vv.value == nil {
+			return "", true, nil
+		}
+
+		return Text(vv.value)
+	case boolValue:
+		return strconv.FormatBool(bool(vv)), false, nil
+	case int8Value:
+		return strconv.FormatInt(int64(vv), 10), false, nil
+	case int16Value:
+		return strconv.FormatInt(int64(vv), 10), false, nil
+	case int32Value:
+		return strconv.FormatInt(int64(vv), 10), false, nil
+	case int64Value:
+		return strconv.FormatInt(int64(vv), 10), false, nil
+	case uint8Value:
+		return strconv.FormatUint(uint64(vv), 10), false, nil
+	case uint16Value:
+		return strconv.FormatUint(uint64(vv), 10), false, nil
+	case uint32Value:
+		return strconv.FormatUint(uint64(vv), 10), false, nil
+	case uint64Value:
+		return strconv.FormatUint(uint64(vv), 10), false, nil
+	case *floatValue:
+		return strconv.FormatFloat(float64(vv.value), 'g', -1, 32), false, nil
+	case *doubleValue:
+		return strconv.FormatFloat(vv.value, 'g', -1, 64), false, nil
+	case dateValue:
+		return DateToTime(uint32(vv)).Format(dateLayout), false, nil
+	case datetimeValue:
+		return DatetimeToTime(uint32(vv)).UTC().Format(datetimeLayout), false, nil
+	case timestampValue:
+		return TimestampToTime(uint64(vv)).UTC().Format(time.RFC3339Nano), false, nil
+	case intervalValue:
+		return strconv.FormatInt(int64(vv), 10), false, nil
+	case tzDateValue:
+		return string(vv), false, nil
+	case tzDatetimeValue:
+		return string(vv), false, nil
+	case tzTimestampValue:
+		return string(vv), false, nil
+	case textValue:
+		return string(vv), false, nil
+	case bytesValue:
+		return string(vv), false, nil
+	case jsonValue:
+		return string(vv), false, nil
+	case jsonDocumentValue:
+		return string(vv), false, nil
+	case ysonValue:
+		return string(vv), false, nil
+	case dyNumberValue:
+		return string(vv), false, nil
+	case *uuidValue:
+		return uuid.UUID(UUIDBigEndianByteOrder.FromNative(vv.value)).String(), false, nil
+	case *decimalValue:
+		precision, scale := vv.innerType.Precision(), vv.innerType.Scale()
+
+		return decimal.Format(decimal.FromBytes(vv.value[:], precision, scale), precision, scale), false, nil
+	default:
+		return "", false, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errTextNotSupported, v.Type().Yql()))
+	}
+}
+
+// FromText parses plain text representation of value of type t (as returned by Text).
+// Text of optional type parses as value of inner type
+func FromText(t types.Type, text string) (Value, error) { //nolint:funlen,gocyclo
+	switch tt := t.(type) {
+	case types.Optional:
+		v, err := FromText(tt.InnerType(), text)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return OptionalValue(v), nil
+	case *types.Decimal:
+		return DecimalValueFromString(text, tt.Precision(), tt.Scale())
+	case types.Primitive:
+		return primitiveFromText(tt, text)
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errTextNotSupported, t.Yql()))
+	}
+}
+
+func primitiveFromText(t types.Primitive, text string) (_ Value, err error) { //nolint:funlen,gocyclo
+	var (
+		i int64
+		u uint64
+		f float64
+		d time.Time
+	)
+	switch t {
+	case types.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return BoolValue(b), nil
+	case types.Int8, types.Int16, types.Int32, types.Int64, types.Interval:
+		if i, err = strconv.ParseInt(text, 10, 64); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		if u, err = strconv.ParseUint(text, 10, 64); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	case types.Float, types.Double:
+		if f, err = strconv.ParseFloat(text, 64); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	case types.Date:
+		if d, err = time.Parse(dateLayout, text); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	case types.Datetime:
+		if d, err = time.Parse(datetimeLayout, text); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	case types.Timestamp:
+		if d, err = time.Parse(time.RFC3339Nano, text); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	switch t {
+	case types.Int8:
+		return Int8Value(int8(i)), nil
+	case types.Int16:
+		return Int16Value(int16(i)), nil
+	case types.Int32:
+		return Int32Value(int32(i)), nil
+	case types.Int64:
+		return Int64Value(i), nil
+	case types.Interval:
+		return IntervalValue(i), nil
+	case types.Uint8:
+		return Uint8Value(uint8(u)), nil
+	case types.Uint16:
+		return Uint16Value(uint16(u)), nil
+	case types.Uint32:
+		return Uint32Value(uint32(u)), nil
+	case types.Uint64:
+		return Uint64Value(u), nil
+	case types.Float:
+		return FloatValue(float32(f)), nil
+	case types.Double:
+		return DoubleValue(f), nil
+	case types.Date:
+		return DateValueFromTime(d), nil
+	case types.Datetime:
+		return DatetimeValueFromTime(d), nil
+	case types.Timestamp:
+		return TimestampValueFromTime(d), nil
+	case types.TzDate:
+		return TzDateValue(text), nil
+	case types.TzDatetime:
+		return TzDatetimeValue(text), nil
+	case types.TzTimestamp:
+		return TzTimestampValue(text), nil
+	case types.Text:
+		return TextValue(text), nil
+	case types.Bytes:
+		return BytesValue([]byte(text)), nil
+	case types.JSON:
+		return JSONValue(text), nil
+	case types.JSONDocument:
+		return JSONDocumentValue(text), nil
+	case types.YSON:
+		return YSONValue([]byte(text)), nil
+	case types.DyNumber:
+		return DyNumberValue(text), nil
+	case types.UUID:
+		id, err := uuid.Parse(text)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return UUIDValueWithByteOrder(id, UUIDBigEndianByteOrder), nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", errTextNotSupported, t.Yql()))
+	}
+}
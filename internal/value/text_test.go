@@ -0,0 +1,89 @@
+package value
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestText(t *testing.T) {
+	decimalValue, err := DecimalValueFromString("123.45", 22, 9)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		value  Value
+		text   string
+		isNull bool
+	}{
+		{value: BoolValue(true), text: "true"},
+		{value: Int32Value(-42), text: "-42"},
+		{value: Uint64Value(42), text: "42"},
+		{value: DoubleValue(1.5), text: "1.5"},
+		{value: TextValue("abc"), text: "abc"},
+		{value: BytesValue([]byte("abc")), text: "abc"},
+		{value: DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), text: "2024-01-02"},
+		{value: DatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)), text: "2024-01-02T03:04:05Z"},
+		{
+			value: TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+			text:  "2024-01-02T03:04:05.000006Z",
+		},
+		{value: decimalValue, text: "123.450000000"},
+		{
+			value: UUIDValueWithByteOrder(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"), UUIDBigEndianByteOrder),
+			text:  "00112233-4455-6677-8899-aabbccddeeff",
+		},
+		{value: OptionalValue(Int32Value(1)), text: "1"},
+		{value: NullValue(types.Int32), isNull: true},
+	} {
+		t.Run(tt.value.Yql(), func(t *testing.T) {
+			text, isNull, err := Text(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.isNull, isNull)
+			require.Equal(t, tt.text, text)
+		})
+	}
+
+	_, _, err = Text(ListValue(Int32Value(1)))
+	require.ErrorIs(t, err, errTextNotSupported)
+}
+
+func TestFromText(t *testing.T) {
+	decimalValue, err := DecimalValueFromString("123.45", 22, 9)
+	require.NoError(t, err)
+
+	for _, v := range []Value{
+		BoolValue(true),
+		Int8Value(-8),
+		Int32Value(-42),
+		Uint64Value(42),
+		FloatValue(1.5),
+		DoubleValue(1.5),
+		TextValue("multi\nline \"text\""),
+		BytesValue([]byte("abc\r\n")),
+		JSONDocumentValue(`{"a":"b\nc"}`),
+		DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+		DatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+		IntervalValueFromDuration(time.Second),
+		UUIDValueWithByteOrder(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"), UUIDBigEndianByteOrder),
+		decimalValue,
+		OptionalValue(Int32Value(1)),
+	} {
+		t.Run(v.Yql(), func(t *testing.T) {
+			text, _, err := Text(v)
+			require.NoError(t, err)
+			parsed, err := FromText(v.Type(), text)
+			require.NoError(t, err)
+			require.Equal(t, v.Yql(), parsed.Yql())
+		})
+	}
+
+	_, err = FromText(types.Int32, "abc")
+	require.Error(t, err)
+	_, err = FromText(types.NewList(types.Int32), "1")
+	require.ErrorIs(t, err, errTextNotSupported)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -60,6 +61,92 @@ func TimestampToTime(n uint64) time.Time {
 	return time.Unix(int64(sec), int64(nsec))
 }
 
+// formatTz formats time with layout and appends name of time location as YDB text
+// representation of TzDate, TzDatetime and TzTimestamp values.
+// Name of time location must be a valid IANA name. Time in location without valid IANA name (such as
+// time.Local or time.FixedZone) converts to location "Etc/GMT±h" with the same whole-hour offset.
+// formatTz returns error if offset of time isn't whole-hour because conversion to other location changes
+// wall clock (and date of TzDate) of time.
+// IANA time locations loads from tzdata of host (see time.LoadLocation), so binaries for hosts without
+// tzdata must import package time/tzdata
+func formatTz(t time.Time, layout string) (string, error) {
+	if !isIANALocation(t) {
+		loc, err := fixedZoneLocation(t)
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		t = t.In(loc)
+	}
+
+	return t.Format(layout) + "," + t.Location().String(), nil
+}
+
+// formatTzOrOffset formats time with formatTz or with numeric offset instead of name of time location
+// if time location can't be represented with IANA name. Values with numeric offset rejects by YDB server,
+// so query with such value fails explicitly instead of silent change of wall clock of time
+func formatTzOrOffset(t time.Time, layout string) string {
+	s, err := formatTz(t, layout)
+	if err != nil {
+		return t.Format(layout) + "," + t.Format("-07:00")
+	}
+
+	return s
+}
+
+// ianaLocations caches results of validation of names of time locations
+var ianaLocations sync.Map
+
+// isIANALocation checks that time location of t has valid IANA name with the same offset at time t
+func isIANALocation(t time.Time) bool {
+	name := t.Location().String()
+	if t.Location() == time.Local || name == "" {
+		return false
+	}
+
+	v, ok := ianaLocations.Load(name)
+	if !ok {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			loc = nil
+		}
+		v, _ = ianaLocations.LoadOrStore(name, loc)
+	}
+
+	loc, _ := v.(*time.Location)
+	if loc == nil {
+		return false
+	}
+
+	_, offset := t.Zone()
+	_, ianaOffset := t.In(loc).Zone()
+
+	return offset == ianaOffset
+}
+
+// fixedZoneLocation returns IANA location "Etc/GMT±h" with offset of t or UTC for zero offset.
+// Returns error if offset isn't whole-hour or out of range of "Etc/GMT±h" locations
+func fixedZoneLocation(t time.Time) (*time.Location, error) {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return time.UTC, nil
+	}
+
+	hours := offset / int(time.Hour/time.Second)
+	if offset%int(time.Hour/time.Second) != 0 || hours < -12 || hours > 14 { //nolint:gomnd
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: offset %s of %q",
+			errUnsupportedTimeZone, t.Format("-07:00"), t.Location().String(),
+		))
+	}
+
+	// sign of offset in names of Etc/GMT locations is inverted (Etc/GMT-3 is UTC+3)
+	loc, err := time.LoadLocation(fmt.Sprintf("Etc/GMT%+d", -hours))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %w", errUnsupportedTimeZone, err))
+	}
+
+	return loc, nil
+}
+
 func TzDateToTime(s string) (t time.Time, err error) {
 	ss := strings.Split(s, ",")
 	if len(ss) != 2 { //nolint:gomnd
@@ -70,3 +70,93 @@ func TestTzSomeToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatTz(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name string
+		src  time.Time
+		exp  string
+	}{
+		{
+			name: "IANA",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, berlin),
+			exp:  "2020-05-29T11:22:54,Europe/Berlin",
+		},
+		{
+			name: "UTC",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, time.UTC),
+			exp:  "2020-05-29T11:22:54,UTC",
+		},
+		{
+			name: "FixedZoneWholeHours",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, time.FixedZone("MSK", 3*60*60)),
+			exp:  "2020-05-29T11:22:54,Etc/GMT-3",
+		},
+		{
+			name: "FixedZoneNegativeOffset",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, time.FixedZone("", -5*60*60)),
+			exp:  "2020-05-29T11:22:54,Etc/GMT+5",
+		},
+		{
+			name: "FixedZoneZeroOffset",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, time.FixedZone("Z", 0)),
+			exp:  "2020-05-29T11:22:54,UTC",
+		},
+		{
+			name: "FixedZoneWithIANANameAndOtherOffset",
+			src:  time.Date(2020, time.May, 29, 11, 22, 54, 0, time.FixedZone("Europe/Berlin", 0)),
+			exp:  "2020-05-29T11:22:54,UTC",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := formatTz(tt.src, LayoutTzDatetime)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, s)
+
+			parsed, err := TzDatetimeToTime(s)
+			require.NoError(t, err)
+			require.True(t, tt.src.Equal(parsed))
+		})
+	}
+}
+
+func TestFormatTzNotWholeHours(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  time.Time
+		exp  string
+	}{
+		{
+			name: "AfterMidnight",
+			src:  time.Date(2020, time.May, 29, 0, 10, 0, 0, time.FixedZone("IST", 5*60*60+30*60)),
+			exp:  "2020-05-29T00:10:00,+05:30",
+		},
+		{
+			name: "BeforeMidnight",
+			src:  time.Date(2020, time.May, 29, 23, 50, 0, 0, time.FixedZone("NPT", 5*60*60+45*60)),
+			exp:  "2020-05-29T23:50:00,+05:45",
+		},
+		{
+			name: "NegativeOffset",
+			src:  time.Date(2020, time.May, 29, 23, 50, 0, 0, time.FixedZone("", -(3*60*60+30*60))),
+			exp:  "2020-05-29T23:50:00,-03:30",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := formatTz(tt.src, LayoutTzDatetime)
+			require.ErrorIs(t, err, errUnsupportedTimeZone)
+
+			// wall clock (and date) of time must not be changed
+			require.Equal(t, tt.exp, formatTzOrOffset(tt.src, LayoutTzDatetime))
+			require.Equal(t, tt.exp[:len(LayoutDate)]+tt.exp[len(LayoutTzDatetime):],
+				string(TzDateValueFromTime(tt.src)),
+			)
+			require.Equal(t, tt.src.Format(LayoutTzTimestamp)+tt.exp[len(LayoutTzDatetime):],
+				string(TzTimestampValueFromTime(tt.src)),
+			)
+		})
+	}
+}
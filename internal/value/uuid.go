@@ -0,0 +1,121 @@
+package value
+
+import (
+	"reflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+// UUIDByteOrder defines interpretation of [16]byte representation of UUID
+type UUIDByteOrder uint8
+
+const (
+	// UUIDNativeByteOrder interprets bytes as high and low big-endian halves of YDB uint128 value.
+	// UUIDNativeByteOrder is a legacy byte order of ydb-go-sdk
+	UUIDNativeByteOrder UUIDByteOrder = iota
+
+	// UUIDBigEndianByteOrder interprets bytes in RFC 4122 byte order, same as in canonical string
+	// representation of UUID and in uuid.UUID types from github.com/google/uuid
+	UUIDBigEndianByteOrder
+)
+
+var uuidBytesType = reflect.TypeOf([16]byte{})
+
+// ToNative converts UUID bytes in byte order to native byte order
+func (order UUIDByteOrder) ToNative(v [16]byte) (native [16]byte) {
+	if order != UUIDBigEndianByteOrder {
+		return v
+	}
+
+	for i := 0; i < 8; i++ {
+		native[i] = v[15-i]
+	}
+	native[8], native[9], native[10], native[11] = v[6], v[7], v[4], v[5]
+	native[12], native[13], native[14], native[15] = v[0], v[1], v[2], v[3]
+
+	return native
+}
+
+// FromNative converts UUID bytes in native byte order to byte order
+func (order UUIDByteOrder) FromNative(native [16]byte) (v [16]byte) {
+	if order != UUIDBigEndianByteOrder {
+		return native
+	}
+
+	for i := 0; i < 8; i++ {
+		v[15-i] = native[i]
+	}
+	v[6], v[7], v[4], v[5] = native[8], native[9], native[10], native[11]
+	v[0], v[1], v[2], v[3] = native[12], native[13], native[14], native[15]
+
+	return v
+}
+
+// UUIDValueWithByteOrder makes UUID value from bytes in given byte order
+func UUIDValueWithByteOrder(v [16]byte, order UUIDByteOrder) *uuidValue {
+	return UUIDValue(order.ToNative(v))
+}
+
+// NullableUUIDValueWithByteOrder makes optional UUID value from bytes in given byte order
+func NullableUUIDValueWithByteOrder(v *[16]byte, order UUIDByteOrder) Value {
+	if v == nil {
+		return NullValue(types.UUID)
+	}
+
+	return OptionalValue(UUIDValueWithByteOrder(*v, order))
+}
+
+// UUIDBytes returns bytes of v if v is a [16]byte or a named type with [16]byte underlying type
+// such as uuid.UUID from github.com/google/uuid
+func UUIDBytes(v interface{}) ([16]byte, bool) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || !rv.Type().ConvertibleTo(uuidBytesType) || rv.Kind() != reflect.Array {
+		return [16]byte{}, false
+	}
+
+	return rv.Convert(uuidBytesType).Interface().([16]byte), true //nolint:forcetypeassert
+}
+
+// UUIDValueFromAny makes UUID value from [16]byte-compatible value or pointer to such value.
+// Nil pointer makes null UUID value
+func UUIDValueFromAny(v interface{}, order UUIDByteOrder) (Value, bool) {
+	if b, ok := UUIDBytes(v); ok {
+		return UUIDValueWithByteOrder(b, order), true
+	}
+
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Pointer || rv.Type().Elem().Kind() != reflect.Array ||
+		!rv.Type().Elem().ConvertibleTo(uuidBytesType) {
+		return nil, false
+	}
+
+	if rv.IsNil() {
+		return NullValue(types.UUID), true
+	}
+
+	b, _ := UUIDBytes(rv.Elem().Interface())
+
+	return NullableUUIDValueWithByteOrder(&b, order), true
+}
+
+// CastToWithUUIDByteOrder casts value to destination as CastTo. UUID values are casted
+// to [16]byte-compatible destinations in given byte order
+func CastToWithUUIDByteOrder(v Value, dst interface{}, order UUIDByteOrder) error {
+	if order == UUIDNativeByteOrder {
+		return CastTo(v, dst)
+	}
+
+	switch vv := v.(type) {
+	case *uuidValue:
+		v = UUIDValue(order.FromNative(vv.value))
+	case *optionalValue:
+		if inner, ok := vv.value.(*uuidValue); ok {
+			v = &optionalValue{
+				innerType: vv.innerType,
+				value:     UUIDValue(order.FromNative(inner.value)),
+			}
+		}
+	}
+
+	return CastTo(v, dst)
+}
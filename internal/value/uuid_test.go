@@ -0,0 +1,60 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+type testUUID [16]byte
+
+func TestUUIDByteOrder(t *testing.T) {
+	bigEndian := [16]byte{
+		0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77,
+		0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff,
+	}
+	t.Run("ToYDB", func(t *testing.T) {
+		a := allocator.New()
+		defer a.Free()
+		v := UUIDValueWithByteOrder(bigEndian, UUIDBigEndianByteOrder).toYDB(a)
+		require.EqualValues(t, uint64(0x6677445500112233), v.GetLow_128())
+		require.EqualValues(t, uint64(0xffeeddccbbaa9988), v.GetHigh_128())
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		native := UUIDBigEndianByteOrder.ToNative(bigEndian)
+		require.NotEqual(t, bigEndian, native)
+		require.Equal(t, bigEndian, UUIDBigEndianByteOrder.FromNative(native))
+		require.Equal(t, native, UUIDNativeByteOrder.ToNative(native))
+	})
+	t.Run("FromAny", func(t *testing.T) {
+		v, ok := UUIDValueFromAny(testUUID(bigEndian), UUIDBigEndianByteOrder)
+		require.True(t, ok)
+		require.Equal(t, UUIDValueWithByteOrder(bigEndian, UUIDBigEndianByteOrder), v)
+
+		v, ok = UUIDValueFromAny((*testUUID)(nil), UUIDBigEndianByteOrder)
+		require.True(t, ok)
+		require.Equal(t, NullValue(types.UUID), v)
+
+		_, ok = UUIDValueFromAny([]byte{1, 2, 3}, UUIDBigEndianByteOrder)
+		require.False(t, ok)
+	})
+	t.Run("CastTo", func(t *testing.T) {
+		v := UUIDValueWithByteOrder(bigEndian, UUIDBigEndianByteOrder)
+
+		var native testUUID
+		require.NoError(t, CastTo(v, &native))
+		require.Equal(t, testUUID(UUIDBigEndianByteOrder.ToNative(bigEndian)), native)
+
+		var dst testUUID
+		require.NoError(t, CastToWithUUIDByteOrder(v, &dst, UUIDBigEndianByteOrder))
+		require.Equal(t, testUUID(bigEndian), dst)
+
+		var optional *testUUID
+		require.NoError(t, CastToWithUUIDByteOrder(OptionalValue(v), &optional, UUIDBigEndianByteOrder))
+		require.NotNil(t, optional)
+		require.Equal(t, testUUID(bigEndian), *optional)
+	})
+}
@@ -2,6 +2,7 @@ package value
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -496,10 +497,37 @@ type DecimalValuer interface {
 }
 
 func (v *decimalValue) castTo(dst interface{}) error {
-	return xerrors.WithStackTrace(fmt.Errorf(
-		"%w '%+v' to '%T' destination",
-		ErrCannotCast, v, dst,
-	))
+	switch vv := dst.(type) {
+	case *decimal.Decimal:
+		*vv = decimal.Decimal{
+			Bytes:     v.value,
+			Precision: v.Precision(),
+			Scale:     v.Scale(),
+		}
+
+		return nil
+	case *string:
+		*vv = decimal.Format(decimal.FromInt128(v.value, v.Precision(), v.Scale()), v.Precision(), v.Scale())
+
+		return nil
+	case *big.Rat:
+		d := decimal.Decimal{Bytes: v.value, Precision: v.Precision(), Scale: v.Scale()}
+		r := d.Rat()
+		if r == nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%s' to '%T' destination",
+				ErrCannotCast, d.String(), dst,
+			))
+		}
+		vv.Set(r)
+
+		return nil
+	default:
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%+v' to '%T' destination",
+			ErrCannotCast, v, dst,
+		))
+	}
 }
 
 func (v *decimalValue) Yql() string {
@@ -585,6 +613,10 @@ func (v *dictValue) DictValues() map[Value]Value {
 }
 
 func (v *dictValue) castTo(dst interface{}) error {
+	if ptr := reflect.ValueOf(dst); ptr.Kind() == reflect.Pointer && ptr.Elem().Kind() == reflect.Map {
+		return castDictToMap(v.values, ptr.Elem())
+	}
+
 	return xerrors.WithStackTrace(fmt.Errorf(
 		"%w '%+v' to '%T' destination",
 		ErrCannotCast, v, dst,
@@ -1130,6 +1162,25 @@ func IntervalValueFromDuration(v time.Duration) intervalValue {
 
 type jsonValue string
 
+// unmarshalJSON decodes json text of Json or JsonDocument value into Go value (struct, map, slice, etc.)
+func unmarshalJSON(v Value, data string, dst interface{}) error {
+	if rv := reflect.ValueOf(dst); rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%s(%+v)' to '%T' destination",
+			ErrCannotCast, v.Type().Yql(), v, dst,
+		))
+	}
+
+	if err := json.Unmarshal(xstring.ToBytes(data), dst); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf(
+			"%w '%s(%+v)' to '%T' destination: %w",
+			ErrCannotCast, v.Type().Yql(), v, dst, err,
+		))
+	}
+
+	return nil
+}
+
 func (v jsonValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
 	case *string:
@@ -1141,10 +1192,7 @@ func (v jsonValue) castTo(dst interface{}) error {
 
 		return nil
 	default:
-		return xerrors.WithStackTrace(fmt.Errorf(
-			"%w '%s(%+v)' to '%T' destination",
-			ErrCannotCast, v.Type().Yql(), v, vv,
-		))
+		return unmarshalJSON(v, string(v), dst)
 	}
 }
 
@@ -1183,10 +1231,7 @@ func (v jsonDocumentValue) castTo(dst interface{}) error {
 
 		return nil
 	default:
-		return xerrors.WithStackTrace(fmt.Errorf(
-			"%w '%s(%+v)' to '%T' destination",
-			ErrCannotCast, v.Type().Yql(), v, vv,
-		))
+		return unmarshalJSON(v, string(v), dst)
 	}
 }
 
@@ -1222,6 +1267,10 @@ func (v *listValue) ListItems() []Value {
 }
 
 func (v *listValue) castTo(dst interface{}) error {
+	if ptr := reflect.ValueOf(dst); ptr.Kind() == reflect.Pointer && ptr.Elem().Kind() == reflect.Slice {
+		return castItemsToSlice(v.items, ptr.Elem())
+	}
+
 	return xerrors.WithStackTrace(fmt.Errorf(
 		"%w '%s(%+v)' to '%T' destination",
 		ErrCannotCast, v.Type().Yql(), v, dst,
@@ -1315,6 +1364,10 @@ type setValue struct {
 }
 
 func (v *setValue) castTo(dst interface{}) error {
+	if ptr := reflect.ValueOf(dst); ptr.Kind() == reflect.Pointer && ptr.Elem().Kind() == reflect.Slice {
+		return castItemsToSlice(v.items, ptr.Elem())
+	}
+
 	return xerrors.WithStackTrace(fmt.Errorf(
 		"%w '%+v' to '%T' destination",
 		ErrCannotCast, v, dst,
@@ -1661,6 +1714,14 @@ type tzDateValue string
 
 func (v tzDateValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
+	case *time.Time:
+		t, err := TzDateToTime(string(v))
+		if err != nil {
+			return err
+		}
+		*vv = t
+
+		return nil
 	case *string:
 		*vv = string(v)
 
@@ -1699,14 +1760,23 @@ func TzDateValue(v string) tzDateValue {
 	return tzDateValue(v)
 }
 
+// TzDateValueFromTime makes TzDate value from time with preserving of time location
 func TzDateValueFromTime(t time.Time) tzDateValue {
-	return tzDateValue(t.Format(LayoutDate))
+	return tzDateValue(formatTzOrOffset(t, LayoutDate))
 }
 
 type tzDatetimeValue string
 
 func (v tzDatetimeValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
+	case *time.Time:
+		t, err := TzDatetimeToTime(string(v))
+		if err != nil {
+			return err
+		}
+		*vv = t
+
+		return nil
 	case *string:
 		*vv = string(v)
 
@@ -1745,8 +1815,9 @@ func TzDatetimeValue(v string) tzDatetimeValue {
 	return tzDatetimeValue(v)
 }
 
+// TzDatetimeValueFromTime makes TzDatetime value from time with preserving of time location
 func TzDatetimeValueFromTime(t time.Time) tzDatetimeValue {
-	return tzDatetimeValue(t.Format(LayoutDatetime))
+	return tzDatetimeValue(formatTzOrOffset(t, LayoutTzDatetime))
 }
 
 type tzTimestampValue string
@@ -1799,8 +1870,9 @@ func TzTimestampValue(v string) tzTimestampValue {
 	return tzTimestampValue(v)
 }
 
+// TzTimestampValueFromTime makes TzTimestamp value from time with preserving of time location
 func TzTimestampValueFromTime(t time.Time) tzTimestampValue {
-	return tzTimestampValue(t.Format(LayoutTimestamp))
+	return tzTimestampValue(formatTzOrOffset(t, LayoutTzTimestamp))
 }
 
 type uint8Value uint8
@@ -2116,6 +2188,13 @@ func (v *uuidValue) castTo(dst interface{}) error {
 
 		return nil
 	default:
+		if ptr := reflect.ValueOf(dst); ptr.Kind() == reflect.Pointer && !ptr.IsNil() &&
+			ptr.Elem().Kind() == reflect.Array && uuidBytesType.ConvertibleTo(ptr.Elem().Type()) {
+			ptr.Elem().Set(reflect.ValueOf(v.value).Convert(ptr.Elem().Type()))
+
+			return nil
+		}
+
 		return xerrors.WithStackTrace(fmt.Errorf(
 			"%w '%s(%+v)' to '%T' destination",
 			ErrCannotCast, v.Type().Yql(), v, vv,
@@ -17,8 +17,21 @@ func Check(err error) (
 			backoff.TypeNoBackoff,
 			false
 	}
+	if IsPanic(err) {
+		// recovered panic means a bug in user callback, so callback must not be retried
+		// even if panic value is a retryable error
+		return -1,
+			TypeNonRetryable,
+			backoff.TypeNoBackoff,
+			false
+	}
 	var e Error
 	if As(err, &e) {
+		var unretryable unretryableError
+		if As(err, &unretryable) {
+			return int64(e.Code()), TypeNonRetryable, backoff.TypeNoBackoff, !e.IsRetryObjectValid()
+		}
+
 		return int64(e.Code()), e.Type(), e.BackoffType(), !e.IsRetryObjectValid()
 	}
 
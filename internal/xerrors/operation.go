@@ -116,6 +116,21 @@ func (e *operationError) Issues() []*Ydb_Issue.IssueMessage {
 	return e.issues
 }
 
+// NodeID reports ID of node which returned operation error
+func (e *operationError) NodeID() uint32 {
+	return e.nodeID
+}
+
+// Address reports address of node which returned operation error
+func (e *operationError) Address() string {
+	return e.address
+}
+
+// TraceID reports trace ID of request which failed with operation error
+func (e *operationError) TraceID() string {
+	return e.traceID
+}
+
 func (e *operationError) Error() string {
 	b := xstring.Buffer()
 	defer b.Free()
@@ -0,0 +1,75 @@
+package xerrors
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is an error which wraps recovered value of panic in user callback
+type PanicError struct {
+	recovered interface{}
+	stack     []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic recovered: %v", e.recovered)
+}
+
+// Recovered returns the value which was passed to panic
+func (e *PanicError) Recovered() interface{} {
+	return e.recovered
+}
+
+// Stack returns stack trace of goroutine at the moment of recover
+func (e *PanicError) Stack() []byte {
+	return e.stack
+}
+
+// Unwrap returns recovered value if it is an error.
+// Recovered panic is non-retryable (see Check) regardless of the unwrapped error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.recovered.(error); ok {
+		return err
+	}
+
+	return nil
+}
+
+// Panic makes PanicError from recovered value. Panic must be called in deferred func
+// for capture stack trace of panicked goroutine
+func Panic(recovered interface{}) error {
+	return &PanicError{
+		recovered: recovered,
+		stack:     debug.Stack(),
+	}
+}
+
+// IsPanic checks whether given err is a recovered panic
+func IsPanic(err error) bool {
+	return ToPanic(err) != nil
+}
+
+// ToPanic returns PanicError from err chain or nil if err is not a recovered panic
+func ToPanic(err error) *PanicError {
+	var e *PanicError
+	if err != nil && As(err, &e) {
+		return e
+	}
+
+	return nil
+}
+
+// WithRecover calls f and converts panic in f into PanicError.
+// If onPanic is not nil it calls with recovered value before returning of error.
+func WithRecover(onPanic func(e interface{}), f func() error) (finalErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if onPanic != nil {
+				onPanic(e)
+			}
+			finalErr = WithStackTrace(Panic(e))
+		}
+	}()
+
+	return f()
+}
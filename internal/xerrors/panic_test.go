@@ -0,0 +1,63 @@
+package xerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRecover(t *testing.T) {
+	t.Run("NoPanic", func(t *testing.T) {
+		testErr := errors.New("test")
+		err := WithRecover(nil, func() error {
+			return testErr
+		})
+		require.ErrorIs(t, err, testErr)
+		require.False(t, IsPanic(err))
+	})
+	t.Run("Panic", func(t *testing.T) {
+		var recovered interface{}
+		err := WithRecover(func(e interface{}) {
+			recovered = e
+		}, func() error {
+			panic("test panic")
+		})
+		require.Error(t, err)
+		require.True(t, IsPanic(err))
+		require.Equal(t, "test panic", recovered)
+		require.Equal(t, "test panic", ToPanic(err).Recovered())
+		require.NotEmpty(t, ToPanic(err).Stack())
+		require.Contains(t, err.Error(), "panic recovered: test panic")
+	})
+	t.Run("PanicWithError", func(t *testing.T) {
+		testErr := errors.New("test")
+		err := WithRecover(nil, func() error {
+			panic(testErr)
+		})
+		require.True(t, IsPanic(err))
+		require.ErrorIs(t, err, testErr)
+	})
+	t.Run("Wrapped", func(t *testing.T) {
+		err := fmt.Errorf("wrapped: %w", WithRecover(nil, func() error {
+			panic(1)
+		}))
+		require.True(t, IsPanic(err))
+		require.Equal(t, 1, ToPanic(err).Recovered())
+	})
+	t.Run("PanicWithRetryableError", func(t *testing.T) {
+		err := WithRecover(nil, func() error {
+			panic(Retryable(errors.New("test")))
+		})
+		require.True(t, IsPanic(err))
+		_, errType, _, _ := Check(err)
+		require.Equal(t, TypeNonRetryable, errType)
+		_, errType, _, _ = Check(fmt.Errorf("wrapped: %w", err))
+		require.Equal(t, TypeNonRetryable, errType)
+	})
+	t.Run("Nil", func(t *testing.T) {
+		require.False(t, IsPanic(nil))
+		require.Nil(t, ToPanic(nil))
+	})
+}
@@ -71,3 +71,10 @@ func TestUnretryableUnwrap(t *testing.T) {
 	wrapped := Unretryable(test)
 	require.ErrorIs(t, wrapped, test)
 }
+
+func TestCheckUnretryable(t *testing.T) {
+	_, errType, _, _ := Check(Retryable(errors.New("test")))
+	require.Equal(t, TypeRetryable, errType)
+	_, errType, _, _ = Check(fmt.Errorf("wrap: %w", Unretryable(Retryable(errors.New("test")))))
+	require.Equal(t, TypeNonRetryable, errType)
+}
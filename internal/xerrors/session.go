@@ -0,0 +1,62 @@
+package xerrors
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+)
+
+// SessionError is an error which means that session is broken (BAD_SESSION or SESSION_EXPIRED)
+// and must be rebuilt. SessionError carries identity of broken session
+type SessionError struct {
+	err       error
+	sessionID string
+	nodeID    uint32
+}
+
+func (e *SessionError) Error() string {
+	return fmt.Sprintf("%v (session %q on node %d)", e.err, e.sessionID, e.nodeID)
+}
+
+// SessionID returns ID of broken session
+func (e *SessionError) SessionID() string {
+	return e.sessionID
+}
+
+// NodeID returns ID of node of broken session
+func (e *SessionError) NodeID() uint32 {
+	return e.nodeID
+}
+
+func (e *SessionError) Unwrap() error {
+	return e.err
+}
+
+// IsBadSession checks whether given err means that session is broken and must be rebuilt
+func IsBadSession(err error) bool {
+	return IsOperationError(err, Ydb.StatusIds_BAD_SESSION, Ydb.StatusIds_SESSION_EXPIRED)
+}
+
+// WithSessionInfo wraps err about broken session into SessionError with identity of session.
+// Other errors and already wrapped errors returns as is
+func WithSessionInfo(err error, sessionID string, nodeID uint32) error {
+	if !IsBadSession(err) || ToSessionError(err) != nil {
+		return err
+	}
+
+	return &SessionError{
+		err:       err,
+		sessionID: sessionID,
+		nodeID:    nodeID,
+	}
+}
+
+// ToSessionError returns SessionError from err chain or nil if err is not a SessionError
+func ToSessionError(err error) *SessionError {
+	var e *SessionError
+	if err != nil && As(err, &e) {
+		return e
+	}
+
+	return nil
+}
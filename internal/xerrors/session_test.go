@@ -0,0 +1,38 @@
+package xerrors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+)
+
+func TestWithSessionInfo(t *testing.T) {
+	t.Run("BadSession", func(t *testing.T) {
+		err := WithSessionInfo(WithStackTrace(Operation(WithStatusCode(Ydb.StatusIds_BAD_SESSION))), "test", 1)
+		require.True(t, IsBadSession(err))
+		require.True(t, IsOperationError(err, Ydb.StatusIds_BAD_SESSION))
+		sessionErr := ToSessionError(err)
+		require.NotNil(t, sessionErr)
+		require.Equal(t, "test", sessionErr.SessionID())
+		require.EqualValues(t, 1, sessionErr.NodeID())
+		require.Contains(t, err.Error(), `session "test" on node 1`)
+		require.Same(t, sessionErr, ToSessionError(WithSessionInfo(WithStackTrace(err), "other", 2)))
+	})
+	t.Run("SessionExpired", func(t *testing.T) {
+		err := WithSessionInfo(Operation(WithStatusCode(Ydb.StatusIds_SESSION_EXPIRED)), "test", 1)
+		require.NotNil(t, ToSessionError(err))
+		require.False(t, IsRetryObjectValid(err))
+	})
+	t.Run("OtherError", func(t *testing.T) {
+		for _, err := range []error{
+			errors.New("test"),
+			Operation(WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+		} {
+			require.Equal(t, err, WithSessionInfo(err, "test", 1))
+			require.Nil(t, ToSessionError(err))
+		}
+		require.Nil(t, WithSessionInfo(nil, "test", 1))
+	})
+}
@@ -27,6 +27,21 @@ func (e *transportError) GRPCStatus() *grpcStatus.Status {
 
 func (e *transportError) isYdbError() {}
 
+// NodeID reports ID of node of failed call
+func (e *transportError) NodeID() uint32 {
+	return e.nodeID
+}
+
+// Address reports address of node of failed call
+func (e *transportError) Address() string {
+	return e.address
+}
+
+// TraceID reports trace ID of failed call
+func (e *transportError) TraceID() string {
+	return e.traceID
+}
+
 func (e *transportError) Code() int32 {
 	return int32(e.status.Code())
 }
@@ -0,0 +1,57 @@
+package xotel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func endpointAttributes(e trace.EndpointInfo) []attribute.KeyValue {
+	if e == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		AttributePeerAddress.String(e.Address()),
+		AttributeNodeID.Int64(int64(e.NodeID())),
+	}
+}
+
+// Driver makes trace.Driver with spans of gRPC calls and propagation of trace context into gRPC metadata
+func Driver(tracerProvider oteltrace.TracerProvider) (t trace.Driver) {
+	s := newSpanStarter(tracerProvider)
+
+	t.OnConnInvoke = func(info trace.DriverConnInvokeStartInfo) func(trace.DriverConnInvokeDoneInfo) {
+		done := s.start(info.Context, string(info.Method), oteltrace.SpanKindClient,
+			append(endpointAttributes(info.Endpoint),
+				AttributeRPCSystem.String("grpc"),
+				AttributeRPCMethod.String(string(info.Method)),
+			)...,
+		)
+		if info.Context != nil {
+			*info.Context = WithTraceParent(*info.Context)
+		}
+
+		return func(info trace.DriverConnInvokeDoneInfo) {
+			done(info.Error, attribute.String("ydb.operation.id", info.OpID))
+		}
+	}
+	t.OnConnNewStream = func(info trace.DriverConnNewStreamStartInfo) func(trace.DriverConnNewStreamDoneInfo) {
+		done := s.start(info.Context, string(info.Method), oteltrace.SpanKindClient,
+			append(endpointAttributes(info.Endpoint),
+				AttributeRPCSystem.String("grpc"),
+				AttributeRPCMethod.String(string(info.Method)),
+			)...,
+		)
+		if info.Context != nil {
+			*info.Context = WithTraceParent(*info.Context)
+		}
+
+		return func(info trace.DriverConnNewStreamDoneInfo) {
+			done(info.Error)
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,98 @@
+package xotel
+
+import (
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Query makes trace.Query with spans of retry loops, sessions and queries of query service
+func Query(tracerProvider oteltrace.TracerProvider) (t trace.Query) {
+	s := newSpanStarter(tracerProvider)
+
+	t.OnDo = func(info trace.QueryDoStartInfo) func(trace.QueryDoDoneInfo) {
+		done := s.start(info.Context, "ydb.query.Do", oteltrace.SpanKindInternal)
+
+		return func(info trace.QueryDoDoneInfo) {
+			done(info.Error, AttributeAttempts.Int(info.Attempts))
+		}
+	}
+	t.OnDoTx = func(info trace.QueryDoTxStartInfo) func(trace.QueryDoTxDoneInfo) {
+		done := s.start(info.Context, "ydb.query.DoTx", oteltrace.SpanKindInternal)
+
+		return func(info trace.QueryDoTxDoneInfo) {
+			done(info.Error, AttributeAttempts.Int(info.Attempts))
+		}
+	}
+	t.OnExec = func(info trace.QueryExecStartInfo) func(trace.QueryExecDoneInfo) {
+		done := s.start(info.Context, "ydb.query.Exec", oteltrace.SpanKindInternal,
+			AttributeDBStatement.String(info.Query),
+		)
+
+		return func(info trace.QueryExecDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnQuery = func(info trace.QueryQueryStartInfo) func(trace.QueryQueryDoneInfo) {
+		done := s.start(info.Context, "ydb.query.Query", oteltrace.SpanKindInternal,
+			AttributeDBStatement.String(info.Query),
+		)
+
+		return func(info trace.QueryQueryDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnSessionCreate = func(info trace.QuerySessionCreateStartInfo) func(trace.QuerySessionCreateDoneInfo) {
+		done := s.start(info.Context, "ydb.query.CreateSession", oteltrace.SpanKindClient)
+
+		return func(info trace.QuerySessionCreateDoneInfo) {
+			if info.Error == nil && info.Session != nil {
+				done(info.Error, sessionAttributes(info.Session)...)
+			} else {
+				done(info.Error)
+			}
+		}
+	}
+	t.OnSessionExec = func(info trace.QuerySessionExecStartInfo) func(trace.QuerySessionExecDoneInfo) {
+		done := s.start(info.Context, "ydb.query.session.Exec", oteltrace.SpanKindClient,
+			append(sessionAttributes(info.Session), AttributeDBStatement.String(info.Query))...,
+		)
+
+		return func(info trace.QuerySessionExecDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnSessionQuery = func(info trace.QuerySessionQueryStartInfo) func(trace.QuerySessionQueryDoneInfo) {
+		done := s.start(info.Context, "ydb.query.session.Query", oteltrace.SpanKindClient,
+			append(sessionAttributes(info.Session), AttributeDBStatement.String(info.Query))...,
+		)
+
+		return func(info trace.QuerySessionQueryDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnTxExec = func(info trace.QueryTxExecStartInfo) func(trace.QueryTxExecDoneInfo) {
+		done := s.start(info.Context, "ydb.query.tx.Exec", oteltrace.SpanKindClient,
+			append(append(sessionAttributes(info.Session), txAttributes(info.Tx)...),
+				AttributeDBStatement.String(info.Query),
+			)...,
+		)
+
+		return func(info trace.QueryTxExecDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnTxQuery = func(info trace.QueryTxQueryStartInfo) func(trace.QueryTxQueryDoneInfo) {
+		done := s.start(info.Context, "ydb.query.tx.Query", oteltrace.SpanKindClient,
+			append(append(sessionAttributes(info.Session), txAttributes(info.Tx)...),
+				AttributeDBStatement.String(info.Query),
+			)...,
+		)
+
+		return func(info trace.QueryTxQueryDoneInfo) {
+			done(info.Error)
+		}
+	}
+
+	return t
+}
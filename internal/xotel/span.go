@@ -0,0 +1,153 @@
+package xotel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// InstrumentationName is a name of tracer of ydb-go-sdk
+const InstrumentationName = "github.com/ydb-platform/ydb-go-sdk/v3"
+
+// Semantic attributes of spans
+const (
+	AttributeDBSystem    = attribute.Key("db.system")
+	AttributeDBStatement = attribute.Key("db.statement")
+	AttributeSessionID   = attribute.Key("ydb.session.id")
+	AttributeNodeID      = attribute.Key("ydb.node.id")
+	AttributeTxID        = attribute.Key("ydb.tx.id")
+	AttributeAttempts    = attribute.Key("ydb.retry.attempts")
+	AttributeLabel       = attribute.Key("ydb.retry.label")
+	AttributeIdempotent  = attribute.Key("ydb.retry.idempotent")
+	AttributeRPCSystem   = attribute.Key("rpc.system")
+	AttributeRPCMethod   = attribute.Key("rpc.method")
+	AttributePeerAddress = attribute.Key("net.peer.name")
+	AttributeTopic       = attribute.Key("messaging.destination.name")
+	AttributePartitionID = attribute.Key("messaging.destination.partition.id")
+	AttributeMessages    = attribute.Key("messaging.batch.message_count")
+	AttributeProducerID  = attribute.Key("ydb.topic.producer_id")
+)
+
+const dbSystem = "ydb"
+
+type sessionInfo interface {
+	ID() string
+	NodeID() uint32
+}
+
+type txInfo interface {
+	ID() string
+}
+
+// spanStarter starts spans with tracer of ydb-go-sdk
+type spanStarter struct {
+	tracer oteltrace.Tracer
+}
+
+func newSpanStarter(tracerProvider oteltrace.TracerProvider) spanStarter {
+	return spanStarter{
+		tracer: tracerProvider.Tracer(InstrumentationName),
+	}
+}
+
+// start starts span as child of span from context and replaces context (if not nil) with context of new span.
+// Returned function ends span with error and additional attributes
+func (s spanStarter) start(
+	ctx *context.Context, name string, kind oteltrace.SpanKind, attrs ...attribute.KeyValue,
+) func(err error, attrs ...attribute.KeyValue) {
+	parent := context.Background()
+	if ctx != nil && *ctx != nil {
+		parent = *ctx
+	}
+
+	spanCtx, span := s.tracer.Start(parent, name,
+		oteltrace.WithSpanKind(kind),
+		oteltrace.WithAttributes(append(attrs, AttributeDBSystem.String(dbSystem))...),
+	)
+	if ctx != nil {
+		*ctx = spanCtx
+	}
+
+	return func(err error, attrs ...attribute.KeyValue) {
+		finish(span, err, attrs...)
+	}
+}
+
+func finish(span oteltrace.Span, err error, attrs ...attribute.KeyValue) {
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func sessionAttributes(s sessionInfo) []attribute.KeyValue {
+	if s == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		AttributeSessionID.String(s.ID()),
+		AttributeNodeID.Int64(int64(s.NodeID())),
+	}
+}
+
+func txAttributes(tx txInfo) []attribute.KeyValue {
+	if tx == nil {
+		return nil
+	}
+
+	return []attribute.KeyValue{
+		AttributeTxID.String(tx.ID()),
+	}
+}
+
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// WithTraceParent returns a copy of parent context with W3C trace context of span from parent context
+// in outgoing gRPC metadata
+func WithTraceParent(ctx context.Context) context.Context {
+	if !oteltrace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	md := metadata.MD{}
+	propagation.TraceContext{}.Inject(ctx, metadataCarrier(md))
+
+	kv := make([]string, 0, len(md)*2) //nolint:gomnd
+	for key, values := range md {
+		for _, value := range values {
+			kv = append(kv, key, value)
+		}
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
@@ -0,0 +1,58 @@
+package xotel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func parentContext(t *testing.T) (context.Context, oteltrace.SpanContext) {
+	traceID, err := oteltrace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := oteltrace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	spanCtx := oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: oteltrace.FlagsSampled,
+	})
+
+	return oteltrace.ContextWithSpanContext(context.Background(), spanCtx), spanCtx
+}
+
+func TestWithTraceParent(t *testing.T) {
+	t.Run("WithoutSpan", func(t *testing.T) {
+		ctx := WithTraceParent(context.Background())
+		_, has := metadata.FromOutgoingContext(ctx)
+		require.False(t, has)
+	})
+	t.Run("WithSpan", func(t *testing.T) {
+		ctx, _ := parentContext(t)
+		md, has := metadata.FromOutgoingContext(WithTraceParent(ctx))
+		require.True(t, has)
+		require.Equal(t,
+			[]string{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+			md.Get("traceparent"),
+		)
+	})
+}
+
+func TestDriverConnInvoke(t *testing.T) {
+	ctx, spanCtx := parentContext(t)
+	d := Driver(noop.NewTracerProvider())
+	onDone := d.OnConnInvoke(trace.DriverConnInvokeStartInfo{
+		Context: &ctx,
+		Method:  "/Ydb.Query.V1.QueryService/ExecuteQuery",
+	})
+	require.Equal(t, spanCtx.TraceID(), oteltrace.SpanContextFromContext(ctx).TraceID())
+	md, has := metadata.FromOutgoingContext(ctx)
+	require.True(t, has)
+	require.Len(t, md.Get("traceparent"), 1)
+	onDone(trace.DriverConnInvokeDoneInfo{})
+}
@@ -0,0 +1,85 @@
+package xotel
+
+import (
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Table makes trace.Table with spans of retry loops, sessions and queries of table service
+func Table(tracerProvider oteltrace.TracerProvider) (t trace.Table) {
+	s := newSpanStarter(tracerProvider)
+
+	t.OnDo = func(info trace.TableDoStartInfo) func(trace.TableDoDoneInfo) {
+		done := s.start(info.Context, "ydb.table.Do", oteltrace.SpanKindInternal,
+			AttributeLabel.String(info.Label),
+			AttributeIdempotent.Bool(info.Idempotent),
+		)
+
+		return func(info trace.TableDoDoneInfo) {
+			done(info.Error, AttributeAttempts.Int(info.Attempts))
+		}
+	}
+	t.OnDoTx = func(info trace.TableDoTxStartInfo) func(trace.TableDoTxDoneInfo) {
+		done := s.start(info.Context, "ydb.table.DoTx", oteltrace.SpanKindInternal,
+			AttributeLabel.String(info.Label),
+			AttributeIdempotent.Bool(info.Idempotent),
+		)
+
+		return func(info trace.TableDoTxDoneInfo) {
+			done(info.Error, AttributeAttempts.Int(info.Attempts))
+		}
+	}
+	t.OnCreateSession = func(info trace.TableCreateSessionStartInfo) func(trace.TableCreateSessionDoneInfo) {
+		done := s.start(info.Context, "ydb.table.CreateSession", oteltrace.SpanKindClient)
+
+		return func(info trace.TableCreateSessionDoneInfo) {
+			if info.Error == nil && info.Session != nil {
+				done(info.Error, append(sessionAttributes(info.Session), AttributeAttempts.Int(info.Attempts))...)
+			} else {
+				done(info.Error, AttributeAttempts.Int(info.Attempts))
+			}
+		}
+	}
+	t.OnSessionQueryExecute = func(
+		info trace.TableExecuteDataQueryStartInfo,
+	) func(trace.TableExecuteDataQueryDoneInfo) {
+		attrs := sessionAttributes(info.Session)
+		if info.Query != nil {
+			attrs = append(attrs, AttributeDBStatement.String(info.Query.YQL()))
+		}
+		done := s.start(info.Context, "ydb.table.session.Execute", oteltrace.SpanKindClient, attrs...)
+
+		return func(info trace.TableExecuteDataQueryDoneInfo) {
+			if info.Error == nil && info.Tx != nil {
+				done(info.Error, txAttributes(info.Tx)...)
+			} else {
+				done(info.Error)
+			}
+		}
+	}
+	t.OnSessionQueryStreamExecute = func(
+		info trace.TableSessionQueryStreamExecuteStartInfo,
+	) func(trace.TableSessionQueryStreamExecuteDoneInfo) {
+		attrs := sessionAttributes(info.Session)
+		if info.Query != nil {
+			attrs = append(attrs, AttributeDBStatement.String(info.Query.YQL()))
+		}
+		done := s.start(info.Context, "ydb.table.session.StreamExecute", oteltrace.SpanKindClient, attrs...)
+
+		return func(info trace.TableSessionQueryStreamExecuteDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnTxCommit = func(info trace.TableTxCommitStartInfo) func(trace.TableTxCommitDoneInfo) {
+		done := s.start(info.Context, "ydb.table.tx.Commit", oteltrace.SpanKindClient,
+			append(sessionAttributes(info.Session), txAttributes(info.Tx)...)...,
+		)
+
+		return func(info trace.TableTxCommitDoneInfo) {
+			done(info.Error)
+		}
+	}
+
+	return t
+}
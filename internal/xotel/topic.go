@@ -0,0 +1,67 @@
+package xotel
+
+import (
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// Topic makes trace.Topic with spans of reading, committing and writing of messages and reconnects of streams
+func Topic(tracerProvider oteltrace.TracerProvider) (t trace.Topic) {
+	s := newSpanStarter(tracerProvider)
+
+	t.OnReaderReconnect = func(info trace.TopicReaderReconnectStartInfo) func(trace.TopicReaderReconnectDoneInfo) {
+		done := s.start(nil, "ydb.topic.reader.Reconnect", oteltrace.SpanKindInternal)
+
+		return func(info trace.TopicReaderReconnectDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnReaderReadMessages = func(
+		info trace.TopicReaderReadMessagesStartInfo,
+	) func(trace.TopicReaderReadMessagesDoneInfo) {
+		done := s.start(info.RequestContext, "ydb.topic.reader.ReadMessages", oteltrace.SpanKindConsumer)
+
+		return func(info trace.TopicReaderReadMessagesDoneInfo) {
+			done(info.Error,
+				AttributeTopic.String(info.Topic),
+				AttributePartitionID.Int64(info.PartitionID),
+				AttributeMessages.Int(info.MessagesCount),
+			)
+		}
+	}
+	t.OnReaderCommit = func(info trace.TopicReaderCommitStartInfo) func(trace.TopicReaderCommitDoneInfo) {
+		done := s.start(info.RequestContext, "ydb.topic.reader.Commit", oteltrace.SpanKindClient,
+			AttributeTopic.String(info.Topic),
+			AttributePartitionID.Int64(info.PartitionID),
+		)
+
+		return func(info trace.TopicReaderCommitDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnWriterReconnect = func(info trace.TopicWriterReconnectStartInfo) func(trace.TopicWriterReconnectDoneInfo) {
+		done := s.start(nil, "ydb.topic.writer.Reconnect", oteltrace.SpanKindInternal,
+			AttributeTopic.String(info.Topic),
+			AttributeProducerID.String(info.ProducerID),
+		)
+
+		return func(info trace.TopicWriterReconnectDoneInfo) {
+			done(info.Error)
+		}
+	}
+	t.OnWriterSendMessages = func(
+		info trace.TopicWriterSendMessagesStartInfo,
+	) func(trace.TopicWriterSendMessagesDoneInfo) {
+		done := s.start(nil, "ydb.topic.writer.SendMessages", oteltrace.SpanKindProducer,
+			AttributeSessionID.String(info.SessionID),
+			AttributeMessages.Int(info.MessagesCount),
+		)
+
+		return func(info trace.TopicWriterSendMessagesDoneInfo) {
+			done(info.Error)
+		}
+	}
+
+	return t
+}
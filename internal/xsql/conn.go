@@ -15,6 +15,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
@@ -239,6 +240,9 @@ func (c *conn) executeDataQuery(ctx context.Context, query string, args []driver
 	if err := res.Err(); err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
+	if target := queryStatsFromContext(ctx); target != nil {
+		*target = res.Stats()
+	}
 
 	return resultNoRows{}, nil
 }
@@ -365,6 +369,7 @@ func (c *conn) execDataQuery(ctx context.Context, query string, params params.Pa
 	return &rows{
 		conn:   c,
 		result: res,
+		stats:  queryStatsFromContext(ctx),
 	}, nil
 }
 
@@ -382,6 +387,7 @@ func (c *conn) execScanQuery(ctx context.Context, query string, params params.Pa
 	return &rows{
 		conn:   c,
 		result: res,
+		stats:  queryStatsFromContext(ctx),
 	}, nil
 }
 
@@ -469,6 +475,13 @@ func (c *conn) Begin() (driver.Tx, error) {
 func (c *conn) normalize(q string, args ...driver.NamedValue) (query string, _ params.Parameters, _ error) {
 	return c.connector.Bindings.RewriteQuery(q, func() (ii []interface{}) {
 		for i := range args {
+			// with default (native) byte order UUID arguments binds as before, through driver.Valuer
+			// implementation of uuid type if any
+			if c.connector.uuidByteOrder != value.UUIDNativeByteOrder {
+				if v, ok := value.UUIDValueFromAny(args[i].Value, c.connector.uuidByteOrder); ok {
+					args[i].Value = v
+				}
+			}
 			ii = append(ii, args[i])
 		}
 
@@ -724,6 +737,19 @@ func (c *conn) IsPrimaryKey(ctx context.Context, tableName, columnName string) (
 	return ok, nil
 }
 
+// BulkUpsert upserts rows to table with single (or several, if data split to chunks) requests
+// instead of row-at-a-time queries. Available with sql.Conn.Raw for batch loading of data
+func (c *conn) BulkUpsert(
+	ctx context.Context, tableName string, data table.BulkUpsertData, opts ...options.BulkUpsertOption,
+) error {
+	err := c.connector.parent.Table().BulkUpsert(ctx, c.normalizePath(tableName), data, opts...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
 func (c *conn) normalizePath(folderOrTable string) (absPath string) {
 	return c.connector.pathNormalizer.NormalizePath(folderOrTable)
 }
@@ -2,6 +2,14 @@ package xsql
 
 import (
 	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 )
 
 var (
@@ -48,4 +56,31 @@ var (
 	_ interface {
 		GetIndexColumns(ctx context.Context, tableName string, indexName string) (columns []string, err error)
 	} = (*conn)(nil)
+
+	_ interface {
+		BulkUpsert(ctx context.Context, tableName string, data table.BulkUpsertData,
+			opts ...options.BulkUpsertOption) error
+	} = (*conn)(nil)
 )
+
+type testUUID [16]byte
+
+func (u testUUID) Value() (driver.Value, error) {
+	return "00010203-0405-0607-0809-0a0b0c0d0e0f", nil
+}
+
+func TestConnNormalizeUUID(t *testing.T) {
+	uuid := testUUID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	t.Run("DefaultByteOrder", func(t *testing.T) {
+		c := &conn{connector: &Connector{}}
+		_, params, err := c.normalize("SELECT $a", driver.NamedValue{Name: "a", Value: uuid})
+		require.NoError(t, err)
+		require.Equal(t, value.TextValue("00010203-0405-0607-0809-0a0b0c0d0e0f"), params[0].Value())
+	})
+	t.Run("BigEndianByteOrder", func(t *testing.T) {
+		c := &conn{connector: &Connector{uuidByteOrder: value.UUIDBigEndianByteOrder}}
+		_, params, err := c.normalize("SELECT $a", driver.NamedValue{Name: "a", Value: uuid})
+		require.NoError(t, err)
+		require.Equal(t, value.UUIDValueWithByteOrder(uuid, value.UUIDBigEndianByteOrder), params[0].Value())
+	})
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
 	metaHeaders "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
@@ -83,6 +85,18 @@ func (opt defaultTxControlOption) Apply(c *Connector) error {
 	return nil
 }
 
+type uuidByteOrderConnectorOption value.UUIDByteOrder
+
+func (order uuidByteOrderConnectorOption) Apply(c *Connector) error {
+	c.uuidByteOrder = value.UUIDByteOrder(order)
+
+	return nil
+}
+
+func WithUUIDByteOrder(order value.UUIDByteOrder) ConnectorOption {
+	return uuidByteOrderConnectorOption(order)
+}
+
 func WithDefaultTxControl(txControl *table.TransactionControl) ConnectorOption {
 	return defaultTxControlOption{txControl}
 }
@@ -190,6 +204,19 @@ func WithretryBudget(b budget.Budget) ConnectorOption {
 	return retryBudgetConnectorOption{b: b}
 }
 
+type txRetryOptionsConnectorOption []retry.Option
+
+func (opts txRetryOptionsConnectorOption) Apply(c *Connector) error {
+	c.txRetryOptions = append(c.txRetryOptions, opts...)
+
+	return nil
+}
+
+// WithTxRetryOptions appends default retry options of retry.DoTx calls with connector
+func WithTxRetryOptions(opts ...retry.Option) ConnectorOption {
+	return txRetryOptionsConnectorOption(opts)
+}
+
 type fakeTxConnectorOption QueryMode
 
 func (m fakeTxConnectorOption) Apply(c *Connector) error {
@@ -262,10 +289,13 @@ type Connector struct {
 	defaultScanQueryOpts  []options.ExecuteScanQueryOption
 	disableServerBalancer bool
 	idleThreshold         time.Duration
+	uuidByteOrder         value.UUIDByteOrder
 
 	trace       *trace.DatabaseSQL
 	traceRetry  *trace.Retry
 	retryBudget budget.Budget
+
+	txRetryOptions []retry.Option
 }
 
 var (
@@ -373,6 +403,10 @@ func (d *driverWrapper) RetryBudget() budget.Budget {
 	return d.c.retryBudget
 }
 
+func (d *driverWrapper) TxRetryOptions() []retry.Option {
+	return d.c.txRetryOptions
+}
+
 func (d *driverWrapper) Open(_ string) (driver.Conn, error) {
 	return nil, ErrUnsupported
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 type (
@@ -13,6 +14,7 @@ type (
 	ctxScanQueryOptionsKey   struct{}
 	ctxModeTypeKey           struct{}
 	ctxTxControlHookKey      struct{}
+	ctxQueryStatsKey         struct{}
 
 	txControlHook func(txControl *table.TransactionControl)
 )
@@ -35,6 +37,20 @@ func queryModeFromContext(ctx context.Context, defaultQueryMode QueryMode) Query
 	return defaultQueryMode
 }
 
+// WithQueryStats returns a copy of context with target of query stats.
+// Stats of data and scan queries stores to target after execution (or after close of rows)
+func WithQueryStats(ctx context.Context, target *stats.QueryStats) context.Context {
+	return context.WithValue(ctx, ctxQueryStatsKey{}, target)
+}
+
+func queryStatsFromContext(ctx context.Context) *stats.QueryStats {
+	if target, ok := ctx.Value(ctxQueryStatsKey{}).(*stats.QueryStats); ok {
+		return target
+	}
+
+	return nil
+}
+
 func WithTxControl(ctx context.Context, txc *table.TransactionControl) context.Context {
 	return context.WithValue(ctx, ctxTransactionControlKey{}, txc)
 }
@@ -63,11 +79,15 @@ func (c *conn) WithScanQueryOptions(ctx context.Context, opts ...options.Execute
 }
 
 func (c *conn) scanQueryOptions(ctx context.Context) []options.ExecuteScanQueryOption {
-	if opts, ok := ctx.Value(ctxScanQueryOptionsKey{}).([]options.ExecuteScanQueryOption); ok {
-		return append(c.scanOpts, opts...)
+	opts := c.scanOpts
+	if ctxOpts, ok := ctx.Value(ctxScanQueryOptionsKey{}).([]options.ExecuteScanQueryOption); ok {
+		opts = append(opts, ctxOpts...)
+	}
+	if queryStatsFromContext(ctx) != nil {
+		opts = append(opts, options.WithExecuteScanQueryStats(options.ExecuteScanQueryStatsTypeBasic))
 	}
 
-	return c.scanOpts
+	return opts
 }
 
 func (c *conn) WithDataQueryOptions(ctx context.Context, opts ...options.ExecuteDataQueryOption) context.Context {
@@ -81,11 +101,15 @@ func (c *conn) WithDataQueryOptions(ctx context.Context, opts ...options.Execute
 }
 
 func (c *conn) dataQueryOptions(ctx context.Context) []options.ExecuteDataQueryOption {
-	if opts, ok := ctx.Value(ctxDataQueryOptionsKey{}).([]options.ExecuteDataQueryOption); ok {
-		return append(c.dataOpts, opts...)
+	opts := c.dataOpts
+	if ctxOpts, ok := ctx.Value(ctxDataQueryOptionsKey{}).([]options.ExecuteDataQueryOption); ok {
+		opts = append(opts, ctxOpts...)
+	}
+	if queryStatsFromContext(ctx) != nil {
+		opts = append(opts, options.WithCollectStatsModeBasic())
 	}
 
-	return c.dataOpts
+	return opts
 }
 
 func (c *conn) withKeepInCache(ctx context.Context) context.Context {
@@ -0,0 +1,28 @@
+package xsql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
+)
+
+func TestQueryStatsFromContext(t *testing.T) {
+	c := &conn{
+		dataOpts: []options.ExecuteDataQueryOption{options.WithKeepInCache(true)},
+	}
+
+	ctx := context.Background()
+	require.Nil(t, queryStatsFromContext(ctx))
+	require.Len(t, c.dataQueryOptions(ctx), 1)
+	require.Empty(t, c.scanQueryOptions(ctx))
+
+	var target stats.QueryStats
+	ctx = WithQueryStats(ctx, &target)
+	require.Same(t, &target, queryStatsFromContext(ctx))
+	require.Len(t, c.dataQueryOptions(ctx), 2)
+	require.Len(t, c.scanQueryOptions(ctx), 1)
+}
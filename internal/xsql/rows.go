@@ -15,6 +15,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 var (
@@ -33,6 +34,9 @@ type rows struct {
 	conn   *conn
 	result result.BaseResult
 
+	// stats is a target of query stats, stats stores on close of rows
+	stats *stats.QueryStats
+
 	// nextSet once need for get first result set as default.
 	// Iterate over many result sets must be with rows.NextResultSet()
 	nextSet sync.Once
@@ -123,7 +127,7 @@ func (r *rows) Next(dst []driver.Value) error {
 	}
 	values := make([]indexed.RequiredOrOptional, len(dst))
 	for i := range dst {
-		values[i] = &valuer{}
+		values[i] = &valuer{uuidByteOrder: r.conn.connector.uuidByteOrder}
 	}
 	if err = r.result.Scan(values...); err != nil {
 		return badconn.Map(xerrors.WithStackTrace(err))
@@ -144,6 +148,10 @@ func (r *rows) Next(dst []driver.Value) error {
 }
 
 func (r *rows) Close() error {
+	if r.stats != nil {
+		*r.stats = r.result.Stats()
+	}
+
 	return r.result.Close()
 }
 
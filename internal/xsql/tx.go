@@ -160,6 +160,7 @@ func (tx *transaction) QueryContext(ctx context.Context, query string, args []dr
 	return &rows{
 		conn:   tx.conn,
 		result: res,
+		stats:  queryStatsFromContext(ctx),
 	}, nil
 }
 
@@ -189,12 +190,17 @@ func (tx *transaction) ExecContext(ctx context.Context, query string, args []dri
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
-	_, err = tx.tx.Execute(ctx,
+	res, err := tx.tx.Execute(ctx,
 		query, &parameters, tx.conn.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
+	defer res.Close()
+
+	if target := queryStatsFromContext(ctx); target != nil {
+		*target = res.Stats()
+	}
 
 	return resultNoRows{}, nil
 }
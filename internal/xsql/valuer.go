@@ -2,15 +2,25 @@ package xsql
 
 import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 )
 
 type valuer struct {
 	v interface{}
+
+	uuidByteOrder value.UUIDByteOrder
 }
 
 func (v *valuer) UnmarshalYDB(raw scanner.RawValue) error {
 	v.v = raw.Any()
 
+	// UUID values in non-native byte order returns as []byte for compatibility with sql.Scanner
+	// implementations of uuid types such as uuid.UUID from github.com/google/uuid
+	if b, ok := v.v.([16]byte); ok && v.uuidByteOrder != value.UUIDNativeByteOrder {
+		b = v.uuidByteOrder.FromNative(b)
+		v.v = b[:]
+	}
+
 	return nil
 }
 
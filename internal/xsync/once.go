@@ -20,11 +20,12 @@ func OnceFunc(f func(ctx context.Context) error) func(ctx context.Context) error
 }
 
 type Once[T closer.Closer] struct {
-	f     func() (T, error)
-	once  sync.Once
-	mutex sync.RWMutex
-	t     T
-	err   error
+	f       func() (T, error)
+	once    sync.Once
+	mutex   sync.RWMutex
+	t       T
+	err     error
+	created bool
 }
 
 func OnceValue[T closer.Closer](f func() (T, error)) *Once[T] {
@@ -53,6 +54,7 @@ func (v *Once[T]) Get() (T, error) {
 		defer v.mutex.Unlock()
 
 		v.t, v.err = v.f()
+		v.created = v.err == nil
 	})
 
 	v.mutex.RLock()
@@ -69,3 +71,16 @@ func (v *Once[T]) Must() T {
 
 	return t
 }
+
+// IfCreated calls f with value if value was created already
+func (v *Once[T]) IfCreated(f func(t T) error) error {
+	v.mutex.RLock()
+	t, created := v.t, v.created
+	v.mutex.RUnlock()
+
+	if !created {
+		return nil
+	}
+
+	return f(t)
+}
@@ -94,4 +94,24 @@ func TestOnceValue(t *testing.T) {
 		require.NoError(t, err)
 		require.Nil(t, v)
 	})
+	t.Run("IfCreated", func(t *testing.T) {
+		once := OnceValue(func() (*testCloser, error) {
+			return &testCloser{inited: true}, nil
+		})
+		called := false
+		require.NoError(t, once.IfCreated(func(v *testCloser) error {
+			called = true
+
+			return nil
+		}))
+		require.False(t, called)
+		once.Must()
+		require.NoError(t, once.IfCreated(func(v *testCloser) error {
+			called = true
+			require.True(t, v.inited)
+
+			return nil
+		}))
+		require.True(t, called)
+	})
 }
@@ -264,6 +264,42 @@ func internalCoordination(
 				Stringer("failure", info.Failure),
 			)
 		},
+		OnSessionAttached: func(info trace.CoordinationSessionAttachedInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), DEBUG, "ydb", "coordination", "session", "attached")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+		},
+		OnSessionDetached: func(info trace.CoordinationSessionDetachedInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), WARN, "ydb", "coordination", "session", "detached")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+		},
+		OnSessionReconnecting: func(info trace.CoordinationSessionReconnectingInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), DEBUG, "ydb", "coordination", "session", "reconnecting")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+		},
+		OnSessionExpired: func(info trace.CoordinationSessionExpiredInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), ERROR, "ydb", "coordination", "session", "expired")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+			)
+		},
 		OnSessionReceive: func(
 			info trace.CoordinationSessionReceiveStartInfo,
 		) func(
@@ -1,6 +1,9 @@
 package log
 
-import "strings"
+import (
+	"strings"
+	"sync/atomic"
+)
 
 type Level int
 
@@ -124,3 +127,35 @@ func FromString(l string) Level {
 		return QUIET
 	}
 }
+
+// globalLevel is a level which sets with SetLevel plus one. Zero value means level not set and
+// loggers use own minimal levels
+var globalLevel atomic.Int32
+
+// SetLevel changes minimal level of SDK logging at runtime for all loggers.
+// Level overrides minimal level of loggers (such as log.WithMinLevel option of log.Default), so SetLevel(DEBUG)
+// enables debug logging of logger with INFO minimal level. Level can be changed at any time (for example,
+// on SIGHUP or from admin endpoint) for enable verbose logging temporarily without restart of application
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func SetLevel(level Level) {
+	globalLevel.Store(int32(level) + 1)
+}
+
+// GetLevel returns minimal level of SDK logging which sets with SetLevel (TRACE if level not set)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func GetLevel() Level {
+	level, _ := levelOverride()
+
+	return level
+}
+
+// levelOverride returns level which sets with SetLevel and true or TRACE and false if level not set
+func levelOverride() (Level, bool) {
+	if v := globalLevel.Load(); v > 0 {
+		return Level(v - 1), true
+	}
+
+	return TRACE, false
+}
@@ -86,7 +86,11 @@ func (l *defaultLogger) format(namespace []string, msg string, logLevel Level) s
 
 func (l *defaultLogger) Log(ctx context.Context, msg string, fields ...Field) {
 	lvl := LevelFromContext(ctx)
-	if lvl < l.minLevel {
+	minLevel := l.minLevel
+	if level, ok := levelOverride(); ok {
+		minLevel = level
+	}
+	if lvl < minLevel {
 		return
 	}
 
@@ -98,8 +102,9 @@ func (l *defaultLogger) Log(ctx context.Context, msg string, fields ...Field) {
 }
 
 type wrapper struct {
-	logQuery bool
-	logger   Logger
+	logQuery           bool
+	parametersRedactor Redactor
+	logger             Logger
 }
 
 func wrapLogger(l Logger, opts ...Option) *wrapper {
@@ -135,5 +140,9 @@ func (l *defaultLogger) appendFields(msg string, fields ...Field) string {
 }
 
 func (l *wrapper) Log(ctx context.Context, msg string, fields ...Field) {
+	if LevelFromContext(ctx) < GetLevel() {
+		return
+	}
+
 	l.logger.Log(ctx, msg, fields...)
 }
@@ -481,6 +481,37 @@ func internalQuery(
 				}
 			}
 		},
+		OnExecuteQuery: func(info trace.QueryExecuteQueryStartInfo) func(info trace.QueryExecuteQueryDoneInfo) {
+			if d.Details()&trace.QuerySessionEvents == 0 {
+				return nil
+			}
+			ctx := with(*info.Context, TRACE, "ydb", "query", "execute")
+			l.Log(ctx, "start",
+				appendParametersField(l.parametersRedactor, info.Parameters,
+					String("SessionID", info.SessionID),
+					String("Query", info.Query),
+				)...,
+			)
+			start := time.Now()
+
+			return func(info trace.QueryExecuteQueryDoneInfo) {
+				if info.Error == nil {
+					l.Log(ctx, "done",
+						latencyField(start),
+					)
+				} else {
+					lvl := WARN
+					if !xerrors.IsYdb(info.Error) {
+						lvl = DEBUG
+					}
+					l.Log(WithLevel(ctx, lvl), "failed",
+						latencyField(start),
+						Error(info.Error),
+						versionField(),
+					)
+				}
+			}
+		},
 		OnSessionQuery: func(info trace.QuerySessionQueryStartInfo) func(info trace.QuerySessionQueryDoneInfo) {
 			if d.Details()&trace.QuerySessionEvents == 0 {
 				return nil
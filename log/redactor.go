@@ -0,0 +1,163 @@
+package log
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+const (
+	maskedValue      = "***"
+	hashedValueBytes = 8
+)
+
+// Redactor redacts values of bound query parameters before logging
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Redactor interface {
+	// Redact returns redacted text of value (in YQL syntax) of parameter with name
+	Redact(name, value string) string
+}
+
+// RedactorFunc is an adapter to allow the use of ordinary functions as Redactor
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type RedactorFunc func(name, value string) string
+
+func (f RedactorFunc) Redact(name, value string) string {
+	return f(name, value)
+}
+
+// RedactNone returns Redactor which logs values of parameters as is
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RedactNone() Redactor {
+	return RedactorFunc(func(name, value string) string {
+		return value
+	})
+}
+
+// RedactMask returns Redactor which replaces values of parameters with mask
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RedactMask() Redactor {
+	return RedactorFunc(func(name, value string) string {
+		return maskedValue
+	})
+}
+
+// RedactHash returns Redactor which replaces values of parameters with prefix of sha256 hash of value.
+// Equal values have equal hashes, so values can be compared without disclosure
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RedactHash() Redactor {
+	return RedactorFunc(func(name, value string) string {
+		sum := sha256.Sum256(xstring.ToBytes(value))
+
+		return "sha256:" + hex.EncodeToString(sum[:hashedValueBytes])
+	})
+}
+
+// RedactAllowlist returns Redactor which logs values of parameters with names from allowlist as is
+// and redacts values of other parameters with redactor (RedactMask if nil).
+// Names of parameters may be defined with or without "$" prefix
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RedactAllowlist(names []string, redactor Redactor) Redactor {
+	if redactor == nil {
+		redactor = RedactMask()
+	}
+	allowed := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		allowed[parameterName(name)] = struct{}{}
+	}
+
+	return RedactorFunc(func(name, value string) string {
+		if _, has := allowed[parameterName(name)]; has {
+			return value
+		}
+
+		return redactor.Redact(name, value)
+	})
+}
+
+func parameterName(name string) string {
+	if len(name) > 0 && name[0] != '$' {
+		return "$" + name
+	}
+
+	return name
+}
+
+type queryParametersOption struct {
+	redactor Redactor
+}
+
+func (o queryParametersOption) applyHolderOption(l *wrapper) {
+	l.parametersRedactor = o.redactor
+}
+
+// WithQueryParameters enables logging of bound query parameters with values redacted by redactor
+// (for example, RedactHash, RedactMask or RedactAllowlist)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryParameters(redactor Redactor) queryParametersOption {
+	return queryParametersOption{redactor: redactor}
+}
+
+type redactedParameters struct {
+	redactor   Redactor
+	parameters *params.Parameters
+}
+
+func (p redactedParameters) String() string {
+	type parameter struct {
+		name  string
+		value string
+	}
+	parameters := make([]parameter, 0, p.parameters.Count())
+	p.parameters.Each(func(name string, v value.Value) {
+		parameters = append(parameters, parameter{
+			name:  name,
+			value: p.redactor.Redact(name, v.Yql()),
+		})
+	})
+	sort.Slice(parameters, func(i, j int) bool {
+		return parameters[i].name < parameters[j].name
+	})
+
+	b := xstring.Buffer()
+	defer b.Free()
+	b.WriteByte('{')
+	for i := range parameters {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(parameters[i].name)
+		b.WriteByte(':')
+		b.WriteString(parameters[i].value)
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+// appendParametersField appends field with redacted values of parameters if logging of parameters enabled
+func appendParametersField(redactor Redactor, parameters interface{}, fields ...Field) []Field {
+	if redactor == nil {
+		return fields
+	}
+	p, ok := parameters.(*params.Parameters)
+	if !ok || p == nil {
+		return fields
+	}
+
+	return append(fields, Stringer("parameters", redactedParameters{
+		redactor:   redactor,
+		parameters: p,
+	}))
+}
@@ -0,0 +1,41 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+)
+
+func TestRedactors(t *testing.T) {
+	require.Equal(t, `"secret"u`, RedactNone().Redact("$password", `"secret"u`))
+	require.Equal(t, "***", RedactMask().Redact("$password", `"secret"u`))
+
+	hash := RedactHash().Redact("$password", `"secret"u`)
+	require.NotContains(t, hash, "secret")
+	require.Equal(t, hash, RedactHash().Redact("$other", `"secret"u`))
+	require.NotEqual(t, hash, RedactHash().Redact("$password", `"other"u`))
+
+	allowlist := RedactAllowlist([]string{"id", "$name"}, nil)
+	require.Equal(t, "1ul", allowlist.Redact("$id", "1ul"))
+	require.Equal(t, `"a"u`, allowlist.Redact("$name", `"a"u`))
+	require.Equal(t, "***", allowlist.Redact("$password", `"secret"u`))
+}
+
+func TestAppendParametersField(t *testing.T) {
+	parameters := (&params.Builder{}).
+		Param("$password").Text("secret").
+		Param("$id").Uint64(1).
+		Build()
+
+	fields := appendParametersField(nil, parameters, String("Query", "SELECT 1"))
+	require.Len(t, fields, 1)
+
+	fields = appendParametersField(RedactAllowlist([]string{"$id"}, RedactMask()), parameters,
+		String("Query", "SELECT 1"),
+	)
+	require.Len(t, fields, 2)
+	require.Equal(t, "parameters", fields[1].Key())
+	require.Equal(t, `{$id:1ul,$password:***}`, fields[1].String())
+}
@@ -0,0 +1,88 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+const slogNamespaceKey = "namespace"
+
+var _ Logger = (*slogLogger)(nil)
+
+type slogLogger struct {
+	handler slog.Handler
+}
+
+// Slog makes Logger which writes records with fields as attributes to stdlib log/slog handler.
+// Namespace of event writes as attribute "namespace"
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Slog(handler slog.Handler) *slogLogger {
+	return &slogLogger{
+		handler: handler,
+	}
+}
+
+// SlogLevel maps level of SDK logging to slog.Level
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func SlogLevel(level Level) slog.Level {
+	switch level {
+	case TRACE:
+		return slog.LevelDebug - 4 //nolint:gomnd
+	case DEBUG:
+		return slog.LevelDebug
+	case INFO:
+		return slog.LevelInfo
+	case WARN:
+		return slog.LevelWarn
+	case ERROR:
+		return slog.LevelError
+	default:
+		return slog.LevelError + 4 //nolint:gomnd
+	}
+}
+
+func (l *slogLogger) Log(ctx context.Context, msg string, fields ...Field) {
+	lvl := SlogLevel(LevelFromContext(ctx))
+	if !l.handler.Enabled(ctx, lvl) {
+		return
+	}
+
+	record := slog.NewRecord(time.Now(), lvl, msg, 0)
+	if names := NamesFromContext(ctx); len(names) > 0 {
+		record.AddAttrs(slog.String(slogNamespaceKey, strings.Join(names, ".")))
+	}
+	for i := range fields {
+		record.AddAttrs(slogAttr(fields[i]))
+	}
+
+	_ = l.handler.Handle(ctx, record)
+}
+
+func slogAttr(f Field) slog.Attr {
+	switch f.Type() {
+	case IntType:
+		return slog.Int(f.Key(), f.IntValue())
+	case Int64Type:
+		return slog.Int64(f.Key(), f.Int64Value())
+	case StringType:
+		return slog.String(f.Key(), f.StringValue())
+	case BoolType:
+		return slog.Bool(f.Key(), f.BoolValue())
+	case DurationType:
+		return slog.Duration(f.Key(), f.DurationValue())
+	case StringsType:
+		return slog.Any(f.Key(), f.StringsValue())
+	case ErrorType:
+		if err := f.ErrorValue(); err != nil {
+			return slog.String(f.Key(), err.Error())
+		}
+
+		return slog.Any(f.Key(), nil)
+	default:
+		return slog.String(f.Key(), f.String())
+	}
+}
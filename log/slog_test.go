@@ -0,0 +1,86 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlog(t *testing.T) {
+	var buf bytes.Buffer
+	l := Slog(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	l.Log(with(context.Background(), TRACE, "ydb", "driver"), "skipped")
+	require.Empty(t, buf.String())
+
+	l.Log(with(context.Background(), WARN, "ydb", "driver"), "message",
+		String("str", "value"),
+		Int("int", 42),
+		Bool("bool", true),
+		Duration("latency", time.Second),
+		Error(errors.New("test")),
+	)
+
+	var record map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "WARN", record["level"])
+	require.Equal(t, "message", record["msg"])
+	require.Equal(t, "ydb.driver", record["namespace"])
+	require.Equal(t, "value", record["str"])
+	require.EqualValues(t, 42, record["int"])
+	require.Equal(t, true, record["bool"])
+	require.EqualValues(t, time.Second, record["latency"])
+	require.Equal(t, "test", record["error"])
+}
+
+type countLogger int
+
+func (l *countLogger) Log(context.Context, string, ...Field) {
+	*l++
+}
+
+func TestSetLevel(t *testing.T) {
+	defer globalLevel.Store(0)
+
+	var l countLogger
+	w := wrapLogger(&l)
+
+	w.Log(WithLevel(context.Background(), DEBUG), "message")
+	require.EqualValues(t, 1, l)
+
+	SetLevel(INFO)
+	require.Equal(t, INFO, GetLevel())
+	w.Log(WithLevel(context.Background(), DEBUG), "message")
+	require.EqualValues(t, 1, l)
+	w.Log(WithLevel(context.Background(), ERROR), "message")
+	require.EqualValues(t, 2, l)
+
+	SetLevel(TRACE)
+	w.Log(WithLevel(context.Background(), DEBUG), "message")
+	require.EqualValues(t, 3, l)
+}
+
+func TestSetLevelOverridesMinLevel(t *testing.T) {
+	defer globalLevel.Store(0)
+
+	var b bytes.Buffer
+	l := Default(&b, WithMinLevel(INFO))
+
+	l.Log(WithLevel(context.Background(), DEBUG), "message")
+	require.Empty(t, b.String())
+
+	SetLevel(DEBUG)
+	l.Log(WithLevel(context.Background(), DEBUG), "message")
+	require.Contains(t, b.String(), "message")
+
+	b.Reset()
+	SetLevel(ERROR)
+	l.Log(WithLevel(context.Background(), WARN), "message")
+	require.Empty(t, b.String())
+}
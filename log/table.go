@@ -323,10 +323,13 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			session := info.Session
 			query := info.Query
 			l.Log(ctx, "start",
-				appendFieldByCondition(l.logQuery,
-					Stringer("query", info.Query),
-					String("id", session.ID()),
-					String("status", session.Status()),
+				appendParametersField(l.parametersRedactor, info.Parameters,
+					appendFieldByCondition(l.logQuery,
+						Stringer("query", info.Query),
+						String("id", session.ID()),
+						String("status", session.Status()),
+						Bool("over_query_service", info.OverQueryService),
+					)...,
 				)...,
 			)
 			start := time.Now()
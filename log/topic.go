@@ -438,6 +438,23 @@ func internalTopic(l Logger, d trace.Detailer) (t trace.Topic) {
 		)
 	}
 
+	t.OnReaderPartitionLag = func(info trace.TopicReaderPartitionLagInfo) {
+		if d.Details()&trace.TopicReaderPartitionEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "topic", "reader", "partition", "lag")
+		l.Log(ctx, "partition read lag exceeded threshold",
+			String("reader_connection_id", info.ReaderConnectionID),
+			String("topic", info.Topic),
+			Int64("partition_id", info.PartitionID),
+			Int64("partition_session_id", info.PartitionSessionID),
+			Int64("committed_offset", info.CommittedOffset),
+			Int64("end_offset", info.EndOffset),
+			Int64("lag", info.Lag),
+			Int64("threshold", info.Threshold),
+		)
+	}
+
 	t.OnReaderPopBatchTx = func(
 		startInfo trace.TopicReaderPopBatchTxStartInfo,
 	) func(trace.TopicReaderPopBatchTxDoneInfo) {
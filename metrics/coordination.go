@@ -5,5 +5,30 @@ import (
 )
 
 func coordination(config Config) (t trace.Coordination) {
+	config = config.WithSystem("coordination").WithSystem("session")
+	events := config.CounterVec("events", "event")
+	record := func(event string) {
+		if config.Details()&trace.CoordinationEvents != 0 {
+			events.With(map[string]string{
+				"event": event,
+			}).Inc()
+		}
+	}
+	t.OnSessionStarted = func(info trace.CoordinationSessionStartedInfo) {
+		record("started")
+	}
+	t.OnSessionAttached = func(info trace.CoordinationSessionAttachedInfo) {
+		record("attached")
+	}
+	t.OnSessionDetached = func(info trace.CoordinationSessionDetachedInfo) {
+		record("detached")
+	}
+	t.OnSessionReconnecting = func(info trace.CoordinationSessionReconnectingInfo) {
+		record("reconnecting")
+	}
+	t.OnSessionExpired = func(info trace.CoordinationSessionExpiredInfo) {
+		record("expired")
+	}
+
 	return t
 }
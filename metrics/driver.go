@@ -3,6 +3,7 @@ package metrics
 import (
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/repeater"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -21,6 +22,7 @@ func driver(config Config) (t trace.Driver) {
 	banned := config.WithSystem("conn").GaugeVec("banned", "endpoint", "node_id", "cause")
 	requestStatuses := config.WithSystem("conn").CounterVec("request_statuses", "status", "endpoint", "node_id")
 	requestMethods := config.WithSystem("conn").CounterVec("request_methods", "method", "endpoint", "node_id")
+	requestLatency := config.WithSystem("conn").TimerVec("request_latency", "method")
 	tli := config.CounterVec("transaction_locks_invalidated")
 
 	type endpointKey struct {
@@ -34,10 +36,14 @@ func driver(config Config) (t trace.Driver) {
 			method   = info.Method
 			endpoint = info.Endpoint.Address()
 			nodeID   = info.Endpoint.NodeID()
+			start    = time.Now()
 		)
 
 		return func(info trace.DriverConnInvokeDoneInfo) {
 			if config.Details()&trace.DriverConnEvents != 0 {
+				requestLatency.With(map[string]string{
+					"method": string(method),
+				}).Record(time.Since(start))
 				requestStatuses.With(map[string]string{
 					"status":   errorBrief(info.Error),
 					"endpoint": endpoint,
@@ -0,0 +1,208 @@
+package prometheus
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+const defaultSeparator = "_"
+
+var _ metrics.Config = (*config)(nil)
+
+type (
+	registry struct {
+		registerer prometheus.Registerer
+		separator  string
+
+		mu         sync.Mutex
+		counters   map[string]*prometheus.CounterVec
+		gauges     map[string]*prometheus.GaugeVec
+		histograms map[string]*prometheus.HistogramVec
+	}
+	config struct {
+		details  trace.Details
+		scope    []string
+		registry *registry
+	}
+	// Option is an option of prometheus metrics config
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(c *config)
+)
+
+// WithDetails defines details of driver events which collects as metrics (trace.DetailsAll by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDetails(details trace.Details) Option {
+	return func(c *config) {
+		c.details = details
+	}
+}
+
+// WithNamespace defines namespace (prefix of names) of metrics
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithNamespace(namespace string) Option {
+	return func(c *config) {
+		if namespace != "" {
+			c.scope = append(c.scope, namespace)
+		}
+	}
+}
+
+// WithSeparator defines separator of scopes in names of metrics ("_" by default).
+// Separator must be non-empty and contain only characters which allowed in names of prometheus
+// metrics (letters, digits, underscores and colons), otherwise option is ignored
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSeparator(separator string) Option {
+	return func(c *config) {
+		if isValidSeparator(separator) {
+			c.registry.separator = separator
+		}
+	}
+}
+
+func isValidSeparator(separator string) bool {
+	if separator == "" {
+		return false
+	}
+	for _, r := range separator {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Config makes metrics.Config which registers collectors of metrics in prometheus registerer.
+// Collectors with same names reuses between configs, so registerer can be shared between drivers
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Config(registerer prometheus.Registerer, opts ...Option) metrics.Config {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+	c := &config{
+		details: trace.DetailsAll,
+		registry: &registry{
+			registerer: registerer,
+			separator:  defaultSeparator,
+			counters:   make(map[string]*prometheus.CounterVec),
+			gauges:     make(map[string]*prometheus.GaugeVec),
+			histograms: make(map[string]*prometheus.HistogramVec),
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
+
+// WithTraces makes driver option which collects metrics of driver events into prometheus registerer:
+// session pools gauges, retry counters, query result parts sizes, topic lag, gRPC calls latencies and others
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTraces(registerer prometheus.Registerer, opts ...Option) ydb.Option {
+	return metrics.WithTraces(Config(registerer, opts...))
+}
+
+func (c *config) Details() trace.Details {
+	return c.details
+}
+
+func (c *config) WithSystem(subsystem string) metrics.Config {
+	return &config{
+		details:  c.details,
+		scope:    append(append(make([]string, 0, len(c.scope)+1), c.scope...), subsystem),
+		registry: c.registry,
+	}
+}
+
+func (c *config) name(name string) string {
+	return strings.Join(append(append(make([]string, 0, len(c.scope)+1), c.scope...), name), c.registry.separator)
+}
+
+func (c *config) CounterVec(name string, labelNames ...string) metrics.CounterVec {
+	name = c.name(name)
+
+	return counterVec{
+		vec: register(c.registry, c.registry.counters, name, func() *prometheus.CounterVec {
+			return prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: name}, labelNames)
+		}),
+	}
+}
+
+func (c *config) GaugeVec(name string, labelNames ...string) metrics.GaugeVec {
+	name = c.name(name)
+
+	return gaugeVec{
+		vec: register(c.registry, c.registry.gauges, name, func() *prometheus.GaugeVec {
+			return prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: name}, labelNames)
+		}),
+	}
+}
+
+func (c *config) TimerVec(name string, labelNames ...string) metrics.TimerVec {
+	name = c.name(name) + c.registry.separator + "seconds"
+
+	return timerVec{
+		vec: register(c.registry, c.registry.histograms, name, func() *prometheus.HistogramVec {
+			return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Name:    name,
+				Help:    name,
+				Buckets: prometheus.DefBuckets,
+			}, labelNames)
+		}),
+	}
+}
+
+func (c *config) HistogramVec(name string, buckets []float64, labelNames ...string) metrics.HistogramVec {
+	name = c.name(name)
+
+	return histogramVec{
+		vec: register(c.registry, c.registry.histograms, name, func() *prometheus.HistogramVec {
+			return prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Name:    name,
+				Help:    name,
+				Buckets: buckets,
+			}, labelNames)
+		}),
+	}
+}
+
+// register returns collector from cache or registers new collector.
+// Already registered collector (from other config with same registerer) reuses
+func register[T prometheus.Collector](r *registry, cache map[string]T, name string, create func() T) T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if vec, has := cache[name]; has {
+		return vec
+	}
+
+	vec := create()
+	if err := r.registerer.Register(vec); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &alreadyRegistered) {
+			panic(err)
+		}
+		if existing, ok := alreadyRegistered.ExistingCollector.(T); ok {
+			vec = existing
+		}
+	}
+	cache[name] = vec
+
+	return vec
+}
@@ -0,0 +1,51 @@
+package prometheus
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	config := Config(registry, WithNamespace("app")).WithSystem("ydb").WithSystem("query")
+
+	config.CounterVec("errs", "status").With(map[string]string{"status": "OK"}).Inc()
+	config.GaugeVec("idle").With(nil).Set(5)
+	config.TimerVec("latency").With(nil).Record(time.Second)
+	config.HistogramVec("attempts", []float64{1, 2, 3}).With(nil).Record(2)
+
+	// same collectors from other config with same registerer
+	Config(registry, WithNamespace("app")).WithSystem("ydb").WithSystem("query").
+		CounterVec("errs", "status").With(map[string]string{"status": "OK"}).Inc()
+
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP app_ydb_query_errs app_ydb_query_errs
+# TYPE app_ydb_query_errs counter
+app_ydb_query_errs{status="OK"} 2
+# HELP app_ydb_query_idle app_ydb_query_idle
+# TYPE app_ydb_query_idle gauge
+app_ydb_query_idle 5
+`), "app_ydb_query_errs", "app_ydb_query_idle"))
+
+	count, err := testutil.GatherAndCount(registry, "app_ydb_query_latency_seconds", "app_ydb_query_attempts")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestWithSeparator(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	Config(registry, WithNamespace("app"), WithSeparator(":")).WithSystem("ydb").GaugeVec("idle").With(nil).Set(1)
+	for i, separator := range []string{"", ".", "-", " "} {
+		Config(registry, WithNamespace("app"), WithSeparator(separator)).WithSystem("ydb").
+			GaugeVec("idle").With(nil).Set(float64(i))
+	}
+
+	count, err := testutil.GatherAndCount(registry, "app:ydb:idle", "app_ydb_idle")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
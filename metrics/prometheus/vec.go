@@ -0,0 +1,54 @@
+package prometheus
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/metrics"
+)
+
+type (
+	counterVec struct {
+		vec *prometheus.CounterVec
+	}
+	gaugeVec struct {
+		vec *prometheus.GaugeVec
+	}
+	timerVec struct {
+		vec *prometheus.HistogramVec
+	}
+	timer struct {
+		observer prometheus.Observer
+	}
+	histogramVec struct {
+		vec *prometheus.HistogramVec
+	}
+	histogram struct {
+		observer prometheus.Observer
+	}
+)
+
+func (v counterVec) With(labels map[string]string) metrics.Counter {
+	return v.vec.With(labels)
+}
+
+func (v gaugeVec) With(labels map[string]string) metrics.Gauge {
+	return v.vec.With(labels)
+}
+
+func (v timerVec) With(labels map[string]string) metrics.Timer {
+	return timer{observer: v.vec.With(labels)}
+}
+
+func (t timer) Record(value time.Duration) {
+	t.observer.Observe(value.Seconds())
+}
+
+func (v histogramVec) With(labels map[string]string) metrics.Histogram {
+	return histogram{observer: v.vec.With(labels)}
+}
+
+func (h histogram) Record(value float64) {
+	h.observer.Observe(value)
+}
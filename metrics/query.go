@@ -237,6 +237,24 @@ func query(config Config) (t trace.Query) {
 			}
 		}
 	}
+	{
+		partConfig := queryConfig.WithSystem("result").WithSystem("part")
+		rows := partConfig.HistogramVec("rows", []float64{0, 1, 10, 100, 1000, 10000, 100000}).With(nil)
+		errs := partConfig.CounterVec("errs", "status")
+		t.OnResultNextPart = func(info trace.QueryResultNextPartStartInfo) func(info trace.QueryResultNextPartDoneInfo) {
+			return func(info trace.QueryResultNextPartDoneInfo) {
+				if partConfig.Details()&trace.QueryResultEvents != 0 {
+					if info.Error != nil {
+						errs.With(map[string]string{
+							"status": errorBrief(info.Error),
+						}).Inc()
+					} else {
+						rows.Record(float64(info.RowsCount))
+					}
+				}
+			}
+		}
+	}
 
 	return t
 }
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func topic(config Config) (t trace.Topic) {
+	config = config.WithSystem("topic")
+	{
+		readerConfig := config.WithSystem("reader")
+		lag := readerConfig.GaugeVec("lag", "topic", "partition_id")
+		messages := readerConfig.CounterVec("messages", "topic")
+		var messagesByTopic sync.Map // counters of messages by topic without allocation of labels for each call
+		errs := readerConfig.CounterVec("errs", "status")
+		t.OnReaderPartitionLag = func(info trace.TopicReaderPartitionLagInfo) {
+			if readerConfig.Details()&trace.TopicReaderPartitionEvents != 0 {
+				lag.With(map[string]string{
+					"topic":        info.Topic,
+					"partition_id": strconv.FormatInt(info.PartitionID, 10),
+				}).Set(float64(info.Lag))
+			}
+		}
+		t.OnReaderReadMessages = func(
+			info trace.TopicReaderReadMessagesStartInfo,
+		) func(trace.TopicReaderReadMessagesDoneInfo) {
+			return func(info trace.TopicReaderReadMessagesDoneInfo) {
+				if readerConfig.Details()&trace.TopicReaderMessageEvents != 0 {
+					if info.Error != nil {
+						errs.With(map[string]string{
+							"status": errorBrief(info.Error),
+						}).Inc()
+
+						return
+					}
+					counter, has := messagesByTopic.Load(info.Topic)
+					if !has {
+						counter, _ = messagesByTopic.LoadOrStore(info.Topic, messages.With(map[string]string{
+							"topic": info.Topic,
+						}))
+					}
+					c := counter.(Counter) //nolint:forcetypeassert
+					for i := 0; i < info.MessagesCount; i++ {
+						c.Inc()
+					}
+				}
+			}
+		}
+	}
+	{
+		writerConfig := config.WithSystem("writer")
+		messages := writerConfig.CounterVec("messages").With(nil)
+		errs := writerConfig.CounterVec("errs", "status")
+		t.OnWriterSendMessages = func(
+			info trace.TopicWriterSendMessagesStartInfo,
+		) func(trace.TopicWriterSendMessagesDoneInfo) {
+			count := info.MessagesCount
+
+			return func(info trace.TopicWriterSendMessagesDoneInfo) {
+				if writerConfig.Details()&trace.TopicWriterStreamEvents != 0 {
+					if info.Error != nil {
+						errs.With(map[string]string{
+							"status": errorBrief(info.Error),
+						}).Inc()
+
+						return
+					}
+					for i := 0; i < count; i++ {
+						messages.Inc()
+					}
+				}
+			}
+		}
+	}
+
+	return t
+}
@@ -2,11 +2,11 @@ package operation
 
 import (
 	"context"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
-	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
@@ -37,6 +37,22 @@ type (
 		Ready         bool
 		Status        string
 		ConsumedUnits float64
+
+		// State is a typed state of operation
+		State State
+		// Progress is a progress of operation in percents if operation metadata provides progress
+		Progress float32
+		// Error is an error of failed or cancelled operation
+		Error error
+	}
+	// Operation is a status of long-running operation
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Operation = operation
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	operations struct {
+		Operations []*operation
+		NextToken  string
 	}
 	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 	typedOperation[PT metadata.Constraint[T], T metadata.TypesConstraint] struct {
@@ -71,16 +87,9 @@ func get(
 			return nil, xerrors.WithStackTrace(err)
 		}
 
-		var md Ydb_Query.ExecuteScriptMetadata
-		err = response.GetOperation().GetMetadata().UnmarshalTo(&md)
-		if err != nil {
-			return nil, xerrors.WithStackTrace(err)
-		}
+		op := operationFromProto(response.GetOperation())
 
-		return &operation{
-			Ready:  response.GetOperation().GetReady(),
-			Status: response.GetOperation().GetStatus().String(),
-		}, nil
+		return &op, nil
 	}, retry.WithIdempotent(true))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
@@ -116,13 +125,8 @@ func list[PT metadata.Constraint[T], T metadata.TypesConstraint](
 
 		for _, op := range response.GetOperations() {
 			operations.Operations = append(operations.Operations, &typedOperation[PT, T]{
-				operation: operation{
-					ID:            op.GetId(),
-					Ready:         op.GetReady(),
-					Status:        op.GetStatus().String(),
-					ConsumedUnits: op.GetCostInfo().GetConsumedUnits(),
-				},
-				Metadata: metadata.FromProto[PT, T](op.GetMetadata()),
+				operation: operationFromProto(op),
+				Metadata:  metadata.FromProto[PT, T](op.GetMetadata()),
 			})
 		}
 
@@ -214,6 +218,101 @@ func (c *Client) Forget(ctx context.Context, opID string) error {
 	return nil
 }
 
+// Wait polls status of operation with poll policy (DefaultPollInterval if nil) until operation completes.
+// Wait returns error of operation if operation failed or cancelled
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Wait(ctx context.Context, opID string, pollPolicy PollPolicy) (*operation, error) {
+	op, err := c.WaitWithProgress(ctx, opID, pollPolicy, nil)
+	if err != nil {
+		return op, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+// WaitWithProgress works as Wait and calls progress callback (if defined) on each poll of operation status
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) WaitWithProgress(
+	ctx context.Context, opID string, pollPolicy PollPolicy, progress func(op *operation),
+) (*operation, error) {
+	if pollPolicy == nil {
+		pollPolicy = PollInterval(DefaultPollInterval)
+	}
+
+	for i := 0; ; i++ {
+		op, err := get(ctx, c.operationServiceClient, opID)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if progress != nil {
+			progress(op)
+		}
+		if op.Ready {
+			if op.Error != nil {
+				return op, xerrors.WithStackTrace(op.Error)
+			}
+
+			return op, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, xerrors.WithStackTrace(ctx.Err())
+		case <-time.After(pollPolicy.Delay(i)):
+		}
+	}
+}
+
+// List returns list of operations of kind (e.g. KindBuildIndex, KindImportFromS3).
+// Metadata of operations are not parsed, typed lists of operations returns ListBuildIndex, ListImportFromS3, etc.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) List(ctx context.Context, kind string, opts ...options.List) (*operations, error) {
+	request := &options.ListOperationsRequest{
+		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
+			Kind: kind,
+		},
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(request)
+		}
+	}
+
+	list, err := retry.RetryWithResult(ctx, func(ctx context.Context) (*operations, error) {
+		response, err := c.operationServiceClient.ListOperations(conn.WithoutWrapping(ctx), &request.ListOperationsRequest)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return nil, xerrors.WithStackTrace(xerrors.Operation(
+				xerrors.WithStatusCode(response.GetStatus()),
+				xerrors.WithIssues(response.GetIssues()),
+			))
+		}
+
+		list := &operations{
+			Operations: make([]*operation, 0, len(response.GetOperations())),
+			NextToken:  response.GetNextPageToken(),
+		}
+		for _, pb := range response.GetOperations() {
+			op := operationFromProto(pb)
+			list.Operations = append(list.Operations, &op)
+		}
+
+		return list, nil
+	}, retry.WithIdempotent(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return list, nil
+}
+
 func (c *Client) Close(ctx context.Context) error {
 	return nil
 }
@@ -226,7 +325,7 @@ func (c *Client) ListBuildIndex(ctx context.Context) (
 ) {
 	request := &options.ListOperationsRequest{
 		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
-			Kind: kindBuildIndex,
+			Kind: KindBuildIndex,
 		},
 	}
 
@@ -248,7 +347,7 @@ func (c *Client) ListImportFromS3(ctx context.Context) (
 ) {
 	request := &options.ListOperationsRequest{
 		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
-			Kind: kindImportFromS3,
+			Kind: KindImportFromS3,
 		},
 	}
 
@@ -270,7 +369,7 @@ func (c *Client) ListExportToS3(ctx context.Context) (
 ) {
 	request := &options.ListOperationsRequest{
 		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
-			Kind: kindExportToS3,
+			Kind: KindExportToS3,
 		},
 	}
 
@@ -292,7 +391,7 @@ func (c *Client) ListExportToYT(ctx context.Context) (
 ) {
 	request := &options.ListOperationsRequest{
 		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
-			Kind: kindExportToYT,
+			Kind: KindExportToYT,
 		},
 	}
 
@@ -314,7 +413,7 @@ func (c *Client) ListExecuteQuery(ctx context.Context, opts ...options.List) (
 ) {
 	request := &options.ListOperationsRequest{
 		ListOperationsRequest: Ydb_Operations.ListOperationsRequest{
-			Kind: kindExecuteQuery,
+			Kind: KindExecuteQuery,
 		},
 	}
 
@@ -0,0 +1,104 @@
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type operationServiceStub struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	operations []*Ydb_Operations.Operation
+	calls      int
+}
+
+func (s *operationServiceStub) GetOperation(
+	ctx context.Context, in *Ydb_Operations.GetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	op := s.operations[min(s.calls, len(s.operations)-1)]
+	s.calls++
+
+	return &Ydb_Operations.GetOperationResponse{Operation: op}, nil
+}
+
+func importMetadata(t *testing.T, completed uint32) *anypb.Any {
+	md, err := anypb.New(&Ydb_Import.ImportFromS3Metadata{
+		ItemsProgress: []*Ydb_Import.ImportItemProgress{
+			{PartsTotal: 4, PartsCompleted: completed},
+		},
+	})
+	require.NoError(t, err)
+
+	return md
+}
+
+func TestClientWait(t *testing.T) {
+	t.Run("Done", func(t *testing.T) {
+		service := &operationServiceStub{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "op", Ready: false, Metadata: importMetadata(t, 1)},
+				{Id: "op", Ready: false, Metadata: importMetadata(t, 2)},
+				{Id: "op", Ready: true, Status: Ydb.StatusIds_SUCCESS, Metadata: importMetadata(t, 4)},
+			},
+		}
+		c := &Client{operationServiceClient: service}
+
+		op, err := c.Get(context.Background(), "op")
+		require.NoError(t, err)
+		require.Equal(t, StateRunning, op.State)
+		require.EqualValues(t, 25, op.Progress)
+
+		op, err = c.Wait(context.Background(), "op", PollInterval(time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, 3, service.calls)
+		require.Equal(t, StateDone, op.State)
+		require.EqualValues(t, 100, op.Progress)
+		require.NoError(t, op.Error)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		c := &Client{operationServiceClient: &operationServiceStub{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "op", Ready: true, Status: Ydb.StatusIds_BAD_REQUEST},
+			},
+		}}
+
+		op, err := c.Wait(context.Background(), "op", nil)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+		require.Equal(t, StateFailed, op.State)
+	})
+	t.Run("Cancelled", func(t *testing.T) {
+		c := &Client{operationServiceClient: &operationServiceStub{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "op", Ready: true, Status: Ydb.StatusIds_CANCELLED},
+			},
+		}}
+
+		op, err := c.Wait(context.Background(), "op", nil)
+		require.Error(t, err)
+		require.Equal(t, StateCancelled, op.State)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		c := &Client{operationServiceClient: &operationServiceStub{
+			operations: []*Ydb_Operations.Operation{
+				{Id: "op", Ready: false},
+			},
+		}}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := c.Wait(ctx, "op", PollInterval(time.Millisecond))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -3,8 +3,10 @@ package operation_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 )
 
 func Example_listOperations() {
@@ -23,3 +25,25 @@ func Example_listOperations() {
 		fmt.Printf(" - %+v\n", op)
 	}
 }
+
+func Example_waitOperation() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close(ctx) // cleanup resources
+	operations, err := db.Operation().List(ctx, operation.KindBuildIndex)
+	if err != nil {
+		panic(err)
+	}
+	for _, op := range operations.Operations {
+		completed, err := db.Operation().Wait(ctx, op.ID, operation.PollInterval(time.Second))
+		if err != nil {
+			fmt.Printf("operation %s failed: %v\n", op.ID, err)
+
+			continue
+		}
+		fmt.Printf("operation %s completed with state %s\n", op.ID, completed.State)
+	}
+}
@@ -1,9 +1,15 @@
 package operation
 
+import "time"
+
+// Kinds of long-running operations
 const (
-	kindExecuteQuery = "scriptexec"
-	kindBuildIndex   = "buildindex"
-	kindImportFromS3 = "import/s3"
-	kindExportToS3   = "export/s3"
-	kindExportToYT   = "export/yt"
+	KindExecuteQuery = "scriptexec"
+	KindBuildIndex   = "buildindex"
+	KindImportFromS3 = "import/s3"
+	KindExportToS3   = "export/s3"
+	KindExportToYT   = "export/yt"
 )
+
+// DefaultPollInterval is a default interval of polling of operation status in Client.Wait
+const DefaultPollInterval = time.Second
@@ -0,0 +1,94 @@
+package operation
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/metadata"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// State is a typed state of long-running operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type State uint8
+
+const (
+	StateUnknown State = iota
+	// StateRunning is a state of not completed operation
+	StateRunning
+	// StateDone is a state of successfully completed operation
+	StateDone
+	// StateFailed is a state of operation completed with error
+	StateFailed
+	// StateCancelled is a state of cancelled operation
+	StateCancelled
+)
+
+func (s State) String() string {
+	switch s {
+	case StateRunning:
+		return "running"
+	case StateDone:
+		return "done"
+	case StateFailed:
+		return "failed"
+	case StateCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+func stateFromProto(op *Ydb_Operations.Operation) State {
+	switch {
+	case !op.GetReady():
+		return StateRunning
+	case op.GetStatus() == Ydb.StatusIds_SUCCESS:
+		return StateDone
+	case op.GetStatus() == Ydb.StatusIds_CANCELLED:
+		return StateCancelled
+	default:
+		return StateFailed
+	}
+}
+
+func operationFromProto(op *Ydb_Operations.Operation) operation {
+	o := operation{
+		ID:            op.GetId(),
+		Ready:         op.GetReady(),
+		Status:        op.GetStatus().String(),
+		ConsumedUnits: op.GetCostInfo().GetConsumedUnits(),
+		State:         stateFromProto(op),
+		Progress:      metadata.Progress(op.GetMetadata()),
+	}
+	if o.State == StateFailed || o.State == StateCancelled {
+		o.Error = xerrors.Operation(
+			xerrors.WithStatusCode(op.GetStatus()),
+			xerrors.WithIssues(op.GetIssues()),
+		)
+	}
+
+	return o
+}
+
+// PollPolicy defines delays between polls of operation status in Client.Wait
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PollPolicy = backoff.Backoff
+
+type pollInterval time.Duration
+
+func (interval pollInterval) Delay(int) time.Duration {
+	return time.Duration(interval)
+}
+
+// PollInterval makes poll policy of Client.Wait with constant interval between polls
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PollInterval(interval time.Duration) PollPolicy {
+	return pollInterval(interval)
+}
@@ -9,6 +9,10 @@ import (
 	"path/filepath"
 	"time"
 
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/balancers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
@@ -23,8 +27,10 @@ import (
 	scriptingConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/scripting/config"
 	tableConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xotel"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -79,6 +85,19 @@ func WithOauth2TokenExchangeCredentials(
 	})
 }
 
+// WithKubernetesServiceAccountCredentials adds credentials that exchange projected kubernetes
+// service account token to access token at STS token endpoint (workload identity federation)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKubernetesServiceAccountCredentials(
+	tokenEndpoint string,
+	opts ...credentials.Oauth2TokenExchangeCredentialsOption,
+) Option {
+	return WithCreateCredentialsFunc(func(context.Context) (credentials.Credentials, error) {
+		return credentials.NewKubernetesServiceAccountCredentials(tokenEndpoint, opts...)
+	})
+}
+
 /*
 WithOauth2TokenExchangeCredentialsFile adds credentials that exchange token using
 OAuth 2.0 token exchange protocol:
@@ -194,6 +213,19 @@ func WithConnectionTTL(ttl time.Duration) Option {
 	}
 }
 
+// WithGrpcConnectionsPerEndpoint opens n grpc connections for each endpoint and stripes
+// calls and streams across them. Multiple connections bypass limits of HTTP/2 flow control
+// of single connection which caps throughput of topic and query streams on fat nodes.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithGrpcConnectionsPerEndpoint(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithConnectionsPerEndpoint(n))
+
+		return nil
+	}
+}
+
 // WithEndpoint defines endpoint option
 //
 // Warning: use ydb.Open with required Driver string parameter instead
@@ -207,6 +239,18 @@ func WithEndpoint(endpoint string) Option {
 	}
 }
 
+// WithEndpoints defines list of bootstrap endpoints.
+// Cluster discovery uses first reachable endpoint from list for survive of outage of single bootstrap node
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithEndpoints(endpoints ...string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithEndpoints(endpoints...))
+
+		return nil
+	}
+}
+
 // WithDatabase defines database option
 //
 // Warning: use ydb.Open with required Driver string parameter instead
@@ -295,6 +339,16 @@ func WithCreateCredentialsFunc(createCredentials func(ctx context.Context) (cred
 	}
 }
 
+// WithCredentialsFunc defines function which provides token for each request by context of request.
+// Use it for request-scoped auth (such as multi-tenant proxies) instead of single credentials per driver.
+// Background requests of driver (discovery, session keep-alive, topic token updates) calls
+// function with context without request values
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCredentialsFunc(f func(ctx context.Context) (string, error)) Option {
+	return WithCredentials(credentials.Func(f))
+}
+
 // WithCredentials in conjunction with Driver.With function prohibit reuse of conn pool.
 // Thus, Driver.With will effectively create totally separate Driver.
 func WithCredentials(c credentials.Credentials) Option {
@@ -311,6 +365,13 @@ func WithBalancer(balancer *balancerConfig.Config) Option {
 	}
 }
 
+// WithCustomBalancer sets custom balancing policy of calls between endpoints of cluster
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCustomBalancer(balancer balancers.Balancer) Option {
+	return WithBalancer(balancers.Custom(balancer))
+}
+
 // WithDialTimeout sets timeout for establishing new Driver to cluster
 //
 // Default dial timeout is config.DefaultDialTimeout
@@ -322,6 +383,44 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithDialRetry sets policy of retries of initial discovery of cluster on dial of driver.
+// On each attempt bootstrap endpoints dial in parallel (each with dial timeout), so
+// startup is not slowed down by unreachable endpoints.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDialRetry(policy config.DialRetryPolicy) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDialRetry(policy))
+
+		return nil
+	}
+}
+
+// WithUnaryClientInterceptor appends interceptors of unary calls to all grpc connections
+// of driver (including connections for discovery and static credentials).
+// Interceptors are called in order of appending.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUnaryClientInterceptor(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithGrpcOptions(grpc.WithChainUnaryInterceptor(interceptors...)))
+
+		return nil
+	}
+}
+
+// WithStreamClientInterceptor appends interceptors of streams to all grpc connections of driver.
+// Interceptors are called in order of appending.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStreamClientInterceptor(interceptors ...grpc.StreamClientInterceptor) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithGrpcOptions(grpc.WithChainStreamInterceptor(interceptors...)))
+
+		return nil
+	}
+}
+
 // With collects additional configuration options.
 //
 // This option does not replace collected option, instead it will append provided options.
@@ -371,7 +470,14 @@ func WithRetryBudget(b budget.Budget) Option {
 // WithTraceDriver appends trace.Driver into driver traces
 func WithTraceDriver(t trace.Driver, opts ...trace.DriverComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
-		c.options = append(c.options, config.WithTrace(t, opts...))
+		c.options = append(c.options,
+			config.WithTrace(t, append(
+				[]trace.DriverComposeOption{
+					trace.WithDriverPanicCallback(c.onPanic),
+				},
+				opts...,
+			)...),
+		)
 
 		return nil
 	}
@@ -383,7 +489,7 @@ func WithTraceRetry(t trace.Retry, opts ...trace.RetryComposeOption) Option {
 		c.options = append(c.options,
 			config.WithTraceRetry(&t, append(
 				[]trace.RetryComposeOption{
-					trace.WithRetryPanicCallback(c.panicCallback),
+					trace.WithRetryPanicCallback(c.onPanic),
 				},
 				opts...,
 			)...),
@@ -488,6 +594,38 @@ func WithSessionPoolSizeLimit(sizeLimit int) Option {
 	}
 }
 
+// WithSessionPoolMinSize set number of idle sessions which table.Client and query.Client
+// create right after ydb.Open and keep alive in background.
+// With non-zero min size table.Client and query.Client are created on ydb.Open (not on first usage).
+// Expired or broken idle sessions are replaced with new ones.
+// Min size cannot exceed the session pool size limit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionPoolMinSize(minSize int) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.sessionPoolMinSize = minSize
+		d.tableOptions = append(d.tableOptions, tableConfig.WithMinSize(minSize))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithPoolMinSize(minSize))
+
+		return nil
+	}
+}
+
+// WithSessionSubPool defines named sub-pool of sessions with independent size limit
+// in table.Client and query.Client.
+// Sub-pool selects by workload label from context (see ydb.WithWorkload).
+// Queries with context without workload label or with unknown workload label use default sessions pool
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionSubPool(name string, sizeLimit int) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithSubPool(name, sizeLimit))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithSubPool(name, sizeLimit))
+
+		return nil
+	}
+}
+
 // WithSessionPoolSessionUsageLimit set max count for use session
 func WithSessionPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	return func(ctx context.Context, d *Driver) error {
@@ -498,6 +636,40 @@ func WithSessionPoolSessionUsageLimit(sessionUsageLimit uint64) Option {
 	}
 }
 
+// WithSessionMaxQueries limits count of queries executed within one session of table.Client
+// and query.Client. Exhausted sessions are closed and replaced with new ones.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionMaxQueries(maxQueries uint64) Option {
+	return WithSessionPoolSessionUsageLimit(maxQueries)
+}
+
+// WithSessionMaxLifetime limits total lifetime of sessions of table.Client and query.Client.
+// Lifetime of each session is jittered, so sessions are recycled gradually and new sessions
+// spread load across nodes after cluster scale-out.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionMaxLifetime(maxLifetime time.Duration) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithSessionMaxLifetime(maxLifetime))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithSessionMaxLifetime(maxLifetime))
+
+		return nil
+	}
+}
+
+// WithSessionIdleTimeToLive limits time to live of idle sessions of table.Client and query.Client.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionIdleTimeToLive(idleTimeToLive time.Duration) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.tableOptions = append(d.tableOptions, tableConfig.WithIdleThreshold(idleTimeToLive))
+		d.queryOptions = append(d.queryOptions, queryConfig.WithSessionIdleTimeToLive(idleTimeToLive))
+
+		return nil
+	}
+}
+
 // WithLazyTx enables lazy transactions in query service client
 //
 // Lazy transaction means that begin call will be noop and first execute creates interactive transaction with given
@@ -512,6 +684,33 @@ func WithLazyTx(lazyTx bool) Option {
 	}
 }
 
+// WithTableProfile registers execution profile (default transaction control, read replica preference with
+// stale read-only transaction control, retry options, timeout) for statements of query client
+// which reference table (or tables in directory) with profile path.
+// Options of all matched profiles applies before options of call, so options of call override them.
+// Timeout of statement is a minimal timeout of matched profiles
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTableProfile(profile query.TableProfile) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryOptions = append(d.queryOptions, queryConfig.WithTableProfile(profile))
+
+		return nil
+	}
+}
+
+// WithQuerySessionMaxInFlight limits count of concurrently executing queries per query service session.
+// Queries over limit wait for completion of other queries of session. Non-positive value means no limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQuerySessionMaxInFlight(maxInFlight int) Option {
+	return func(ctx context.Context, d *Driver) error {
+		d.queryOptions = append(d.queryOptions, queryConfig.WithSessionMaxInFlight(maxInFlight))
+
+		return nil
+	}
+}
+
 // WithSessionPoolIdleThreshold defines interval for idle sessions
 func WithSessionPoolIdleThreshold(idleThreshold time.Duration) Option {
 	return func(ctx context.Context, c *Driver) error {
@@ -582,10 +781,29 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithTableOverQuery makes table client to execute data queries (table.Session.Execute and
+// table.Transaction.Execute) with query service in the same sessions, so code on table client
+// can move to query service without rewriting. Scheme queries, prepared statements and other
+// table calls are executed with table service as before.
+// Backend of data query call reports with field OverQueryService of trace.TableExecuteDataQueryStartInfo
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTableOverQuery() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithExecuteDataQueryOverQueryService(true))
+
+		return nil
+	}
+}
+
 // WithPanicCallback specified behavior on panic
-// Warning: WithPanicCallback must be defined on start of all options
-// (before `WithTrace{Driver,Table,Scheme,Scripting,Coordination,Ratelimiter}` and other options)
-// If not defined - panic would not intercept with driver
+//
+// Panics in user callbacks of Do/DoTx (table and query clients) and topic listener handlers
+// always recovers and returns as non-retryable error with stack trace (see IsPanicError).
+// Panics in trace callbacks always recovers too, but trace callbacks have no result for returning
+// of error, so recovered panics of trace callbacks are ignored.
+// Panic callback calls with recovered value before returning of error. For crash process on panic
+// re-panic in callback.
 func WithPanicCallback(panicCallback func(e interface{})) Option {
 	return func(ctx context.Context, c *Driver) error {
 		c.panicCallback = panicCallback
@@ -604,7 +822,7 @@ func WithTraceTable(t trace.Table, opts ...trace.TableComposeOption) Option { //
 				&t,
 				append(
 					[]trace.TableComposeOption{
-						trace.WithTablePanicCallback(c.panicCallback),
+						trace.WithTablePanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -623,7 +841,7 @@ func WithTraceQuery(t trace.Query, opts ...trace.QueryComposeOption) Option { //
 			queryConfig.WithTrace(&t,
 				append(
 					[]trace.QueryComposeOption{
-						trace.WithQueryPanicCallback(c.panicCallback),
+						trace.WithQueryPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -643,7 +861,7 @@ func WithTraceScripting(t trace.Scripting, opts ...trace.ScriptingComposeOption)
 				t,
 				append(
 					[]trace.ScriptingComposeOption{
-						trace.WithScriptingPanicCallback(c.panicCallback),
+						trace.WithScriptingPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -663,7 +881,7 @@ func WithTraceScheme(t trace.Scheme, opts ...trace.SchemeComposeOption) Option {
 				t,
 				append(
 					[]trace.SchemeComposeOption{
-						trace.WithSchemePanicCallback(c.panicCallback),
+						trace.WithSchemePanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -683,7 +901,7 @@ func WithTraceCoordination(t trace.Coordination, opts ...trace.CoordinationCompo
 				&t,
 				append(
 					[]trace.CoordinationComposeOption{
-						trace.WithCoordinationPanicCallback(c.panicCallback),
+						trace.WithCoordinationPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -703,7 +921,7 @@ func WithTraceRatelimiter(t trace.Ratelimiter, opts ...trace.RatelimiterComposeO
 				t,
 				append(
 					[]trace.RatelimiterComposeOption{
-						trace.WithRatelimiterPanicCallback(c.panicCallback),
+						trace.WithRatelimiterPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -732,7 +950,7 @@ func WithTraceDiscovery(t trace.Discovery, opts ...trace.DiscoveryComposeOption)
 				t,
 				append(
 					[]trace.DiscoveryComposeOption{
-						trace.WithDiscoveryPanicCallback(c.panicCallback),
+						trace.WithDiscoveryPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -752,7 +970,7 @@ func WithTraceTopic(t trace.Topic, opts ...trace.TopicComposeOption) Option { //
 				t,
 				append(
 					[]trace.TopicComposeOption{
-						trace.WithTopicPanicCallback(c.panicCallback),
+						trace.WithTopicPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -772,7 +990,7 @@ func WithTraceDatabaseSQL(t trace.DatabaseSQL, opts ...trace.DatabaseSQLComposeO
 				&t,
 				append(
 					[]trace.DatabaseSQLComposeOption{
-						trace.WithDatabaseSQLPanicCallback(c.panicCallback),
+						trace.WithDatabaseSQLPanicCallback(c.onPanic),
 					},
 					opts...,
 				)...,
@@ -783,6 +1001,24 @@ func WithTraceDatabaseSQL(t trace.DatabaseSQL, opts ...trace.DatabaseSQLComposeO
 	}
 }
 
+// WithOtelTracing adds OpenTelemetry tracing of driver, table, query and topic events.
+// Spans has semantic attributes (db.system, db.statement, session id, node id) and
+// trace context of spans propagates into gRPC metadata of requests (W3C trace context)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOtelTracing(tracerProvider oteltrace.TracerProvider) Option {
+	if tracerProvider == nil {
+		return nil
+	}
+
+	return MergeOptions(
+		WithTraceDriver(xotel.Driver(tracerProvider)),
+		WithTraceTable(xotel.Table(tracerProvider)),
+		WithTraceQuery(xotel.Query(tracerProvider)),
+		WithTraceTopic(xotel.Topic(tracerProvider)),
+	)
+}
+
 // Private technical options for correct copies processing
 
 func withOnClose(onClose func(c *Driver)) Option {
@@ -0,0 +1,40 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestTracePanicRecovering(t *testing.T) {
+	ctx := context.Background()
+	panicTrace := WithTraceDriver(trace.Driver{
+		OnClose: func(trace.DriverCloseStartInfo) func(trace.DriverCloseDoneInfo) {
+			panic("test")
+		},
+	})
+	t.Run("WithoutPanicCallback", func(t *testing.T) {
+		d := &Driver{}
+		require.NoError(t, panicTrace(ctx, d))
+		require.NotPanics(t, func() {
+			trace.DriverOnClose(config.New(d.options...).Trace(), &ctx, stack.FunctionID(""))(nil)
+		})
+	})
+	t.Run("PanicCallbackAfterTrace", func(t *testing.T) {
+		var recovered []interface{}
+		d := &Driver{}
+		require.NoError(t, panicTrace(ctx, d))
+		require.NoError(t, WithPanicCallback(func(e interface{}) {
+			recovered = append(recovered, e)
+		})(ctx, d))
+		require.NotPanics(t, func() {
+			trace.DriverOnClose(config.New(d.options...).Trace(), &ctx, stack.FunctionID(""))(nil)
+		})
+		require.Equal(t, []interface{}{"test"}, recovered)
+	})
+}
@@ -0,0 +1,68 @@
+package ydb
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
+)
+
+type (
+	// Outbox publishes messages into topic within transaction
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Outbox struct {
+		db        *Driver
+		tx        query.TxActor
+		topicPath string
+		writer    *topicwriter.TxWriter
+	}
+
+	// OutboxOperation is the function which executes table queries over tx and publishes messages with outbox
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	OutboxOperation func(ctx context.Context, tx query.TxActor, outbox *Outbox) error
+)
+
+// Publish writes messages into topic within transaction.
+// Messages become visible to topic readers only after commit of transaction
+// and will be dropped on rollback
+func (o *Outbox) Publish(ctx context.Context, messages ...topicwriter.Message) error {
+	if o.writer == nil {
+		writer, err := o.db.Topic().StartTransactionalWriter(o.tx, o.topicPath)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		o.writer = writer
+	}
+
+	return o.writer.Write(ctx, messages...)
+}
+
+// DoTxWithOutbox is a transactional outbox helper. It executes op in query service transaction
+// with retries (same as query.Client.DoTx) and provides Outbox for publishing messages
+// into topicPath within the same transaction.
+// Table changes and published messages are committed or rolled back atomically.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DoTxWithOutbox(
+	ctx context.Context,
+	db *Driver,
+	topicPath string,
+	op OutboxOperation,
+	opts ...query.DoTxOption,
+) error {
+	err := db.Query().DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+		return op(ctx, tx, &Outbox{
+			db:        db,
+			tx:        tx,
+			topicPath: topicPath,
+		})
+	}, opts...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
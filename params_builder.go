@@ -8,3 +8,14 @@ import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 func ParamsBuilder() params.Builder {
 	return params.Builder{}
 }
+
+// ParamsFromStruct makes query arguments from fields of struct (or pointer to struct) v.
+// Names of arguments defines by `sql` tag (as in ScanStruct) or by names of fields.
+// Pointer fields makes optional values (nil pointers makes NULL of optional type), slices makes
+// lists, maps makes dicts, nested structs makes structs and fields with `json` tag option
+// makes JsonDocument values. Embedded structs without tag are inlined.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsFromStruct(v any) (*params.Parameters, error) {
+	return params.FromStruct(v, "sql")
+}
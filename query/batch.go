@@ -0,0 +1,26 @@
+package query
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+)
+
+type (
+	// Statement is a query with execute options for Client.ExecBatch
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Statement struct {
+		Query   string
+		Options []options.Execute
+	}
+
+	// BatchResult is a result of statement executed by Client.ExecBatch.
+	// Exactly one of Result and Err is not nil
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	BatchResult struct {
+		// Result is a materialized result of statement
+		Result Result
+		// Err is an error of statement execution
+		Err error
+	}
+)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
@@ -96,6 +97,24 @@ type (
 		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 		QueryRow(ctx context.Context, query string, opts ...options.Execute) (Row, error)
 
+		// ExecBatch executes independent statements one after another on single session without
+		// acquiring session from pool for each statement, and returns materialized results or errors
+		// of statements in order of statements.
+		// Error of statement doesn't stop execution of next statements. Statements which failed with
+		// errors of session are executed again on other session, other errors are not retried.
+		// Returned error is not nil only if batch can't be executed (e.g. ctx is done), results of
+		// not executed statements contain the error
+		//
+		// Warning: results of statements are materialized and can happened to "OOM killed" problem
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		ExecBatch(ctx context.Context, statements []Statement) ([]BatchResult, error)
+
+		// Explain returns parsed explain plan of query without execution
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Explain(ctx context.Context, query string, opts ...options.Execute) (*Plan, error)
+
 		// ExecuteScript starts long executing script with polling results later
 		//
 		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
@@ -109,6 +128,39 @@ type (
 		FetchScriptResults(
 			ctx context.Context, opID string, opts ...options.FetchScriptOption,
 		) (*options.FetchScriptResult, error)
+
+		// CancelOperation cancels long executing operation (such as script started with ExecuteScript)
+		// by operation ID. CancelOperation can be called from any goroutine or process
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		CancelOperation(ctx context.Context, opID string) error
+
+		// Stats returns current state of default sessions pool
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Stats() PoolStats
+	}
+
+	// PoolStats is a snapshot of sessions pool state
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PoolStats struct {
+		// Limit is an upper bound of sessions in pool
+		Limit int
+		// Index is a count of sessions in pool
+		Index int
+		// Idle is a count of sessions which are ready for use
+		Idle int
+		// InUse is a count of sessions which are used by clients right now
+		InUse int
+		// Wait is a count of callers which wait for session
+		Wait int
+		// CreateInProgress is a count of sessions which are creating right now
+		CreateInProgress int
+		// Created is a total count of created sessions
+		Created uint64
+		// Deleted is a total count of deleted sessions
+		Deleted uint64
 	}
 )
 
@@ -162,3 +214,22 @@ func WithLabel(lbl string) options.RetryOptionsOption {
 func WithRetryBudget(b budget.Budget) options.RetryOptionsOption {
 	return options.WithRetryBudget(b)
 }
+
+// WithHedging creates option with hedged attempts of idempotent operation (see retry.WithHedging)
+//
+// Hedged attempts applies only to Client.Query, Client.QueryRow and Client.QueryResultSet helpers which
+// returns own result of each attempt. Client.Do and Client.DoTx ignores hedging because op runs user code
+// which may modify shared state.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithHedging(delay time.Duration, maxAttempts int) options.RetryOptionsOption {
+	return options.WithHedging(delay, maxAttempts)
+}
+
+// TableProfile is a set of execution settings (default transaction control, retry options, timeout)
+// which applies to statements of Client.Exec, Client.Query, Client.QueryResultSet and Client.QueryRow
+// referencing table (or tables in directory) with given path.
+// Tables are detected by identifiers in text of statement, so path must be written as in statements
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TableProfile = config.TableProfile
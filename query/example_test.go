@@ -125,6 +125,41 @@ func Example_queryRow() {
 	fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
 }
 
+func Example_readRowAndReadAll() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		panic(err)
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	type myStruct struct {
+		ID    int32  `sql:"id"`
+		MyStr string `sql:"myStr"`
+	}
+
+	// Do retry operation on errors with best effort
+	one, err := query.ReadRow[myStruct](ctx, db.Query(),
+		`SELECT 42 as id, "my string" as myStr`,
+		query.WithIdempotent(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("id=%v, myStr='%s'\n", one.ID, one.MyStr)
+
+	all, err := query.ReadAll[myStruct](ctx, db.Query(),
+		`SELECT 42 as id, "my string" as myStr UNION ALL SELECT 43 as id, "other string" as myStr`,
+		query.WithIdempotent(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	for _, v := range all {
+		fmt.Printf("id=%v, myStr='%s'\n", v.ID, v.MyStr)
+	}
+}
+
 func Example_explain() {
 	ctx := context.TODO()
 	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
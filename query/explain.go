@@ -0,0 +1,148 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// PlanSnapshot is a captured explain plan of query
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanSnapshot struct {
+		Query string `json:"query"`
+		Plan  string `json:"plan"`
+		AST   string `json:"ast,omitempty"`
+	}
+
+	// PlanSnapshots is a set of captured explain plans by names of statements.
+	// PlanSnapshots can be serialized with encoding/json for comparing with snapshots captured later
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanSnapshots map[string]PlanSnapshot
+
+	// PlanChangeKind describes kind of difference between explain plans of statement
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanChangeKind string
+
+	// PlanChange is a machine-readable difference between explain plans of statement
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanChange struct {
+		Name    string         `json:"name"`
+		Kind    PlanChangeKind `json:"kind"`
+		OldPlan string         `json:"old_plan,omitempty"`
+		NewPlan string         `json:"new_plan,omitempty"`
+	}
+)
+
+const (
+	PlanAdded   = PlanChangeKind("added")
+	PlanRemoved = PlanChangeKind("removed")
+	PlanChanged = PlanChangeKind("changed")
+)
+
+func capturePlan(ctx context.Context, c Executor, sql string, opts ...options.Execute) (plan PlanSnapshot, _ error) {
+	plan.Query = sql
+
+	err := c.Exec(ctx, sql, append(opts,
+		options.WithExecMode(options.ExecModeExplain),
+		options.WithStatsMode(options.StatsModeBasic, func(stats Stats) {
+			if stats != nil {
+				plan.Plan = stats.QueryPlan()
+				plan.AST = stats.QueryAST()
+			}
+		}),
+	)...)
+	if err != nil {
+		return plan, xerrors.WithStackTrace(err)
+	}
+
+	return plan, nil
+}
+
+// ExplainAll captures explain plans of queries (by names of statements) without execution
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ExplainAll(
+	ctx context.Context, c Executor, queries map[string]string, opts ...options.Execute,
+) (PlanSnapshots, error) {
+	plans := make(PlanSnapshots, len(queries))
+	for name, sql := range queries {
+		plan, err := capturePlan(ctx, c, sql, opts...)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("explain %q failed: %w", name, err))
+		}
+		plans[name] = plan
+	}
+
+	return plans, nil
+}
+
+// Diff returns changes of explain plans from plans to newPlans ordered by names of statements.
+// Plans in JSON format compares without regard to formatting and order of keys
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (plans PlanSnapshots) Diff(newPlans PlanSnapshots) (changes []PlanChange) {
+	for name, oldPlan := range plans {
+		newPlan, has := newPlans[name]
+		switch {
+		case !has:
+			changes = append(changes, PlanChange{
+				Name:    name,
+				Kind:    PlanRemoved,
+				OldPlan: oldPlan.Plan,
+			})
+		case !equalPlans(oldPlan.Plan, newPlan.Plan):
+			changes = append(changes, PlanChange{
+				Name:    name,
+				Kind:    PlanChanged,
+				OldPlan: oldPlan.Plan,
+				NewPlan: newPlan.Plan,
+			})
+		}
+	}
+	for name, newPlan := range newPlans {
+		if _, has := plans[name]; !has {
+			changes = append(changes, PlanChange{
+				Name:    name,
+				Kind:    PlanAdded,
+				NewPlan: newPlan.Plan,
+			})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes
+}
+
+func equalPlans(lhs, rhs string) bool {
+	if lhs == rhs {
+		return true
+	}
+
+	var l, r any
+	if json.Unmarshal([]byte(lhs), &l) != nil || json.Unmarshal([]byte(rhs), &r) != nil {
+		return false
+	}
+
+	lb, err := json.Marshal(l)
+	if err != nil {
+		return false
+	}
+	rb, err := json.Marshal(r)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(lb, rb)
+}
@@ -0,0 +1,88 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+type explainExecutor struct {
+	query.Executor
+
+	plans map[string]string
+}
+
+func (e *explainExecutor) Exec(ctx context.Context, sql string, opts ...options.Execute) error {
+	settings := options.ExecuteSettings(opts...)
+	if settings.ExecMode() != options.ExecModeExplain {
+		panic("unexpected exec mode")
+	}
+	settings.StatsCallback()(stats.FromQueryStats(&Ydb_TableStats.QueryStats{
+		QueryPlan: e.plans[sql],
+		QueryAst:  "ast",
+	}))
+
+	return nil
+}
+
+func TestExplainAll(t *testing.T) {
+	ctx := context.Background()
+	queries := map[string]string{
+		"a": "SELECT 1",
+		"b": "SELECT 2",
+		"c": "SELECT 3",
+	}
+	oldPlans, err := query.ExplainAll(ctx, &explainExecutor{plans: map[string]string{
+		"SELECT 1": `{"Plan":{"Node Type":"Query","PlanNodeId":1}}`,
+		"SELECT 2": `{"Plan":{"Node Type":"Query"}}`,
+		"SELECT 3": `{"Plan":{"Node Type":"Query"}}`,
+	}}, queries)
+	require.NoError(t, err)
+	require.Len(t, oldPlans, 3)
+	require.Equal(t, query.PlanSnapshot{
+		Query: "SELECT 2",
+		Plan:  `{"Plan":{"Node Type":"Query"}}`,
+		AST:   "ast",
+	}, oldPlans["b"])
+
+	data, err := json.Marshal(oldPlans)
+	require.NoError(t, err)
+	var restoredPlans query.PlanSnapshots
+	require.NoError(t, json.Unmarshal(data, &restoredPlans))
+	require.Equal(t, oldPlans, restoredPlans)
+
+	delete(queries, "c")
+	queries["d"] = "SELECT 4"
+	newPlans, err := query.ExplainAll(ctx, &explainExecutor{plans: map[string]string{
+		"SELECT 1": `{"Plan": {"PlanNodeId": 1, "Node Type": "Query"}}`,
+		"SELECT 2": `{"Plan":{"Node Type":"ResultSet"}}`,
+		"SELECT 4": `{}`,
+	}}, queries)
+	require.NoError(t, err)
+
+	require.Equal(t, []query.PlanChange{
+		{
+			Name:    "b",
+			Kind:    query.PlanChanged,
+			OldPlan: `{"Plan":{"Node Type":"Query"}}`,
+			NewPlan: `{"Plan":{"Node Type":"ResultSet"}}`,
+		},
+		{
+			Name:    "c",
+			Kind:    query.PlanRemoved,
+			OldPlan: `{"Plan":{"Node Type":"Query"}}`,
+		},
+		{
+			Name:    "d",
+			Kind:    query.PlanAdded,
+			NewPlan: `{}`,
+		},
+	}, restoredPlans.Diff(newPlans))
+}
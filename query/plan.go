@@ -0,0 +1,208 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// Plan is a parsed explain plan of query
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Plan struct {
+		// Root is a root node of plan tree
+		Root *PlanNode
+		// Tables contains paths of tables which query accesses to
+		Tables []string
+		// AST is an abstract syntax tree of query
+		AST string
+		// Raw is a plan of query in JSON format as returned by server
+		Raw string
+	}
+
+	// PlanNode is a node of explain plan tree
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanNode struct {
+		ID int
+		// Type is a type of node (such as "Query", "ResultSet", "Stage" and etc.)
+		Type      string
+		Operators []PlanOperator
+		Tables    []string
+		Children  []*PlanNode
+		// Properties contains other attributes of node
+		Properties map[string]any
+	}
+
+	// PlanOperator is an operator of explain plan node
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	PlanOperator struct {
+		// Name is a name of operator (such as "TableFullScan", "Filter", "Limit" and etc.)
+		Name string
+		// Table is a table which operator reads from or writes to
+		Table string
+		// EstimatedRows, EstimatedCost and EstimatedSize are estimations of optimizer.
+		// Zero values means that server has no estimations
+		EstimatedRows float64
+		EstimatedCost float64
+		EstimatedSize float64
+		// Properties contains other attributes of operator
+		Properties map[string]any
+	}
+)
+
+// ParsePlan parses explain plan of query in JSON format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParsePlan(plan, ast string) (*Plan, error) {
+	var raw struct {
+		Plan   map[string]any `json:"Plan"`
+		Tables []struct {
+			Name string `json:"name"`
+		} `json:"tables"`
+	}
+	if err := json.Unmarshal([]byte(plan), &raw); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("parse plan failed: %w", err))
+	}
+
+	p := &Plan{
+		AST: ast,
+		Raw: plan,
+	}
+	if raw.Plan != nil {
+		p.Root = parsePlanNode(raw.Plan)
+	}
+	for _, t := range raw.Tables {
+		p.Tables = append(p.Tables, t.Name)
+	}
+
+	return p, nil
+}
+
+// Walk calls f for each node of plan tree in depth-first order while f returns true
+func (p *Plan) Walk(f func(node *PlanNode) bool) {
+	if p.Root != nil {
+		p.Root.walk(f)
+	}
+}
+
+func (n *PlanNode) walk(f func(node *PlanNode) bool) bool {
+	if !f(n) {
+		return false
+	}
+	for _, child := range n.Children {
+		if !child.walk(f) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parsePlanNode(raw map[string]any) *PlanNode {
+	node := &PlanNode{
+		Properties: make(map[string]any),
+	}
+	for k, v := range raw {
+		switch k {
+		case "PlanNodeId":
+			if id, ok := v.(float64); ok {
+				node.ID = int(id)
+			}
+		case "Node Type":
+			node.Type, _ = v.(string)
+		case "Tables":
+			node.Tables = planStrings(v)
+		case "Operators":
+			ops, _ := v.([]any)
+			for _, op := range ops {
+				if op, ok := op.(map[string]any); ok {
+					node.Operators = append(node.Operators, parsePlanOperator(op))
+				}
+			}
+		case "Plans":
+			children, _ := v.([]any)
+			for _, child := range children {
+				if child, ok := child.(map[string]any); ok {
+					node.Children = append(node.Children, parsePlanNode(child))
+				}
+			}
+		default:
+			node.Properties[k] = v
+		}
+	}
+
+	return node
+}
+
+func parsePlanOperator(raw map[string]any) (op PlanOperator) {
+	op.Properties = make(map[string]any)
+	for k, v := range raw {
+		switch k {
+		case "Name":
+			op.Name, _ = v.(string)
+		case "Table":
+			op.Table, _ = v.(string)
+		case "E-Rows":
+			op.EstimatedRows = planNumber(v)
+		case "E-Cost":
+			op.EstimatedCost = planNumber(v)
+		case "E-Size":
+			op.EstimatedSize = planNumber(v)
+		default:
+			op.Properties[k] = v
+		}
+	}
+
+	return op
+}
+
+func planStrings(v any) (ss []string) {
+	items, _ := v.([]any)
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			ss = append(ss, s)
+		}
+	}
+
+	return ss
+}
+
+func planNumber(v any) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+
+		return f
+	default:
+		return 0
+	}
+}
+
+// Explain returns parsed explain plan of query without execution
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Explain(ctx context.Context, c Executor, sql string, opts ...options.Execute) (*Plan, error) {
+	snapshot, err := capturePlan(ctx, c, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	plan, err := ParsePlan(snapshot.Plan, snapshot.AST)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,84 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+const testPlan = `{
+	"meta": {"version": "0.2", "type": "query"},
+	"tables": [{"name": "/local/series", "reads": [{"type": "FullScan"}]}],
+	"Plan": {
+		"Node Type": "Query",
+		"PlanNodeType": "Query",
+		"Plans": [{
+			"Node Type": "ResultSet",
+			"PlanNodeId": 3,
+			"PlanNodeType": "ResultSet",
+			"Plans": [{
+				"Node Type": "Limit-TableFullScan",
+				"PlanNodeId": 1,
+				"Tables": ["series"],
+				"Operators": [
+					{"Name": "Limit", "Limit": "1"},
+					{"Name": "TableFullScan", "Table": "series", "E-Rows": "10", "E-Cost": 20.5, "E-Size": "No estimate"}
+				]
+			}]
+		}]
+	}
+}`
+
+func TestParsePlan(t *testing.T) {
+	plan, err := query.ParsePlan(testPlan, "ast")
+	require.NoError(t, err)
+	require.Equal(t, "ast", plan.AST)
+	require.Equal(t, testPlan, plan.Raw)
+	require.Equal(t, []string{"/local/series"}, plan.Tables)
+	require.Equal(t, "Query", plan.Root.Type)
+	require.Len(t, plan.Root.Children, 1)
+
+	resultSet := plan.Root.Children[0]
+	require.Equal(t, 3, resultSet.ID)
+	require.Equal(t, "ResultSet", resultSet.Type)
+	require.Equal(t, "ResultSet", resultSet.Properties["PlanNodeType"])
+
+	scan := resultSet.Children[0]
+	require.Equal(t, []string{"series"}, scan.Tables)
+	require.Equal(t, []query.PlanOperator{
+		{
+			Name:       "Limit",
+			Properties: map[string]any{"Limit": "1"},
+		},
+		{
+			Name:          "TableFullScan",
+			Table:         "series",
+			EstimatedRows: 10,
+			EstimatedCost: 20.5,
+			Properties:    map[string]any{},
+		},
+	}, scan.Operators)
+
+	var ids []int
+	plan.Walk(func(node *query.PlanNode) bool {
+		ids = append(ids, node.ID)
+
+		return node.ID != 3
+	})
+	require.Equal(t, []int{0, 3}, ids)
+
+	_, err = query.ParsePlan("{", "")
+	require.Error(t, err)
+}
+
+func TestExplain(t *testing.T) {
+	plan, err := query.Explain(context.Background(), &explainExecutor{plans: map[string]string{
+		"SELECT 1": testPlan,
+	}}, "SELECT 1")
+	require.NoError(t, err)
+	require.Equal(t, "ast", plan.AST)
+	require.Equal(t, "Query", plan.Root.Type)
+}
@@ -0,0 +1,319 @@
+// Package querytest provides in-memory implementation of query client for unit tests
+// without YDB instance: register fixtures with rows for queries and pass Client to the code under test.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package querytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var (
+	// ErrUnexpectedQuery returns from executions of queries without registered fixture
+	ErrUnexpectedQuery = errors.New("unexpected query")
+
+	// ErrNotImplemented returns from methods which in-memory client doesn't support
+	ErrNotImplemented = errors.New("not implemented in querytest")
+
+	errMoreThanOneRow       = errors.New("unexpected more than one row in result set")
+	errMoreThanOneResultSet = errors.New("unexpected more than one result set")
+	errNoResultSets         = errors.New("no result sets")
+	errNoRows               = errors.New("no rows in result set")
+)
+
+func errWrongCountOfValues(resultSetIndex, rowIndex, count, expected int) error {
+	return xerrors.WithStackTrace(fmt.Errorf("result set %d, row %d: %d values instead of %d",
+		resultSetIndex, rowIndex, count, expected,
+	))
+}
+
+var _ query.Client = (*Client)(nil)
+
+type (
+	// Client is an in-memory implementation of query.Client for unit tests.
+	// Client returns registered fixtures for queries instead of executing them on YDB
+	Client struct {
+		mu       sync.Mutex
+		fixtures map[string]*Fixture
+		calls    []Call
+		txID     int
+	}
+
+	// Fixture describes result of query execution
+	Fixture struct {
+		resultSets []*ResultSet
+		err        error
+	}
+
+	// Call is a recorded execution of query
+	Call struct {
+		Query  string
+		Params *params.Parameters
+		TxID   string
+	}
+)
+
+// New makes in-memory query client without fixtures
+func New() *Client {
+	return &Client{
+		fixtures: make(map[string]*Fixture),
+	}
+}
+
+// normalize makes key of fixture from text of query without regard to whitespaces
+func normalize(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
+
+// On registers fixture for query. Queries compares without regard to whitespaces
+func (c *Client) On(sql string) *Fixture {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f := &Fixture{}
+	c.fixtures[normalize(sql)] = f
+
+	return f
+}
+
+// Return sets result sets of query execution
+func (f *Fixture) Return(resultSets ...*ResultSet) *Fixture {
+	f.resultSets = resultSets
+	f.err = nil
+
+	return f
+}
+
+// ReturnError sets error of query execution
+func (f *Fixture) ReturnError(err error) *Fixture {
+	f.resultSets = nil
+	f.err = err
+
+	return f
+}
+
+// Calls returns recorded executions of queries in order of execution
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+func (c *Client) execute(ctx context.Context, txID, sql string, opts ...options.Execute) (*internalResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	settings := options.ExecuteSettings(opts...)
+
+	c.mu.Lock()
+	c.calls = append(c.calls, Call{
+		Query:  sql,
+		Params: settings.Params(),
+		TxID:   txID,
+	})
+	f, has := c.fixtures[normalize(sql)]
+	c.mu.Unlock()
+
+	if !has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrUnexpectedQuery, sql))
+	}
+
+	if f.err != nil {
+		return nil, xerrors.WithStackTrace(f.err)
+	}
+
+	resultSets := make([]query.ClosableResultSet, 0, len(f.resultSets))
+	for i, rs := range f.resultSets {
+		materialized, err := rs.materialize(i)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		resultSets = append(resultSets, materialized)
+	}
+
+	return &internalResult{resultSets: resultSets}, nil
+}
+
+type internalResult struct {
+	resultSets []query.ClosableResultSet
+}
+
+func (r *internalResult) result() query.Result {
+	resultSets := make([]result.Set, 0, len(r.resultSets))
+	for _, rs := range r.resultSets {
+		resultSets = append(resultSets, rs)
+	}
+
+	return internalQuery.MaterializedResult(resultSets...)
+}
+
+func (r *internalResult) resultSet() (query.ClosableResultSet, error) {
+	switch len(r.resultSets) {
+	case 0:
+		return nil, xerrors.WithStackTrace(errNoResultSets)
+	case 1:
+		return r.resultSets[0], nil
+	default:
+		return nil, xerrors.WithStackTrace(errMoreThanOneResultSet)
+	}
+}
+
+func (r *internalResult) row(ctx context.Context) (query.Row, error) {
+	rs, err := r.resultSet()
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	row, err := rs.NextRow(ctx)
+	if err != nil {
+		if xerrors.Is(err, io.EOF) {
+			return nil, xerrors.WithStackTrace(errNoRows)
+		}
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if _, err = rs.NextRow(ctx); err == nil {
+		return nil, xerrors.WithStackTrace(errMoreThanOneRow)
+	}
+
+	return row, nil
+}
+
+func (c *Client) exec(ctx context.Context, txID, sql string, opts ...options.Execute) error {
+	_, err := c.execute(ctx, txID, sql, opts...)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *Client) query(ctx context.Context, txID, sql string, opts ...options.Execute) (query.Result, error) {
+	r, err := c.execute(ctx, txID, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r.result(), nil
+}
+
+func (c *Client) queryResultSet(
+	ctx context.Context, txID, sql string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	r, err := c.execute(ctx, txID, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r.resultSet()
+}
+
+func (c *Client) queryRow(ctx context.Context, txID, sql string, opts ...options.Execute) (query.Row, error) {
+	r, err := c.execute(ctx, txID, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r.row(ctx)
+}
+
+func (c *Client) Exec(ctx context.Context, sql string, opts ...options.Execute) error {
+	return c.exec(ctx, "", sql, opts...)
+}
+
+func (c *Client) Query(ctx context.Context, sql string, opts ...options.Execute) (query.Result, error) {
+	return c.query(ctx, "", sql, opts...)
+}
+
+func (c *Client) QueryResultSet(
+	ctx context.Context, sql string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	return c.queryResultSet(ctx, "", sql, opts...)
+}
+
+func (c *Client) QueryRow(ctx context.Context, sql string, opts ...options.Execute) (query.Row, error) {
+	return c.queryRow(ctx, "", sql, opts...)
+}
+
+// Do calls op once with in-memory session
+func (c *Client) Do(ctx context.Context, op query.Operation, opts ...query.DoOption) error {
+	err := op(ctx, &session{client: c})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+// DoTx calls op once with in-memory transaction
+func (c *Client) DoTx(ctx context.Context, op query.TxOperation, opts ...query.DoTxOption) error {
+	err := op(ctx, c.begin())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (c *Client) begin() *transaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.txID++
+
+	return &transaction{
+		LazyID: tx.ID(fmt.Sprintf("querytest-tx-%d", c.txID)),
+		client: c,
+	}
+}
+
+func (c *Client) Explain(ctx context.Context, sql string, opts ...options.Execute) (*query.Plan, error) {
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: Explain", ErrNotImplemented))
+}
+
+func (c *Client) ExecuteScript(
+	ctx context.Context, sql string, ttl time.Duration, ops ...options.Execute,
+) (*options.ExecuteScriptOperation, error) {
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: ExecuteScript", ErrNotImplemented))
+}
+
+func (c *Client) FetchScriptResults(
+	ctx context.Context, opID string, opts ...options.FetchScriptOption,
+) (*options.FetchScriptResult, error) {
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: FetchScriptResults", ErrNotImplemented))
+}
+
+func (c *Client) CancelOperation(ctx context.Context, opID string) error {
+	return xerrors.WithStackTrace(fmt.Errorf("%w: CancelOperation", ErrNotImplemented))
+}
+
+func (c *Client) Stats() query.PoolStats {
+	return query.PoolStats{}
+}
+
+// ExecBatch executes statements one after another with registered fixtures
+func (c *Client) ExecBatch(ctx context.Context, statements []query.Statement) ([]query.BatchResult, error) {
+	results := make([]query.BatchResult, len(statements))
+	for i, statement := range statements {
+		results[i].Result, results[i].Err = c.query(ctx, "", statement.Query, statement.Options...)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,84 @@
+package querytest_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query/querytest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	c := querytest.New()
+	c.On("SELECT id, title FROM series").Return(
+		querytest.NewResultSet(querytest.Columns("id", "title")...).
+			AddRow(types.Uint64Value(1), types.TextValue("IT Crowd")).
+			AddRow(types.Uint64Value(2), types.TextValue("Silicon Valley")),
+	)
+	c.On("SELECT title FROM series WHERE id = $id").Return(
+		querytest.NewResultSet(querytest.Column{Name: "title", Type: types.Optional(types.TypeText)}).
+			AddRow(types.OptionalValue(types.TextValue("IT Crowd"))),
+	)
+	errFailed := errors.New("failed")
+	c.On("DELETE FROM series").ReturnError(errFailed)
+
+	t.Run("QueryResultSet", func(t *testing.T) {
+		rs, err := c.QueryResultSet(ctx, "SELECT id, title\n\tFROM series")
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "title"}, rs.Columns())
+		var ids []uint64
+		for {
+			row, err := rs.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			var s struct {
+				ID    uint64 `sql:"id"`
+				Title string `sql:"title"`
+			}
+			require.NoError(t, row.ScanStruct(&s))
+			ids = append(ids, s.ID)
+		}
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
+	t.Run("QueryRow", func(t *testing.T) {
+		_, err := c.QueryRow(ctx, "SELECT id, title FROM series")
+		require.Error(t, err)
+
+		row, err := c.QueryRow(ctx, "SELECT title FROM series WHERE id = $id",
+			query.WithParameters(ydb.ParamsBuilder().Param("$id").Uint64(1).Build()),
+		)
+		require.NoError(t, err)
+		var title *string
+		require.NoError(t, row.Scan(&title))
+		require.Equal(t, "IT Crowd", *title)
+	})
+	t.Run("DoTx", func(t *testing.T) {
+		err := c.DoTx(ctx, func(ctx context.Context, tx query.TxActor) error {
+			return tx.Exec(ctx, "DELETE FROM series")
+		})
+		require.ErrorIs(t, err, errFailed)
+	})
+	t.Run("UnexpectedQuery", func(t *testing.T) {
+		err := c.Do(ctx, func(ctx context.Context, s query.Session) error {
+			return s.Exec(ctx, "SELECT 1")
+		})
+		require.ErrorIs(t, err, querytest.ErrUnexpectedQuery)
+	})
+	t.Run("Calls", func(t *testing.T) {
+		calls := c.Calls()
+		require.Len(t, calls, 5)
+		require.Equal(t, 1, calls[2].Params.Count())
+		require.Equal(t, "DELETE FROM series", calls[3].Query)
+		require.NotEmpty(t, calls[3].TxID)
+		require.Empty(t, calls[4].TxID)
+	})
+}
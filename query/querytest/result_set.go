@@ -0,0 +1,101 @@
+package querytest
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	internalTypes "github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type (
+	// Column describes column of result set fixture.
+	// If Type is nil - type of column infers from the first row of result set
+	Column struct {
+		Name string
+		Type types.Type
+	}
+
+	// ResultSet is a fixture of result set
+	ResultSet struct {
+		columns []Column
+		rows    [][]types.Value
+	}
+)
+
+// NewResultSet makes fixture of result set with given columns
+func NewResultSet(columns ...Column) *ResultSet {
+	return &ResultSet{
+		columns: columns,
+	}
+}
+
+// Columns makes columns with given names and types inferred from rows
+func Columns(names ...string) []Column {
+	columns := make([]Column, 0, len(names))
+	for _, name := range names {
+		columns = append(columns, Column{Name: name})
+	}
+
+	return columns
+}
+
+// AddRow appends row with values of columns in order of columns
+func (rs *ResultSet) AddRow(values ...types.Value) *ResultSet {
+	rs.rows = append(rs.rows, values)
+
+	return rs
+}
+
+func (rs *ResultSet) columnTypes() []types.Type {
+	columnTypes := make([]types.Type, len(rs.columns))
+	for i, c := range rs.columns {
+		switch {
+		case c.Type != nil:
+			columnTypes[i] = c.Type
+		case len(rs.rows) > 0 && i < len(rs.rows[0]):
+			columnTypes[i] = rs.rows[0][i].Type()
+		default:
+			columnTypes[i] = types.Void()
+		}
+	}
+
+	return columnTypes
+}
+
+func (rs *ResultSet) materialize(index int) (query.ClosableResultSet, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	var (
+		columnNames = make([]string, len(rs.columns))
+		columnTypes = rs.columnTypes()
+		columns     = make([]*Ydb.Column, len(rs.columns))
+		rows        = make([]query.Row, 0, len(rs.rows))
+	)
+	for i, c := range rs.columns {
+		columnNames[i] = c.Name
+		columns[i] = &Ydb.Column{
+			Name: c.Name,
+			Type: proto.Clone(internalTypes.TypeToYDB(columnTypes[i], a)).(*Ydb.Type), //nolint:forcetypeassert
+		}
+	}
+	for i, values := range rs.rows {
+		if len(values) != len(rs.columns) {
+			return nil, errWrongCountOfValues(index, i, len(values), len(rs.columns))
+		}
+		row := &Ydb.Value{
+			Items: make([]*Ydb.Value, len(values)),
+		}
+		for j, v := range values {
+			row.Items[j] = proto.Clone(value.ToYDB(v, a).GetValue()).(*Ydb.Value) //nolint:forcetypeassert
+		}
+		rows = append(rows, internalQuery.NewRow(columns, row))
+	}
+
+	return internalQuery.MaterializedResultSet(index, columnNames, columnTypes, rows), nil
+}
@@ -0,0 +1,85 @@
+package querytest
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+var (
+	_ query.Session     = (*session)(nil)
+	_ query.Transaction = (*transaction)(nil)
+)
+
+type (
+	session struct {
+		client *Client
+	}
+	transaction struct {
+		tx.LazyID
+
+		client *Client
+	}
+)
+
+func (s *session) ID() string {
+	return "querytest-session"
+}
+
+func (s *session) NodeID() uint32 {
+	return 0
+}
+
+func (s *session) Status() string {
+	return "IDLE"
+}
+
+func (s *session) Exec(ctx context.Context, sql string, opts ...options.Execute) error {
+	return s.client.exec(ctx, "", sql, opts...)
+}
+
+func (s *session) Query(ctx context.Context, sql string, opts ...options.Execute) (query.Result, error) {
+	return s.client.query(ctx, "", sql, opts...)
+}
+
+func (s *session) QueryResultSet(
+	ctx context.Context, sql string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	return s.client.queryResultSet(ctx, "", sql, opts...)
+}
+
+func (s *session) QueryRow(ctx context.Context, sql string, opts ...options.Execute) (query.Row, error) {
+	return s.client.queryRow(ctx, "", sql, opts...)
+}
+
+func (s *session) Begin(ctx context.Context, txSettings query.TransactionSettings) (query.Transaction, error) {
+	return s.client.begin(), nil
+}
+
+func (t *transaction) Exec(ctx context.Context, sql string, opts ...options.Execute) error {
+	return t.client.exec(ctx, t.ID(), sql, opts...)
+}
+
+func (t *transaction) Query(ctx context.Context, sql string, opts ...options.Execute) (query.Result, error) {
+	return t.client.query(ctx, t.ID(), sql, opts...)
+}
+
+func (t *transaction) QueryResultSet(
+	ctx context.Context, sql string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	return t.client.queryResultSet(ctx, t.ID(), sql, opts...)
+}
+
+func (t *transaction) QueryRow(ctx context.Context, sql string, opts ...options.Execute) (query.Row, error) {
+	return t.client.queryRow(ctx, t.ID(), sql, opts...)
+}
+
+func (t *transaction) CommitTx(ctx context.Context) error {
+	return nil
+}
+
+func (t *transaction) Rollback(ctx context.Context) error {
+	return nil
+}
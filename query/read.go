@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ReadRow is a helper which executes query and scans the exactly single row from exactly single result set
+// into struct T with Row.ScanStruct.
+//
+// If c is a Client - query executes with retries (as Client.QueryRow does)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadRow[T any](ctx context.Context, c Executor, sql string, opts ...options.Execute) (dst T, _ error) {
+	row, err := c.QueryRow(ctx, sql, opts...)
+	if err != nil {
+		return dst, xerrors.WithStackTrace(err)
+	}
+
+	if err = row.ScanStruct(&dst); err != nil {
+		return dst, xerrors.WithStackTrace(err)
+	}
+
+	return dst, nil
+}
+
+// ReadAll is a helper which executes query and scans all rows from exactly single result set
+// into slice of structs T with Row.ScanStruct.
+//
+// If c is a Client - query executes with retries (as Client.QueryResultSet does)
+//
+// Warning: the large result set from query will be materialized and can happened to "OOM killed" problem
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadAll[T any](ctx context.Context, c Executor, sql string, opts ...options.Execute) ([]T, error) {
+	rs, err := c.QueryResultSet(ctx, sql, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = rs.Close(ctx)
+	}()
+
+	var rows []T
+	for {
+		row, err := rs.NextRow(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return rows, nil
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		var dst T
+		if err = row.ScanStruct(&dst); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		rows = append(rows, dst)
+	}
+}
@@ -0,0 +1,98 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+type testExecutor struct {
+	query.Executor
+
+	rows []query.Row
+	err  error
+}
+
+func (e *testExecutor) QueryRow(ctx context.Context, sql string, opts ...options.Execute) (query.Row, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	return e.rows[0], nil
+}
+
+func (e *testExecutor) QueryResultSet(
+	ctx context.Context, sql string, opts ...options.Execute,
+) (query.ClosableResultSet, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	return internalQuery.MaterializedResultSet(0, []string{"id", "name"}, nil, e.rows), nil
+}
+
+func testRow(id uint64, name string) query.Row {
+	return internalQuery.NewRow([]*Ydb.Column{
+		{
+			Name: "id",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+		},
+		{
+			Name: "name",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+		},
+	}, &Ydb.Value{
+		Items: []*Ydb.Value{
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: id}},
+			{Value: &Ydb.Value_TextValue{TextValue: name}},
+		},
+	})
+}
+
+type testStruct struct {
+	ID   uint64 `sql:"id"`
+	Name string `sql:"name"`
+}
+
+func TestReadRow(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OK", func(t *testing.T) {
+		v, err := query.ReadRow[testStruct](ctx, &testExecutor{rows: []query.Row{testRow(1, "a")}}, "SELECT 1")
+		require.NoError(t, err)
+		require.Equal(t, testStruct{ID: 1, Name: "a"}, v)
+	})
+	t.Run("Error", func(t *testing.T) {
+		testErr := errors.New("test")
+		_, err := query.ReadRow[testStruct](ctx, &testExecutor{err: testErr}, "SELECT 1")
+		require.ErrorIs(t, err, testErr)
+	})
+}
+
+func TestReadAll(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OK", func(t *testing.T) {
+		v, err := query.ReadAll[testStruct](ctx, &testExecutor{
+			rows: []query.Row{testRow(1, "a"), testRow(2, "b")},
+		}, "SELECT 1")
+		require.NoError(t, err)
+		require.Equal(t, []testStruct{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, v)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		v, err := query.ReadAll[testStruct](ctx, &testExecutor{}, "SELECT 1")
+		require.NoError(t, err)
+		require.Empty(t, v)
+	})
+	t.Run("ScanError", func(t *testing.T) {
+		_, err := query.ReadAll[struct {
+			ID string `sql:"id"`
+		}](ctx, &testExecutor{rows: []query.Row{testRow(1, "a")}}, "SELECT 1")
+		require.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 )
 
 type (
@@ -28,6 +29,14 @@ func WithScanStructAllowMissingColumnsFromSelect() ScanStructOption {
 	return scanner.WithAllowMissingColumnsFromSelect()
 }
 
+// WithScanStructUUIDByteOrder defines byte order of UUID values scanned into [16]byte-compatible
+// struct fields. Use types.UUIDBigEndianByteOrder for uuid.UUID fields from github.com/google/uuid
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithScanStructUUIDByteOrder(order value.UUIDByteOrder) ScanStructOption {
+	return scanner.WithUUIDByteOrder(order)
+}
+
 func WithScanStructAllowMissingFieldsInStruct() ScanStructOption {
 	return scanner.WithAllowMissingFieldsInStruct()
 }
@@ -24,6 +24,11 @@ type (
 		Begin(ctx context.Context, txSettings TransactionSettings) (Transaction, error)
 	}
 	Stats = stats.QueryStats
+
+	// ProgressStats holds statistics of query execution reported with response part
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ProgressStats = stats.ProgressStats
 )
 
 const (
@@ -73,6 +78,61 @@ func WithStatsMode(mode options.StatsMode, callback func(Stats)) options.Execute
 	return options.WithStatsMode(mode, callback)
 }
 
+// WithProgress defines callback which receives affected rows count and phase statistics
+// of every response part of query stream, so long-running statements report progress
+// incrementally. If stats mode is none then stats mode switches to basic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithProgress(callback func(progress ProgressStats)) options.Execute {
+	return options.WithProgress(callback)
+}
+
 func WithCallOptions(opts ...grpc.CallOption) options.Execute {
 	return options.WithCallOptions(opts...)
 }
+
+// WithResponsePartLimitBytes limits size of single response part which client reads from ExecuteQuery stream.
+// On exceeding of limit the stream is cancelled (server stops query execution) and reading of result
+// returns error. Non-positive limit (default) means no limit.
+// Limit is applied on client side only: ExecuteQuery request has no field for limit of response part size, so
+// server isn't aware of limit and the option doesn't reduce traffic from server. The option protects client from
+// buffering of unexpected big response parts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResponsePartLimitBytes(limit int64) options.Execute {
+	return options.WithResponsePartLimitBytes(limit)
+}
+
+// WithMaxResultBytes limits total size of result (sum of sizes of all response parts) which client reads
+// from ExecuteQuery stream. Size of single response part is limited with WithResponsePartLimitBytes only.
+// On exceeding of limit the stream is cancelled (server stops query execution) and reading of result
+// returns error. Non-positive limit (default) means no limit.
+// Like WithResponsePartLimitBytes, limit is applied on client side only and doesn't reduce traffic from server
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxResultBytes(limit int64) options.Execute {
+	return options.WithMaxResultBytes(limit)
+}
+
+// WithStreamBufferParts defines count of response parts which client reads ahead from
+// ExecuteQuery stream in background. Parts are read ahead until buffer is full, so client holds
+// up to parts+1 response parts (the last one is waiting for free place in buffer). With
+// WithResponsePartLimitBytes memory of read ahead parts is bounded as (parts+1)*limit bytes.
+// Non-positive value (default) means reading of parts on demand only
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStreamBufferParts(parts int) options.Execute {
+	return options.WithStreamBufferParts(parts)
+}
+
+// WithResultBufferRows limits count of rows in response parts which client reads ahead from
+// ExecuteQuery stream in background. Next part is read ahead only while buffered parts contain
+// less than given count of rows. Count of rows in part is defined by server, so buffered parts
+// may contain more rows than limit by count of rows of the last read part.
+// Without WithStreamBufferParts count of buffered parts is limited with count of rows too.
+// Non-positive value (default) means no limit of rows
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultBufferRows(rows int) options.Execute {
+	return options.WithResultBufferRows(rows)
+}
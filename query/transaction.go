@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
 	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/tx"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 )
@@ -97,12 +98,28 @@ func WithSerializableReadWrite() TransactionOption {
 	return internal.WithSerializableReadWrite()
 }
 
-func WithSnapshotReadOnly() TransactionOption {
-	return internal.WithSnapshotReadOnly()
+// WithSnapshotReadOnly returns snapshot read-only transaction mode option.
+// It can be used as transaction settings option (see TxSettings, BeginTx) or as execute option of
+// Client.Exec, Client.Query and others (executes query with snapshot read-only transaction control)
+func WithSnapshotReadOnly() options.TxModeOption {
+	return options.WithSnapshotReadOnly()
 }
 
-func WithStaleReadOnly() TransactionOption {
-	return internal.WithStaleReadOnly()
+// WithStaleReadOnly returns stale read-only transaction mode option.
+// It can be used as transaction settings option (see TxSettings, BeginTx) or as execute option of
+// Client.Exec, Client.Query and others (executes query with stale read-only transaction control)
+func WithStaleReadOnly() options.TxModeOption {
+	return options.WithStaleReadOnly()
+}
+
+// WithPreferReplica returns execute option which allows reading of query from followers (read replicas)
+// of tables. YDB routes stale read-only transactions to followers of tables with read replicas settings
+// (see options.WithReadReplicasSettings of table client), so option executes query with stale read-only
+// transaction control. Data may be slightly outdated
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPreferReplica() options.Execute {
+	return options.WithStaleReadOnly()
 }
 
 func WithInconsistentReads() internal.OnlineReadOnlyOption {
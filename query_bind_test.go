@@ -566,6 +566,28 @@ SELECT $param1, $param2`,
 				table.ValueParam("$param2", types.Int32Value(200)),
 			),
 		},
+		{
+			b: testutil.QueryBind(
+				ydb.WithAutoDeclare(),
+				ydb.WithStructArgBinding(),
+			),
+			sql: "SELECT $id, $title",
+			args: []interface{}{
+				struct {
+					ID    uint64 `sql:"id"`
+					Title string `sql:"title"`
+				}{ID: 1, Title: "test"},
+			},
+			yql: `-- bind declares
+DECLARE $id AS Uint64;
+DECLARE $title AS Utf8;
+
+SELECT $id, $title`,
+			params: table.NewQueryParameters(
+				table.ValueParam("$id", types.Uint64Value(1)),
+				table.ValueParam("$title", types.TextValue("test")),
+			),
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			yql, parameters, err := tt.b.RewriteQuery(tt.sql, tt.args...)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/options"
 )
 
@@ -51,6 +52,16 @@ func WithReport() options.AcquireOption {
 	return options.WithReport()
 }
 
+// WithWaitForCapacity makes acquire request blocking until quota is available or ctx is done.
+// Concurrent waiting acquires of the same resource are served in order of arrival.
+// If ctx has deadline - acquire requests to server wait quota up to deadline.
+// Option ignored for report requests (WithReport).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWaitForCapacity() options.AcquireOption {
+	return options.WithWaitForCapacity()
+}
+
 func WithOperationTimeout(operationTimeout time.Duration) options.AcquireOption {
 	return options.WithOperationTimeout(operationTimeout)
 }
@@ -58,3 +69,27 @@ func WithOperationTimeout(operationTimeout time.Duration) options.AcquireOption
 func WithOperationCancelAfter(operationCancelAfter time.Duration) options.AcquireOption {
 	return options.WithOperationCancelAfter(operationCancelAfter)
 }
+
+// WithLocalCache enables client-side cache of quota. Client acquires quota from server in batches
+// (by default batch is 10 times greater than requested amount, see WithLocalCachePrefetchFactor) and
+// serves AcquireResource calls locally until leased amount is exhausted or ttl of the lease is expired.
+// Unused units of expired lease are lost: they are not returned to server and not served locally anymore,
+// so ttl bounds time of holding excess quota.
+// Acquire request which refills the lease waits on server for whole batch, not only for requested amount.
+// Only acquire requests (WithAcquire) are served from cache, report requests (WithReport) always sent to server.
+//
+// Use with ydb.WithRatelimiterOptions(ratelimiter.WithLocalCache(ttl))
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithLocalCache(ttl time.Duration) config.Option {
+	return config.WithLocalCache(ttl)
+}
+
+// WithLocalCachePrefetchFactor defines size of batch of quota which local cache (see WithLocalCache)
+// acquires from server relative to requested amount. Factor is capped to 100, zero factor means
+// default factor (10). Factor 1 disables prefetching: every refill acquires requested amount only
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithLocalCachePrefetchFactor(factor uint64) config.Option {
+	return config.WithLocalCachePrefetchFactor(factor)
+}
@@ -0,0 +1,129 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+var (
+	errReconfigureNoLogger          = xerrors.Wrap(errors.New("ydb: logger not defined with WithLogger option"))
+	errReconfigureNoSlowQuery       = xerrors.Wrap(errors.New("ydb: slow query detector not defined with WithSlowQueryThreshold option")) //nolint:lll
+	errReconfigureNotConnected      = xerrors.Wrap(errors.New("ydb: driver not connected"))
+	errReconfigureNilBalancerConfig = xerrors.Wrap(errors.New("ydb: nil balancer config"))
+)
+
+// ReconfigureOption is an option of Driver.Reconfigure
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ReconfigureOption func(ctx context.Context, d *Driver) error
+
+// reconfigurableDetails is a trace.Detailer which can be changed after log traces were built
+type reconfigurableDetails struct {
+	details atomic.Uint64
+}
+
+func newReconfigurableDetails(details trace.Detailer) *reconfigurableDetails {
+	d := &reconfigurableDetails{}
+	if details != nil {
+		d.details.Store(uint64(details.Details()))
+	}
+
+	return d
+}
+
+func (d *reconfigurableDetails) Details() trace.Details {
+	return trace.Details(d.details.Load())
+}
+
+// ReconfigureLogDetails changes details of logger defined with WithLogger option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReconfigureLogDetails(details trace.Detailer) ReconfigureOption {
+	return func(ctx context.Context, d *Driver) error {
+		if d.reconfigurableDetails == nil {
+			return xerrors.WithStackTrace(errReconfigureNoLogger)
+		}
+		d.reconfigurableDetails.details.Store(uint64(details.Details()))
+
+		return nil
+	}
+}
+
+// ReconfigureSessionPoolSizeLimit changes max size of default sessions pool in table.Client and query.Client.
+// Excess idle sessions are closed on return to the pool
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReconfigureSessionPoolSizeLimit(sizeLimit int) ReconfigureOption {
+	return func(ctx context.Context, d *Driver) error {
+		if d.table == nil || d.query == nil {
+			return xerrors.WithStackTrace(errReconfigureNotConnected)
+		}
+		d.table.Must().SetPoolLimit(sizeLimit)
+		d.query.Must().SetPoolLimit(sizeLimit)
+
+		return nil
+	}
+}
+
+// ReconfigureBalancer changes balancer preferences (e.g. balancers.PreferLocalDC) and re-applies them
+// to the discovered endpoints. Switching to or from balancers.SingleConn isn't supported
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReconfigureBalancer(balancer *balancerConfig.Config) ReconfigureOption {
+	return func(ctx context.Context, d *Driver) error {
+		if balancer == nil {
+			return xerrors.WithStackTrace(errReconfigureNilBalancerConfig)
+		}
+
+		d.mtx.Lock()
+		defer d.mtx.Unlock()
+
+		if d.balancer == nil {
+			return xerrors.WithStackTrace(errReconfigureNotConnected)
+		}
+
+		if err := d.balancer.Reconfigure(ctx, *balancer); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return nil
+	}
+}
+
+// ReconfigureSlowQueryThreshold changes threshold of slow query detector defined with WithSlowQueryThreshold option.
+// Non-positive threshold disables detection
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReconfigureSlowQueryThreshold(threshold time.Duration) ReconfigureOption {
+	return func(ctx context.Context, d *Driver) error {
+		if d.slowQueryDetector == nil {
+			return xerrors.WithStackTrace(errReconfigureNoSlowQuery)
+		}
+		d.slowQueryDetector.SetThreshold(threshold)
+
+		return nil
+	}
+}
+
+// Reconfigure applies safe subset of settings to the open Driver without reconnecting.
+// Options are applied in order, Reconfigure stops on first error.
+// Children drivers made with Driver.With keep their own settings except shared slow query detector
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Reconfigure(ctx context.Context, opts ...ReconfigureOption) error {
+	for _, opt := range opts {
+		if opt != nil {
+			if err := opt(ctx, d); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package ydb
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestDriverReconfigure(t *testing.T) {
+	ctx := context.Background()
+	t.Run("LogDetails", func(t *testing.T) {
+		d, err := newConnectionFromOptions(ctx,
+			WithLogger(log.Default(io.Discard), trace.DriverEvents),
+		)
+		require.NoError(t, err)
+		require.Equal(t, trace.DriverEvents, d.loggerDetails.Details())
+		require.NoError(t, d.Reconfigure(ctx, ReconfigureLogDetails(trace.DetailsAll)))
+		require.Equal(t, trace.DetailsAll, d.loggerDetails.Details())
+	})
+	t.Run("SlowQueryThreshold", func(t *testing.T) {
+		d, err := newConnectionFromOptions(ctx,
+			WithSlowQueryThreshold(time.Second, func(q RecentQuery) {}),
+		)
+		require.NoError(t, err)
+		require.NoError(t, d.Reconfigure(ctx, ReconfigureSlowQueryThreshold(time.Minute)))
+		require.Equal(t, time.Minute, d.slowQueryDetector.Threshold())
+	})
+	t.Run("NotDefined", func(t *testing.T) {
+		d, err := newConnectionFromOptions(ctx)
+		require.NoError(t, err)
+		require.ErrorIs(t, d.Reconfigure(ctx, ReconfigureLogDetails(trace.DetailsAll)), errReconfigureNoLogger)
+		require.ErrorIs(t, d.Reconfigure(ctx, ReconfigureSlowQueryThreshold(time.Minute)), errReconfigureNoSlowQuery)
+		require.ErrorIs(t, d.Reconfigure(ctx, ReconfigureBalancer(nil)), errReconfigureNilBalancerConfig)
+		require.ErrorIs(t, d.Reconfigure(ctx, ReconfigureSessionPoolSizeLimit(10)), errReconfigureNotConnected)
+	})
+}
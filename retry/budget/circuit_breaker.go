@@ -0,0 +1,186 @@
+package budget
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+const (
+	defaultCircuitBreakerFailureThreshold = 10
+	defaultCircuitBreakerOpenTimeout      = 5 * time.Second
+)
+
+type (
+	circuitBreakerState int
+	circuitBreaker      struct {
+		budget Budget
+
+		failureThreshold int
+		openTimeout      time.Duration
+		clock            clockwork.Clock
+
+		mu       xsync.Mutex
+		state    circuitBreakerState
+		failures int
+		openedAt time.Time
+	}
+	circuitBreakerOption func(cb *circuitBreaker)
+)
+
+const (
+	circuitBreakerClosed = circuitBreakerState(iota)
+	circuitBreakerOpen
+	circuitBreakerHalfOpen
+)
+
+var (
+	_ Feedback = (*circuitBreaker)(nil)
+	_ Releaser = (*circuitBreaker)(nil)
+)
+
+// WithFailureThreshold defines count of consecutive failed attempts which opens circuit breaker
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFailureThreshold(failures int) circuitBreakerOption {
+	return func(cb *circuitBreaker) {
+		if failures > 0 {
+			cb.failureThreshold = failures
+		}
+	}
+}
+
+// WithOpenTimeout defines duration of open state of circuit breaker.
+// After timeout circuit breaker allows single probe retry
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOpenTimeout(timeout time.Duration) circuitBreakerOption {
+	return func(cb *circuitBreaker) {
+		if timeout > 0 {
+			cb.openTimeout = timeout
+		}
+	}
+}
+
+func withCircuitBreakerClock(clock clockwork.Clock) circuitBreakerOption {
+	return func(cb *circuitBreaker) {
+		cb.clock = clock
+	}
+}
+
+// CircuitBreaker makes retry budget which sheds retries during sustained outage.
+// Circuit breaker opens after consecutive failed attempts and rejects retries while open.
+// After open timeout circuit breaker allows single probe retry and closes on success of attempt.
+// Retries in closed state acquires quota from budget (if not nil)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func CircuitBreaker(budget Budget, opts ...circuitBreakerOption) *circuitBreaker {
+	cb := &circuitBreaker{
+		budget:           budget,
+		failureThreshold: defaultCircuitBreakerFailureThreshold,
+		openTimeout:      defaultCircuitBreakerOpenTimeout,
+		clock:            clockwork.NewRealClock(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cb)
+		}
+	}
+
+	return cb
+}
+
+// Acquire implements Budget
+func (cb *circuitBreaker) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var probe bool
+	allowed := xsync.WithLock(&cb.mu, func() bool {
+		switch cb.state {
+		case circuitBreakerOpen:
+			if cb.clock.Since(cb.openedAt) < cb.openTimeout {
+				return false
+			}
+			cb.state = circuitBreakerHalfOpen
+			probe = true
+
+			return true
+		case circuitBreakerHalfOpen:
+			// probe retry in progress
+			return false
+		default:
+			return true
+		}
+	})
+	if !allowed {
+		return xerrors.WithStackTrace(ErrCircuitBreakerOpen)
+	}
+
+	if cb.budget != nil {
+		if err := cb.budget.Acquire(ctx); err != nil {
+			if probe {
+				cb.mu.WithLock(func() {
+					cb.state = circuitBreakerOpen
+				})
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Release implements Releaser. Release returns circuit breaker from half-open state to open state
+// if probe retry ends without result (non-retryable error or canceled context), so next retry becomes probe
+func (cb *circuitBreaker) Release() {
+	cb.mu.WithLock(func() {
+		if cb.state == circuitBreakerHalfOpen {
+			cb.state = circuitBreakerOpen
+		}
+	})
+
+	if releaser, has := cb.budget.(Releaser); has {
+		releaser.Release()
+	}
+}
+
+// OnSuccess implements Feedback
+func (cb *circuitBreaker) OnSuccess() {
+	cb.mu.WithLock(func() {
+		cb.state = circuitBreakerClosed
+		cb.failures = 0
+	})
+
+	if feedback, has := cb.budget.(Feedback); has {
+		feedback.OnSuccess()
+	}
+}
+
+// OnFailure implements Feedback
+func (cb *circuitBreaker) OnFailure() {
+	cb.mu.WithLock(func() {
+		cb.failures++
+		if cb.state == circuitBreakerHalfOpen || cb.failures >= cb.failureThreshold {
+			cb.state = circuitBreakerOpen
+			cb.openedAt = cb.clock.Now()
+		}
+	})
+
+	if feedback, has := cb.budget.(Feedback); has {
+		feedback.OnFailure()
+	}
+}
+
+// IsOpen returns true if circuit breaker rejects retries
+func (cb *circuitBreaker) IsOpen() bool {
+	return xsync.WithLock(&cb.mu, func() bool {
+		return cb.state != circuitBreakerClosed
+	})
+}
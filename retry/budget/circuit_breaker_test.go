@@ -0,0 +1,102 @@
+package budget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	ctx := xtest.Context(t)
+	b := TokenBucket(10, 0.5)
+	require.NoError(t, b.Acquire(ctx))
+	for i := 0; i < 5; i++ {
+		b.OnFailure()
+	}
+	require.ErrorIs(t, b.Acquire(ctx), ErrNoQuota)
+	b.OnSuccess()
+	require.NoError(t, b.Acquire(ctx))
+	for i := 0; i < 100; i++ {
+		b.OnSuccess()
+	}
+	require.InDelta(t, 10, b.Tokens(), 0)
+	for i := 0; i < 100; i++ {
+		b.OnFailure()
+	}
+	require.InDelta(t, 0, b.Tokens(), 0)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := clockwork.NewFakeClock()
+	cb := CircuitBreaker(nil,
+		WithFailureThreshold(3),
+		WithOpenTimeout(time.Second),
+		withCircuitBreakerClock(clock),
+	)
+	require.NoError(t, cb.Acquire(ctx))
+	cb.OnFailure()
+	cb.OnFailure()
+	require.False(t, cb.IsOpen())
+	cb.OnFailure()
+	require.True(t, cb.IsOpen())
+	require.ErrorIs(t, cb.Acquire(ctx), ErrCircuitBreakerOpen)
+
+	// probe retry after open timeout
+	clock.Advance(time.Second)
+	require.NoError(t, cb.Acquire(ctx))
+	require.ErrorIs(t, cb.Acquire(ctx), ErrCircuitBreakerOpen)
+
+	// failed probe opens circuit breaker again
+	cb.OnFailure()
+	require.ErrorIs(t, cb.Acquire(ctx), ErrCircuitBreakerOpen)
+
+	clock.Advance(time.Second)
+	require.NoError(t, cb.Acquire(ctx))
+	cb.OnSuccess()
+	require.False(t, cb.IsOpen())
+	require.NoError(t, cb.Acquire(ctx))
+}
+
+func TestCircuitBreakerRelease(t *testing.T) {
+	ctx := xtest.Context(t)
+	clock := clockwork.NewFakeClock()
+	cb := CircuitBreaker(nil,
+		WithFailureThreshold(1),
+		WithOpenTimeout(time.Second),
+		withCircuitBreakerClock(clock),
+	)
+	cb.OnFailure()
+	clock.Advance(time.Second)
+	require.NoError(t, cb.Acquire(ctx))
+	require.ErrorIs(t, cb.Acquire(ctx), ErrCircuitBreakerOpen)
+
+	// probe without result allows next probe
+	cb.Release()
+	require.True(t, cb.IsOpen())
+	require.NoError(t, cb.Acquire(ctx))
+
+	// release in closed state does nothing
+	cb.OnSuccess()
+	cb.Release()
+	require.False(t, cb.IsOpen())
+}
+
+func TestCircuitBreakerWithBudget(t *testing.T) {
+	ctx := xtest.Context(t)
+	b := TokenBucket(2, 1)
+	cb := CircuitBreaker(b, WithFailureThreshold(100))
+	cb.OnFailure()
+	require.ErrorIs(t, cb.Acquire(ctx), ErrNoQuota)
+	cb.OnSuccess()
+	require.NoError(t, cb.Acquire(ctx))
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, cb.Acquire(canceledCtx), context.Canceled)
+}
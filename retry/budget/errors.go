@@ -12,5 +12,10 @@ var (
 	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 	ErrNoQuota = xerrors.Wrap(errors.New("no retry quota"))
 
+	// ErrCircuitBreakerOpen is an error of retry budget with open circuit breaker
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ErrCircuitBreakerOpen = xerrors.Wrap(errors.New("retry circuit breaker is open"))
+
 	errClosedBudget = xerrors.Wrap(errors.New("retry budget closed"))
 )
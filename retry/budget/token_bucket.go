@@ -0,0 +1,92 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+)
+
+type (
+	// Feedback is an optional interface of Budget which receives results of attempts from retryer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Feedback interface {
+		// OnSuccess calls on successful attempt
+		OnSuccess()
+		// OnFailure calls on failed attempt with retryable error
+		OnFailure()
+	}
+	// Releaser is an optional interface of Budget which retryer notifies if quota acquired for attempt
+	// but result of attempt not reported with Feedback (attempt ends with non-retryable error
+	// or context canceled)
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Releaser interface {
+		// Release calls on end of retries if result of last acquired attempt not reported
+		Release()
+	}
+	tokenBucket struct {
+		mu         xsync.Mutex
+		maxTokens  float64
+		tokenRatio float64
+		tokens     float64
+	}
+)
+
+var _ Feedback = (*tokenBucket)(nil)
+
+// TokenBucket makes retry budget which shares between all call sites (as retry throttling in gRPC).
+// Bucket starts with maxTokens tokens. Failed attempt withdraws one token, successful attempt
+// deposits tokenRatio tokens (up to maxTokens). Retries are allowed while bucket has more than
+// maxTokens/2 tokens, so retries stop during sustained outage and resume after recovery
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func TokenBucket(maxTokens, tokenRatio float64) *tokenBucket {
+	if maxTokens <= 0 || tokenRatio <= 0 {
+		panic(fmt.Sprintf("wrong token bucket params: maxTokens=%v, tokenRatio=%v", maxTokens, tokenRatio))
+	}
+
+	return &tokenBucket{
+		maxTokens:  maxTokens,
+		tokenRatio: tokenRatio,
+		tokens:     maxTokens,
+	}
+}
+
+// Acquire implements Budget
+func (b *tokenBucket) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if xsync.WithLock(&b.mu, func() bool {
+		return b.tokens > b.maxTokens/2
+	}) {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(ErrNoQuota)
+}
+
+// OnSuccess implements Feedback
+func (b *tokenBucket) OnSuccess() {
+	b.mu.WithLock(func() {
+		b.tokens = min(b.tokens+b.tokenRatio, b.maxTokens)
+	})
+}
+
+// OnFailure implements Feedback
+func (b *tokenBucket) OnFailure() {
+	b.mu.WithLock(func() {
+		b.tokens = max(b.tokens-1, 0)
+	})
+}
+
+// Tokens returns current count of tokens in bucket
+func (b *tokenBucket) Tokens() float64 {
+	return xsync.WithLock(&b.mu, func() float64 {
+		return b.tokens
+	})
+}
@@ -0,0 +1,130 @@
+package retry
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var _ Option = hedgingOption{}
+
+type hedgingOption struct {
+	delay       time.Duration
+	maxAttempts int
+}
+
+func (o hedgingOption) ApplyRetryOption(opts *retryOptions) {
+	opts.hedgingDelay = o.delay
+	opts.hedgingMaxAttempts = o.maxAttempts
+}
+
+// WithHedging enables hedged attempts of idempotent operation in RetryWithResult which run op concurrently.
+//
+// Warning: hedged attempts run op concurrently, so op must not modify shared state (such as variables
+// captured by closure) and must return result of attempt instead. For this reason Retry ignores hedging, and
+// Do and DoTx don't accept this option.
+//
+// If attempt not completed within delay, retryer launches concurrent attempt on other node (sessions pools
+// prefer sessions on nodes which not used by previous attempts) up to maxAttempts concurrent attempts.
+// First successful attempt wins, other attempts cancels and drains before return, results of losing attempts
+// closes if result implements Close method.
+// Hedged attempts acquires quota from retry budget. Hedging applies to idempotent operations only
+// and reduces tail latency of read-only queries
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithHedging(delay time.Duration, maxAttempts int) hedgingOption {
+	return hedgingOption{
+		delay:       delay,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (opts *retryOptions) hedgingEnabled() bool {
+	return opts.idempotent && opts.hedgingDelay > 0 && opts.hedgingMaxAttempts > 1
+}
+
+type (
+	hedgedResult[T any] struct {
+		v   T
+		err error
+	}
+	ctxCloser interface {
+		Close(ctx context.Context) error
+	}
+)
+
+// closeHedgedResult releases value of losing attempt (such as result of query) if value is closable
+func closeHedgedResult(ctx context.Context, v any) {
+	switch closer := v.(type) {
+	case ctxCloser:
+		_ = closer.Close(ctx)
+	case io.Closer:
+		_ = closer.Close()
+	}
+}
+
+// opWithHedging runs attempt of operation and launches hedged attempts if previous attempts not completed within
+// hedging delay. Hedged attempts prefer nodes which not used by previous attempts (including nodes of sessions).
+// Returns result of first successful attempt or error of last failed attempt. Losing attempts are cancelled
+// and drained before return, successful results of losing attempts are closed.
+// Flag acquired is true if hedged attempts acquired quota from retry budget
+func opWithHedging[T any](ctx context.Context,
+	options *retryOptions, op func(context.Context) (T, error),
+) (zeroValue T, acquired bool, finalErr error) {
+	ctx, cancel := context.WithCancel(endpoint.WithUsedNodes(ctx, endpoint.NewUsedNodes()))
+	defer cancel()
+
+	var (
+		results  = make(chan hedgedResult[T], options.hedgingMaxAttempts)
+		launched = 0
+		received = 0
+		launch   = func() {
+			launched++
+			go func() {
+				v, err := opWithRecover(ctx, options, op)
+				results <- hedgedResult[T]{v: v, err: err}
+			}()
+		}
+		timer = time.NewTimer(options.hedgingDelay)
+	)
+	defer timer.Stop()
+
+	launch()
+
+	for received < launched {
+		select {
+		case <-timer.C:
+			if launched >= options.hedgingMaxAttempts {
+				continue
+			}
+			if err := options.budget.Acquire(ctx); err != nil {
+				// no quota for hedged attempt, waits already launched attempts
+				continue
+			}
+			acquired = true
+			launch()
+			if launched < options.hedgingMaxAttempts {
+				timer.Reset(options.hedgingDelay)
+			}
+		case r := <-results:
+			received++
+			if r.err == nil {
+				cancel()
+				for ; received < launched; received++ {
+					if loser := <-results; loser.err == nil {
+						closeHedgedResult(xcontext.ValueOnly(ctx), loser.v)
+					}
+				}
+
+				return r.v, acquired, nil
+			}
+			finalErr = r.err
+		}
+	}
+
+	return zeroValue, acquired, xerrors.WithStackTrace(finalErr)
+}
@@ -0,0 +1,137 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type testHedgedCloser struct {
+	closed atomic.Bool
+}
+
+func (c *testHedgedCloser) Close(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	c.closed.Store(true)
+
+	return nil
+}
+
+func TestRetryWithHedging(t *testing.T) {
+	t.Run("SlowFirstAttempt", func(t *testing.T) {
+		var (
+			ctx       = xtest.Context(t)
+			attempts  atomic.Int32
+			cancelled = make(chan struct{})
+		)
+		v, err := RetryWithResult(ctx, func(ctx context.Context) (int, error) {
+			require.NotNil(t, endpoint.ContextUsedNodes(ctx))
+			if attempts.Add(1) == 1 {
+				<-ctx.Done()
+				close(cancelled)
+
+				return 0, ctx.Err()
+			}
+
+			return 2, nil
+		}, WithIdempotent(true), WithHedging(time.Millisecond, 2))
+		require.NoError(t, err)
+		require.Equal(t, 2, v)
+		// losing attempt drained before return
+		select {
+		case <-cancelled:
+		default:
+			t.Fatal("losing attempt not drained")
+		}
+		require.EqualValues(t, 2, attempts.Load())
+	})
+	t.Run("LosingResultClosed", func(t *testing.T) {
+		var (
+			ctx      = xtest.Context(t)
+			attempts atomic.Int32
+			first    = &testHedgedCloser{}
+			second   = &testHedgedCloser{}
+			release  = make(chan struct{})
+		)
+		v, err := RetryWithResult(ctx, func(ctx context.Context) (*testHedgedCloser, error) {
+			if attempts.Add(1) == 1 {
+				<-release
+
+				return first, nil
+			}
+			close(release)
+
+			return second, nil
+		}, WithIdempotent(true), WithHedging(time.Millisecond, 2))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, attempts.Load())
+		winner, loser := v, first
+		if v == first {
+			loser = second
+		}
+		require.False(t, winner.closed.Load())
+		require.True(t, loser.closed.Load())
+	})
+	t.Run("FastFirstAttempt", func(t *testing.T) {
+		var (
+			ctx      = xtest.Context(t)
+			attempts atomic.Int32
+		)
+		v, err := RetryWithResult(ctx, func(ctx context.Context) (int, error) {
+			attempts.Add(1)
+
+			return 1, nil
+		}, WithIdempotent(true), WithHedging(time.Hour, 2))
+		require.NoError(t, err)
+		require.Equal(t, 1, v)
+		require.EqualValues(t, 1, attempts.Load())
+	})
+	t.Run("AllAttemptsFailed", func(t *testing.T) {
+		var (
+			ctx      = xtest.Context(t)
+			attempts atomic.Int32
+			testErr  = errors.New("test")
+		)
+		_, err := RetryWithResult(ctx, func(ctx context.Context) (int, error) {
+			attempts.Add(1)
+			time.Sleep(100 * time.Millisecond)
+
+			return 0, testErr
+		}, WithIdempotent(true), WithHedging(time.Millisecond, 3))
+		require.ErrorIs(t, err, testErr)
+		require.EqualValues(t, 3, attempts.Load())
+	})
+	t.Run("NonIdempotent", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		_, err := RetryWithResult(ctx, func(ctx context.Context) (int, error) {
+			require.Nil(t, endpoint.ContextUsedNodes(ctx))
+
+			return 1, nil
+		}, WithHedging(time.Millisecond, 2))
+		require.NoError(t, err)
+	})
+	t.Run("RetryIgnoresHedging", func(t *testing.T) {
+		var (
+			ctx      = xtest.Context(t)
+			attempts int
+		)
+		err := Retry(ctx, func(ctx context.Context) error {
+			require.Nil(t, endpoint.ContextUsedNodes(ctx))
+			attempts++
+			time.Sleep(10 * time.Millisecond)
+
+			return nil
+		}, WithIdempotent(true), WithHedging(time.Millisecond, 2))
+		require.NoError(t, err)
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -1,6 +1,8 @@
 package retry
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
@@ -11,6 +13,9 @@ type retryMode struct {
 	errType            xerrors.Type
 	backoff            backoff.Type
 	isRetryObjectValid bool
+
+	// customBackoff overrides backoff by policy of status code
+	customBackoff backoff.Backoff
 }
 
 func (m retryMode) MustRetry(isOperationIdempotent bool) bool {
@@ -36,3 +41,14 @@ func (m retryMode) BackoffType() backoff.Type { return m.backoff }
 func (m retryMode) MustDeleteSession() bool { return !m.isRetryObjectValid }
 
 func (m retryMode) IsRetryObjectValid() bool { return m.isRetryObjectValid }
+
+func (m retryMode) delay(i int, options *retryOptions) time.Duration {
+	if m.customBackoff != nil {
+		return m.customBackoff.Delay(i)
+	}
+
+	return backoff.Delay(m.BackoffType(), i,
+		backoff.WithFastBackoff(options.fastBackoff),
+		backoff.WithSlowBackoff(options.slowBackoff),
+	)
+}
@@ -0,0 +1,105 @@
+package retry
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Decision overrides decision about retry of operation error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Decision uint8
+
+const (
+	// DecisionDefault keeps builtin decision about retry
+	DecisionDefault = Decision(iota)
+	// DecisionRetry makes error retryable for all operations
+	DecisionRetry
+	// DecisionRetryIdempotent makes error retryable for idempotent operations only
+	DecisionRetryIdempotent
+	// DecisionNoRetry makes error non-retryable
+	DecisionNoRetry
+)
+
+// Policy overrides retry behaviour for operation errors with status code
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Policy struct {
+	// Decision overrides decision about retry. DecisionDefault keeps builtin decision
+	Decision Decision
+	// Backoff overrides builtin backoff. Nil keeps builtin backoff
+	Backoff backoff.Backoff
+}
+
+var _ Option = policyOverrideOption(nil)
+
+type policyOverrideOption map[Ydb.StatusIds_StatusCode]Policy
+
+func (policies policyOverrideOption) ApplyRetryOption(opts *retryOptions) {
+	if len(policies) == 0 {
+		return
+	}
+	if opts.policies == nil {
+		opts.policies = make(map[Ydb.StatusIds_StatusCode]Policy, len(policies))
+	}
+	for code, policy := range policies {
+		opts.policies[code] = policy
+	}
+}
+
+func (policies policyOverrideOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, policies)
+}
+
+func (policies policyOverrideOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, policies)
+}
+
+// WithPolicyOverride overrides builtin decision table of retryer for operation errors with status codes.
+// For example, OVERLOADED errors can be retried with longer backoff or ABORTED errors can be made
+// non-retryable for specific workload. Policies of several options merges, last policy for status code wins
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPolicyOverride(policies map[Ydb.StatusIds_StatusCode]Policy) policyOverrideOption {
+	return policies
+}
+
+// withPolicy applies overridden policy of operation error status code to retry mode
+func (m retryMode) withPolicy(err error, policies map[Ydb.StatusIds_StatusCode]Policy) retryMode {
+	if len(policies) == 0 {
+		return m
+	}
+
+	opErr := xerrors.OperationError(err)
+	if opErr == nil {
+		return m
+	}
+
+	policy, has := policies[Ydb.StatusIds_StatusCode(opErr.Code())]
+	if !has {
+		return m
+	}
+
+	switch policy.Decision {
+	case DecisionRetry:
+		m.errType = xerrors.TypeRetryable
+	case DecisionRetryIdempotent:
+		m.errType = xerrors.TypeConditionallyRetryable
+	case DecisionNoRetry:
+		m.errType = xerrors.TypeNonRetryable
+	}
+
+	if policy.Backoff != nil {
+		m.customBackoff = policy.Backoff
+		m.backoff = backoff.TypeAny
+	} else if policy.Decision == DecisionRetry || policy.Decision == DecisionRetryIdempotent {
+		if m.backoff == backoff.TypeNoBackoff {
+			// errors without builtin backoff retries with fast backoff for prevent of busy loop
+			m.backoff = backoff.TypeFast
+		}
+	}
+
+	return m
+}
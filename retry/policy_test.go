@@ -0,0 +1,78 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+type constBackoff time.Duration
+
+func (b constBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+func TestRetryWithPolicyOverride(t *testing.T) {
+	aborted := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_ABORTED))
+	t.Run("NoRetry", func(t *testing.T) {
+		attempts := 0
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			attempts++
+
+			return aborted
+		}, WithPolicyOverride(map[Ydb.StatusIds_StatusCode]Policy{
+			Ydb.StatusIds_ABORTED: {Decision: DecisionNoRetry},
+		}))
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_ABORTED))
+		require.Equal(t, 1, attempts)
+	})
+	t.Run("Retry", func(t *testing.T) {
+		attempts := 0
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_PRECONDITION_FAILED))
+			}
+
+			return nil
+		}, WithPolicyOverride(map[Ydb.StatusIds_StatusCode]Policy{
+			Ydb.StatusIds_PRECONDITION_FAILED: {Decision: DecisionRetry, Backoff: constBackoff(time.Millisecond)},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+	t.Run("Mode", func(t *testing.T) {
+		policies := map[Ydb.StatusIds_StatusCode]Policy{
+			Ydb.StatusIds_OVERLOADED:          {Backoff: constBackoff(time.Minute)},
+			Ydb.StatusIds_GENERIC_ERROR:       {Decision: DecisionRetryIdempotent},
+			Ydb.StatusIds_SCHEME_ERROR:        {},
+			Ydb.StatusIds_PRECONDITION_FAILED: {Decision: DecisionNoRetry},
+		}
+		opts := &retryOptions{fastBackoff: backoff.Fast, slowBackoff: backoff.Slow}
+
+		overloaded := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+		m := Check(overloaded).withPolicy(overloaded, policies)
+		require.True(t, m.MustRetry(false))
+		require.Equal(t, time.Minute, m.delay(0, opts))
+
+		generic := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_GENERIC_ERROR))
+		m = Check(generic).withPolicy(generic, policies)
+		require.False(t, m.MustRetry(false))
+		require.True(t, m.MustRetry(true))
+		require.True(t, m.MustBackoff())
+
+		scheme := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+		require.Equal(t, Check(scheme), Check(scheme).withPolicy(scheme, policies))
+
+		transport := xerrors.Transport(context.Canceled)
+		require.Equal(t, Check(transport), Check(transport).withPolicy(transport, policies))
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -28,6 +30,11 @@ type retryOptions struct {
 	slowBackoff backoff.Backoff
 	budget      budget.Budget
 
+	hedgingDelay       time.Duration
+	hedgingMaxAttempts int
+
+	policies map[Ydb.StatusIds_StatusCode]Policy
+
 	panicCallback func(e interface{})
 }
 
@@ -241,7 +248,9 @@ func (o panicCallbackOption) ApplyDoTxOption(opts *doTxOptions) {
 }
 
 // WithPanicCallback returns panic callback option
-// If not defined - panic would not intercept with driver
+//
+// Panics in retry operation always recovers and returns as non-retryable error (see ydb.IsPanicError).
+// If panic callback defined - it calls with recovered value before returning of error.
 func WithPanicCallback(panicCallback func(e interface{})) panicCallbackOption {
 	return panicCallbackOption{callback: panicCallback}
 }
@@ -256,6 +265,8 @@ func WithPanicCallback(panicCallback func(e interface{})) panicCallbackOption {
 //
 // Warning: if context without deadline or cancellation func was passed, Retry will work infinitely.
 //
+// Retry ignores WithHedging option because op returns nothing and usually modifies shared state.
+//
 // # If you need to retry your op func on some logic errors - you must return RetryableError() from retryOperation
 func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr error) {
 	_, err := RetryWithResult[*struct{}](ctx, func(ctx context.Context) (*struct{}, error) {
@@ -265,7 +276,7 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 		}
 
 		return nil, nil //nolint:nilnil
-	}, opts...)
+	}, append(opts[:len(opts):len(opts)], hedgingOption{})...)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -329,6 +340,13 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 	defer func() {
 		onDone(attempts, finalErr)
 	}()
+	// acquired is true while quota of budget acquired for attempt which result not reported to budget
+	var acquired bool
+	defer func() {
+		if releaser, has := options.budget.(budget.Releaser); has && acquired {
+			releaser.Release()
+		}
+	}()
 	for {
 		i++
 		attempts++
@@ -340,13 +358,37 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 			))
 
 		default:
-			v, err := opWithRecover(ctx, options, op)
+			var (
+				v   T
+				err error
+			)
+			if options.hedgingEnabled() {
+				var hedged bool
+				v, hedged, err = opWithHedging(ctx, options, op)
+				acquired = acquired || hedged
+			} else {
+				v, err = opWithRecover(ctx, options, op)
+			}
+
+			feedback, hasFeedback := options.budget.(budget.Feedback)
 
 			if err == nil {
+				if hasFeedback {
+					feedback.OnSuccess()
+				}
+				acquired = false
+
 				return v, nil
 			}
 
-			m := Check(err)
+			m := Check(err).withPolicy(err, options.policies)
+
+			if m.MustRetry(options.idempotent) {
+				if hasFeedback {
+					feedback.OnFailure()
+				}
+				acquired = false
+			}
 
 			if m.StatusCode() != code {
 				i = 0
@@ -362,10 +404,7 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 				))
 			}
 
-			t := time.NewTimer(backoff.Delay(m.BackoffType(), i,
-				backoff.WithFastBackoff(options.fastBackoff),
-				backoff.WithSlowBackoff(options.slowBackoff),
-			))
+			t := time.NewTimer(m.delay(i, options))
 
 			select {
 			case <-ctx.Done():
@@ -391,6 +430,7 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 						),
 					)
 				}
+				acquired = true
 			}
 
 			lastErr = err
@@ -401,19 +441,16 @@ func RetryWithResult[T any](ctx context.Context, //nolint:revive,funlen
 func opWithRecover[T any](ctx context.Context,
 	options *retryOptions, op func(context.Context) (T, error),
 ) (_ T, finalErr error) {
-	var zeroValue T
-	if options.panicCallback != nil {
-		defer func() {
-			if e := recover(); e != nil {
-				options.panicCallback(e)
-				finalErr = xerrors.WithStackTrace(
-					fmt.Errorf("panic recovered: %v", e),
-				)
-			}
-		}()
-	}
+	var (
+		zeroValue T
+		v         T
+	)
+
+	err := xerrors.WithRecover(options.panicCallback, func() (err error) {
+		v, err = op(ctx)
 
-	v, err := op(ctx)
+		return err
+	})
 	if err != nil {
 		return zeroValue, xerrors.WithStackTrace(err)
 	}
@@ -13,9 +13,11 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 )
 
 func TestRetryModes(t *testing.T) {
@@ -188,6 +190,49 @@ func TestRetryWithBudget(t *testing.T) {
 	})
 }
 
+func TestRetryWithBudgetFeedback(t *testing.T) {
+	ctx := xtest.Context(t)
+	b := budget.TokenBucket(4, 1)
+	attempts := 0
+	err := Retry(ctx, func(ctx context.Context) (err error) {
+		attempts++
+
+		return RetryableError(errors.New("custom error"))
+	}, WithBudget(b), WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))))
+	require.ErrorIs(t, err, budget.ErrNoQuota)
+	require.Equal(t, 2, attempts)
+
+	err = Retry(ctx, func(ctx context.Context) (err error) {
+		return nil
+	}, WithBudget(b))
+	require.NoError(t, err)
+	require.InDelta(t, 3, b.Tokens(), 0)
+}
+
+func TestRetryWithCircuitBreakerNonRetryableProbe(t *testing.T) {
+	ctx := xtest.Context(t)
+	cb := budget.CircuitBreaker(nil, budget.WithFailureThreshold(1), budget.WithOpenTimeout(time.Nanosecond))
+	fastBackoff := WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond)))
+	errNonRetryable := errors.New("non-retryable error")
+
+	attempts := 0
+	err := Retry(ctx, func(ctx context.Context) (err error) {
+		attempts++
+		if attempts == 1 {
+			return RetryableError(errors.New("custom error"))
+		}
+
+		return errNonRetryable
+	}, WithBudget(cb), fastBackoff)
+	require.ErrorIs(t, err, errNonRetryable)
+	require.Equal(t, 2, attempts)
+
+	// probe without result releases half-open state, so next retry becomes probe
+	require.NoError(t, cb.Acquire(ctx))
+	cb.OnSuccess()
+	require.False(t, cb.IsOpen())
+}
+
 type MockPanicCallback struct {
 	called   bool
 	received interface{}
@@ -230,6 +275,19 @@ func TestOpWithRecover_WithPanic(t *testing.T) {
 	require.Equal(t, "test panic", mockCallback.received)
 }
 
+func TestRetryPanicWithRetryableError(t *testing.T) {
+	ctx := xtest.Context(t)
+	attempts := 0
+	err := Retry(ctx, func(ctx context.Context) (err error) {
+		attempts++
+
+		panic(RetryableError(errors.New("test")))
+	}, WithIdempotent(true))
+	require.Error(t, err)
+	require.Equal(t, 1, attempts)
+	require.True(t, xerrors.IsPanic(err))
+}
+
 func TestRetryWithResult(t *testing.T) {
 	ctx := xtest.Context(t)
 	t.Run("HappyWay", func(t *testing.T) {
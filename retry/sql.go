@@ -199,6 +199,11 @@ func DoTxWithResult[T any](ctx context.Context, db *sql.DB, //nolint:funlen
 		options.retryOptions[0] = WithTrace(d.TraceRetry())
 		options.retryOptions[1] = WithBudget(d.RetryBudget())
 	}
+	if d, has := db.Driver().(interface {
+		TxRetryOptions() []Option
+	}); has {
+		options.retryOptions = append(options.retryOptions, d.TxRetryOptions()...)
+	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyDoTxOption(&options)
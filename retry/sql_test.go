@@ -233,3 +233,51 @@ func TestDoTx(t *testing.T) {
 		})
 	}
 }
+
+type mockConnectorWithTxRetryOptions struct {
+	*mockConnector
+	txRetryOptions []Option
+}
+
+func (m *mockConnectorWithTxRetryOptions) Driver() driver.Driver {
+	return m
+}
+
+func (m *mockConnectorWithTxRetryOptions) TxRetryOptions() []Option {
+	return m.txRetryOptions
+}
+
+func TestDoTxWithDriverTxRetryOptions(t *testing.T) {
+	for i, tt := range errsToCheck {
+		if !tt.canRetry[idempotent] || tt.canRetry[nonIdempotent] {
+			continue
+		}
+		t.Run(strconv.Itoa(i)+"."+tt.err.Error(), func(t *testing.T) {
+			m := &mockConnectorWithTxRetryOptions{
+				mockConnector: &mockConnector{
+					t:        t,
+					queryErr: badconn.Map(tt.err),
+					execErr:  badconn.Map(tt.err),
+				},
+				txRetryOptions: []Option{
+					WithIdempotent(true),
+					WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+					WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+				},
+			}
+			db := sql.OpenDB(m)
+			var attempts int
+			err := DoTx(context.Background(), db, func(ctx context.Context, tx *sql.Tx) error {
+				attempts++
+				if attempts > 1 {
+					return nil
+				}
+				_, err := tx.ExecContext(ctx, "SELECT 1")
+
+				return err
+			})
+			require.NoError(t, err)
+			require.Equal(t, 2, attempts)
+		})
+	}
+}
@@ -63,3 +63,76 @@ func WithChangeOwner(owner string) PermissionsOption {
 		})
 	}
 }
+
+// RecursiveProgress describes progress of recursive operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type RecursiveProgress struct {
+	// Path of processed entry
+	Path string
+	// Type of processed entry
+	Type EntryType
+	// Done is a count of processed entries
+	Done int
+	// Total is a count of entries of the operation
+	Total int
+}
+
+type recursiveDesc interface {
+	SetConcurrency(concurrency int)
+	SetProgress(progress func(RecursiveProgress))
+}
+
+// RecursiveOption is an option for recursive operations
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type RecursiveOption func(recursiveDesc)
+
+// WithRecursiveConcurrency limits count of entries processed in parallel
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRecursiveConcurrency(concurrency int) RecursiveOption {
+	return func(d recursiveDesc) {
+		d.SetConcurrency(concurrency)
+	}
+}
+
+// WithRecursiveProgress sets callback which called after processing of each entry.
+// Calls of callback are serialized.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRecursiveProgress(progress func(RecursiveProgress)) RecursiveOption {
+	return func(d recursiveDesc) {
+		d.SetProgress(progress)
+	}
+}
+
+type walkDesc interface {
+	AppendEntryTypes(types ...EntryType)
+	SetMaxDepth(depth int)
+}
+
+// WalkOption is an option for Walk
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type WalkOption func(walkDesc)
+
+// WithWalkEntryTypes filters entries passed to WalkFunc by type.
+// Nested directories are walked regardless of filter.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWalkEntryTypes(types ...EntryType) WalkOption {
+	return func(d walkDesc) {
+		d.AppendEntryTypes(types...)
+	}
+}
+
+// WithWalkMaxDepth limits depth of walking. Children of root have depth 1.
+// Zero depth means no limit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWalkMaxDepth(depth int) WalkOption {
+	return func(d walkDesc) {
+		d.SetMaxDepth(depth)
+	}
+}
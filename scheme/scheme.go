@@ -2,8 +2,13 @@ package scheme
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/nodeconfig"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 type Client interface {
@@ -14,8 +19,70 @@ type Client interface {
 	ListDirectory(ctx context.Context, path string) (d Directory, err error)
 	RemoveDirectory(ctx context.Context, path string) (err error)
 	ModifyPermissions(ctx context.Context, path string, opts ...PermissionsOption) (err error)
+
+	// RemoveRecursive removes path with all nested directories, tables, topics and coordination nodes.
+	// RemoveRecursive method is equivalent to the bash command `rm -rf path`.
+	// Database root directory and system directory `.sys` are not removed.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RemoveRecursive(ctx context.Context, path string, opts ...RecursiveOption) (err error)
+
+	// CopyRecursive copies src path with all nested directories, tables, topics and coordination nodes
+	// to dst path. Tables are copied with one consistent request, topics and coordination nodes
+	// are created with settings of source entries (messages of topics are not copied).
+	// CopyRecursive method is equivalent to the bash command `cp -r src dst`.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	CopyRecursive(ctx context.Context, src, dst string, opts ...RecursiveOption) (err error)
+
+	// Walk calls f for each entry of tree with root path in depth-first order.
+	// Entries are listed directory by directory, so whole tree is not loaded into memory.
+	// System directory `.sys` is not walked.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Walk(ctx context.Context, root string, f WalkFunc, opts ...WalkOption) (err error)
+
+	// GrantPermissions grants permissions on path to subject
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	GrantPermissions(ctx context.Context, path, subject string, permissionNames ...string) (err error)
+
+	// RevokePermissions revokes permissions on path from subject
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	RevokePermissions(ctx context.Context, path, subject string, permissionNames ...string) (err error)
+
+	// SetOwner changes owner of path
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	SetOwner(ctx context.Context, path, owner string) (err error)
+
+	// DescribePermissions returns owner and access control list of path
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DescribePermissions(ctx context.Context, path string) (d PermissionsDescription, err error)
+
+	// DescribeAny describes path of any kind and returns entry with details of the kind.
+	// Details are requested from service of the kind (table, topic or coordination), so
+	// the client must be created by driver (ydb.Open) for describing of such entries.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DescribeAny(ctx context.Context, path string) (d Description, err error)
 }
 
+// WalkFunc is a callback of Walk with full path of entry.
+// Returning of SkipDir for directory skips walking of the directory,
+// for other entries - skips remaining entries of parent directory.
+// Any other error stops walking and returns from Walk.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type WalkFunc func(path string, e Entry) error
+
+// SkipDir is a special return value of WalkFunc for skipping of directory
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var SkipDir = errors.New("skip this directory") //nolint:revive,stylecheck,errname
+
 type EntryType uint
 
 type Directory struct {
@@ -35,6 +102,8 @@ const (
 	EntryTopic
 	EntryColumnStore
 	EntryColumnTable
+	EntryExternalTable
+	EntryView
 )
 
 func (t EntryType) String() string {
@@ -61,6 +130,10 @@ func (t EntryType) String() string {
 		return "ColumnStore"
 	case EntryColumnTable:
 		return "ColumnTable"
+	case EntryExternalTable:
+		return "ExternalTable"
+	case EntryView:
+		return "View"
 	}
 }
 
@@ -108,6 +181,14 @@ func (e *Entry) IsTopic() bool {
 	return e.Type == EntryTopic
 }
 
+func (e *Entry) IsExternalTable() bool {
+	return e.Type == EntryExternalTable
+}
+
+func (e *Entry) IsView() bool {
+	return e.Type == EntryView
+}
+
 func (e *Entry) From(y *Ydb_Scheme.Entry) {
 	*e = Entry{
 		Name:                 y.GetName(),
@@ -140,6 +221,10 @@ func entryType(t Ydb_Scheme.Entry_Type) EntryType {
 		return EntryColumnStore
 	case Ydb_Scheme.Entry_COLUMN_TABLE:
 		return EntryColumnTable
+	case Ydb_Scheme.Entry_EXTERNAL_TABLE:
+		return EntryExternalTable
+	case Ydb_Scheme.Entry_VIEW:
+		return EntryView
 	default:
 		return EntryTypeUnknown
 	}
@@ -165,6 +250,41 @@ type Permissions struct {
 	PermissionNames []string
 }
 
+// Names of generic permissions
+const (
+	PermissionGenericRead   = "ydb.generic.read"
+	PermissionGenericWrite  = "ydb.generic.write"
+	PermissionGenericList   = "ydb.generic.list"
+	PermissionGenericUse    = "ydb.generic.use"
+	PermissionGenericManage = "ydb.generic.manage"
+	PermissionGenericFull   = "ydb.generic.full"
+)
+
+// PermissionsDescription describes owner and access control list of path
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PermissionsDescription struct {
+	Path  string
+	Owner string
+
+	// Permissions are explicitly granted on path
+	Permissions []Permissions
+
+	// EffectivePermissions are granted on path and inherited from parents
+	EffectivePermissions []Permissions
+}
+
+// SubjectPermissions returns names of effective permissions of subject
+func (d *PermissionsDescription) SubjectPermissions(subject string) (permissionNames []string) {
+	for _, p := range d.EffectivePermissions {
+		if p.Subject == subject {
+			permissionNames = append(permissionNames, p.PermissionNames...)
+		}
+	}
+
+	return permissionNames
+}
+
 func (p Permissions) To(y *Ydb_Scheme.Permissions) {
 	y.Subject = p.Subject
 	y.PermissionNames = p.PermissionNames
@@ -176,3 +296,22 @@ func InnerConvertEntry(y *Ydb_Scheme.Entry) *Entry {
 
 	return res
 }
+
+// Description is a description of scheme entry with details of entry kind.
+// Only one of the detail fields is set according to type of entry:
+//   - Directory for directories and databases
+//   - Table for row and column tables
+//   - Topic for topics
+//   - CoordinationNode for coordination nodes
+//
+// Entries of other kinds (such as external tables and views) are described by Entry only.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Description struct {
+	Entry
+
+	Directory        *Directory
+	Table            *options.Description
+	Topic            *topictypes.TopicDescription
+	CoordinationNode *nodeconfig.NodeConfig
+}
@@ -155,3 +155,31 @@ func Example_explainValidate() {
 		fmt.Printf("Explain failed: %v", err)
 	}
 }
+
+func Example_executeScript() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+	var resumeToken string
+	db.Scripting().ExecuteScript(ctx, `
+		CREATE TABLE series (id Uint64, title Text, PRIMARY KEY (id));
+		UPSERT INTO series (id, title) VALUES (1, "IT Crowd");
+		SELECT COUNT(*) FROM series;
+	`)(func(res *scripting.ScriptResult, err error) bool {
+		if err != nil {
+			fmt.Printf("script failed: %v", err)
+
+			return false
+		}
+		defer res.Result.Close() // cleanup resources
+		resumeToken = res.ResumeToken
+
+		return true
+	})
+	fmt.Printf("resume token of script: %s", resumeToken)
+}
@@ -0,0 +1,55 @@
+package scripting
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+type (
+	// ScriptResult is a result of single statement of multi-statement script
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptResult struct {
+		// Index is an index of statement in script (starts from zero)
+		Index int
+		// Statement is a text of executed statement
+		Statement string
+		// Result is a result of statement. Result is nil if statement failed
+		Result result.Result
+		// ResumeToken is a token for resume of script execution from next statement
+		// after success execution of statement or from this statement after failure
+		ResumeToken string
+	}
+
+	// ScriptSettings is a settings of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptSettings struct {
+		Parameters  *params.Parameters
+		ResumeToken string
+	}
+
+	// ScriptOption is an option of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptOption func(s *ScriptSettings)
+)
+
+// WithScriptParameters defines parameters of script statements
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithScriptParameters(parameters *params.Parameters) ScriptOption {
+	return func(s *ScriptSettings) {
+		s.Parameters = parameters
+	}
+}
+
+// WithResumeToken resumes execution of script from statement pointed by resume token
+// from ScriptResult of previous execution of the same script
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResumeToken(token string) ScriptOption {
+	return func(s *ScriptSettings) {
+		s.ResumeToken = token
+	}
+}
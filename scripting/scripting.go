@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 )
@@ -34,4 +35,17 @@ type Client interface {
 		query string,
 		params *params.Parameters,
 	) (result.StreamResult, error)
+
+	// ExecuteScript splits multi-statement script (e.g. YQL migration) to statements and executes
+	// statements one by one. DECLARE, PRAGMA and other statements without results are prepended
+	// to each executed statement.
+	// Returned iterator yields results of statements or error of failed statement.
+	// Execution of long script can be resumed with ScriptResult.ResumeToken (see WithResumeToken)
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ExecuteScript(
+		ctx context.Context,
+		script string,
+		opts ...ScriptOption,
+	) xiter.Seq2[*ScriptResult, error]
 }
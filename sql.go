@@ -10,8 +10,11 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -102,6 +105,23 @@ func WithTxControl(ctx context.Context, txc *table.TransactionControl) context.C
 	return xsql.WithTxControl(ctx, txc)
 }
 
+// WithQueryStats returns a copy of context with target of query stats.
+// Stats of data and scan queries stores to target after execution of query
+// (for queries with rows - after close of rows)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryStats(ctx context.Context, target *stats.QueryStats) context.Context {
+	return xsql.WithQueryStats(ctx, target)
+}
+
+// WithExplain returns a copy of context for explain of query instead of execute.
+// Rows of explained query contains single row with columns AST and Plan
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithExplain(ctx context.Context) context.Context {
+	return xsql.WithQueryMode(ctx, ExplainQueryMode)
+}
+
 type ConnectorOption = xsql.ConnectorOption
 
 type QueryBindConnectorOption interface {
@@ -113,6 +133,36 @@ func WithDefaultQueryMode(mode QueryMode) ConnectorOption {
 	return xsql.WithDefaultQueryMode(mode)
 }
 
+type valueScanner struct {
+	dst types.ValueUnmarshaler
+}
+
+func (s valueScanner) Scan(src any) error {
+	v, err := bind.ToValue(src)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return s.dst.UnmarshalYDBValue(v)
+}
+
+// ScanValue wraps dst into sql.Scanner for use as destination of database/sql rows scanning.
+// Scanned value is converted into YDB value and passed to dst.UnmarshalYDBValue
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ScanValue(dst types.ValueUnmarshaler) sql.Scanner {
+	return valueScanner{dst: dst}
+}
+
+// WithUUIDByteOrder defines byte order of UUID query args and UUID values of rows.
+// With non-native byte order UUID values scans as []byte, so uuid types which implement sql.Scanner
+// (such as uuid.UUID from github.com/google/uuid with types.UUIDBigEndianByteOrder) are supported
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUUIDByteOrder(order types.UUIDByteOrder) ConnectorOption {
+	return xsql.WithUUIDByteOrder(order)
+}
+
 func WithFakeTx(mode QueryMode) ConnectorOption {
 	return xsql.WithFakeTx(mode)
 }
@@ -133,6 +183,24 @@ func WithNumericArgs() QueryBindConnectorOption {
 	return xsql.WithQueryBind(bind.NumericArgs{})
 }
 
+// WithStructArgBinding expands query args which are Go structs (or pointers to structs) to named
+// query parameters. Names of parameters defines by `sql` tag (name of Go field by default).
+// Use with WithAutoDeclare for automatic declaration of parameters
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStructArgBinding() QueryBindConnectorOption {
+	return xsql.WithQueryBind(bind.StructArgs{})
+}
+
+// WithTxRetryOptions defines default retry options (budget, idempotence, etc.) of transactions
+// which retries with retry.DoTx and retry.DoTxWithResult over sql.DB with this connector.
+// Options of call of retry.DoTx applies after connector defaults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTxRetryOptions(opts ...retry.Option) ConnectorOption {
+	return xsql.WithTxRetryOptions(opts...)
+}
+
 func WithDefaultTxControl(txControl *table.TransactionControl) ConnectorOption {
 	return xsql.WithDefaultTxControl(txControl)
 }
@@ -0,0 +1,480 @@
+// Package backup provides dump of tables to local directory and restore of tables from dump.
+// Dump of each table is a directory with scheme.pb (text protobuf of Ydb.Table.CreateTableRequest)
+// and data_NN.csv files as in layout of `ydb tools dump`. Values of CSV are quoted (and may contain line breaks),
+// NULL values writes as unquoted null.
+// Restore parses CSV data on client side and uploads typed rows with BulkUpsert
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package backup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+)
+
+const (
+	schemeFileName   = "scheme.pb"
+	dataFilePrefix   = "data_"
+	dataFileSuffix   = ".csv"
+	dirPermissions   = 0o755
+	filePermissions  = 0o644
+	dataFileNameSize = 2
+)
+
+var errNoTables = xerrors.Wrap(errors.New("no tables for dump"))
+
+type db interface {
+	Name() string
+	Table() table.Client
+}
+
+// Dump dumps scheme and data of tables to directory. Paths of tables may be absolute
+// or relative to database root. Data of each table reads with single ordered ReadTable stream
+// (dump of table repeats from scratch on retryable errors), so data of table is consistent
+// only if table is not changed during dump
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Dump(ctx context.Context, db db, dir string, tablePaths []string, opts ...Option) error {
+	if len(tablePaths) == 0 {
+		return xerrors.WithStackTrace(errNoTables)
+	}
+
+	o := newOptions(opts...)
+	throttler := newThrottler(o.bytesPerSecond)
+
+	for _, tablePath := range tablePaths {
+		absPath, relPath := resolvePath(db.Name(), tablePath)
+		tableDir := filepath.Join(dir, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(tableDir, dirPermissions); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			desc, err := s.DescribeTable(ctx, absPath)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			if err = writeScheme(tableDir, desc); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return dumpData(ctx, s, absPath, tableDir, desc, o, throttler)
+		}, table.WithIdempotent())
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("dump of table %q failed: %w", absPath, err))
+		}
+	}
+
+	return nil
+}
+
+// Restore creates tables from dump in directory and uploads data of tables with BulkUpsert.
+// Tables creates in database root with paths relative to directory of dump
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Restore(ctx context.Context, db db, dir string, opts ...Option) error {
+	o := newOptions(opts...)
+	throttler := newThrottler(o.bytesPerSecond)
+
+	var tableDirs []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && d.Name() == schemeFileName {
+			tableDirs = append(tableDirs, filepath.Dir(p))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	for _, tableDir := range tableDirs {
+		relPath, err := filepath.Rel(dir, tableDir)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		absPath, _ := resolvePath(db.Name(), filepath.ToSlash(relPath))
+
+		if err := restoreTable(ctx, db, absPath, tableDir, o, throttler); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("restore of table %q failed: %w", absPath, err))
+		}
+	}
+
+	return nil
+}
+
+// resolvePath returns absolute path of table and path relative to database root
+func resolvePath(database, tablePath string) (absPath, relPath string) {
+	if strings.HasPrefix(tablePath, "/") {
+		return tablePath, strings.TrimPrefix(strings.TrimPrefix(tablePath, database), "/")
+	}
+
+	return path.Join(database, tablePath), tablePath
+}
+
+func writeScheme(tableDir string, desc options.Description) error {
+	a := allocator.New()
+	defer a.Free()
+
+	request := &Ydb_Table.CreateTableRequest{
+		PrimaryKey: desc.PrimaryKey,
+	}
+	for _, column := range desc.Columns {
+		request.Columns = append(request.Columns, &Ydb_Table.ColumnMeta{
+			Name:   column.Name,
+			Type:   types.TypeToYDB(column.Type, a),
+			Family: column.Family,
+		})
+	}
+	for _, index := range desc.Indexes {
+		tableIndex := &Ydb_Table.TableIndex{
+			Name:         index.Name,
+			IndexColumns: index.IndexColumns,
+			DataColumns:  index.DataColumns,
+		}
+		if index.Type == options.IndexTypeGlobalAsync {
+			tableIndex.Type = &Ydb_Table.TableIndex_GlobalAsyncIndex{
+				GlobalAsyncIndex: &Ydb_Table.GlobalAsyncIndex{},
+			}
+		} else {
+			tableIndex.Type = &Ydb_Table.TableIndex_GlobalIndex{
+				GlobalIndex: &Ydb_Table.GlobalIndex{},
+			}
+		}
+		request.Indexes = append(request.Indexes, tableIndex)
+	}
+
+	data, err := prototext.MarshalOptions{Multiline: true}.Marshal(request)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(os.WriteFile(filepath.Join(tableDir, schemeFileName), data, filePermissions))
+}
+
+func readScheme(tableDir string) (opts []options.CreateTableOption, columnTypes map[string]types.Type, _ error) {
+	data, err := os.ReadFile(filepath.Join(tableDir, schemeFileName))
+	if err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	var request Ydb_Table.CreateTableRequest
+	if err = prototext.Unmarshal(data, &request); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+
+	columnTypes = make(map[string]types.Type, len(request.GetColumns()))
+	for _, column := range request.GetColumns() {
+		t := types.TypeFromYDB(column.GetType())
+		columnTypes[column.GetName()] = t
+		opts = append(opts, options.WithColumn(column.GetName(), t))
+	}
+	opts = append(opts, options.WithPrimaryKeyColumn(request.GetPrimaryKey()...))
+	for _, index := range request.GetIndexes() {
+		indexType := options.GlobalIndex()
+		if index.GetGlobalAsyncIndex() != nil {
+			indexType = options.GlobalAsyncIndex()
+		}
+		opts = append(opts, options.WithIndex(index.GetName(),
+			options.WithIndexColumns(index.GetIndexColumns()...),
+			options.WithDataColumns(index.GetDataColumns()...),
+			options.WithIndexType(indexType),
+		))
+	}
+
+	return opts, columnTypes, nil
+}
+
+func dumpData(
+	ctx context.Context, s table.Session, tablePath, tableDir string, desc options.Description,
+	o backupOptions, throttler *throttler,
+) error {
+	columns := make([]string, len(desc.Columns))
+	for i, column := range desc.Columns {
+		columns[i] = column.Name
+	}
+
+	// data files of previous attempt removes for dump from scratch
+	staleFiles, err := filepath.Glob(filepath.Join(tableDir, dataFilePrefix+"*"+dataFileSuffix))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	for _, staleFile := range staleFiles {
+		if err = os.Remove(staleFile); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	res, err := s.StreamReadTable(ctx, tablePath, options.ReadOrdered(), options.ReadColumns(columns...))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = res.Close()
+	}()
+
+	w := &dataWriter{
+		dir:         tableDir,
+		header:      csvLine(columns, nil),
+		maxFileSize: o.maxFileSize,
+		progress: func(bytes int) {
+			if o.progress != nil {
+				o.progress(tablePath, bytes)
+			}
+		},
+	}
+
+	var (
+		values = make([]value.Value, len(columns))
+		dst    = make([]indexed.RequiredOrOptional, len(columns))
+		texts  = make([]string, len(columns))
+		nulls  = make([]bool, len(columns))
+	)
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	for res.NextResultSet(ctx) {
+		for res.NextRow() {
+			if err = res.Scan(dst...); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			for i := range values {
+				texts[i], nulls[i], err = value.Text(values[i])
+				if err != nil {
+					return xerrors.WithStackTrace(fmt.Errorf("column %q: %w", columns[i], err))
+				}
+			}
+			line := csvLine(texts, nulls)
+			if err = throttler.wait(ctx, len(line)); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err = w.write(line); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+	if err = res.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return xerrors.WithStackTrace(w.flush())
+}
+
+// csvLine makes line of CSV with quoted values. NULL values writes as unquoted null
+func csvLine(values []string, nulls []bool) []byte {
+	var b bytes.Buffer
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if nulls != nil && nulls[i] {
+			b.WriteString(defaultNullValue)
+
+			continue
+		}
+		b.WriteByte('"')
+		b.WriteString(strings.ReplaceAll(v, `"`, `""`))
+		b.WriteByte('"')
+	}
+	b.WriteByte('\n')
+
+	return b.Bytes()
+}
+
+// dataWriter writes lines of CSV to data files with size up to maxFileSize
+type dataWriter struct {
+	dir         string
+	header      []byte
+	maxFileSize int
+	progress    func(bytes int)
+
+	buf   bytes.Buffer
+	files int
+	total int
+}
+
+func (w *dataWriter) write(line []byte) error {
+	if w.buf.Len() > 0 && w.buf.Len()+len(line) > w.maxFileSize {
+		if err := w.flush(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	if w.buf.Len() == 0 {
+		w.buf.Write(w.header)
+	}
+	w.buf.Write(line)
+
+	return nil
+}
+
+func (w *dataWriter) flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+
+	fileName := fmt.Sprintf("%s%0*d%s", dataFilePrefix, dataFileNameSize, w.files, dataFileSuffix)
+	if err := os.WriteFile(filepath.Join(w.dir, fileName), w.buf.Bytes(), filePermissions); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	w.files++
+	w.total += w.buf.Len()
+	w.buf.Reset()
+	w.progress(w.total)
+
+	return nil
+}
+
+func restoreTable(ctx context.Context, db db, tablePath, tableDir string, o backupOptions, throttler *throttler) error {
+	createOptions, columnTypes, err := readScheme(tableDir)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		return s.CreateTable(ctx, tablePath, createOptions...)
+	}, table.WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	dataFiles, err := filepath.Glob(filepath.Join(tableDir, dataFilePrefix+"*"+dataFileSuffix))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	sort.Strings(dataFiles)
+
+	total := 0
+	for _, dataFile := range dataFiles {
+		err = readDataFile(dataFile, columnTypes, o.maxBatchSize, func(rows value.Value, size int) error {
+			if err := throttler.wait(ctx, size); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return db.Table().BulkUpsert(ctx, tablePath, table.BulkUpsertDataRows(rows))
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("upload of %q failed: %w", filepath.Base(dataFile), err))
+		}
+		if info, err := os.Stat(dataFile); err == nil {
+			total += int(info.Size())
+		}
+		if o.progress != nil {
+			o.progress(tablePath, total)
+		}
+	}
+
+	return nil
+}
+
+// readDataFile reads rows of data file and calls upload with batches of rows which have size of CSV data
+// up to maxBatchSize
+func readDataFile(
+	dataFile string, columnTypes map[string]types.Type, maxBatchSize int,
+	upload func(rows value.Value, size int) error,
+) error {
+	f, err := os.Open(dataFile)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer f.Close()
+
+	return readData(f, columnTypes, maxBatchSize, upload)
+}
+
+func readData(
+	r io.Reader, columnTypes map[string]types.Type, maxBatchSize int,
+	upload func(rows value.Value, size int) error,
+) error {
+	csv := newCsvReader(r)
+	columns, _, _, err := csv.read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return xerrors.WithStackTrace(err)
+	}
+	for _, column := range columns {
+		if _, has := columnTypes[column]; !has {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: unknown column %q", errBadCsv, column))
+		}
+	}
+
+	var (
+		rows      []value.Value
+		batchSize int
+	)
+	flush := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := upload(value.ListValue(rows...), batchSize); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		rows, batchSize = nil, 0
+
+		return nil
+	}
+	for {
+		texts, nulls, size, err := csv.read()
+		if errors.Is(err, io.EOF) {
+			return flush()
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if len(texts) != len(columns) {
+			return csv.errorf("expected %d values, got %d", len(columns), len(texts))
+		}
+		fields := make([]value.StructValueField, len(columns))
+		for i, column := range columns {
+			v, err := fromText(columnTypes[column], texts[i], nulls[i])
+			if err != nil {
+				return csv.errorf("column %q: %v", column, err)
+			}
+			fields[i] = value.StructValueField{Name: column, V: v}
+		}
+		if len(rows) > 0 && batchSize+size > maxBatchSize {
+			if err = flush(); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+		rows = append(rows, value.StructValue(fields...))
+		batchSize += size
+	}
+}
+
+func fromText(t types.Type, text string, isNull bool) (value.Value, error) {
+	if !isNull {
+		return value.FromText(t, text)
+	}
+	if optional, ok := t.(types.Optional); ok {
+		return value.NullValue(optional.InnerType()), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("null value of not optional type %s", t.Yql()))
+}
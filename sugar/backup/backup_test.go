@@ -0,0 +1,182 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestResolvePath(t *testing.T) {
+	absPath, relPath := resolvePath("/local", "dir/series")
+	require.Equal(t, "/local/dir/series", absPath)
+	require.Equal(t, "dir/series", relPath)
+
+	absPath, relPath = resolvePath("/local", "/local/dir/series")
+	require.Equal(t, "/local/dir/series", absPath)
+	require.Equal(t, "dir/series", relPath)
+}
+
+func TestCsvLine(t *testing.T) {
+	require.Equal(t, "\"1\",null,\"a \"\"quoted\"\", text\"\n",
+		string(csvLine([]string{"1", "", `a "quoted", text`}, []bool{false, true, false})),
+	)
+}
+
+func TestDataWriter(t *testing.T) {
+	dir := t.TempDir()
+	var progress []int
+	w := &dataWriter{
+		dir:         dir,
+		header:      []byte("id\n"),
+		maxFileSize: 10,
+		progress: func(bytes int) {
+			progress = append(progress, bytes)
+		},
+	}
+	for _, line := range []string{"1\n", "2\n", "3\n", "4\n", "5\n"} {
+		require.NoError(t, w.write([]byte(line)))
+	}
+	require.NoError(t, w.flush())
+
+	files, err := filepath.Glob(filepath.Join(dir, "data_*.csv"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	data, err := os.ReadFile(filepath.Join(dir, "data_00.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "id\n1\n2\n3\n", string(data))
+
+	data, err = os.ReadFile(filepath.Join(dir, "data_01.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "id\n4\n5\n", string(data))
+	require.Equal(t, []int{9, 16}, progress)
+}
+
+func TestScheme(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, writeScheme(dir, options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "title", Type: types.Optional(types.TypeText)},
+		},
+		PrimaryKey: []string{"id"},
+		Indexes: []options.IndexDescription{
+			{Name: "title_index", IndexColumns: []string{"title"}, Type: options.IndexTypeGlobalAsync},
+		},
+	}))
+
+	data, err := os.ReadFile(filepath.Join(dir, schemeFileName))
+	require.NoError(t, err)
+
+	var request Ydb_Table.CreateTableRequest
+	require.NoError(t, prototext.Unmarshal(data, &request))
+	require.Len(t, request.GetColumns(), 2)
+	require.Equal(t, "title", request.GetColumns()[1].GetName())
+	require.NotNil(t, request.GetColumns()[1].GetType().GetOptionalType())
+	require.Equal(t, []string{"id"}, request.GetPrimaryKey())
+	require.NotNil(t, request.GetIndexes()[0].GetGlobalAsyncIndex())
+
+	opts, columnTypes, err := readScheme(dir)
+	require.NoError(t, err)
+	require.Len(t, opts, 4)
+	require.Equal(t, types.Optional(types.TypeText), columnTypes["title"])
+}
+
+func TestDataRoundTrip(t *testing.T) {
+	columnTypes := map[string]types.Type{
+		"id":      types.TypeUint64,
+		"title":   types.Optional(types.TypeText),
+		"payload": types.Optional(types.TypeJSONDocument),
+		"data":    types.TypeBytes,
+	}
+	columns := []string{"id", "title", "payload", "data"}
+	rows := [][]value.Value{
+		{
+			value.Uint64Value(1),
+			value.OptionalValue(value.TextValue("multi\nline,\r\n\"quoted\" text")),
+			value.OptionalValue(value.JSONDocumentValue("{\n  \"a\": \"b\\nc\"\n}")),
+			value.BytesValue([]byte("\n")),
+		},
+		{
+			value.Uint64Value(2),
+			value.OptionalValue(value.TextValue("null")),
+			value.NullValue(types.TypeJSONDocument),
+			value.BytesValue(nil),
+		},
+		{
+			value.Uint64Value(3),
+			value.NullValue(types.TypeText),
+			value.NullValue(types.TypeJSONDocument),
+			value.BytesValue([]byte("\"\"")),
+		},
+	}
+
+	var data bytes.Buffer
+	data.Write(csvLine(columns, nil))
+	for _, row := range rows {
+		texts := make([]string, len(row))
+		nulls := make([]bool, len(row))
+		for i := range row {
+			var err error
+			texts[i], nulls[i], err = value.Text(row[i])
+			require.NoError(t, err)
+		}
+		data.Write(csvLine(texts, nulls))
+	}
+
+	var batches []string
+	require.NoError(t, readData(&data, columnTypes, 1, func(rows value.Value, size int) error {
+		batches = append(batches, rows.Yql())
+
+		return nil
+	}))
+
+	expected := make([]string, len(rows))
+	for i, row := range rows {
+		fields := make([]value.StructValueField, len(row))
+		for j := range row {
+			fields[j] = value.StructValueField{Name: columns[j], V: row[j]}
+		}
+		expected[i] = value.ListValue(value.StructValue(fields...)).Yql()
+	}
+	require.Equal(t, expected, batches)
+}
+
+func TestReadDataErrors(t *testing.T) {
+	columnTypes := map[string]types.Type{"id": types.TypeUint64}
+	upload := func(rows value.Value, size int) error { return nil }
+	for _, data := range []string{
+		"\"id\"\n\"1",
+		"\"id\"\nnull\n",
+		"\"id\"\n1\n",
+		"\"other\"\n\"1\"\n",
+		"\"id\"\n\"1\",\"2\"\n",
+	} {
+		require.Error(t, readData(strings.NewReader(data), columnTypes, 100, upload), data)
+	}
+}
+
+func TestThrottler(t *testing.T) {
+	throttler := newThrottler(1000)
+	start := time.Now()
+	require.NoError(t, throttler.wait(context.Background(), 50))
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, throttler.wait(ctx, 1000), context.Canceled)
+
+	require.NoError(t, newThrottler(0).wait(ctx, 1000))
+}
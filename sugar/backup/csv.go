@@ -0,0 +1,111 @@
+package backup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errBadCsv = errors.New("bad csv")
+
+// csvReader reads lines of CSV written by csvLine. Quoted values may contain line breaks,
+// unquoted null means NULL value
+type csvReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+func newCsvReader(r io.Reader) *csvReader {
+	return &csvReader{r: bufio.NewReader(r)}
+}
+
+// read returns values of next line of CSV and count of bytes of line. Returns io.EOF after the last line
+func (r *csvReader) read() (values []string, nulls []bool, size int, _ error) {
+	r.line++
+	for {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(values) > 0 {
+				return nil, nil, 0, r.errorf("unexpected end of data")
+			}
+
+			return nil, nil, 0, xerrors.WithStackTrace(err)
+		}
+		size++
+
+		var (
+			v    strings.Builder
+			null bool
+		)
+		if b == '"' {
+			n, err := r.readQuoted(&v)
+			size += n
+			if err != nil {
+				return nil, nil, 0, xerrors.WithStackTrace(err)
+			}
+			if b, err = r.r.ReadByte(); err != nil && !errors.Is(err, io.EOF) {
+				return nil, nil, 0, xerrors.WithStackTrace(err)
+			}
+			if err == nil {
+				size++
+			} else {
+				b = '\n'
+			}
+		} else {
+			for b != ',' && b != '\n' {
+				v.WriteByte(b)
+				if b, err = r.r.ReadByte(); err != nil {
+					if !errors.Is(err, io.EOF) {
+						return nil, nil, 0, xerrors.WithStackTrace(err)
+					}
+					b = '\n'
+				} else {
+					size++
+				}
+			}
+			if v.String() != defaultNullValue {
+				return nil, nil, 0, r.errorf("unquoted value %q", v.String())
+			}
+			v.Reset()
+			null = true
+		}
+		values = append(values, v.String())
+		nulls = append(nulls, null)
+
+		switch b {
+		case ',':
+		case '\n':
+			return values, nulls, size, nil
+		default:
+			return nil, nil, 0, r.errorf("unexpected %q after quoted value", b)
+		}
+	}
+}
+
+// readQuoted reads quoted value after opening quote up to closing quote inclusive
+func (r *csvReader) readQuoted(v *strings.Builder) (size int, _ error) {
+	for {
+		s, err := r.r.ReadString('"')
+		size += len(s)
+		if err != nil {
+			return size, r.errorf("unterminated quoted value")
+		}
+		v.WriteString(s[:len(s)-1])
+
+		next, err := r.r.Peek(1)
+		if err != nil || next[0] != '"' {
+			return size, nil
+		}
+		_, _ = r.r.ReadByte()
+		size++
+		v.WriteByte('"')
+	}
+}
+
+func (r *csvReader) errorf(format string, args ...interface{}) error {
+	return xerrors.WithStackTrace(fmt.Errorf("%w: line %d: %s", errBadCsv, r.line, fmt.Sprintf(format, args...)))
+}
@@ -0,0 +1,108 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	defaultMaxFileSize = 100 << 20 // 100MB
+	defaultNullValue   = "null"
+)
+
+type (
+	backupOptions struct {
+		maxFileSize    int
+		maxBatchSize   int
+		bytesPerSecond int
+		progress       func(tablePath string, bytes int)
+	}
+
+	// Option is an option of Dump and Restore
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Option func(o *backupOptions)
+)
+
+// WithMaxFileSize defines max size of data file of table dump (100MB by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxFileSize(size int) Option {
+	return func(o *backupOptions) {
+		if size > 0 {
+			o.maxFileSize = size
+		}
+	}
+}
+
+// WithBytesPerSecond throttles reading of tables data on dump and writing of tables data on restore
+// (bytes of CSV data per second). Zero means no throttling
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBytesPerSecond(bytesPerSecond int) Option {
+	return func(o *backupOptions) {
+		o.bytesPerSecond = bytesPerSecond
+	}
+}
+
+// WithProgress defines callback which calls after each dumped or restored data file
+// with total count of bytes of table data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithProgress(progress func(tablePath string, bytes int)) Option {
+	return func(o *backupOptions) {
+		o.progress = progress
+	}
+}
+
+func newOptions(opts ...Option) backupOptions {
+	o := backupOptions{
+		maxFileSize: defaultMaxFileSize,
+		// size of CSV data of rows is less than size of bulk upsert request with the rows,
+		// so requests split to chunks by BulkUpsert
+		maxBatchSize: options.DefaultBulkUpsertChunkSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	return o
+}
+
+// throttler limits rate of processed bytes
+type throttler struct {
+	bytesPerSecond int
+	start          time.Time
+	bytes          int
+}
+
+func newThrottler(bytesPerSecond int) *throttler {
+	return &throttler{
+		bytesPerSecond: bytesPerSecond,
+		start:          time.Now(),
+	}
+}
+
+// wait accounts n processed bytes and waits while rate of processed bytes exceeds limit
+func (t *throttler) wait(ctx context.Context, n int) error {
+	if t.bytesPerSecond <= 0 {
+		return nil
+	}
+	t.bytes += n
+	expected := time.Duration(float64(t.bytes) / float64(t.bytesPerSecond) * float64(time.Second))
+	delay := expected - time.Since(t.start)
+	if delay <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
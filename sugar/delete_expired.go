@@ -0,0 +1,32 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const deleteExpiredBeforeParameter = "$deleteExpiredBefore"
+
+func deleteExpiredPredicate(column string) string {
+	return fmt.Sprintf("`%s` < %s", column, deleteExpiredBeforeParameter)
+}
+
+// DeleteExpired deletes rows of table which have been marked as deleted (soft-deleted) earlier than ttl ago.
+// The column must be a timestamp of the soft deletion, rows with NULL in the column are not deleted.
+// DeleteExpired deletes rows in bounded batches with DeleteWhere and returns total count of deleted rows.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DeleteExpired(
+	ctx context.Context, db dbForDeleteWhere, tablePath string, column string, ttl time.Duration,
+	opts ...DeleteWhereOption,
+) (deleted uint64, _ error) {
+	return DeleteWhere(ctx, db, tablePath, deleteExpiredPredicate(column), append([]DeleteWhereOption{
+		WithDeleteParameters(
+			table.ValueParam(deleteExpiredBeforeParameter, types.TimestampValueFromTime(time.Now().Add(-ttl))),
+		),
+	}, opts...)...)
+}
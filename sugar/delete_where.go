@@ -0,0 +1,143 @@
+package sugar
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const defaultDeleteBatchSize = 1000
+
+type (
+	dbForDeleteWhere interface {
+		dbName
+		dbTable
+	}
+	deleteWhereOptions struct {
+		batchSize  uint64
+		parameters []*params.Parameter
+		progress   func(deleted uint64)
+	}
+	// DeleteWhereOption is an option of DeleteWhere
+	DeleteWhereOption func(o *deleteWhereOptions)
+)
+
+// WithBatchSize defines max count of rows which deletes in single transaction
+func WithBatchSize(batchSize uint64) DeleteWhereOption {
+	return func(o *deleteWhereOptions) {
+		if batchSize > 0 {
+			o.batchSize = batchSize
+		}
+	}
+}
+
+// WithDeleteParameters defines parameters of predicate (parameters will be declared automatically)
+func WithDeleteParameters(parameters ...table.ParameterOption) DeleteWhereOption {
+	return func(o *deleteWhereOptions) {
+		o.parameters = append(o.parameters, *table.NewQueryParameters(parameters...)...)
+	}
+}
+
+// WithDeleteProgress defines callback which calls after each deleted batch with total count of deleted rows
+func WithDeleteProgress(progress func(deleted uint64)) DeleteWhereOption {
+	return func(o *deleteWhereOptions) {
+		o.progress = progress
+	}
+}
+
+func deleteWhereQuery(tablePath string, primaryKey []string, predicate string, parameters []*params.Parameter) string {
+	var b strings.Builder
+	b.WriteString(parametersToDeclares(parameters))
+	b.WriteString("DECLARE $batchSize AS Uint64;\n\n")
+	fmt.Fprintf(&b, "$toDelete = (SELECT `%s` FROM `%s` WHERE %s ORDER BY `%s` LIMIT $batchSize);\n\n",
+		strings.Join(primaryKey, "`, `"), tablePath, predicate, strings.Join(primaryKey, "`, `"),
+	)
+	b.WriteString("SELECT COUNT(*) AS deleted FROM $toDelete;\n")
+	fmt.Fprintf(&b, "DELETE FROM `%s` ON SELECT * FROM $toDelete;\n", tablePath)
+
+	return b.String()
+}
+
+// DeleteWhere deletes rows of table which matches predicate (YQL expression for WHERE clause)
+// in bounded batches. Each batch deletes in separated transaction with retries, so DeleteWhere
+// is not atomic: on error some batches may be already deleted.
+// DeleteWhere returns total count of deleted rows.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DeleteWhere(
+	ctx context.Context, db dbForDeleteWhere, tablePath string, predicate string, opts ...DeleteWhereOption,
+) (deleted uint64, _ error) {
+	options := deleteWhereOptions{
+		batchSize: defaultDeleteBatchSize,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	if !strings.HasPrefix(tablePath, "/") {
+		tablePath = path.Join(db.Name(), tablePath)
+	}
+
+	var primaryKey []string
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+		description, err := s.DescribeTable(ctx, tablePath)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		primaryKey = description.PrimaryKey
+
+		return nil
+	}, table.WithIdempotent())
+	if err != nil {
+		return deleted, xerrors.WithStackTrace(fmt.Errorf("cannot describe table %q: %w", tablePath, err))
+	}
+
+	var (
+		q          = deleteWhereQuery(tablePath, primaryKey, predicate, options.parameters)
+		parameters = append(params.Parameters{params.Named("$batchSize", types.Uint64Value(options.batchSize))},
+			options.parameters...,
+		)
+	)
+	for {
+		var batch uint64
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			_, res, err := s.Execute(ctx, table.DefaultTxControl(), q, &parameters)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			defer func() {
+				_ = res.Close()
+			}()
+
+			if err = res.NextResultSetErr(ctx); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if !res.NextRow() {
+				return xerrors.WithStackTrace(fmt.Errorf("no rows in result of %q", q))
+			}
+
+			return res.ScanNamed(named.Required("deleted", &batch))
+		}, table.WithIdempotent())
+		if err != nil {
+			return deleted, xerrors.WithStackTrace(err)
+		}
+
+		deleted += batch
+		if options.progress != nil {
+			options.progress(deleted)
+		}
+
+		if batch < options.batchSize {
+			return deleted, nil
+		}
+	}
+}
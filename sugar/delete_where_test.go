@@ -0,0 +1,28 @@
+package sugar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestDeleteWhereQuery(t *testing.T) {
+	require.Equal(t, "DECLARE $ts AS Timestamp;\n"+
+		"DECLARE $batchSize AS Uint64;\n"+
+		"\n"+
+		"$toDelete = (SELECT `id`, `ts` FROM `/local/events` WHERE ts < $ts ORDER BY `id`, `ts` LIMIT $batchSize);\n"+
+		"\n"+
+		"SELECT COUNT(*) AS deleted FROM $toDelete;\n"+
+		"DELETE FROM `/local/events` ON SELECT * FROM $toDelete;\n",
+		deleteWhereQuery("/local/events", []string{"id", "ts"}, "ts < $ts", []*params.Parameter{
+			params.Named("$ts", types.TimestampValue(0)),
+		}),
+	)
+}
+
+func TestDeleteExpiredPredicate(t *testing.T) {
+	require.Equal(t, "`deleted_at` < $deleteExpiredBefore", deleteExpiredPredicate("deleted_at"))
+}
@@ -0,0 +1,171 @@
+package sugar
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+// ResultToCSV reads all result sets of query result and writes its rows into w in CSV format.
+// Every result set starts with header row with names of columns. Values formats as:
+//   - NULL as empty string
+//   - Decimal in exact decimal notation
+//   - Date as 2006-01-02, Datetime and Timestamp in RFC3339 format in UTC
+//   - Uuid in canonical form
+//   - containers (List, Struct, Dict, etc.) as JSON text
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ResultToCSV(w io.Writer, r query.Result) error {
+	csvWriter := csv.NewWriter(w)
+
+	err := encodeResult(r,
+		func(columns []string) error {
+			return csvWriter.Write(columns)
+		},
+		func(columns []string, values []value.Value) error {
+			record := make([]string, len(values))
+			for i := range values {
+				s, err := csvField(value.JSONCompatible(values[i]))
+				if err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+				record[i] = s
+			}
+
+			return csvWriter.Write(record)
+		},
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	csvWriter.Flush()
+
+	return xerrors.WithStackTrace(csvWriter.Error())
+}
+
+// ResultToJSONLines reads all result sets of query result and writes its rows into w as JSON objects
+// (one object per line) with keys in order of columns. Values encodes as:
+//   - NULL as null
+//   - Decimal, Interval and Uuid as strings
+//   - Date as "2006-01-02", Datetime and Timestamp as RFC3339 strings in UTC
+//   - Json and JsonDocument as embedded JSON
+//   - List, Set and Tuple as arrays, Struct and Dict as objects
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ResultToJSONLines(w io.Writer, r query.Result) error {
+	var buf bytes.Buffer
+
+	err := encodeResult(r, nil, func(columns []string, values []value.Value) error {
+		buf.Reset()
+		buf.WriteByte('{')
+		for i := range values {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, err := json.Marshal(columns[i])
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			v, err := json.Marshal(value.JSONCompatible(values[i]))
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			buf.Write(k)
+			buf.WriteByte(':')
+			buf.Write(v)
+		}
+		buf.WriteString("}\n")
+
+		_, err := w.Write(buf.Bytes())
+
+		return err
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func encodeResult(
+	r query.Result,
+	onResultSet func(columns []string) error,
+	onRow func(columns []string, values []value.Value) error,
+) error {
+	ctx := context.Background()
+	for {
+		rs, err := r.NextResultSet(ctx)
+		if err != nil {
+			if xerrors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return xerrors.WithStackTrace(err)
+		}
+
+		columns := rs.Columns()
+		if onResultSet != nil {
+			if err = onResultSet(columns); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+
+		values := make([]value.Value, len(columns))
+		dst := make([]interface{}, len(columns))
+		for i := range values {
+			dst[i] = &values[i]
+		}
+
+		for {
+			row, err := rs.NextRow(ctx)
+			if err != nil {
+				if xerrors.Is(err, io.EOF) {
+					break
+				}
+
+				return xerrors.WithStackTrace(err)
+			}
+			if err = row.Scan(dst...); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			if err = onRow(columns, values); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+}
+
+func csvField(v any) (string, error) {
+	switch vv := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return vv, nil
+	case bool:
+		return strconv.FormatBool(vv), nil
+	case float32:
+		return strconv.FormatFloat(float64(vv), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(vv, 'g', -1, 64), nil
+	case time.Time:
+		return vv.Format(time.RFC3339Nano), nil
+	case json.RawMessage:
+		return string(vv), nil
+	default:
+		b, err := json.Marshal(vv)
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+
+		return string(b), nil
+	}
+}
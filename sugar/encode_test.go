@@ -0,0 +1,84 @@
+package sugar_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/sugar"
+)
+
+func newEncodeTestResult() query.Result {
+	columns := []*Ydb.Column{
+		{
+			Name: "id",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+		},
+		{
+			Name: "name",
+			Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+				Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+			}}},
+		},
+		{
+			Name: "price",
+			Type: &Ydb.Type{Type: &Ydb.Type_DecimalType{DecimalType: &Ydb.DecimalType{Precision: 22, Scale: 9}}},
+		},
+		{
+			Name: "ts",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_TIMESTAMP}},
+		},
+		{
+			Name: "data",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON}},
+		},
+	}
+	row := func(id uint64, name *string) query.Row {
+		nameValue := &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+		if name != nil {
+			nameValue = &Ydb.Value{Value: &Ydb.Value_TextValue{TextValue: *name}}
+		}
+
+		return internalQuery.NewRow(columns, &Ydb.Value{
+			Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: id}},
+				nameValue,
+				{Value: &Ydb.Value_Low_128{Low_128: 1500000000}},
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: 1500000}},
+				{Value: &Ydb.Value_TextValue{TextValue: `{"x":1}`}},
+			},
+		})
+	}
+	name := "a,b"
+
+	return internalQuery.MaterializedResult(
+		internalQuery.MaterializedResultSet(0, []string{"id", "name", "price", "ts", "data"}, nil,
+			[]query.Row{row(1, &name), row(2, nil)},
+		),
+	)
+}
+
+func TestResultToCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sugar.ResultToCSV(&buf, newEncodeTestResult()))
+	require.Equal(t, ""+
+		"id,name,price,ts,data\n"+
+		"1,\"a,b\",1.500000000,1970-01-01T00:00:01.5Z,\"{\"\"x\"\":1}\"\n"+
+		"2,,1.500000000,1970-01-01T00:00:01.5Z,\"{\"\"x\"\":1}\"\n",
+		buf.String(),
+	)
+}
+
+func TestResultToJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, sugar.ResultToJSONLines(&buf, newEncodeTestResult()))
+	require.Equal(t, ""+
+		`{"id":1,"name":"a,b","price":"1.500000000","ts":"1970-01-01T00:00:01.5Z","data":{"x":1}}`+"\n"+
+		`{"id":2,"name":null,"price":"1.500000000","ts":"1970-01-01T00:00:01.5Z","data":{"x":1}}`+"\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,194 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/pagination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+// ErrInvalidPageToken indicates that page token is malformed or belongs to another listing
+var ErrInvalidPageToken = pagination.ErrInvalidToken
+
+// DirectoryPage is a page of directory listing
+type DirectoryPage struct {
+	// Entries are directory children ordered by name
+	Entries []scheme.Entry
+
+	// NextPageToken is a token of the next page. Empty NextPageToken means that listing is over
+	NextPageToken string
+}
+
+// ListDirectoryPage returns page of directory children ordered by name.
+// Empty pageToken means the first page. The returned NextPageToken is a serializable string which
+// stays valid between requests (it is not bound to the client or process), so it can be passed
+// to HTTP clients of paged admin endpoints as is.
+// Non-positive pageSize means all remaining children.
+func ListDirectoryPage(ctx context.Context, c scheme.Client, path string, pageToken string, pageSize int) (
+	page DirectoryPage, _ error,
+) {
+	var after string
+	if pageToken != "" {
+		token, err := pagination.Decode(pageToken, path)
+		if err != nil {
+			return page, xerrors.WithStackTrace(err)
+		}
+		after = token.After
+	}
+
+	d, err := c.ListDirectory(ctx, path)
+	if err != nil {
+		return page, xerrors.WithStackTrace(err)
+	}
+
+	children := d.Children
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+
+	start := sort.Search(len(children), func(i int) bool {
+		return children[i].Name > after
+	})
+	children = children[start:]
+
+	if pageSize > 0 && len(children) > pageSize {
+		children = children[:pageSize]
+		page.NextPageToken = pagination.Token{
+			Scope: path,
+			After: children[len(children)-1].Name,
+		}.Encode()
+	}
+
+	page.Entries = children
+
+	return page, nil
+}
+
+// TreeEntry is an entry of scheme tree with full path of entry
+type TreeEntry struct {
+	scheme.Entry
+
+	// Path is a full path of entry
+	Path string
+}
+
+// TreePage is a page of walking of scheme tree
+type TreePage struct {
+	// Entries are entries of tree in depth-first order with children of directories ordered by name
+	Entries []TreeEntry
+
+	// NextPageToken is a token of the next page. Empty NextPageToken means that walking is over
+	NextPageToken string
+}
+
+var errPageIsFull = errors.New("page is full")
+
+// WalkPage returns page of entries of tree with root path in depth-first order with children of
+// directories ordered by name. System directory `.sys` is not walked.
+// Empty pageToken means the first page. The returned NextPageToken is a serializable string which
+// stays valid between requests, directories which entirely precede the token are not listed again.
+// Non-positive pageSize means all remaining entries.
+func WalkPage(ctx context.Context, c scheme.Client, root string, pageToken string, pageSize int) (
+	page TreePage, _ error,
+) {
+	root = path.Clean(root)
+
+	var after []string
+	if pageToken != "" {
+		token, err := pagination.Decode(pageToken, root)
+		if err != nil {
+			return page, xerrors.WithStackTrace(err)
+		}
+		after = strings.Split(token.After, "/")
+	}
+
+	var hasMore bool
+	err := walkPage(ctx, c, root, nil, after, func(e TreeEntry) error {
+		if pageSize > 0 && len(page.Entries) == pageSize {
+			hasMore = true
+
+			return errPageIsFull
+		}
+		page.Entries = append(page.Entries, e)
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageIsFull) {
+		return TreePage{}, xerrors.WithStackTrace(err)
+	}
+
+	if hasMore {
+		last := page.Entries[len(page.Entries)-1]
+		page.NextPageToken = pagination.Token{
+			Scope: root,
+			After: strings.TrimPrefix(strings.TrimPrefix(last.Path, root), "/"),
+		}.Encode()
+	}
+
+	return page, nil
+}
+
+// walkPage walks directory with path relative to root (as path elements) and calls f for entries after given
+// position. Order of depth-first walking with sorted children is an order of lists of path elements
+func walkPage(ctx context.Context, c scheme.Client, root string, dir, after []string,
+	f func(e TreeEntry) error,
+) error {
+	dirPath := path.Join(append([]string{root}, dir...)...)
+	d, err := c.ListDirectory(ctx, dirPath)
+	if err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("failed to list directory %q: %w", dirPath, err))
+	}
+
+	children := d.Children
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+
+	for i := range children {
+		child := children[i]
+		if child.Name == ".sys" {
+			continue
+		}
+
+		elems := append(append(make([]string, 0, len(dir)+1), dir...), child.Name)
+		cmp := comparePathElems(elems, after)
+		if cmp > 0 {
+			if err := f(TreeEntry{Entry: child, Path: path.Join(dirPath, child.Name)}); err != nil {
+				return err
+			}
+		}
+
+		// subtree of directory precedes position if directory precedes position and isn't a parent of it
+		if child.IsDirectory() && (cmp > 0 || isPathPrefix(elems, after)) {
+			if err := walkPage(ctx, c, root, elems, after, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func comparePathElems(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+func isPathPrefix(prefix, elems []string) bool {
+	return len(prefix) <= len(elems) && comparePathElems(prefix, elems[:len(prefix)]) == 0
+}
@@ -0,0 +1,138 @@
+package sugar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+type testSchemeClient struct {
+	scheme.Client
+
+	children []scheme.Entry
+}
+
+func (c testSchemeClient) ListDirectory(ctx context.Context, path string) (scheme.Directory, error) {
+	return scheme.Directory{
+		Entry: scheme.Entry{
+			Name: path,
+			Type: scheme.EntryDirectory,
+		},
+		Children: append([]scheme.Entry(nil), c.children...),
+	}, nil
+}
+
+func TestListDirectoryPage(t *testing.T) {
+	ctx := context.Background()
+	c := testSchemeClient{
+		children: []scheme.Entry{
+			{Name: "e"}, {Name: "a"}, {Name: "d"}, {Name: "b"}, {Name: "c"},
+		},
+	}
+	var (
+		names []string
+		token string
+	)
+	for {
+		page, err := ListDirectoryPage(ctx, c, "/local/dir", token, 2)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Entries), 2)
+		for _, e := range page.Entries {
+			names = append(names, e.Name)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, names)
+
+	t.Run("AllEntries", func(t *testing.T) {
+		page, err := ListDirectoryPage(ctx, c, "/local/dir", "", 0)
+		require.NoError(t, err)
+		require.Len(t, page.Entries, 5)
+		require.Empty(t, page.NextPageToken)
+	})
+	t.Run("InvalidToken", func(t *testing.T) {
+		_, err := ListDirectoryPage(ctx, c, "/local/dir", "invalid token", 2)
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+	t.Run("TokenOfAnotherPath", func(t *testing.T) {
+		page, err := ListDirectoryPage(ctx, c, "/local/dir", "", 2)
+		require.NoError(t, err)
+		_, err = ListDirectoryPage(ctx, c, "/local/other", page.NextPageToken, 2)
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+type testSchemeTreeClient struct {
+	scheme.Client
+
+	tree map[string][]scheme.Entry
+}
+
+func (c testSchemeTreeClient) ListDirectory(ctx context.Context, path string) (scheme.Directory, error) {
+	return scheme.Directory{
+		Entry: scheme.Entry{
+			Name: path,
+			Type: scheme.EntryDirectory,
+		},
+		Children: append([]scheme.Entry(nil), c.tree[path]...),
+	}, nil
+}
+
+func TestWalkPage(t *testing.T) {
+	ctx := context.Background()
+	c := testSchemeTreeClient{tree: map[string][]scheme.Entry{
+		"/local": {
+			{Name: "b", Type: scheme.EntryDirectory},
+			{Name: ".sys", Type: scheme.EntryDirectory},
+			{Name: "a", Type: scheme.EntryTable},
+			{Name: "c", Type: scheme.EntryTopic},
+		},
+		"/local/b": {
+			{Name: "y", Type: scheme.EntryTable},
+			{Name: "x", Type: scheme.EntryDirectory},
+		},
+		"/local/b/x": {
+			{Name: "t", Type: scheme.EntryTable},
+		},
+	}}
+	expected := []string{"/local/a", "/local/b", "/local/b/x", "/local/b/x/t", "/local/b/y", "/local/c"}
+
+	for _, pageSize := range []int{1, 2, 4} {
+		var (
+			paths []string
+			token string
+		)
+		for {
+			page, err := WalkPage(ctx, c, "/local", token, pageSize)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page.Entries), pageSize)
+			for _, e := range page.Entries {
+				paths = append(paths, e.Path)
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			token = page.NextPageToken
+		}
+		require.Equal(t, expected, paths, pageSize)
+	}
+
+	t.Run("AllEntries", func(t *testing.T) {
+		page, err := WalkPage(ctx, c, "/local/", "", 0)
+		require.NoError(t, err)
+		require.Len(t, page.Entries, len(expected))
+		require.Empty(t, page.NextPageToken)
+	})
+	t.Run("TokenOfAnotherRoot", func(t *testing.T) {
+		page, err := WalkPage(ctx, c, "/local", "", 2)
+		require.NoError(t, err)
+		_, err = WalkPage(ctx, c, "/local/b", page.NextPageToken, 2)
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
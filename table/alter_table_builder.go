@@ -0,0 +1,201 @@
+package table
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/metadata"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// DefaultIndexBuildProgressInterval is a default interval of polling of index build progress
+const DefaultIndexBuildProgressInterval = time.Second
+
+// AlterTableBuilder is a fluent builder of table schema changes.
+// All changes applies with single AlterTable request.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type AlterTableBuilder struct {
+	path    string
+	opts    []options.AlterTableOption
+	indexes []string
+
+	progressInterval time.Duration
+	progress         func(index string, progress float32)
+	listIndexBuilds  func(ctx context.Context) ([]*metadata.BuildIndex, error)
+}
+
+// NewAlterTableBuilder makes builder of changes of table with path
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewAlterTableBuilder(path string) *AlterTableBuilder {
+	return &AlterTableBuilder{
+		path:             path,
+		progressInterval: DefaultIndexBuildProgressInterval,
+	}
+}
+
+// AddColumn adds column with name and type
+func (b *AlterTableBuilder) AddColumn(name string, typ types.Type) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithAddColumn(name, typ))
+
+	return b
+}
+
+// DropColumn drops column with name
+func (b *AlterTableBuilder) DropColumn(name string) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithDropColumn(name))
+
+	return b
+}
+
+// SetTimeToLive sets TTL settings of table
+func (b *AlterTableBuilder) SetTimeToLive(settings options.TimeToLiveSettings) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithSetTimeToLiveSettings(settings))
+
+	return b
+}
+
+// DropTimeToLive drops TTL settings of table
+func (b *AlterTableBuilder) DropTimeToLive() *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithDropTimeToLive())
+
+	return b
+}
+
+// SetPartitioning sets partitioning policy of table
+func (b *AlterTableBuilder) SetPartitioning(settings options.PartitioningSettings) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithAlterPartitionSettingsObject(settings))
+
+	return b
+}
+
+// SetAttribute sets (adds or changes) attribute of table
+func (b *AlterTableBuilder) SetAttribute(key, value string) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithAlterAttribute(key, value))
+
+	return b
+}
+
+// DropAttribute drops attribute of table
+func (b *AlterTableBuilder) DropAttribute(key string) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithDropAttribute(key))
+
+	return b
+}
+
+// AddIndex adds secondary index. Index builds online on server side,
+// progress of build can be watched with WithIndexBuildProgress
+func (b *AlterTableBuilder) AddIndex(name string, opts ...options.IndexOption) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithAddIndex(name, opts...))
+	b.indexes = append(b.indexes, name)
+
+	return b
+}
+
+// DropIndex drops secondary index
+func (b *AlterTableBuilder) DropIndex(name string) *AlterTableBuilder {
+	b.opts = append(b.opts, options.WithDropIndex(name))
+
+	return b
+}
+
+// WithIndexBuildProgress sets callback of progress (in percents) of building of added indexes.
+// Progress polls from build index operations with interval (DefaultIndexBuildProgressInterval if zero)
+func (b *AlterTableBuilder) WithIndexBuildProgress(
+	operations *operation.Client, interval time.Duration, progress func(index string, progress float32),
+) *AlterTableBuilder {
+	if interval > 0 {
+		b.progressInterval = interval
+	}
+	b.progress = progress
+	b.listIndexBuilds = func(ctx context.Context) ([]*metadata.BuildIndex, error) {
+		list, err := operations.ListBuildIndex(ctx)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		builds := make([]*metadata.BuildIndex, 0, len(list.Operations))
+		for _, op := range list.Operations {
+			if !op.Ready && op.Metadata != nil {
+				builds = append(builds, op.Metadata)
+			}
+		}
+
+		return builds, nil
+	}
+
+	return b
+}
+
+// Options returns alter table options of builder
+func (b *AlterTableBuilder) Options() []options.AlterTableOption {
+	return b.opts
+}
+
+// Apply applies changes of table within session
+func (b *AlterTableBuilder) Apply(ctx context.Context, s Session) error {
+	if b.progress == nil || len(b.indexes) == 0 {
+		return xerrors.WithStackTrace(s.AlterTable(ctx, b.path, b.opts...))
+	}
+
+	pollCtx, stopPolling := context.WithCancel(ctx)
+	pollDone := make(chan struct{})
+	go func() {
+		defer close(pollDone)
+		b.pollIndexBuildProgress(pollCtx)
+	}()
+
+	err := s.AlterTable(ctx, b.path, b.opts...)
+	stopPolling()
+	<-pollDone
+
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	for _, index := range b.indexes {
+		b.progress(index, 100)
+	}
+
+	return nil
+}
+
+// Execute applies changes of table with session from client
+func (b *AlterTableBuilder) Execute(ctx context.Context, c Client, opts ...Option) error {
+	return xerrors.WithStackTrace(c.Do(ctx, func(ctx context.Context, s Session) error {
+		return b.Apply(ctx, s)
+	}, opts...))
+}
+
+func (b *AlterTableBuilder) pollIndexBuildProgress(ctx context.Context) {
+	ticker := time.NewTicker(b.progressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		builds, err := b.listIndexBuilds(ctx)
+		if err != nil {
+			// progress is informational, errors of polling skips until next tick
+			continue
+		}
+		for _, build := range builds {
+			if build.Path != b.path {
+				continue
+			}
+			for _, index := range b.indexes {
+				if build.Index == index {
+					b.progress(index, build.Progress)
+				}
+			}
+		}
+	}
+}
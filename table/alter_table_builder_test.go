@@ -0,0 +1,92 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/metadata"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type alterTableSessionStub struct {
+	Session
+
+	alter func(ctx context.Context, request *Ydb_Table.AlterTableRequest) error
+}
+
+func (s *alterTableSessionStub) AlterTable(ctx context.Context, path string, opts ...options.AlterTableOption) error {
+	a := allocator.New()
+	defer a.Free()
+
+	request := &Ydb_Table.AlterTableRequest{Path: path}
+	for _, opt := range opts {
+		opt.ApplyAlterTableOption((*options.AlterTableDesc)(request), a)
+	}
+
+	return s.alter(ctx, request)
+}
+
+func TestAlterTableBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Options", func(t *testing.T) {
+		b := NewAlterTableBuilder("/db/table").
+			AddColumn("new", types.Optional(types.TypeUint64)).
+			DropColumn("old").
+			DropTimeToLive().
+			SetAttribute("key", "value").
+			AddIndex("idx", options.WithIndexColumns("new"))
+
+		err := b.Apply(ctx, &alterTableSessionStub{alter: func(ctx context.Context, request *Ydb_Table.AlterTableRequest) error {
+			require.Equal(t, "/db/table", request.GetPath())
+			require.Len(t, request.GetAddColumns(), 1)
+			require.Equal(t, "new", request.GetAddColumns()[0].GetName())
+			require.Equal(t, []string{"old"}, request.GetDropColumns())
+			require.IsType(t, &Ydb_Table.AlterTableRequest_DropTtlSettings{}, request.GetTtlAction())
+			require.Equal(t, map[string]string{"key": "value"}, request.GetAlterAttributes())
+			require.Len(t, request.GetAddIndexes(), 1)
+			require.Equal(t, []string{"new"}, request.GetAddIndexes()[0].GetIndexColumns())
+
+			return nil
+		}})
+		require.NoError(t, err)
+	})
+
+	t.Run("IndexBuildProgress", func(t *testing.T) {
+		b := NewAlterTableBuilder("/db/table").AddIndex("idx", options.WithIndexColumns("a"))
+
+		var progress []float32
+		b.WithIndexBuildProgress(nil, time.Millisecond, func(index string, p float32) {
+			require.Equal(t, "idx", index)
+			progress = append(progress, p)
+		})
+		polled := make(chan struct{})
+		b.listIndexBuilds = func(ctx context.Context) ([]*metadata.BuildIndex, error) {
+			select {
+			case polled <- struct{}{}:
+			default:
+			}
+
+			return []*metadata.BuildIndex{
+				{Path: "/db/other", Index: "idx", Progress: 10},
+				{Path: "/db/table", Index: "idx", Progress: 42},
+			}, nil
+		}
+
+		err := b.Apply(ctx, &alterTableSessionStub{alter: func(ctx context.Context, request *Ydb_Table.AlterTableRequest) error {
+			<-polled
+
+			return nil
+		}})
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(progress), 2)
+		require.Equal(t, float32(42), progress[0])
+		require.Equal(t, float32(100), progress[len(progress)-1])
+	})
+}
@@ -0,0 +1,301 @@
+package table
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errNoRows = errors.New("no rows for bulk upsert")
+
+// BulkUpsertData is a payload of Client.BulkUpsert
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type BulkUpsertData interface {
+	// ToYDB makes bulk upsert requests with payload split to chunks with size up to chunkSize bytes.
+	// Used internally only.
+	ToYDB(a *allocator.Allocator, tableName string, chunkSize int) ([]*Ydb_Table.BulkUpsertRequest, error)
+}
+
+type bulkUpsertRows struct {
+	rows value.Value
+	err  error
+}
+
+// BulkUpsertDataRows makes bulk upsert payload from list of structs value.
+// Rows split to chunks by size.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertDataRows(rows value.Value) BulkUpsertData {
+	return bulkUpsertRows{
+		rows: rows,
+	}
+}
+
+// BulkUpsertRowsFromStructs makes bulk upsert payload from Go structs.
+// Names of columns defines by `sql` tag (as in ScanStruct) or by names of fields.
+// Rows split to chunks by size.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertRowsFromStructs[T any](rows []T) BulkUpsertData {
+	if len(rows) == 0 {
+		return bulkUpsertRows{
+			err: xerrors.WithStackTrace(errNoRows),
+		}
+	}
+
+	items := make([]value.Value, len(rows))
+	for i := range rows {
+		fields, err := value.StructFieldsFromGo(rows[i], "sql")
+		if err != nil {
+			return bulkUpsertRows{
+				err: xerrors.WithStackTrace(fmt.Errorf("row %d: %w", i, err)),
+			}
+		}
+		items[i] = value.StructValue(fields...)
+	}
+
+	return bulkUpsertRows{
+		rows: value.ListValue(items...),
+	}
+}
+
+func (d bulkUpsertRows) ToYDB(
+	a *allocator.Allocator, tableName string, chunkSize int,
+) ([]*Ydb_Table.BulkUpsertRequest, error) {
+	if d.err != nil {
+		return nil, xerrors.WithStackTrace(d.err)
+	}
+
+	chunks := splitRows(value.ToYDB(d.rows, a), chunkSize)
+	requests := make([]*Ydb_Table.BulkUpsertRequest, len(chunks))
+	for i, chunk := range chunks {
+		requests[i] = &Ydb_Table.BulkUpsertRequest{
+			Table: tableName,
+			Rows:  chunk,
+		}
+	}
+
+	return requests, nil
+}
+
+func splitRows(rows *Ydb.TypedValue, chunkSize int) []*Ydb.TypedValue {
+	if chunkSize <= 0 || proto.Size(rows) <= chunkSize {
+		return []*Ydb.TypedValue{rows}
+	}
+
+	var (
+		items  = rows.GetValue().GetItems()
+		chunks []*Ydb.TypedValue
+		begin  int
+		size   int
+	)
+	appendChunk := func(end int) {
+		chunks = append(chunks, &Ydb.TypedValue{
+			Type:  rows.GetType(),
+			Value: &Ydb.Value{Items: items[begin:end]},
+		})
+	}
+	for i, item := range items {
+		itemSize := proto.Size(item)
+		if i > begin && size+itemSize > chunkSize {
+			appendChunk(i)
+			begin, size = i, 0
+		}
+		size += itemSize
+	}
+	appendChunk(len(items))
+
+	return chunks
+}
+
+type bulkUpsertCsv struct {
+	data     []byte
+	settings *Ydb_Formats.CsvSettings
+}
+
+// CsvFormatOption is an option of CSV payload of bulk upsert
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type CsvFormatOption func(settings *Ydb_Formats.CsvSettings)
+
+// WithCsvHeader marks first not skipped line as header with names of columns
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCsvHeader() CsvFormatOption {
+	return func(settings *Ydb_Formats.CsvSettings) {
+		settings.Header = true
+	}
+}
+
+// WithCsvNullValue defines string value which would be interpreted as NULL
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCsvNullValue(null []byte) CsvFormatOption {
+	return func(settings *Ydb_Formats.CsvSettings) {
+		settings.NullValue = null
+	}
+}
+
+// WithCsvDelimiter defines fields delimiter ("," by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCsvDelimiter(delimiter []byte) CsvFormatOption {
+	return func(settings *Ydb_Formats.CsvSettings) {
+		settings.Delimiter = delimiter
+	}
+}
+
+// WithCsvSkipRows defines count of rows which skips before CSV data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCsvSkipRows(skipRows uint32) CsvFormatOption {
+	return func(settings *Ydb_Formats.CsvSettings) {
+		settings.SkipRows = skipRows
+	}
+}
+
+// BulkUpsertDataCsv makes bulk upsert payload from CSV data.
+// Data split to chunks by lines, header line (if defined) repeats in each chunk.
+// Values with line breaks inside of quotes are not supported for data bigger than chunk size.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertDataCsv(data []byte, opts ...CsvFormatOption) BulkUpsertData {
+	settings := &Ydb_Formats.CsvSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(settings)
+		}
+	}
+
+	return bulkUpsertCsv{
+		data:     data,
+		settings: settings,
+	}
+}
+
+func (d bulkUpsertCsv) ToYDB(
+	_ *allocator.Allocator, tableName string, chunkSize int,
+) ([]*Ydb_Table.BulkUpsertRequest, error) {
+	request := func(data []byte, settings *Ydb_Formats.CsvSettings) *Ydb_Table.BulkUpsertRequest {
+		return &Ydb_Table.BulkUpsertRequest{
+			Table: tableName,
+			DataFormat: &Ydb_Table.BulkUpsertRequest_CsvSettings{
+				CsvSettings: settings,
+			},
+			Data: data,
+		}
+	}
+
+	if chunkSize <= 0 || len(d.data) <= chunkSize {
+		return []*Ydb_Table.BulkUpsertRequest{request(d.data, d.settings)}, nil
+	}
+
+	// skipped rows and header processes on client side for split of data
+	body := d.data
+	for i := uint32(0); i < d.settings.GetSkipRows(); i++ {
+		_, body = cutLine(body)
+	}
+	var header []byte
+	if d.settings.GetHeader() {
+		header, body = cutLine(body)
+	}
+
+	settings := &Ydb_Formats.CsvSettings{
+		Delimiter: d.settings.GetDelimiter(),
+		NullValue: d.settings.GetNullValue(),
+		Header:    d.settings.GetHeader(),
+	}
+
+	var requests []*Ydb_Table.BulkUpsertRequest
+	for len(body) > 0 {
+		var chunk []byte
+		chunk, body = cutLines(body, chunkSize-len(header))
+		requests = append(requests, request(append(append(make([]byte, 0, len(header)+len(chunk)), header...),
+			chunk...), settings))
+	}
+
+	return requests, nil
+}
+
+// cutLine cuts first line (with line break) from data
+func cutLine(data []byte) (line, tail []byte) {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return data[:i+1], data[i+1:]
+	}
+
+	return data, nil
+}
+
+// cutLines cuts whole lines with size up to limit from data. Line longer than limit cuts as is
+func cutLines(data []byte, limit int) (lines, tail []byte) {
+	if len(data) <= limit {
+		return data, nil
+	}
+	if i := bytes.LastIndexByte(data[:max(limit, 0)], '\n'); i >= 0 {
+		return data[:i+1], data[i+1:]
+	}
+
+	return cutLine(data)
+}
+
+type bulkUpsertArrow struct {
+	data     []byte
+	settings *Ydb_Formats.ArrowBatchSettings
+}
+
+// ArrowFormatOption is an option of Apache Arrow payload of bulk upsert
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ArrowFormatOption func(settings *Ydb_Formats.ArrowBatchSettings)
+
+// WithArrowSchema defines serialized Apache Arrow schema of record batch
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithArrowSchema(schema []byte) ArrowFormatOption {
+	return func(settings *Ydb_Formats.ArrowBatchSettings) {
+		settings.Schema = schema
+	}
+}
+
+// BulkUpsertDataArrow makes bulk upsert payload from serialized Apache Arrow record batch.
+// Parquet files should be converted to Arrow record batches before upsert.
+// Arrow payload is not split to chunks and sends with single request.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertDataArrow(data []byte, opts ...ArrowFormatOption) BulkUpsertData {
+	settings := &Ydb_Formats.ArrowBatchSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(settings)
+		}
+	}
+
+	return bulkUpsertArrow{
+		data:     data,
+		settings: settings,
+	}
+}
+
+func (d bulkUpsertArrow) ToYDB(
+	_ *allocator.Allocator, tableName string, _ int,
+) ([]*Ydb_Table.BulkUpsertRequest, error) {
+	return []*Ydb_Table.BulkUpsertRequest{
+		{
+			Table: tableName,
+			DataFormat: &Ydb_Table.BulkUpsertRequest_ArrowBatchSettings{
+				ArrowBatchSettings: d.settings,
+			},
+			Data: d.data,
+		},
+	}, nil
+}
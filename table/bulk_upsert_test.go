@@ -0,0 +1,95 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+)
+
+func TestBulkUpsertRowsFromStructs(t *testing.T) {
+	type row struct {
+		ID      uint64 `sql:"id"`
+		Payload string `sql:"payload"`
+		Skipped string `sql:"-"`
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	rows := make([]row, 100)
+	for i := range rows {
+		rows[i] = row{ID: uint64(i), Payload: "0123456789"}
+	}
+
+	t.Run("SingleChunk", func(t *testing.T) {
+		requests, err := BulkUpsertRowsFromStructs(rows).ToYDB(a, "/db/table", 0)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		require.Equal(t, "/db/table", requests[0].GetTable())
+		require.Len(t, requests[0].GetRows().GetValue().GetItems(), len(rows))
+
+		members := requests[0].GetRows().GetType().GetListType().GetItem().GetStructType().GetMembers()
+		require.Len(t, members, 2)
+		require.Equal(t, "id", members[0].GetName())
+		require.Equal(t, "payload", members[1].GetName())
+	})
+
+	t.Run("Chunks", func(t *testing.T) {
+		requests, err := BulkUpsertRowsFromStructs(rows).ToYDB(a, "/db/table", 200)
+		require.NoError(t, err)
+		require.Greater(t, len(requests), 1)
+
+		count := 0
+		for _, request := range requests {
+			require.NotNil(t, request.GetRows().GetType().GetListType())
+			count += len(request.GetRows().GetValue().GetItems())
+		}
+		require.Equal(t, len(rows), count)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := BulkUpsertRowsFromStructs([]row{}).ToYDB(a, "/db/table", 0)
+		require.ErrorIs(t, err, errNoRows)
+	})
+}
+
+func TestBulkUpsertDataCsv(t *testing.T) {
+	data := []byte("# comment\nid,value\n1,a\n2,b\n3,c\n")
+
+	t.Run("SingleChunk", func(t *testing.T) {
+		requests, err := BulkUpsertDataCsv(data, WithCsvSkipRows(1), WithCsvHeader()).ToYDB(nil, "/db/table", 0)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		require.Equal(t, data, requests[0].GetData())
+		require.EqualValues(t, 1, requests[0].GetCsvSettings().GetSkipRows())
+		require.True(t, requests[0].GetCsvSettings().GetHeader())
+	})
+
+	t.Run("Chunks", func(t *testing.T) {
+		requests, err := BulkUpsertDataCsv(data,
+			WithCsvSkipRows(1),
+			WithCsvHeader(),
+			WithCsvDelimiter([]byte(",")),
+		).ToYDB(nil, "/db/table", 18)
+		require.NoError(t, err)
+
+		var chunks []string
+		for _, request := range requests {
+			require.Zero(t, request.GetCsvSettings().GetSkipRows())
+			require.True(t, request.GetCsvSettings().GetHeader())
+			require.Equal(t, []byte(","), request.GetCsvSettings().GetDelimiter())
+			chunks = append(chunks, string(request.GetData()))
+		}
+		require.Equal(t, []string{"id,value\n1,a\n2,b\n", "id,value\n3,c\n"}, chunks)
+	})
+}
+
+func TestBulkUpsertDataArrow(t *testing.T) {
+	requests, err := BulkUpsertDataArrow([]byte("batch"), WithArrowSchema([]byte("schema"))).ToYDB(nil, "/db/table", 1)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, []byte("batch"), requests[0].GetData())
+	require.Equal(t, []byte("schema"), requests[0].GetArrowBatchSettings().GetSchema())
+}
@@ -601,4 +601,5 @@ const (
 	ChangefeedFormatUnspecified         = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_UNSPECIFIED)
 	ChangefeedFormatJSON                = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_JSON)
 	ChangefeedFormatDynamoDBStreamsJSON = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_DYNAMODB_STREAMS_JSON)
+	ChangefeedFormatDebeziumJSON        = ChangefeedFormat(Ydb_Table.ChangefeedFormat_FORMAT_DEBEZIUM_JSON)
 )
@@ -1,9 +1,12 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
@@ -790,6 +793,95 @@ func WithDropTimeToLive() AlterTableOption {
 	return dropTimeToLive{}
 }
 
+type (
+	ChangefeedDesc   Ydb_Table.Changefeed
+	ChangefeedOption func(*ChangefeedDesc)
+)
+
+// WithChangefeedRetentionPeriod defines retention period of changefeed records
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedRetentionPeriod(retentionPeriod time.Duration) ChangefeedOption {
+	return func(d *ChangefeedDesc) {
+		d.RetentionPeriod = durationpb.New(retentionPeriod)
+	}
+}
+
+// WithChangefeedVirtualTimestamps enables virtual timestamps of changefeed records
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedVirtualTimestamps() ChangefeedOption {
+	return func(d *ChangefeedDesc) {
+		d.VirtualTimestamps = true
+	}
+}
+
+// WithChangefeedResolvedTimestamps enables resolved timestamps (heartbeats) of changefeed with interval
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedResolvedTimestamps(interval time.Duration) ChangefeedOption {
+	return func(d *ChangefeedDesc) {
+		d.ResolvedTimestampsInterval = durationpb.New(interval)
+	}
+}
+
+// WithChangefeedInitialScan enables initial scan of table data into changefeed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithChangefeedInitialScan() ChangefeedOption {
+	return func(d *ChangefeedDesc) {
+		d.InitialScan = true
+	}
+}
+
+type addChangefeed struct {
+	name   string
+	mode   ChangefeedMode
+	format ChangefeedFormat
+	opts   []ChangefeedOption
+}
+
+func (c addChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	changefeed := &Ydb_Table.Changefeed{
+		Name:   c.name,
+		Mode:   Ydb_Table.ChangefeedMode_Mode(c.mode),
+		Format: Ydb_Table.ChangefeedFormat_Format(c.format),
+	}
+	for _, opt := range c.opts {
+		if opt != nil {
+			opt((*ChangefeedDesc)(changefeed))
+		}
+	}
+	d.AddChangefeeds = append(d.AddChangefeeds, changefeed)
+}
+
+// WithAddChangefeed adds changefeed to table in AlterTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAddChangefeed(
+	name string, mode ChangefeedMode, format ChangefeedFormat, opts ...ChangefeedOption,
+) AlterTableOption {
+	return addChangefeed{
+		name:   name,
+		mode:   mode,
+		format: format,
+		opts:   opts,
+	}
+}
+
+type dropChangefeed string
+
+func (name dropChangefeed) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	d.DropChangefeeds = append(d.DropChangefeeds, string(name))
+}
+
+// WithDropChangefeed drops changefeed of table in AlterTable request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDropChangefeed(name string) AlterTableOption {
+	return dropChangefeed(name)
+}
+
 type (
 	CopyTableDesc   Ydb_Table.CopyTableRequest
 	CopyTableOption func(*CopyTableDesc)
@@ -980,8 +1072,57 @@ type (
 	BulkUpsertOption interface {
 		ApplyBulkUpsertOption() []grpc.CallOption
 	}
+
+	// BulkUpsertChunkSizeOption limits size of payload of single bulk upsert request
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	BulkUpsertChunkSizeOption int
+)
+
+// DefaultBulkUpsertChunkSize is a default size limit of payload of single bulk upsert request of table.Client
+const DefaultBulkUpsertChunkSize = 8 << 20
+
+func (BulkUpsertChunkSizeOption) ApplyBulkUpsertOption() []grpc.CallOption {
+	return nil
+}
+
+// WithBulkUpsertChunkSize limits size of payload of single request in table.Client.BulkUpsert.
+// Zero or negative size disables splitting of payload.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBulkUpsertChunkSize(size int) BulkUpsertChunkSizeOption {
+	return BulkUpsertChunkSizeOption(size)
+}
+
+type (
+	DeleteRowsDesc struct {
+		BatchSize int
+	}
+	DeleteRowsOption interface {
+		ApplyDeleteRowsOption(desc *DeleteRowsDesc)
+	}
+
+	// DeleteRowsBatchSizeOption limits count of keys which deletes in single transaction
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DeleteRowsBatchSizeOption int
 )
 
+// DefaultDeleteRowsBatchSize is a default count of keys which deletes in single transaction of table.Session.DeleteRows
+const DefaultDeleteRowsBatchSize = 1000
+
+func (size DeleteRowsBatchSizeOption) ApplyDeleteRowsOption(desc *DeleteRowsDesc) {
+	desc.BatchSize = int(size)
+}
+
+// WithDeleteRowsBatchSize limits count of keys which deletes in single transaction in table.Session.DeleteRows.
+// Zero or negative size disables splitting of keys.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDeleteRowsBatchSize(size int) DeleteRowsBatchSizeOption {
+	return DeleteRowsBatchSizeOption(size)
+}
+
 type (
 	ExecuteScanQueryDesc   Ydb_Table.ExecuteScanQueryRequest
 	ExecuteScanQueryOption interface {
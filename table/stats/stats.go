@@ -17,3 +17,6 @@ type CompilationStats = stats.CompilationStats
 type TableAccess = stats.TableAccess
 
 type OperationStats = stats.OperationStats
+
+// ProgressStats holds intermediate statistics of query execution
+type ProgressStats = stats.ProgressStats
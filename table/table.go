@@ -68,6 +68,13 @@ type Client interface {
 	// If op TxOperation return non nil - transaction will be rollback
 	// Warning: if context without deadline or cancellation func than DoTx can run indefinitely
 	DoTx(ctx context.Context, op TxOperation, opts ...Option) error
+
+	// BulkUpsert upserts data into table without session.
+	// Data split to chunks (see options.WithBulkUpsertChunkSize), each chunk is retried independently.
+	// Upserts of chunks are not atomic: on error part of data may be already upserted.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	BulkUpsert(ctx context.Context, table string, data BulkUpsertData, opts ...options.BulkUpsertOption) error
 }
 
 type SessionStatus = string
@@ -193,6 +200,20 @@ type Session interface {
 		opts ...options.ReadRowsOption,
 	) (_ result.Result, err error)
 
+	// DeleteRows deletes rows of table at given path by primary keys.
+	// Keys must be a List of Struct values with primary key columns (for example, made with
+	// types.ListValue(types.StructValue(...), ...)). Keys deletes in batches
+	// (see options.WithDeleteRowsBatchSize), each batch deletes in separated transaction,
+	// so DeleteRows is not atomic, but idempotent and may be retried entirely.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	DeleteRows(
+		ctx context.Context,
+		path string,
+		keys value.Value,
+		opts ...options.DeleteRowsOption,
+	) (err error)
+
 	BeginTransaction(
 		ctx context.Context,
 		tx *TransactionSettings,
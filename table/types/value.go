@@ -93,7 +93,12 @@ func IntervalValueFromDuration(v time.Duration) Value {
 	return value.IntervalValueFromDuration(v)
 }
 
-// TzDateValueFromTime makes TzDate value from time.Time
+// TzDateValueFromTime makes TzDate value from time.Time with preserving of time location
+//
+// Time in location without valid IANA name (time.Local or time.FixedZone) converts to location "Etc/GMT±h"
+// with the same whole-hour offset. Time with not whole-hour offset (such as +05:30) can't be represented
+// with IANA name without change of wall clock, so value keeps numeric offset and rejects by YDB server.
+// IANA time locations loads from tzdata of host, import time/tzdata if host has no tzdata.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -101,7 +106,12 @@ func TzDateValueFromTime(t time.Time) Value {
 	return value.TzDateValueFromTime(t)
 }
 
-// TzDatetimeValueFromTime makes TzDatetime value from time.Time
+// TzDatetimeValueFromTime makes TzDatetime value from time.Time with preserving of time location
+//
+// Time in location without valid IANA name (time.Local or time.FixedZone) converts to location "Etc/GMT±h"
+// with the same whole-hour offset. Time with not whole-hour offset (such as +05:30) can't be represented
+// with IANA name without change of wall clock, so value keeps numeric offset and rejects by YDB server.
+// IANA time locations loads from tzdata of host, import time/tzdata if host has no tzdata.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -109,7 +119,12 @@ func TzDatetimeValueFromTime(t time.Time) Value {
 	return value.TzDatetimeValueFromTime(t)
 }
 
-// TzTimestampValueFromTime makes TzTimestamp value from time.Time
+// TzTimestampValueFromTime makes TzTimestamp value from time.Time with preserving of time location
+//
+// Time in location without valid IANA name (time.Local or time.FixedZone) converts to location "Etc/GMT±h"
+// with the same whole-hour offset. Time with not whole-hour offset (such as +05:30) can't be represented
+// with IANA name without change of wall clock, so value keeps numeric offset and rejects by YDB server.
+// IANA time locations loads from tzdata of host, import time/tzdata if host has no tzdata.
 //
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
@@ -176,9 +191,64 @@ func ZeroValue(t Type) Value { return value.ZeroValue(t) }
 
 func OptionalValue(v Value) Value { return value.OptionalValue(v) }
 
-// Decimal supported in scanner API
+type (
+	// ValueMarshaler is the interface implemented by types that can convert themselves into YDB value.
+	// ValueMarshaler honors in ParamsBuilder (see Param(name).Marshal) and database/sql query args
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ValueMarshaler = value.ValueMarshaler
+
+	// ValueUnmarshaler is the interface implemented by types that can convert themselves from YDB value.
+	// ValueUnmarshaler honors as scan destination in table and query clients
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ValueUnmarshaler = value.ValueUnmarshaler
+)
+
+// UUIDByteOrder defines interpretation of [16]byte representation of UUID
+type UUIDByteOrder = value.UUIDByteOrder
+
+const (
+	// UUIDNativeByteOrder interprets bytes as high and low big-endian halves of YDB uint128 value.
+	// UUIDNativeByteOrder is a legacy byte order of ydb-go-sdk
+	UUIDNativeByteOrder = value.UUIDNativeByteOrder
+
+	// UUIDBigEndianByteOrder interprets bytes in RFC 4122 byte order, same as in canonical string
+	// representation of UUID and in uuid.UUID types from github.com/google/uuid
+	UUIDBigEndianByteOrder = value.UUIDBigEndianByteOrder
+)
+
+// UUIDValueWithByteOrder makes UUID value from bytes in given byte order
+func UUIDValueWithByteOrder(v [16]byte, order UUIDByteOrder) Value {
+	return value.UUIDValueWithByteOrder(v, order)
+}
+
+// NullableUUIDValueWithByteOrder makes optional UUID value from bytes in given byte order
+func NullableUUIDValueWithByteOrder(v *[16]byte, order UUIDByteOrder) Value {
+	return value.NullableUUIDValueWithByteOrder(v, order)
+}
+
+// Decimal is a native decimal type with precision and scale.
+// Decimal supported in scanner API of table and query clients, as database/sql
+// scan destination and as query parameter
 type Decimal = decimal.Decimal
 
+// ParseDecimal parses decimal from string with given precision and scale
+func ParseDecimal(s string, precision, scale uint32) (*Decimal, error) {
+	return decimal.NewFromString(s, precision, scale)
+}
+
+// DecimalFromBigInt makes decimal from unscaled integer v with given precision and scale
+func DecimalFromBigInt(v *big.Int, precision, scale uint32) *Decimal {
+	return decimal.NewFromBigInt(v, precision, scale)
+}
+
+// DecimalFromRat makes decimal from rational v with given precision and scale.
+// Digits after scale are rounded half away from zero
+func DecimalFromRat(v *big.Rat, precision, scale uint32) (*Decimal, error) {
+	return decimal.NewFromRat(v, precision, scale)
+}
+
 // DecimalValue creates decimal value of given types t and value v.
 // Note that Decimal.Bytes interpreted as big-endian int128.
 func DecimalValue(v *Decimal) Value {
@@ -440,3 +510,38 @@ func NullableDyNumberValue(v *string) Value {
 func Nullable(t Type, v interface{}) Value {
 	return value.Nullable(t, v)
 }
+
+// FormatOption is an option for Format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type FormatOption = value.FormatOption
+
+// WithFormatMaxItems limits count of rendered items of collections (list, set, dict, tuple).
+// Non-positive value means no limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFormatMaxItems(maxItems int) FormatOption { return value.WithFormatMaxItems(maxItems) }
+
+// WithFormatMaxBytes limits count of rendered bytes of string values (text, bytes, json, yson).
+// Non-positive value means no limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFormatMaxBytes(maxBytes int) FormatOption { return value.WithFormatMaxBytes(maxBytes) }
+
+// WithFormatTimeLayout defines layout for rendering of date, datetime and timestamp values
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFormatTimeLayout(layout string) FormatOption { return value.WithFormatTimeLayout(layout) }
+
+// WithFormatRedactedFields defines names of struct fields which values must be hidden
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFormatRedactedFields(names ...string) FormatOption {
+	return value.WithFormatRedactedFields(names...)
+}
+
+// Format renders value as a text in YQL-like form for debugging and logging.
+// Without options Format returns the same result as Value.Yql()
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Format(v Value, opts ...FormatOption) string { return value.Format(v, opts...) }
@@ -66,6 +66,16 @@ func TestCoordinationSemaphore(t *testing.T) {
 	}
 	fmt.Printf("semaphore my-semaphore created\n")
 
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	changes, err := s.WatchSemaphore(watchCtx, "my-semaphore")
+	if err != nil {
+		t.Fatalf("failed to watch semaphore: %v", err)
+	}
+	if change := <-changes; change.Err != nil || change.Description.Count != 0 {
+		t.Fatalf("unexpected initial state of semaphore: %+v", change)
+	}
+
 	lease, err := s.AcquireSemaphore(ctx, "my-semaphore", 10)
 	if err != nil {
 		t.Fatalf("failed to acquire semaphore: %v", err)
@@ -73,7 +83,26 @@ func TestCoordinationSemaphore(t *testing.T) {
 	defer lease.Release()
 	fmt.Printf("session 1 acquired semaphore 10\n")
 
+	if change := <-changes; change.Err != nil || !change.OwnersChanged || change.Description.Count != 10 {
+		t.Fatalf("unexpected change of semaphore: %+v", change)
+	}
+	cancelWatch()
+	fmt.Printf("session 1 watched acquire of semaphore my-semaphore\n")
+
+	events := s.Events()
+	if event := <-events; event.Type != coordination.SessionEventStart || event.SessionID != s.SessionID() {
+		t.Fatalf("unexpected session event: %+v", event)
+	}
+
 	s.Reconnect()
+	for event := range events {
+		if event.Type == coordination.SessionEventAttached {
+			break
+		}
+		if event.Type == coordination.SessionEventExpired {
+			t.Fatalf("unexpected session event: %+v", event)
+		}
+	}
 	fmt.Printf("session 1 reconnected\n")
 
 	desc, err := s.DescribeSemaphore(
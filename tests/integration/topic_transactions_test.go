@@ -15,6 +15,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -152,3 +153,27 @@ func TestWriteInTransaction(t *testing.T) {
 		t.Logf("transactions count: %v", transactionsCount)
 	})
 }
+
+func TestDoTxWithOutbox(t *testing.T) {
+	if os.Getenv("YDB_VERSION") != "nightly" && version.Lt(os.Getenv("YDB_VERSION"), "25.0") {
+		t.Skip("require enables transactions for topics")
+	}
+
+	scope := newScope(t)
+	reader := scope.TopicReader()
+
+	err := ydb.DoTxWithOutbox(scope.Ctx, scope.Driver(), scope.TopicPath(),
+		func(ctx context.Context, tx query.TxActor, outbox *ydb.Outbox) error {
+			if err := tx.Exec(ctx, "SELECT 1"); err != nil {
+				return err
+			}
+
+			return outbox.Publish(ctx, topicwriter.Message{Data: strings.NewReader("outbox")})
+		},
+	)
+	require.NoError(t, err)
+
+	mess, err := reader.ReadMessage(scope.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, "outbox", string(xtest.Must(io.ReadAll(mess))))
+}
@@ -0,0 +1,143 @@
+package ydb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+)
+
+// Service is a kind of requests limited by WithDefaultTimeouts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Service int
+
+const (
+	// ServiceQuery is a calls of query service: executing of queries, transactions and scripts with db.Query()
+	ServiceQuery Service = iota + 1
+
+	// ServiceTable is a calls of table service: executing of queries and operations with db.Table()
+	ServiceTable
+
+	// ServiceScheme is a calls of scheme service: operations with directories and permissions with db.Scheme()
+	ServiceScheme
+
+	// ServiceScripting is a calls of scripting service with db.Scripting()
+	ServiceScripting
+
+	// ServiceTopicWrite is a Write of topic writers created with db.Topic()
+	ServiceTopicWrite
+)
+
+// grpcServices maps prefixes of grpc methods to services
+var grpcServices = map[string]Service{
+	"/Ydb.Table.V1.TableService/":         ServiceTable,
+	"/Ydb.Scheme.V1.SchemeService/":       ServiceScheme,
+	"/Ydb.Scripting.V1.ScriptingService/": ServiceScripting,
+}
+
+// WithDefaultTimeouts sets default timeouts of requests for services. Timeout applies only if
+// context of request has no deadline, so forgotten deadlines do not lead to unbounded hangs.
+// Timeout of ServiceQuery limits each call of query client (Do, DoTx, Exec, Query and others,
+// Do and DoTx limits with all attempts of operation).
+// Calls of table, scheme and scripting services limits with timeout each (including reading of result streams).
+// Timeout of ServiceTopicWrite limits each Write of topic writers (including waiting for acknowledge of
+// server with topicoptions.WithWriterWaitServerAck).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultTimeouts(timeouts map[Service]time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		grpcTimeouts := make(map[string]time.Duration, len(timeouts))
+		for prefix, service := range grpcServices {
+			if timeout := timeouts[service]; timeout > 0 {
+				grpcTimeouts[prefix] = timeout
+			}
+		}
+		if len(grpcTimeouts) > 0 {
+			c.options = append(c.options, config.WithGrpcOptions(
+				grpc.WithChainUnaryInterceptor(defaultTimeoutUnaryInterceptor(grpcTimeouts)),
+				grpc.WithChainStreamInterceptor(defaultTimeoutStreamInterceptor(grpcTimeouts)),
+			))
+		}
+		if timeout := timeouts[ServiceQuery]; timeout > 0 {
+			c.queryOptions = append(c.queryOptions, queryConfig.WithDefaultTimeout(timeout))
+		}
+		if timeout := timeouts[ServiceTopicWrite]; timeout > 0 {
+			c.topicOptions = append(c.topicOptions, func(c *topic.Config) {
+				c.DefaultWriteTimeout = timeout
+			})
+		}
+
+		return nil
+	}
+}
+
+func defaultTimeout(ctx context.Context, timeouts map[string]time.Duration, method string) time.Duration {
+	if _, has := ctx.Deadline(); has {
+		return 0
+	}
+	for prefix, timeout := range timeouts {
+		if strings.HasPrefix(method, prefix) {
+			return timeout
+		}
+	}
+
+	return 0
+}
+
+func defaultTimeoutUnaryInterceptor(timeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		if timeout := defaultTimeout(ctx, timeouts, method); timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = xcontext.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func defaultTimeoutStreamInterceptor(timeouts map[string]time.Duration) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		timeout := defaultTimeout(ctx, timeouts, method)
+		if timeout <= 0 {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+
+		ctx, cancel := xcontext.WithTimeout(ctx, timeout)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			cancel()
+
+			return nil, err
+		}
+
+		return &cancelOnFinishStream{ClientStream: stream, cancel: cancel}, nil
+	}
+}
+
+// cancelOnFinishStream releases resources of timeout context after finishing of stream
+type cancelOnFinishStream struct {
+	grpc.ClientStream
+
+	cancel context.CancelFunc
+}
+
+func (s *cancelOnFinishStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.cancel()
+	}
+
+	return err
+}
@@ -0,0 +1,65 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
+)
+
+func TestWithDefaultTimeouts(t *testing.T) {
+	d := &Driver{}
+	require.NoError(t, WithDefaultTimeouts(map[Service]time.Duration{
+		ServiceScheme:     time.Minute,
+		ServiceQuery:      time.Hour,
+		ServiceTopicWrite: time.Second,
+	})(context.Background(), d))
+	require.Len(t, d.options, 1)
+	require.Greater(t, len(config.New(d.options...).GrpcDialOptions()), len(config.New().GrpcDialOptions()))
+
+	var topicConfig topic.Config
+	for _, opt := range d.topicOptions {
+		opt(&topicConfig)
+	}
+	require.Equal(t, time.Second, topicConfig.DefaultWriteTimeout)
+	require.Equal(t, time.Hour, queryConfig.New(d.queryOptions...).DefaultTimeout())
+
+	timeouts := map[string]time.Duration{
+		"/Ydb.Scheme.V1.SchemeService/": time.Minute,
+	}
+	interceptor := defaultTimeoutUnaryInterceptor(timeouts)
+	invoke := func(ctx context.Context, method string) (deadline time.Time, has bool) {
+		require.NoError(t, interceptor(ctx, method, nil, nil, nil,
+			func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				deadline, has = ctx.Deadline()
+
+				return nil
+			},
+		))
+
+		return deadline, has
+	}
+
+	t.Run("Scheme", func(t *testing.T) {
+		deadline, has := invoke(context.Background(), "/Ydb.Scheme.V1.SchemeService/DescribePath")
+		require.True(t, has)
+		require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, has := invoke(context.Background(), "/Ydb.Table.V1.TableService/CreateSession")
+		require.False(t, has)
+	})
+	t.Run("CallerDeadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour*24)
+		defer cancel()
+		deadline, has := invoke(ctx, "/Ydb.Scheme.V1.SchemeService/DescribePath")
+		require.True(t, has)
+		require.WithinDuration(t, time.Now().Add(time.Hour*24), deadline, time.Second)
+	})
+}
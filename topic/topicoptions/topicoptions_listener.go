@@ -20,3 +20,29 @@ func WithListenerAddDecoder(codec topictypes.Codec, decoderCreate CreateDecoderF
 		cfg.Decoders.AddDecoder(rawtopiccommon.Codec(codec), decoderCreate)
 	}
 }
+
+// Metadata keys of messages which republished to dead-letter topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const (
+	DeadLetterMetadataAttempts        = topiclistenerinternal.DeadLetterMetadataAttempts
+	DeadLetterMetadataError           = topiclistenerinternal.DeadLetterMetadataError
+	DeadLetterMetadataSourceTopic     = topiclistenerinternal.DeadLetterMetadataSourceTopic
+	DeadLetterMetadataSourcePartition = topiclistenerinternal.DeadLetterMetadataSourcePartition
+	DeadLetterMetadataSourceOffset    = topiclistenerinternal.DeadLetterMetadataSourceOffset
+)
+
+// WithDeadLetterPolicy set dead-letter policy for the listener.
+// If OnReadMessages handler returns error maxAttempts times for a batch - listener republishes
+// messages of the batch to targetTopic with attempts metadata (see DeadLetterMetadataAttempts and others),
+// commits the batch and continues read, so poison messages doesn't block the partition.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDeadLetterPolicy(targetTopic string, maxAttempts int) ListenerOption {
+	return func(cfg *topiclistenerinternal.StreamListenerConfig) {
+		cfg.DeadLetter = &topiclistenerinternal.DeadLetterPolicy{
+			TargetTopic: targetTopic,
+			MaxAttempts: maxAttempts,
+		}
+	}
+}
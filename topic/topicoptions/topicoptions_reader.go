@@ -110,6 +110,28 @@ func WithReaderCommitCountTrigger(count int) ReaderOption {
 	}
 }
 
+// WithReaderLagThreshold set threshold of read lag (count of not committed messages) of partition.
+// Trace callback trace.Topic.OnReaderPartitionLag called when lag of partition exceeds the threshold.
+// 0 (default) disable lag checks.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderLagThreshold(messages int64) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.LagThreshold = messages
+	}
+}
+
+// WithReaderPartitionStatusInterval set interval of requests of status of assigned partitions from server.
+// Status responses update end offsets (and lag) of partitions which don't receive new messages.
+// 0 disable requests of status, default interval is 10 seconds.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderPartitionStatusInterval(interval time.Duration) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.PartitionStatusInterval = interval
+	}
+}
+
 // WithBatchReadMinCount
 // prefer min count messages in batch
 // sometimes batch can contain fewer messages, for example if local buffer is full and SDK can't receive more messages
@@ -176,7 +198,9 @@ type CommitMode = topicreadercommon.PublicCommitMode
 
 const (
 	// CommitModeAsync - commit return true if commit success add to internal send buffer (but not sent to server)
-	// now it is grpc buffer, in feature it may be internal sdk buffer
+	// now it is grpc buffer, in feature it may be internal sdk buffer.
+	// Commits are coalesced to one server request by WithReaderCommitTimeLagTrigger and
+	// WithReaderCommitCountTrigger options
 	CommitModeAsync = topicreadercommon.CommitModeAsync // default
 
 	// CommitModeNone - reader will not be commit operation
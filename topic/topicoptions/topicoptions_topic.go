@@ -4,7 +4,9 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -47,3 +49,48 @@ func WithOperationCancelAfter(operationCancelAfter time.Duration) TopicOption {
 		config.SetOperationCancelAfter(&c.Common, operationCancelAfter)
 	}
 }
+
+// WithPanicCallback set callback which calls on panic in user handlers of topic listener.
+// Panic in handler always recovers and stops the listener with error (see ydb.IsPanicError).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPanicCallback(panicCallback func(e interface{})) TopicOption {
+	return func(c *topic.Config) {
+		config.SetPanicCallback(&c.Common, panicCallback)
+	}
+}
+
+// TopicSpec describes topics and consumers which creates by WithAutoCreate
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicSpec struct {
+	// CreateOptions are options of created topics
+	CreateOptions []CreateOption
+
+	// Consumer is settings of consumers added for readers, Name of the consumer ignored
+	Consumer topictypes.Consumer
+}
+
+// WithAutoCreate enables creation of missing topics (with spec.CreateOptions) and addition of missing consumers
+// (with spec.Consumer settings) before first connection of readers and writers of the client.
+// Useful for dev/test environments and dynamic provisioning of tenants.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAutoCreate(spec TopicSpec) TopicOption {
+	return func(c *topic.Config) {
+		c.AutoCreate = &topic.AutoCreateConfig{
+			CreateTopic: func(req *rawtopic.CreateTopicRequest) {
+				for _, opt := range spec.CreateOptions {
+					if opt != nil {
+						opt.ApplyCreateOption(req)
+					}
+				}
+			},
+			Consumer: func(consumer *rawtopic.Consumer) {
+				settings := spec.Consumer
+				settings.Name = consumer.Name
+				settings.ToRaw(consumer)
+			},
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package topicoptions
 
 import (
+	"bytes"
+	"io"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -25,6 +28,29 @@ func WithWriterAddEncoder(codec topictypes.Codec, f CreateEncoderFunc) WriterOpt
 	return topicwriterinternal.WithAddEncoder(rawtopiccommon.Codec(codec), f)
 }
 
+// CreateDictionaryEncoderFunc for create message encoders with trained dictionary
+type CreateDictionaryEncoderFunc func(writer io.Writer, dictionary []byte) (io.WriteCloser, error)
+
+// WithZstdDictionary add zstd encoder with trained dictionary to writer.
+// Dictionary improves compression ratio for small homogeneous messages.
+// SDK doesn't contain zstd implementation, createEncoder must create encoder of zstd library, for example:
+//
+//	topicoptions.WithZstdDictionary(dict, func(w io.Writer, dict []byte) (io.WriteCloser, error) {
+//		return zstd.NewWriter(w, zstd.WithEncoderDict(dict))
+//	})
+//
+// Readers must decode messages with the same dictionary.
+// Use it with WithWriterCodec(topictypes.CodecZstd) or with codec override of messages.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithZstdDictionary(dictionary []byte, createEncoder CreateDictionaryEncoderFunc) WriterOption {
+	dictionary = bytes.Clone(dictionary)
+
+	return topicwriterinternal.WithAddEncoder(rawtopiccommon.CodecZstd, func(writer io.Writer) (io.WriteCloser, error) {
+		return createEncoder(writer, dictionary)
+	})
+}
+
 // WithWriterCheckRetryErrorFunction can override default error retry policy
 // use CheckErrorRetryDecisionDefault for use default behavior for the error
 // callback func must be fast and deterministic: always result same result for same error - it can be called
@@ -208,3 +234,25 @@ func WithWriterTrace(t trace.Topic) WriterOption { //nolint:gocritic
 func WithWriterUpdateTokenInterval(interval time.Duration) WriterOption {
 	return topicwriterinternal.WithTokenUpdateInterval(interval)
 }
+
+// WithWriterSpillBuffer sets buffer for messages, which can't be put to the writer queue without waiting
+// (queue is full during outage of the server or writer wasn't connected yet). With the buffer Write doesn't block
+// and returns after put messages to the buffer, the writer replays spilled messages in order of spilling.
+// Spill buffer can't be used with sync write (see WithWriterWaitServerAck), messages of transactions
+// are never spilled.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWriterSpillBuffer(buffer topicwriter.SpillBuffer) WriterOption {
+	return topicwriterinternal.WithSpillBuffer(buffer)
+}
+
+// WithSeqNoStorage sets storage for persisting of SeqNo of last acknowledged message. Writer loads stored SeqNo
+// on start and continues numbering of messages after max of stored SeqNo and last SeqNo known by server,
+// so messages with explicit SeqNo, which were written before restart of the process, are deduplicated by server.
+// Storage is keyed by producer ID, set stable producer ID with WithWriterProducerID.
+// Failed load of SeqNo closes the writer, failed saves are retried in background.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSeqNoStorage(storage topicwriter.SeqNoStorage) WriterOption {
+	return topicwriterinternal.WithSeqNoStorage(storage)
+}
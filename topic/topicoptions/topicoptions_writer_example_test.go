@@ -1,8 +1,11 @@
 package topicoptions_test
 
 import (
+	"context"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicwriter"
 )
 
 func ExampleWithWriterCheckRetryErrorFunction() {
@@ -22,3 +25,23 @@ func ExampleWithWriterCheckRetryErrorFunction() {
 	)
 	_, _ = writer, err
 }
+
+func ExampleWithWriterSpillBuffer() {
+	ctx := context.TODO()
+	var db *ydb.Driver
+
+	buffer, err := topicwriter.NewFileSpillBuffer("/var/lib/app/topic-spill",
+		topicwriter.WithSpillMaxBytes(1024*1024*1024),
+		topicwriter.WithSpillSyncPolicy(topicwriter.SpillSyncAlways),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer buffer.Close()
+
+	writer, err := db.Topic().StartWriter("topic", topicoptions.WithWriterSpillBuffer(buffer))
+	if err != nil {
+		panic(err)
+	}
+	defer writer.Close(ctx)
+}
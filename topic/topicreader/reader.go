@@ -160,6 +160,24 @@ type Batch = topicreadercommon.PublicBatch
 // ReadBatchOption is type for options of read batch
 type ReadBatchOption = topicreaderinternal.PublicReadBatchOption
 
+// Stats contains state of partitions assigned to the reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Stats = topicreaderinternal.PublicReaderStats
+
+// PartitionStats contains read progress and lag of the partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PartitionStats = topicreaderinternal.PublicPartitionStats
+
+// Stats returns currently assigned partitions with committed, read and end offsets and read lag.
+// The method is safe for concurrent use with read and commit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Stats() Stats {
+	return r.reader.Stats()
+}
+
 // Close stop work with reader
 // return when reader complete internal works, flush commit buffer, ets
 // or when ctx cancelled
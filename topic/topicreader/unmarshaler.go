@@ -0,0 +1,55 @@
+package topicreader
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicserde"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// MetadataContentType is a key of message metadata with content type of message data
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const MetadataContentType = topicreaderinternal.MetadataContentType
+
+// Unmarshaler deserializes data of messages to values
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Unmarshaler = topicreaderinternal.PublicUnmarshaler
+
+// WithUnmarshaler sets unmarshaler of messages for Reader.Unmarshal
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUnmarshaler(unmarshaler Unmarshaler) topicreaderinternal.PublicReaderOption {
+	return topicreaderinternal.WithUnmarshaler(unmarshaler)
+}
+
+// JSONUnmarshaler deserializes messages with encoding/json
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func JSONUnmarshaler() Unmarshaler {
+	return topicserde.JSON{}
+}
+
+// ProtobufUnmarshaler deserializes messages to values which implement proto.Message
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ProtobufUnmarshaler() Unmarshaler {
+	return topicserde.Protobuf{}
+}
+
+// AvroUnmarshaler deserializes messages with avro schema. Values converts from avro through JSON representation.
+// If registry is not nil - schema of message resolves by id from header of data (Confluent wire format)
+// and schema argument may be empty.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AvroUnmarshaler(schema string, registry topictypes.SchemaRegistry) Unmarshaler {
+	return topicserde.NewAvro(schema, registry)
+}
+
+// Unmarshal reads data of message and deserializes it to dst with unmarshaler of the reader
+// (see WithUnmarshaler). Content type of message passes to unmarshaler from metadata (MetadataContentType key).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Unmarshal(mess *Message, dst interface{}) error {
+	return r.reader.UnmarshalMessage(mess, dst)
+}
@@ -0,0 +1,220 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+var errBadWorkersCount = errors.New("ydb: workers count of ProcessByKey must be greater than 0")
+
+// TopicMessageReadCommitter is interface for read and commit messages of topicreader.Reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicMessageReadCommitter interface {
+	TopicMessageReader
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+// ProcessByKey reads messages from reader and processes them by handler in workers goroutines.
+// Messages with the same key (result of keyFn) processes sequentially in order of reading by the same worker,
+// messages with different keys may be processed concurrently.
+// Message commits only after it and all earlier messages of the partition session were processed.
+// Not processed messages of ended partition session (for example, partition reassigned to other reader)
+// are dropped without processing and commit, they will be read by the new owner of the partition.
+//
+// ProcessByKey blocks until ctx cancelled, reader failed or handler returned error, and returns the error.
+// Messages which processing not completed at the moment are not committed and will be read again
+// after reconnect of the reader.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ProcessByKey(
+	ctx context.Context,
+	reader TopicMessageReadCommitter,
+	keyFn func(mess *topicreader.Message) string,
+	workers int,
+	handler func(ctx context.Context, mess *topicreader.Message) error,
+) error {
+	if workers <= 0 {
+		return xerrors.WithStackTrace(errBadWorkersCount)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	tracker := newPartitionsTracker(reader)
+
+	queues := make([]chan *trackedMessage, workers)
+	for i := range queues {
+		queue := make(chan *trackedMessage, 1)
+		queues[i] = queue
+		g.Go(func() error {
+			for mess := range queue {
+				// messages of ended partition session (e.g. partition reassigned to other reader) are dropped
+				if !mess.partition.ended() {
+					if err := handler(ctx, mess.mess); err != nil {
+						return xerrors.WithStackTrace(err)
+					}
+				}
+				if err := tracker.done(ctx, mess); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		defer func() {
+			for _, queue := range queues {
+				close(queue)
+			}
+		}()
+
+		for {
+			mess, err := reader.ReadMessage(ctx)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			queue := queues[workerIndex(keyFn(mess), workers)]
+			select {
+			case <-ctx.Done():
+				return xerrors.WithStackTrace(ctx.Err())
+			case queue <- tracker.add(mess):
+			}
+		}
+	})
+
+	return g.Wait()
+}
+
+func workerIndex(key string, workers int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+
+	return int(h.Sum32() % uint32(workers))
+}
+
+type trackedMessage struct {
+	mess      *topicreader.Message
+	partition *partitionMessages
+	processed bool
+}
+
+type partitionMessages struct {
+	session *topicreadercommon.PartitionSession
+
+	// messages in order of reading, processed messages on head of the queue removes on commit
+	messages []*trackedMessage
+
+	// committing is true while one of workers commits processed messages of the partition
+	committing bool
+}
+
+func (p *partitionMessages) ended() bool {
+	return p.session.Context().Err() != nil
+}
+
+// popProcessed removes processed messages from head of the queue
+func (p *partitionMessages) popProcessed() (processed []*trackedMessage) {
+	for len(p.messages) > 0 && p.messages[0].processed {
+		processed = append(processed, p.messages[0])
+		p.messages[0] = nil
+		p.messages = p.messages[1:]
+	}
+
+	return processed
+}
+
+// partitionsTracker commits processed messages in order of reading within partition sessions
+type partitionsTracker struct {
+	reader TopicMessageReadCommitter
+
+	m          xsync.Mutex
+	partitions map[*topicreadercommon.PartitionSession]*partitionMessages
+}
+
+func newPartitionsTracker(reader TopicMessageReadCommitter) *partitionsTracker {
+	return &partitionsTracker{
+		reader:     reader,
+		partitions: make(map[*topicreadercommon.PartitionSession]*partitionMessages),
+	}
+}
+
+func (t *partitionsTracker) add(mess *topicreader.Message) *trackedMessage {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	session := topicreadercommon.GetCommitRange(mess).PartitionSession
+	partition, ok := t.partitions[session]
+	if !ok {
+		// new partition session usually starts after end of other sessions (rebalance of partitions)
+		for key, p := range t.partitions {
+			if p.ended() && !p.committing {
+				delete(t.partitions, key)
+			}
+		}
+		partition = &partitionMessages{session: session}
+		t.partitions[session] = partition
+	}
+
+	tracked := &trackedMessage{mess: mess, partition: partition}
+	partition.messages = append(partition.messages, tracked)
+
+	return tracked
+}
+
+// done marks message as processed and commits processed messages from head of the partition queue.
+// Only one worker commits messages of the partition at the moment (in order of reading), commits made
+// without lock of tracker. Messages of ended partition session are dropped without commit
+func (t *partitionsTracker) done(ctx context.Context, tracked *trackedMessage) error {
+	partition := tracked.partition
+
+	t.m.Lock()
+	tracked.processed = true
+	if partition.committing {
+		// processed message will be committed by worker which commits now
+		t.m.Unlock()
+
+		return nil
+	}
+	partition.committing = true
+	t.m.Unlock()
+
+	for {
+		t.m.Lock()
+		if partition.ended() {
+			partition.committing = false
+			partition.messages = nil
+			delete(t.partitions, partition.session)
+			t.m.Unlock()
+
+			return nil
+		}
+		processed := partition.popProcessed()
+		if len(processed) == 0 {
+			partition.committing = false
+			t.m.Unlock()
+
+			return nil
+		}
+		t.m.Unlock()
+
+		for _, tracked := range processed {
+			if err := t.reader.Commit(ctx, tracked.mess); err != nil {
+				t.m.WithLock(func() {
+					partition.committing = false
+				})
+
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreadercommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type readCommitterStub struct {
+	messages []*topicreader.Message
+
+	m         sync.Mutex
+	committed []int64
+}
+
+func (r *readCommitterStub) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	r.m.Lock()
+	if len(r.messages) > 0 {
+		mess := r.messages[0]
+		r.messages = r.messages[1:]
+		r.m.Unlock()
+
+		return mess, nil
+	}
+	r.m.Unlock()
+
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func (r *readCommitterStub) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.committed = append(r.committed, obj.(*topicreader.Message).Offset)
+
+	return nil
+}
+
+func (r *readCommitterStub) committedOffsets() []int64 {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	return append([]int64(nil), r.committed...)
+}
+
+// blockingCommitterStub blocks commits until release
+type blockingCommitterStub struct {
+	*readCommitterStub
+
+	release chan struct{}
+}
+
+func (r *blockingCommitterStub) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	select {
+	case <-r.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return r.readCommitterStub.Commit(ctx, obj)
+}
+
+func TestProcessByKey(t *testing.T) {
+	keys := []string{"a", "b", "a", "c", "b", "a"}
+	newSession := func() *topicreadercommon.PartitionSession {
+		return topicreadercommon.NewPartitionSession(context.Background(), "topic", 0, 0, "", 0, 0, 0)
+	}
+	newReaderWithSession := func(session *topicreadercommon.PartitionSession) *readCommitterStub {
+		reader := &readCommitterStub{}
+		for i, key := range keys {
+			reader.messages = append(reader.messages, topicreadercommon.NewPublicMessageBuilder().
+				PartitionSession(session).
+				Offset(int64(i)).
+				MessageGroupID(key).
+				Build(),
+			)
+		}
+
+		return reader
+	}
+	newReader := func() *readCommitterStub {
+		return newReaderWithSession(newSession())
+	}
+	keyFn := func(mess *topicreader.Message) string {
+		return mess.MessageGroupID
+	}
+
+	t.Run("OrderPerKeyAndCommits", func(t *testing.T) {
+		reader := newReader()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			m         sync.Mutex
+			processed = make(map[string][]int64)
+			count     int
+		)
+		err := ProcessByKey(ctx, reader, keyFn, 3, func(ctx context.Context, mess *topicreader.Message) error {
+			if mess.Offset == 0 {
+				// slow first message delays commit of next messages of the partition
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			m.Lock()
+			defer m.Unlock()
+
+			processed[mess.MessageGroupID] = append(processed[mess.MessageGroupID], mess.Offset)
+			count++
+			if count == len(keys) {
+				go func() {
+					require.Eventually(t, func() bool {
+						return len(reader.committedOffsets()) == len(keys)
+					}, time.Second, time.Millisecond)
+					cancel()
+				}()
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, map[string][]int64{"a": {0, 2, 5}, "b": {1, 4}, "c": {3}}, processed)
+		require.Equal(t, []int64{0, 1, 2, 3, 4, 5}, reader.committedOffsets())
+	})
+
+	t.Run("HandlerError", func(t *testing.T) {
+		reader := newReader()
+		testErr := errors.New("test")
+		err := ProcessByKey(context.Background(), reader, keyFn, 2,
+			func(ctx context.Context, mess *topicreader.Message) error {
+				if mess.Offset == 1 {
+					return testErr
+				}
+
+				return nil
+			},
+		)
+		require.ErrorIs(t, err, testErr)
+		require.NotContains(t, reader.committedOffsets(), int64(1))
+		for _, offset := range reader.committedOffsets() {
+			require.Less(t, offset, int64(1))
+		}
+	})
+
+	t.Run("EndedPartitionSession", func(t *testing.T) {
+		session := newSession()
+		reader := newReaderWithSession(session)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			m         sync.Mutex
+			processed []int64
+		)
+		err := ProcessByKey(ctx, reader, keyFn, 1, func(ctx context.Context, mess *topicreader.Message) error {
+			m.Lock()
+			defer m.Unlock()
+
+			processed = append(processed, mess.Offset)
+			if mess.Offset == 1 {
+				// partition reassigned to other reader while processing
+				session.Close()
+				time.AfterFunc(10*time.Millisecond, cancel)
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []int64{0, 1}, processed)
+		require.Equal(t, []int64{0}, reader.committedOffsets())
+	})
+
+	t.Run("CommitWithoutTrackerLock", func(t *testing.T) {
+		reader := &blockingCommitterStub{readCommitterStub: newReader(), release: make(chan struct{})}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// messages of keys "a" and "b" processes by different workers
+		require.NotEqual(t, workerIndex("a", 4), workerIndex("b", 4))
+
+		err := ProcessByKey(ctx, reader, keyFn, 4, func(ctx context.Context, mess *topicreader.Message) error {
+			if mess.Offset == 4 {
+				// worker of key "b" processed next message while commit of message 0 is blocked
+				close(reader.release)
+				go func() {
+					require.Eventually(t, func() bool {
+						return len(reader.committedOffsets()) == len(keys)
+					}, time.Second, time.Millisecond)
+					cancel()
+				}()
+			}
+
+			return nil
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []int64{0, 1, 2, 3, 4, 5}, reader.committedOffsets())
+	})
+
+	t.Run("BadWorkers", func(t *testing.T) {
+		require.ErrorIs(t, ProcessByKey(context.Background(), newReader(), keyFn, 0, nil), errBadWorkersCount)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicserde"
 )
 
 // Codec code for use in topics
@@ -178,3 +179,8 @@ func (p *PartitionInfo) FromRaw(raw *rawtopic.PartitionInfo) {
 	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
 	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
 }
+
+// SchemaRegistry resolves schemas of messages to ids and back, used by avro (de)serializers of topic messages
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SchemaRegistry = topicserde.PublicSchemaRegistry
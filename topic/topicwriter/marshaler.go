@@ -0,0 +1,62 @@
+package topicwriter
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicserde"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// MetadataContentType is a key of message metadata with content type of values written by WriteValues
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const MetadataContentType = topicwriterinternal.MetadataContentType
+
+// Marshaler serializes values to data of messages
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Marshaler = topicwriterinternal.PublicMarshaler
+
+// WithMarshaler sets marshaler of values for WriteValues
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMarshaler(marshaler Marshaler) topicwriterinternal.PublicWriterOption {
+	return topicwriterinternal.WithMarshaler(marshaler)
+}
+
+// JSONMarshaler serializes values with encoding/json
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func JSONMarshaler() Marshaler {
+	return topicserde.JSON{}
+}
+
+// ProtobufMarshaler serializes values which implement proto.Message
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ProtobufMarshaler() Marshaler {
+	return topicserde.Protobuf{}
+}
+
+// AvroMarshaler serializes values with avro schema. Values converts to avro through JSON representation.
+// If registry is not nil - data prefixes with zero magic byte and id of the schema from registry
+// (Confluent wire format).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AvroMarshaler(schema string, registry topictypes.SchemaRegistry) Marshaler {
+	return topicserde.NewAvro(schema, registry)
+}
+
+// WriteValues serializes values with marshaler of the writer (see WithMarshaler) and writes them as messages
+// with content type in metadata (MetadataContentType key)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *Writer) WriteValues(ctx context.Context, values ...interface{}) error {
+	messages, err := w.inner.MarshalMessages(values)
+	if err != nil {
+		return err
+	}
+
+	return w.inner.Write(ctx, messages)
+}
@@ -0,0 +1,12 @@
+package topicwriter
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+)
+
+// SeqNoStorage is a persistent storage of SeqNo of last acknowledged message of producer (e.g. file or table).
+// Writer loads SeqNo from the storage on start and saves SeqNo of acknowledged messages in background.
+// Implementation must be safe for concurrent use.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type SeqNoStorage = topicwriterinternal.PublicSeqNoStorage
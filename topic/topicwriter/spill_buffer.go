@@ -0,0 +1,75 @@
+package topicwriter
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+)
+
+type (
+	// SpillBuffer is a storage of messages, which can't be put to the writer queue without waiting:
+	// the queue is full (e.g. during short outage of the server) or writer wasn't connected yet.
+	// Writer replays spilled messages to the queue in order of spilling.
+	// Implementation must be safe for concurrent use.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	SpillBuffer = topicwriterinternal.PublicSpillBuffer
+
+	// SpilledMessage is a message stored in SpillBuffer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	SpilledMessage = topicwriterinternal.PublicSpilledMessage
+
+	// FileSpillBuffer is a SpillBuffer which stores messages on disk
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	FileSpillBuffer = topicwriterinternal.PublicFileSpillBuffer
+
+	// FileSpillBufferOption is an option of FileSpillBuffer
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	FileSpillBufferOption = topicwriterinternal.PublicFileSpillBufferOption
+
+	// SpillSyncPolicy defines when FileSpillBuffer calls fsync
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	SpillSyncPolicy = topicwriterinternal.PublicSpillSyncPolicy
+)
+
+const (
+	// SpillSyncNone doesn't call fsync, messages survive restart of process but may be lost on OS crash
+	SpillSyncNone = topicwriterinternal.PublicSpillSyncNone
+
+	// SpillSyncAlways calls fsync on every change of the buffer
+	SpillSyncAlways = topicwriterinternal.PublicSpillSyncAlways
+)
+
+// ErrSpillBufferFull returns from Write if messages can't be put to the queue and the spill buffer is full.
+// It must be checked by errors.Is
+var ErrSpillBufferFull = topicwriterinternal.PublicErrSpillBufferFull
+
+// NewFileSpillBuffer opens (or creates) spill buffer in the directory.
+// Messages left in the buffer by previous writer will be replayed by the next writer with the buffer.
+// Buffer must not be shared between several writers, close the buffer after close of the writer.
+//
+// Delivery of spilled messages is at least once: the message may be sent twice if the process
+// crashed between put the message to writer queue and remove it from the buffer.
+// Use explicit SeqNo (see topicoptions.WithWriterSetAutoSeqNo) for deduplicate the messages by server.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewFileSpillBuffer(dir string, opts ...FileSpillBufferOption) (*FileSpillBuffer, error) {
+	return topicwriterinternal.NewPublicFileSpillBuffer(dir, opts...)
+}
+
+// WithSpillMaxBytes limits size of messages in the spill buffer, default limit is 256MiB. Zero means no limit.
+// Write returns ErrSpillBufferFull if the limit exceeded.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSpillMaxBytes(maxBytes int64) FileSpillBufferOption {
+	return topicwriterinternal.WithSpillMaxBytes(maxBytes)
+}
+
+// WithSpillSyncPolicy defines fsync policy of the spill buffer, default is SpillSyncNone
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSpillSyncPolicy(policy SpillSyncPolicy) FileSpillBufferOption {
+	return topicwriterinternal.WithSpillSyncPolicy(policy)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/tx"
 )
 
 type (
@@ -45,6 +46,22 @@ func (w *Writer) Write(ctx context.Context, messages ...Message) error {
 	return w.inner.Write(ctx, messages)
 }
 
+// WriteWithTx send messages to topic within transaction (for example query.Transaction or query.TxActor).
+// Messages become visible to readers after commit of transaction only, so messages commits atomically
+// with other changes of transaction. Commit of transaction waits until all written messages are acknowledged.
+// If transaction isn't committed - messages are discarded by server.
+// Writer stays open after transaction completed and can be used with other transactions.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *Writer) WriteWithTx(ctx context.Context, transaction tx.Identifier, messages ...Message) error {
+	internalTx, err := tx.AsTransaction(transaction)
+	if err != nil {
+		return err
+	}
+
+	return w.inner.WriteWithTx(ctx, internalTx, messages)
+}
+
 // WaitInit waits until the reader is initialized
 // or an error occurs, return PublicInitialInfo and err
 func (w *Writer) WaitInit(ctx context.Context) (err error) {
@@ -468,6 +468,82 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 			}
 		}
 	}
+	{
+		h1 := t.OnSessionAttached
+		h2 := x.OnSessionAttached
+		ret.OnSessionAttached = func(c CoordinationSessionAttachedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionDetached
+		h2 := x.OnSessionDetached
+		ret.OnSessionDetached = func(c CoordinationSessionDetachedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionReconnecting
+		h2 := x.OnSessionReconnecting
+		ret.OnSessionReconnecting = func(c CoordinationSessionReconnectingInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionExpired
+		h2 := x.OnSessionExpired
+		ret.OnSessionExpired = func(c CoordinationSessionExpiredInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
 	{
 		h1 := t.OnSessionReceive
 		h2 := x.OnSessionReceive
@@ -789,6 +865,34 @@ func (t *Coordination) onSessionServerError(c CoordinationSessionServerErrorInfo
 	}
 	fn(c)
 }
+func (t *Coordination) onSessionAttached(c CoordinationSessionAttachedInfo) {
+	fn := t.OnSessionAttached
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionDetached(c CoordinationSessionDetachedInfo) {
+	fn := t.OnSessionDetached
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionReconnecting(c CoordinationSessionReconnectingInfo) {
+	fn := t.OnSessionReconnecting
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionExpired(c CoordinationSessionExpiredInfo) {
+	fn := t.OnSessionExpired
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
 func (t *Coordination) onSessionReceive(c CoordinationSessionReceiveStartInfo) func(CoordinationSessionReceiveDoneInfo) {
 	fn := t.OnSessionReceive
 	if fn == nil {
@@ -999,6 +1103,30 @@ func CoordinationOnSessionServerError(t *Coordination, failure *Ydb_Coordination
 	t.onSessionServerError(p)
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionAttached(t *Coordination, sessionID uint64) {
+	var p CoordinationSessionAttachedInfo
+	p.SessionID = sessionID
+	t.onSessionAttached(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionDetached(t *Coordination, sessionID uint64) {
+	var p CoordinationSessionDetachedInfo
+	p.SessionID = sessionID
+	t.onSessionDetached(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionReconnecting(t *Coordination, sessionID uint64) {
+	var p CoordinationSessionReconnectingInfo
+	p.SessionID = sessionID
+	t.onSessionReconnecting(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionExpired(t *Coordination, sessionID uint64) {
+	var p CoordinationSessionExpiredInfo
+	p.SessionID = sessionID
+	t.onSessionExpired(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func CoordinationOnSessionReceive(t *Coordination) func(response *Ydb_Coordination.SessionResponse, _ error) {
 	var p CoordinationSessionReceiveStartInfo
 	res := t.onSessionReceive(p)
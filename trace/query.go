@@ -10,6 +10,12 @@ import (
 
 //go:generate gtrace
 
+type (
+	queryParameters interface {
+		String() string
+	}
+)
+
 type (
 	// Query specified trace of retry call activity.
 	// gtrace:gen
@@ -73,6 +79,8 @@ type (
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnTxQueryRow func(QueryTxQueryRowStartInfo) func(QueryTxQueryRowDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnExecuteQuery func(QueryExecuteQueryStartInfo) func(info QueryExecuteQueryDoneInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnResultNew func(QueryResultNewStartInfo) func(info QueryResultNewDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnResultNextPart func(QueryResultNextPartStartInfo) func(info QueryResultNextPartDoneInfo)
@@ -374,6 +382,23 @@ type (
 		Tx    txInfo
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryExecuteQueryStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+
+		SessionID  string
+		Query      string
+		Parameters queryParameters
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	QueryExecuteQueryDoneInfo struct {
+		Error error
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryResultNewStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -411,7 +436,9 @@ type (
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryResultNextPartDoneInfo struct {
 		Stats *Ydb_TableStats.QueryStats
-		Error error
+		// RowsCount is a count of rows in result set of part
+		RowsCount int
+		Error     error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	QueryResultNextResultSetStartInfo struct {
@@ -964,6 +964,41 @@ func (t *Query) Compose(x *Query, opts ...QueryComposeOption) *Query {
 			}
 		}
 	}
+	{
+		h1 := t.OnExecuteQuery
+		h2 := x.OnExecuteQuery
+		ret.OnExecuteQuery = func(q QueryExecuteQueryStartInfo) func(QueryExecuteQueryDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(QueryExecuteQueryDoneInfo)
+			if h1 != nil {
+				r = h1(q)
+			}
+			if h2 != nil {
+				r1 = h2(q)
+			}
+			return func(info QueryExecuteQueryDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(info)
+				}
+				if r1 != nil {
+					r1(info)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnResultNew
 		h2 := x.OnResultNew
@@ -1503,6 +1538,21 @@ func (t *Query) onTxQueryRow(q QueryTxQueryRowStartInfo) func(QueryTxQueryRowDon
 	}
 	return res
 }
+func (t *Query) onExecuteQuery(q QueryExecuteQueryStartInfo) func(info QueryExecuteQueryDoneInfo) {
+	fn := t.OnExecuteQuery
+	if fn == nil {
+		return func(QueryExecuteQueryDoneInfo) {
+			return
+		}
+	}
+	res := fn(q)
+	if res == nil {
+		return func(QueryExecuteQueryDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Query) onResultNew(q QueryResultNewStartInfo) func(info QueryResultNewDoneInfo) {
 	fn := t.OnResultNew
 	if fn == nil {
@@ -1918,6 +1968,21 @@ func QueryOnTxQueryRow(t *Query, c *context.Context, call call, tx txInfo, query
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func QueryOnExecuteQuery(t *Query, c *context.Context, call call, sessionID string, query string, parameters queryParameters) func(error) {
+	var p QueryExecuteQueryStartInfo
+	p.Context = c
+	p.Call = call
+	p.SessionID = sessionID
+	p.Query = query
+	p.Parameters = parameters
+	res := t.onExecuteQuery(p)
+	return func(e error) {
+		var p QueryExecuteQueryDoneInfo
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func QueryOnResultNew(t *Query, c *context.Context, call call) func(error) {
 	var p QueryResultNewStartInfo
 	p.Context = c
@@ -1930,14 +1995,15 @@ func QueryOnResultNew(t *Query, c *context.Context, call call) func(error) {
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func QueryOnResultNextPart(t *Query, c *context.Context, call call) func(stats *Ydb_TableStats.QueryStats, _ error) {
+func QueryOnResultNextPart(t *Query, c *context.Context, call call) func(stats *Ydb_TableStats.QueryStats, rowsCount int, _ error) {
 	var p QueryResultNextPartStartInfo
 	p.Context = c
 	p.Call = call
 	res := t.onResultNextPart(p)
-	return func(stats *Ydb_TableStats.QueryStats, e error) {
+	return func(stats *Ydb_TableStats.QueryStats, rowsCount int, e error) {
 		var p QueryResultNextPartDoneInfo
 		p.Stats = stats
+		p.RowsCount = rowsCount
 		p.Error = e
 		res(p)
 	}
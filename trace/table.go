@@ -190,6 +190,8 @@ type (
 		Query       tableDataQuery
 		Parameters  tableQueryParameters
 		KeepInCache bool
+		// OverQueryService is true if data query executes with query service instead of table service
+		OverQueryService bool
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteStartInfo struct {
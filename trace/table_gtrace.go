@@ -1401,7 +1401,7 @@ func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool) func(tx txInfo, prepared bool, result tableResult, _ error) {
+func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session sessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool, overQueryService bool) func(tx txInfo, prepared bool, result tableResult, _ error) {
 	var p TableExecuteDataQueryStartInfo
 	p.Context = c
 	p.Call = call
@@ -1409,6 +1409,7 @@ func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session
 	p.Query = query
 	p.Parameters = parameters
 	p.KeepInCache = keepInCache
+	p.OverQueryService = overQueryService
 	res := t.onSessionQueryExecute(p)
 	return func(tx txInfo, prepared bool, result tableResult, e error) {
 		var p TableExecuteDataQueryDoneInfo
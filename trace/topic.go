@@ -104,6 +104,10 @@ type (
 		OnReaderReadMessages func(TopicReaderReadMessagesStartInfo) func(TopicReaderReadMessagesDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnReaderUnknownGrpcMessage func(OnReadUnknownGrpcMessageInfo)
+		// OnReaderPartitionLag called when read lag of partition exceeds threshold of the reader
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnReaderPartitionLag func(TopicReaderPartitionLagInfo)
 
 		// TopicWriterStreamLifeCycleEvents
 
@@ -175,6 +179,19 @@ type (
 		Error error
 	}
 
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TopicReaderPartitionLagInfo struct {
+		ReaderConnectionID string
+		PartitionContext   context.Context //nolint:containedctx
+		Topic              string
+		PartitionID        int64
+		PartitionSessionID int64
+		CommittedOffset    int64
+		EndOffset          int64
+		Lag                int64
+		Threshold          int64
+	}
+
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TopicReaderSendCommitMessageStartInfo struct {
 		CommitsInfo TopicReaderStreamSendCommitMessageStartMessageInfo
@@ -688,6 +688,25 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 			}
 		}
 	}
+	{
+		h1 := t.OnReaderPartitionLag
+		h2 := x.OnReaderPartitionLag
+		ret.OnReaderPartitionLag = func(t TopicReaderPartitionLagInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
 	{
 		h1 := t.OnWriterReconnect
 		h2 := x.OnWriterReconnect
@@ -1252,6 +1271,13 @@ func (t *Topic) onReaderUnknownGrpcMessage(o OnReadUnknownGrpcMessageInfo) {
 	}
 	fn(o)
 }
+func (t *Topic) onReaderPartitionLag(t1 TopicReaderPartitionLagInfo) {
+	fn := t.OnReaderPartitionLag
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
 func (t *Topic) onWriterReconnect(t1 TopicWriterReconnectStartInfo) func(TopicWriterReconnectDoneInfo) {
 	fn := t.OnWriterReconnect
 	if fn == nil {
@@ -1644,6 +1670,20 @@ func TopicOnReaderUnknownGrpcMessage(t *Topic, readerConnectionID string, e erro
 	t.onReaderUnknownGrpcMessage(p)
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TopicOnReaderPartitionLag(t *Topic, readerConnectionID string, partitionContext context.Context, topic string, partitionID int64, partitionSessionID int64, committedOffset int64, endOffset int64, lag int64, threshold int64) {
+	var p TopicReaderPartitionLagInfo
+	p.ReaderConnectionID = readerConnectionID
+	p.PartitionContext = partitionContext
+	p.Topic = topic
+	p.PartitionID = partitionID
+	p.PartitionSessionID = partitionSessionID
+	p.CommittedOffset = committedOffset
+	p.EndOffset = endOffset
+	p.Lag = lag
+	p.Threshold = threshold
+	t.onReaderPartitionLag(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func TopicOnWriterReconnect(t *Topic, writerInstanceID string, topic string, producerID string, attempt int) func(error) {
 	var p TopicWriterReconnectStartInfo
 	p.WriterInstanceID = writerInstanceID
@@ -9,12 +9,17 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"math/big"
+	"net"
 	"os"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates"
@@ -151,3 +156,73 @@ func TestWithCertificatesCached(t *testing.T) {
 		})
 	}
 }
+
+func TestWithClientInterceptors(t *testing.T) {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	var calls []string
+	d := &Driver{}
+	for _, opt := range []Option{
+		WithUnaryClientInterceptor(
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+				invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+			) error {
+				calls = append(calls, "first:"+method)
+
+				return invoker(ctx, method, req, reply, cc, opts...)
+			},
+		),
+		WithUnaryClientInterceptor(
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+				invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+			) error {
+				calls = append(calls, "second:"+method)
+
+				return invoker(ctx, method, req, reply, cc, opts...)
+			},
+		),
+		WithStreamClientInterceptor(
+			func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+				streamer grpc.Streamer, opts ...grpc.CallOption,
+			) (grpc.ClientStream, error) {
+				calls = append(calls, "stream:"+method)
+
+				return streamer(ctx, desc, cc, method, opts...)
+			},
+		),
+	} {
+		require.NoError(t, opt(context.Background(), d))
+	}
+
+	cc, err := grpc.Dial("passthrough:///bufnet", //nolint:staticcheck
+		append(config.New(d.options...).GrpcDialOptions(),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+		)...,
+	)
+	require.NoError(t, err)
+	defer cc.Close()
+
+	client := grpc_health_v1.NewHealthClient(cc)
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	stream, err := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"first:/grpc.health.v1.Health/Check",
+		"second:/grpc.health.v1.Health/Check",
+		"stream:/grpc.health.v1.Health/Watch",
+	}, calls)
+}